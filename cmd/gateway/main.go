@@ -6,14 +6,19 @@ package main
 import (
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
 	"os"
 	"path/filepath"
 
 	base58 "github.com/jbenet/go-base58"
 	"github.com/minio/cli"
 	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/auth"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -194,11 +199,18 @@ func checkCfg(ctx context.Context) (err error) {
 
 // Run starts a Minio Gateway given proper config
 func (flags GatewayFlags) Run(ctx context.Context) (err error) {
+	// minio serves on a loopback address behind the handler for the calls
+	// it has no routes for, see serveFront
+	minioAddress, err := loopbackAddress()
+	if err != nil {
+		return err
+	}
+
 	err = minio.RegisterGatewayCommand(cli.Command{
 		Name:  "storj",
 		Usage: "Storj",
 		Action: func(cliCtx *cli.Context) error {
-			return flags.action(ctx, cliCtx)
+			return flags.action(ctx, cliCtx, minioAddress)
 		},
 		HideHelpCommand: true,
 	})
@@ -217,22 +229,76 @@ func (flags GatewayFlags) Run(ctx context.Context) (err error) {
 	}
 
 	minio.Main([]string{"storj", "gateway", "storj",
-		"--address", flags.Server.Address, "--config-dir", flags.Minio.Dir, "--quiet"})
+		"--address", minioAddress, "--config-dir", flags.Minio.Dir, "--quiet"})
 	return errs.New("unexpected minio exit")
 }
 
-func (flags GatewayFlags) action(ctx context.Context, cliCtx *cli.Context) (err error) {
+func (flags GatewayFlags) action(ctx context.Context, cliCtx *cli.Context, minioAddress string) (err error) {
 	gw, err := flags.NewGateway(ctx)
 	if err != nil {
 		return err
 	}
 
+	err = flags.serveFront(gw, minioAddress)
+	if err != nil {
+		return err
+	}
+
 	minio.StartGateway(cliCtx, miniogw.Logging(gw, zap.L()))
 	return errs.New("unexpected minio exit")
 }
 
+// serveFront serves the gateway address with a miniogw.Handler, which passes
+// the requests it doesn't handle on to minio at minioAddress. The certificates
+// minio would use for TLS are used for the gateway address too.
+func (flags GatewayFlags) serveFront(gw *miniogw.Gateway, minioAddress string) error {
+	certFile := filepath.Join(flags.Minio.Dir, "certs", "public.crt")
+	keyFile := filepath.Join(flags.Minio.Dir, "certs", "private.key")
+	_, certErr := os.Stat(certFile)
+	_, keyErr := os.Stat(keyFile)
+	useTLS := certErr == nil && keyErr == nil
+
+	target := &url.URL{Scheme: "http", Host: minioAddress}
+	if useTLS {
+		target.Scheme = "https"
+	}
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	if useTLS {
+		// minio serves the certificates of the gateway address on loopback
+		proxy.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+
+	creds := auth.Credentials{AccessKey: flags.Minio.AccessKey, SecretKey: flags.Minio.SecretKey}
+	server := &http.Server{Handler: miniogw.NewHandler(zap.L(), gw, creds, proxy)}
+
+	listener, err := net.Listen("tcp", flags.Server.Address)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if useTLS {
+			err = server.ServeTLS(listener, certFile, keyFile)
+		} else {
+			err = server.Serve(listener)
+		}
+		zap.S().Fatal("gateway server stopped: ", err)
+	}()
+	return nil
+}
+
+// loopbackAddress returns a free address on the loopback interface.
+func loopbackAddress() (address string, err error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer func() { err = errs.Combine(err, listener.Close()) }()
+	return listener.Addr().String(), nil
+}
+
 // NewGateway creates a new minio Gateway
-func (flags GatewayFlags) NewGateway(ctx context.Context) (gw minio.Gateway, err error) {
+func (flags GatewayFlags) NewGateway(ctx context.Context) (gw *miniogw.Gateway, err error) {
 	access, err := setup.LoadEncryptionAccess(ctx, flags.Enc)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,153 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/linksharing"
+	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/peertls/tlsopts"
+	"storj.io/storj/pkg/process"
+)
+
+// LinkSharing defines link sharing configuration
+type LinkSharing struct {
+	Address   string `user:"true" help:"public address to listen on" devDefault:"localhost:8080" releaseDefault:":8443"`
+	CertFile  string `user:"true" help:"server certificate file, the service is served over http if empty" default:""`
+	KeyFile   string `user:"true" help:"server key file" default:""`
+	PublicURL string `user:"true" help:"public url for the server, at which the shared links are published" devDefault:"http://localhost:8080" releaseDefault:""`
+
+	TLS tlsopts.Config
+}
+
+var (
+	// Error is the default error class for the link sharing service
+	Error = errs.Class("linksharing")
+
+	rootCmd = &cobra.Command{
+		Use:   "linksharing",
+		Short: "Link Sharing Service",
+	}
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run the link sharing service",
+		RunE:  cmdRun,
+	}
+	setupCmd = &cobra.Command{
+		Use:         "setup",
+		Short:       "Create config files",
+		RunE:        cmdSetup,
+		Annotations: map[string]string{"type": "setup"},
+	}
+
+	runCfg   LinkSharing
+	setupCfg LinkSharing
+
+	confDir string
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "linksharing")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for link sharing configuration")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(setupCmd)
+	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir))
+	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.SetupMode())
+}
+
+func cmdRun(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	log := zap.L()
+
+	if runCfg.PublicURL == "" {
+		return Error.New("public-url is required")
+	}
+	if (runCfg.CertFile == "") != (runCfg.KeyFile == "") {
+		return Error.New("cert-file and key-file must be set together")
+	}
+
+	uplinkCfg := uplink.Config{}
+	uplinkCfg.Volatile.TLS.SkipPeerCAWhitelist = !runCfg.TLS.UsePeerCAWhitelist
+	uplinkCfg.Volatile.TLS.PeerCAWhitelistPath = runCfg.TLS.PeerCAWhitelistPath
+	uplinkCfg.Volatile.PeerIDVersion = runCfg.TLS.PeerIDVersions
+
+	uplk, err := uplink.NewUplink(ctx, &uplinkCfg)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, uplk.Close()) }()
+
+	handler, err := linksharing.NewHandler(log.Named("handler"), uplk, linksharing.HandlerConfig{
+		URLBase: runCfg.PublicURL,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	listener, err := net.Listen("tcp", runCfg.Address)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	server := &http.Server{Handler: handler}
+	log.Info("Starting link sharing service", zap.Stringer("address", listener.Addr()))
+
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.Shutdown(context.Background())
+	})
+	group.Go(func() error {
+		defer cancel()
+		var err error
+		if runCfg.CertFile != "" {
+			err = server.ServeTLS(listener, runCfg.CertFile, runCfg.KeyFile)
+		} else {
+			err = server.Serve(listener)
+		}
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return Error.Wrap(err)
+	})
+	return group.Wait()
+}
+
+func cmdSetup(cmd *cobra.Command, args []string) (err error) {
+	setupDir, err := filepath.Abs(confDir)
+	if err != nil {
+		return err
+	}
+
+	valid, _ := fpath.IsValidSetupDir(setupDir)
+	if !valid {
+		return fmt.Errorf("link sharing configuration already exists (%v)", setupDir)
+	}
+
+	err = os.MkdirAll(setupDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return process.SaveConfigWithAllDefaults(cmd.Flags(), filepath.Join(setupDir, "config.yaml"), nil)
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
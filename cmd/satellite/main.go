@@ -20,8 +20,10 @@ import (
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/internal/version"
 	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -71,6 +73,13 @@ var (
 		Args:  cobra.MinimumNArgs(3),
 		RunE:  cmdValueAttribution,
 	}
+	durabilityCmd = &cobra.Command{
+		Use:   "durability",
+		Short: "Generate a durability report of the stored segments",
+		Long: "Generate histograms of the healthy pieces of the remote segments per project and bucket, " +
+			"and a CSV of the segments whose redundancy margin is below --margin.",
+		RunE: cmdDurability,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -87,6 +96,19 @@ var (
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"sqlite3://$CONFDIR/master.db"`
 		Output   string `help:"destination of report output" default:""`
 	}
+	durabilityCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"sqlite3://$CONFDIR/master.db"`
+		Metainfo struct {
+			DatabaseURL string `help:"the database connection string to use" releaseDefault:"postgres://" devDefault:"bolt://$CONFDIR/pointerdb.db"`
+		}
+		Overlay struct {
+			Node struct {
+				OnlineWindow time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"1h"`
+			}
+		}
+		Margin int    `help:"segments with a redundancy margin below this are exported to the at-risk CSV" default:"5"`
+		Output string `help:"destination of the at-risk segments CSV" default:""`
+	}
 	confDir     string
 	identityDir string
 )
@@ -103,11 +125,13 @@ func init() {
 	rootCmd.AddCommand(reportsCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
+	reportsCmd.AddCommand(durabilityCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(qdiagCmd, &qdiagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeUsageCmd, &nodeUsageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(partnerAttributionCmd, &partnerAttribtionCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(durabilityCmd, &durabilityCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -289,6 +313,59 @@ func cmdValueAttribution(cmd *cobra.Command, args []string) (err error) {
 	return reports.GenerateAttributionCSV(ctx, partnerAttribtionCfg.Database, *partnerID, start, end, file)
 }
 
+func cmdDurability(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	log := zap.L()
+
+	db, err := satellitedb.New(log.Named("db"), durabilityCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	pointerDB, err := metainfo.NewStore(log.Named("metainfo:store"), durabilityCfg.Metainfo.DatabaseURL)
+	if err != nil {
+		return errs.New("error connecting to metainfo database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, pointerDB.Close())
+	}()
+
+	durability := reports.NewDurability(log.Named("durability"),
+		metainfo.NewService(log.Named("metainfo:service"), pointerDB, db.Buckets(), db.PendingObjects()),
+		overlay.NewCache(log.Named("overlay"), db.OverlayCache(), overlay.NodeSelectionConfig{
+			OnlineWindow: durabilityCfg.Overlay.Node.OnlineWindow,
+		}),
+		durabilityCfg.Margin,
+	)
+	if err := durability.Collect(ctx); err != nil {
+		return err
+	}
+
+	if err := durability.WriteHistograms(os.Stdout); err != nil {
+		return err
+	}
+
+	// send output to stdout
+	if durabilityCfg.Output == "" {
+		fmt.Println()
+		return durability.WriteAtRiskCSV(os.Stdout)
+	}
+
+	// send output to file
+	file, err := os.Create(durabilityCfg.Output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	return durability.WriteAtRiskCSV(file)
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
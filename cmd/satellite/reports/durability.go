@@ -0,0 +1,288 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reports
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error is the default error class for reports.
+	Error = errs.Class("reports error")
+
+	mon = monkit.Package()
+)
+
+// BucketDurability contains the health of the remote segments of a bucket.
+type BucketDurability struct {
+	ProjectID  string
+	BucketName string
+	// Histogram maps the number of healthy pieces to the number of segments.
+	Histogram map[int32]int64
+}
+
+// AtRiskSegment is a remote segment whose redundancy margin is below the
+// reported margin.
+type AtRiskSegment struct {
+	ProjectID        string
+	BucketName       string
+	Segment          string
+	EncryptedPath    string
+	HealthyPieces    int32
+	RedundancyMargin int32
+	Redundancy       *pb.RedundancyScheme
+}
+
+// Durability collects the health of all remote segments in metainfo.
+//
+// A piece is healthy when the node storing it is neither disqualified nor offline.
+// The redundancy margin of a segment is the number of pieces which can be lost
+// before the segment can't be recovered.
+type Durability struct {
+	log      *zap.Logger
+	metainfo *metainfo.Service
+	overlay  *overlay.Cache
+	margin   int32
+
+	// reliable caches the status of the nodes already looked up
+	reliable map[storj.NodeID]bool
+
+	Buckets []*BucketDurability
+	AtRisk  []AtRiskSegment
+
+	buckets map[[2]string]*BucketDurability
+}
+
+// NewDurability creates a durability report, which exports the segments
+// whose redundancy margin is less than margin.
+func NewDurability(log *zap.Logger, metainfo *metainfo.Service, overlay *overlay.Cache, margin int) *Durability {
+	return &Durability{
+		log:      log,
+		metainfo: metainfo,
+		overlay:  overlay,
+		margin:   int32(margin),
+		reliable: map[storj.NodeID]bool{},
+		buckets:  map[[2]string]*BucketDurability{},
+	}
+}
+
+// Collect walks all pointers in metainfo and computes the health of the remote segments.
+func (durability *Durability) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = durability.metainfo.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+				if pointer.GetRemote() == nil {
+					continue
+				}
+
+				if err := durability.add(ctx, item.Key.String(), pointer.GetRemote()); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	sort.Slice(durability.Buckets, func(i, k int) bool {
+		a, b := durability.Buckets[i], durability.Buckets[k]
+		if a.ProjectID != b.ProjectID {
+			return a.ProjectID < b.ProjectID
+		}
+		return a.BucketName < b.BucketName
+	})
+	sort.SliceStable(durability.AtRisk, func(i, k int) bool {
+		return durability.AtRisk[i].RedundancyMargin < durability.AtRisk[k].RedundancyMargin
+	})
+	return nil
+}
+
+// add records the health of a single remote segment.
+func (durability *Durability) add(ctx context.Context, path string, remote *pb.RemoteSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// paths have the format projectID/segment/bucket/encryptedPath
+	pathElements := storj.SplitPath(path)
+	if len(pathElements) < 3 {
+		durability.log.Warn("invalid segment path", zap.String("Path", path))
+		return nil
+	}
+	projectID, segment, bucketName := pathElements[0], pathElements[1], pathElements[2]
+	encryptedPath := storj.JoinPaths(pathElements[3:]...)
+
+	healthy, err := durability.countHealthy(ctx, remote.GetRemotePieces())
+	if err != nil {
+		return err
+	}
+
+	key := [2]string{projectID, bucketName}
+	bucket, ok := durability.buckets[key]
+	if !ok {
+		bucket = &BucketDurability{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+			Histogram:  map[int32]int64{},
+		}
+		durability.buckets[key] = bucket
+		durability.Buckets = append(durability.Buckets, bucket)
+	}
+	bucket.Histogram[healthy]++
+
+	margin := healthy - remote.GetRedundancy().GetMinReq()
+	if margin < durability.margin {
+		durability.AtRisk = append(durability.AtRisk, AtRiskSegment{
+			ProjectID:        projectID,
+			BucketName:       bucketName,
+			Segment:          segment,
+			EncryptedPath:    encryptedPath,
+			HealthyPieces:    healthy,
+			RedundancyMargin: margin,
+			Redundancy:       remote.GetRedundancy(),
+		})
+	}
+	return nil
+}
+
+// countHealthy returns the number of pieces stored on reliable nodes,
+// the nodes which weren't seen yet are looked up in the overlay.
+func (durability *Durability) countHealthy(ctx context.Context, pieces []*pb.RemotePiece) (healthy int32, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var unknown storj.NodeIDList
+	for _, piece := range pieces {
+		if _, ok := durability.reliable[piece.NodeId]; !ok {
+			unknown = append(unknown, piece.NodeId)
+		}
+	}
+
+	if len(unknown) > 0 {
+		badNodes, err := durability.overlay.KnownUnreliableOrOffline(ctx, unknown)
+		if err != nil {
+			return 0, Error.New("error getting node status %s", err)
+		}
+		for _, id := range unknown {
+			durability.reliable[id] = true
+		}
+		for _, id := range badNodes {
+			durability.reliable[id] = false
+		}
+	}
+
+	for _, piece := range pieces {
+		if durability.reliable[piece.NodeId] {
+			healthy++
+		}
+	}
+	return healthy, nil
+}
+
+// WriteHistograms writes the histograms of healthy pieces per bucket, followed by
+// the histogram of the whole project.
+func (durability *Durability) WriteHistograms(w io.Writer) error {
+	const padding = 3
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	fmt.Fprintln(tw, "Project ID\tBucket\tHealthy Pieces\tSegments\t")
+
+	writeHistogram := func(projectID, bucketName string, histogram map[int32]int64) {
+		var counts []int32
+		for healthy := range histogram {
+			counts = append(counts, healthy)
+		}
+		sort.Slice(counts, func(i, k int) bool { return counts[i] < counts[k] })
+		for _, healthy := range counts {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t\n", projectID, bucketName, healthy, histogram[healthy])
+		}
+	}
+
+	for i, bucket := range durability.Buckets {
+		writeHistogram(bucket.ProjectID, bucket.BucketName, bucket.Histogram)
+
+		// write the project total after its last bucket
+		if i+1 < len(durability.Buckets) && durability.Buckets[i+1].ProjectID == bucket.ProjectID {
+			continue
+		}
+		total := map[int32]int64{}
+		for _, other := range durability.Buckets {
+			if other.ProjectID != bucket.ProjectID {
+				continue
+			}
+			for healthy, segments := range other.Histogram {
+				total[healthy] += segments
+			}
+		}
+		writeHistogram(bucket.ProjectID, "*", total)
+	}
+
+	return tw.Flush()
+}
+
+// WriteAtRiskCSV writes the segments whose redundancy margin is below the margin as CSV.
+func (durability *Durability) WriteAtRiskCSV(w io.Writer) (err error) {
+	cw := csv.NewWriter(w)
+	defer func() {
+		cw.Flush()
+		err = errs.Combine(err, cw.Error())
+	}()
+
+	headers := []string{
+		"projectID",
+		"bucketName",
+		"segment",
+		"encryptedPath",
+		"healthyPieces",
+		"redundancyMargin",
+		"minRequired",
+		"repairThreshold",
+		"successThreshold",
+		"total",
+	}
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+
+	for _, segment := range durability.AtRisk {
+		record := []string{
+			segment.ProjectID,
+			segment.BucketName,
+			segment.Segment,
+			segment.EncryptedPath,
+			strconv.Itoa(int(segment.HealthyPieces)),
+			strconv.Itoa(int(segment.RedundancyMargin)),
+			strconv.Itoa(int(segment.Redundancy.GetMinReq())),
+			strconv.Itoa(int(segment.Redundancy.GetRepairThreshold())),
+			strconv.Itoa(int(segment.Redundancy.GetSuccessThreshold())),
+			strconv.Itoa(int(segment.Redundancy.GetTotal())),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reports_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/cmd/satellite/reports"
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/uplink"
+)
+
+func TestDurability(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		ul := planet.Uplinks[0]
+
+		rs := &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+
+		err := ul.UploadWithConfig(ctx, satellite, rs, "injured", "path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		// disqualify the node storing one of the pieces of the uploaded segment
+		listResponse, _, err := satellite.Metainfo.Service.List(ctx, "", "", "", true, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, listResponse, 1)
+		pointer, err := satellite.Metainfo.Service.Get(ctx, listResponse[0].GetPath())
+		require.NoError(t, err)
+		require.Equal(t, pb.Pointer_REMOTE, pointer.GetType())
+
+		_, err = satellite.DB.OverlayCache().UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       pointer.GetRemote().GetRemotePieces()[0].NodeId,
+			IsUp:         true,
+			AuditSuccess: false,
+			AuditLambda:  0,
+			AuditWeight:  1,
+			AuditDQ:      0.5,
+			UptimeLambda: 1,
+			UptimeWeight: 1,
+			UptimeDQ:     0.5,
+		})
+		require.NoError(t, err)
+
+		// disqualified nodes aren't selected for uploads
+		err = ul.UploadWithConfig(ctx, satellite, rs, "healthy", "path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		durability := reports.NewDurability(zaptest.NewLogger(t), satellite.Metainfo.Service, satellite.Overlay.Service, 2)
+		require.NoError(t, durability.Collect(ctx))
+
+		require.Len(t, durability.Buckets, 2)
+		histograms := map[string]map[int32]int64{}
+		for _, bucket := range durability.Buckets {
+			histograms[bucket.BucketName] = bucket.Histogram
+		}
+		assert.Equal(t, map[int32]int64{3: 1}, histograms["injured"])
+		assert.Equal(t, map[int32]int64{4: 1}, histograms["healthy"])
+
+		require.Len(t, durability.AtRisk, 1)
+		atRisk := durability.AtRisk[0]
+		assert.Equal(t, "injured", atRisk.BucketName)
+		assert.Equal(t, "l", atRisk.Segment)
+		assert.Equal(t, int32(3), atRisk.HealthyPieces)
+		assert.Equal(t, int32(1), atRisk.RedundancyMargin)
+
+		var histogramOutput bytes.Buffer
+		require.NoError(t, durability.WriteHistograms(&histogramOutput))
+		assert.Contains(t, histogramOutput.String(), "injured")
+		assert.Contains(t, histogramOutput.String(), "healthy")
+
+		var csvOutput bytes.Buffer
+		require.NoError(t, durability.WriteAtRiskCSV(&csvOutput))
+		records, err := csv.NewReader(&csvOutput).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, []string{atRisk.ProjectID, "injured", "l", atRisk.EncryptedPath, "3", "1", "2", "3", "4", "4"}, records[1])
+	})
+}
@@ -147,6 +147,20 @@ func printDashboard(data *pb.DashboardResponse) error {
 			return err
 		}
 
+		if disks := data.GetDisks(); len(disks) > 1 {
+			w = tabwriter.NewWriter(color.Output, 0, 0, 5, ' ', tabwriter.AlignRight)
+			fmt.Fprintf(w, "\n\t%s\t%s\t%s\t\n", color.GreenString("Available"), color.GreenString("Used"), color.GreenString("Allocated"))
+			for _, disk := range disks {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", disk.GetPath(),
+					color.WhiteString(memory.Size(disk.GetAvailableSpace()).Base10String()),
+					color.WhiteString(memory.Size(disk.GetUsedSpace()).Base10String()),
+					color.WhiteString(memory.Size(disk.GetAllocatedSpace()).Base10String()))
+			}
+			if err = w.Flush(); err != nil {
+				return err
+			}
+		}
+
 	} else {
 		color.Yellow("Loading...\n")
 	}
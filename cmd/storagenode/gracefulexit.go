@@ -0,0 +1,132 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	prompt "github.com/segmentio/go-prompt"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/transport"
+)
+
+type gracefulExitClient struct {
+	client pb.NodeGracefulExitClient
+	conn   *grpc.ClientConn
+}
+
+func dialGracefulExitClient(ctx context.Context, address string) (*gracefulExitClient, error) {
+	conn, err := transport.DialAddressInsecure(ctx, address)
+	if err != nil {
+		return &gracefulExitClient{}, err
+	}
+
+	return &gracefulExitClient{
+		client: pb.NewNodeGracefulExitClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (client *gracefulExitClient) close() error {
+	return client.conn.Close()
+}
+
+func cmdGracefulExitInit(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	client, err := dialGracefulExitClient(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing graceful exit client failed", err)
+		}
+	}()
+
+	resp, err := client.client.GetNonExitingSatellites(ctx, &pb.GetNonExitingSatellitesRequest{})
+	if err != nil {
+		return err
+	}
+	satellites := resp.GetSatellites()
+	if len(satellites) == 0 {
+		fmt.Println("No satellites available for graceful exit.")
+		return nil
+	}
+
+	choices := make([]string, 0, len(satellites))
+	for _, satellite := range satellites {
+		choices = append(choices, fmt.Sprintf("%s (%s, %s used)",
+			satellite.DomainName, satellite.NodeId, memory.Size(satellite.SpaceUsed).Base10String()))
+	}
+
+	selected := satellites[prompt.Choose("Please select the satellite you want to gracefully exit", choices)]
+
+	fmt.Println()
+	fmt.Printf("Graceful exit transfers all pieces of %s to other nodes and deletes them afterwards.\n", selected.DomainName)
+	fmt.Println("Once started, the graceful exit cannot be canceled and the node won't receive new pieces from this satellite.")
+	if !prompt.Confirm("Are you sure you want to continue? (y/n)") {
+		return nil
+	}
+
+	progress, err := client.client.InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{NodeId: selected.NodeId})
+	if err != nil {
+		return errs.New("unable to start graceful exit for %s: %v", selected.DomainName, err)
+	}
+
+	fmt.Printf("Graceful exit started for %s, use exit-status to follow its progress.\n", progress.DomainName)
+	return nil
+}
+
+func cmdGracefulExitStatus(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	client, err := dialGracefulExitClient(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.S().Debug("closing graceful exit client failed", err)
+		}
+	}()
+
+	resp, err := client.client.GetExitProgress(ctx, &pb.GetExitProgressRequest{})
+	if err != nil {
+		return err
+	}
+
+	progress := resp.GetProgress()
+	if len(progress) == 0 {
+		fmt.Println("No graceful exit in progress.")
+		return nil
+	}
+
+	color.NoColor = !useColor
+
+	w := tabwriter.NewWriter(color.Output, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Domain Name\tNode ID\tPercent Complete\tStatus")
+	for _, exit := range progress {
+		status := color.YellowString("in progress")
+		switch {
+		case exit.Finished && exit.Successful:
+			status = color.GreenString("successful")
+		case exit.Finished:
+			status = color.RedString("failed")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%.2f%%\t%s\n", exit.DomainName, exit.NodeId, exit.PercentComplete, status)
+	}
+
+	return w.Flush()
+}
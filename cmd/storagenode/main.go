@@ -67,6 +67,18 @@ var (
 		RunE:        cmdDashboard,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	gracefulExitInitCmd = &cobra.Command{
+		Use:         "exit-satellite",
+		Short:       "Initiate graceful exit",
+		RunE:        cmdGracefulExitInit,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	gracefulExitStatusCmd = &cobra.Command{
+		Use:         "exit-status",
+		Short:       "Display graceful exit status",
+		RunE:        cmdGracefulExitStatus,
+		Annotations: map[string]string{"type": "helper"},
+	}
 
 	runCfg       StorageNodeFlags
 	setupCfg     StorageNodeFlags
@@ -74,6 +86,9 @@ var (
 	dashboardCfg struct {
 		Address string `default:"127.0.0.1:7778" help:"address for dashboard service"`
 	}
+	gracefulExitCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"address of the storage node private server"`
+	}
 	defaultDiagDir string
 	confDir        string
 	identityDir    string
@@ -98,18 +113,31 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(gracefulExitInitCmd)
+	rootCmd.AddCommand(gracefulExitStatusCmd)
+	rootCmd.AddCommand(migrateStorageCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(configCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(diagCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(dashboardCmd, &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(gracefulExitInitCmd, &gracefulExitCfg, defaults, cfgstruct.ConfDir(confDir))
+	process.Bind(gracefulExitStatusCmd, &gracefulExitCfg, defaults, cfgstruct.ConfDir(confDir))
+	process.Bind(migrateStorageCmd, &migrateCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
 func databaseConfig(config storagenode.Config) storagenodedb.Config {
+	// while the data is migrated, the database in the new path is used and
+	// the pieces are read from the old path
+	dbPath := config.Storage.Path
+	if config.Storage.MigrateTo != "" {
+		dbPath = config.Storage.MigrateTo
+	}
+
 	return storagenodedb.Config{
 		Storage:  config.Storage.Path,
-		Info:     filepath.Join(config.Storage.Path, "piecestore.db"),
-		Info2:    filepath.Join(config.Storage.Path, "info.db"),
+		Info:     filepath.Join(dbPath, "piecestore.db"),
+		Info2:    filepath.Join(dbPath, "info.db"),
 		Pieces:   config.Storage.Path,
 		Kademlia: config.Kademlia.DBPath,
 	}
@@ -131,6 +159,13 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	if runCfg.Storage.MigrateTo != "" {
+		// the database is copied once, afterwards the copy is authoritative
+		if err := copyDatabase(runCfg.Storage.Path, runCfg.Storage.MigrateTo, false); err != nil {
+			return errs.New("Error copying master database on storagenode: %+v", err)
+		}
+	}
+
 	db, err := storagenodedb.New(log.Named("db"), databaseConfig(runCfg.Config))
 	if err != nil {
 		return errs.New("Error starting master database on storagenode: %+v", err)
@@ -161,6 +196,10 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return errs.New("Error creating tables for master database on storagenode: %+v", err)
 	}
 
+	if peer.Storage2.Migrator != nil {
+		go completeMigration(ctx, log, peer.Storage2.Migrator, filepath.Join(confDir, "config.yaml"), runCfg.Storage.MigrateTo)
+	}
+
 	runError := peer.Run(ctx)
 	closeError := peer.Close()
 
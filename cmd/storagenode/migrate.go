@@ -0,0 +1,283 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+const (
+	storagePathKey      = "storage.path"
+	storageMigrateToKey = "storage.migrate-to"
+)
+
+// databaseFiles are the files of the info database, the write-ahead log is
+// copied before the database itself, so that an existing database implies
+// a complete copy.
+var databaseFiles = []string{"info.db-wal", "info.db"}
+
+var (
+	migrateStorageCmd = &cobra.Command{
+		Use:   "migrate-storage",
+		Short: "Migrate the stored data to a new path",
+		Long: "Copies the pieces and the database to a new path, verifies the pieces and switches the configured storage path. " +
+			"The storage node must be stopped, unless --online is used, which migrates the data while the node is running after its next restart.",
+		RunE:        cmdMigrateStorage,
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	migrateCfg struct {
+		storagenode.Config
+
+		To     string `help:"path to migrate the stored data to" default:""`
+		Online bool   `help:"if true, the data is migrated while the storage node is running after its next restart" default:"false"`
+	}
+)
+
+func cmdMigrateStorage(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	log := zap.L()
+
+	if migrateCfg.To == "" {
+		return errs.New("the path to migrate to is required, use --to")
+	}
+
+	from, err := filepath.Abs(migrateCfg.Storage.Path)
+	if err != nil {
+		return err
+	}
+	to, err := filepath.Abs(migrateCfg.To)
+	if err != nil {
+		return err
+	}
+	if from == to {
+		return errs.New("the data is already stored in %s", to)
+	}
+
+	configFile := filepath.Join(confDir, "config.yaml")
+
+	if migrateCfg.Online {
+		err = updateConfig(configFile, map[string]string{storageMigrateToKey: to})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("The data will be migrated to %s after the storage node is restarted.\n", to)
+		return nil
+	}
+
+	// the node isn't running, so the database in the old path is authoritative
+	if err := copyDatabase(from, to, true); err != nil {
+		return err
+	}
+
+	config := migrateCfg.Config
+	config.Storage.Path = to
+	config.Storage.MigrateTo = ""
+
+	db, err := storagenodedb.New(log.Named("db"), databaseConfig(config))
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	err = db.CreateTables()
+	if err != nil {
+		return errs.New("Error creating tables for master database on storage node: %v", err)
+	}
+
+	source, err := filestore.NewAt(from)
+	if err != nil {
+		return err
+	}
+
+	migrator := pieces.NewMigrator(log.Named("migrator"), db.PieceInfo(), source, db.Pieces())
+	if err := migrator.Run(ctx); err != nil {
+		return err
+	}
+	printMigrationStats(migrator.Stats())
+
+	err = updateConfig(configFile, map[string]string{storagePathKey: to, storageMigrateToKey: ""})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("The storage path was switched to %s, the data in %s can be removed.\n", to, from)
+	return nil
+}
+
+// completeMigration switches the configured storage path once the online
+// migration of the running peer has completed.
+func completeMigration(ctx context.Context, log *zap.Logger, migrator *pieces.Migrator, configFile, to string) {
+	select {
+	case <-migrator.Completed():
+	case <-ctx.Done():
+		return
+	}
+
+	err := updateConfig(configFile, map[string]string{storagePathKey: to, storageMigrateToKey: ""})
+	if err != nil {
+		log.Error("failed to switch the storage path", zap.String("Path", to), zap.Error(err))
+		return
+	}
+	log.Info("storage path switched, restart the storage node to stop using the old path", zap.String("Path", to))
+}
+
+func printMigrationStats(stats pieces.MigrationStats) {
+	fmt.Printf("Copied %d pieces (%v), skipped %d already migrated pieces.\n", stats.Copied, memory.Size(stats.Bytes), stats.Skipped)
+	if stats.Missing > 0 {
+		fmt.Printf("%d pieces were missing.\n", stats.Missing)
+	}
+	if stats.Corrupted > 0 {
+		fmt.Printf("%d pieces were corrupted and not copied.\n", stats.Corrupted)
+	}
+}
+
+// copyDatabase copies the info database from one storage path to another.
+// Unless overwrite is set, an existing database in the destination is kept.
+func copyDatabase(from, to string, overwrite bool) error {
+	if err := os.MkdirAll(to, 0700); err != nil {
+		return err
+	}
+
+	if !overwrite {
+		if _, err := os.Stat(filepath.Join(to, "info.db")); err == nil {
+			return nil
+		}
+	}
+
+	// remove the leftovers of a previous copy, a stale write-ahead log
+	// would otherwise be applied to the new database
+	for _, name := range []string{"info.db", "info.db-wal", "info.db-shm"} {
+		if err := os.Remove(filepath.Join(to, name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	for _, name := range databaseFiles {
+		err := copyFile(filepath.Join(from, name), filepath.Join(to, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyFile copies a file by writing a temporary file and renaming it.
+func copyFile(from, to string) (err error) {
+	source, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, source.Close()) }()
+
+	tmp, err := ioutil.TempFile(filepath.Dir(to), filepath.Base(to)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tmp, source)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	err = errs.Combine(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), to)
+	}
+	if err != nil {
+		return errs.Combine(err, os.Remove(tmp.Name()))
+	}
+	return nil
+}
+
+// updateConfig atomically sets the values of keys in the config file,
+// keys with an empty value are removed.
+func updateConfig(configFile string, values map[string]string) (err error) {
+	content, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	updated := map[string]bool{}
+	var out bytes.Buffer
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		key := strings.TrimSpace(line)
+		if i := strings.Index(key, ":"); i >= 0 {
+			key = key[:i]
+		}
+
+		value, ok := values[key]
+		if !ok {
+			fmt.Fprintln(&out, line)
+			continue
+		}
+
+		updated[key] = true
+		if value != "" {
+			fmt.Fprintf(&out, "%s: %q\n", key, value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	for key, value := range values {
+		if !updated[key] && value != "" {
+			fmt.Fprintf(&out, "\n%s: %q\n", key, value)
+		}
+	}
+
+	info, err := os.Stat(configFile)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(configFile), filepath.Base(configFile)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(out.Bytes())
+	if err == nil {
+		err = tmp.Chmod(info.Mode())
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	err = errs.Combine(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), configFile)
+	}
+	if err != nil {
+		return errs.Combine(err, os.Remove(tmp.Name()))
+	}
+	return nil
+}
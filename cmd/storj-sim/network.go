@@ -24,6 +24,7 @@ import (
 	"storj.io/storj/internal/dbutil/pgutil"
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/internal/processgroup"
+	"storj.io/storj/pkg/identity"
 )
 
 const (
@@ -78,6 +79,16 @@ func networkExec(flags *Flags, args []string, command string) error {
 		if err != nil {
 			return err
 		}
+
+		// recreate the network, so the storage nodes are set up with the
+		// IDs of the satellites
+		if err := processes.Close(); err != nil {
+			return err
+		}
+		processes, err = newNetwork(flags)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = processes.Exec(ctx, command)
@@ -402,6 +413,16 @@ func newNetwork(flags *Flags) (*Processes, error) {
 	if flags.StorageNodeCount > maxStoragenodeCount {
 		return nil, fmt.Errorf("exceeded the max instance count of %d with Storage Node count of %d", maxStoragenodeCount, flags.StorageNodeCount)
 	}
+	// the storage nodes check in with the satellites whose identity exists
+	var satelliteURLs []string
+	for _, satellite := range satellites {
+		id, err := identity.NodeIDFromCertPath(filepath.Join(satellite.Directory, "identity.cert"))
+		if err != nil {
+			continue
+		}
+		satelliteURLs = append(satelliteURLs, id.String()+"@"+satellite.Address)
+	}
+
 	for i := 0; i < flags.StorageNodeCount; i++ {
 		process := processes.New(Info{
 			Name:       fmt.Sprintf("storagenode/%d", i),
@@ -434,6 +455,7 @@ func newNetwork(flags *Flags) (*Processes, error) {
 				"--server.extensions.revocation=false",
 				"--server.use-peer-ca-whitelist=false",
 				"--storage.satellite-id-restriction=false",
+				"--storage.whitelisted-satellites", strings.Join(satelliteURLs, ","),
 
 				"--version.server-address", fmt.Sprintf("http://%s/", versioncontrol.Address),
 				"--debug.addr", net.JoinHostPort(host, port(storagenodePeer, i, debugHTTP)),
@@ -5,10 +5,13 @@ package cmd
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -23,8 +26,10 @@ import (
 )
 
 var (
-	progress *bool
-	expires  *string
+	progress    *bool
+	expires     *string
+	parallelism *int
+	resume      *bool
 )
 
 func init() {
@@ -35,6 +40,8 @@ func init() {
 	}, RootCmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
 	expires = cpCmd.Flags().String("expires", "", "optional expiration date of an object. Please use format (yyyy-mm-ddThh:mm:ssZhh:mm)")
+	parallelism = cpCmd.Flags().Int("parallelism", 1, "number of segments to transfer in parallel")
+	resume = cpCmd.Flags().Bool("resume", false, "if true, continue the upload of a file where an earlier upload failed")
 }
 
 // upload transfers src from local machine to s3 compatible object dst
@@ -65,6 +72,9 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 
 	var file *os.File
 	if src.Base() == "-" {
+		if *resume {
+			return fmt.Errorf("cannot resume an upload from stdin")
+		}
 		file = os.Stdin
 	} else {
 		file, err = os.Open(src.Path())
@@ -95,13 +105,13 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 
 	defer closeProjectAndBucket(project, bucket)
 
-	reader := io.Reader(file)
+	reader := io.ReadSeeker(file)
 	var bar *progressbar.ProgressBar
 	if showProgress {
 		bar = progressbar.New64(fileInfo.Size()).SetUnits(progressbar.U_BYTES).SetWidth(80)
 		bar.ShowSpeed = true
 		bar.Start()
-		reader = bar.NewProxyReader(reader)
+		reader = &progressReader{ReadSeeker: reader, bar: bar}
 	}
 
 	opts := &libuplink.UploadOptions{}
@@ -112,8 +122,14 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 
 	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
 	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+	opts.Volatile.Parallelism = *parallelism
 
-	if err := bucket.UploadObject(ctx, dst.Path(), reader, opts); err != nil {
+	if file == os.Stdin {
+		err = bucket.UploadObject(ctx, dst.Path(), reader, opts)
+	} else {
+		err = resumeUpload(ctx, bucket, src, dst, reader, fileInfo, opts)
+	}
+	if err != nil {
 		return err
 	}
 
@@ -126,6 +142,57 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 	return nil
 }
 
+// resumeUpload uploads a local file and records the progress of the upload,
+// so that the upload can be continued with --resume when it fails.
+func resumeUpload(ctx context.Context, bucket *libuplink.Bucket, src fpath.FPath, dst fpath.FPath, reader io.ReadSeeker, fileInfo os.FileInfo, opts *libuplink.UploadOptions) error {
+	source, err := filepath.Abs(src.Path())
+	if err != nil {
+		return err
+	}
+
+	journalDir := filepath.Join(confDir, "uploads")
+	if err := os.MkdirAll(journalDir, 0700); err != nil {
+		return err
+	}
+	hash := sha256.Sum256([]byte(source + "\x00" + dst.String()))
+	journalPath := filepath.Join(journalDir, hex.EncodeToString(hash[:16])+".json")
+
+	// a new upload starts over, even when an earlier upload failed
+	if !*resume {
+		if err := os.Remove(journalPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	err = bucket.ResumeUpload(ctx, dst.Path(), reader, fileInfo, journalPath, opts)
+	if libuplink.ErrSourceChanged.Has(err) {
+		return fmt.Errorf("%s has changed since the upload began, upload it again without --resume: %v", src, err)
+	}
+	return err
+}
+
+// progressReader reports the reads of a file to a progress bar
+type progressReader struct {
+	io.ReadSeeker
+	bar *progressbar.ProgressBar
+}
+
+// Read reads from the file and advances the progress bar
+func (reader *progressReader) Read(p []byte) (n int, err error) {
+	n, err = reader.ReadSeeker.Read(p)
+	reader.bar.Add(n)
+	return n, err
+}
+
+// Seek seeks in the file and moves the progress bar to the new offset
+func (reader *progressReader) Seek(offset int64, whence int) (int64, error) {
+	position, err := reader.ReadSeeker.Seek(offset, whence)
+	if err == nil {
+		reader.bar.Set64(position)
+	}
+	return position, err
+}
+
 // download transfers s3 compatible object src to dst on local machine
 func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool) (err error) {
 	if src.IsLocal() {
@@ -153,7 +220,10 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 		return convertError(err, src)
 	}
 
-	rc, err := object.DownloadRange(ctx, 0, object.Meta.Size)
+	downloadOpts := &libuplink.DownloadOptions{}
+	downloadOpts.Volatile.Parallelism = *parallelism
+
+	rc, err := object.DownloadRangeWithOptions(ctx, 0, object.Meta.Size, downloadOpts)
 	if err != nil {
 		return err
 	}
@@ -232,7 +302,10 @@ func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err erro
 		return convertError(err, src)
 	}
 
-	rc, err := object.DownloadRange(ctx, 0, object.Meta.Size)
+	downloadOpts := &libuplink.DownloadOptions{}
+	downloadOpts.Volatile.Parallelism = *parallelism
+
+	rc, err := object.DownloadRangeWithOptions(ctx, 0, object.Meta.Size, downloadOpts)
 	if err != nil {
 		return err
 	}
@@ -260,6 +333,7 @@ func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err erro
 	}
 	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
 	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+	opts.Volatile.Parallelism = *parallelism
 	err = bucket.UploadObject(ctx, dst.Path(), reader, opts)
 	if err != nil {
 		return err
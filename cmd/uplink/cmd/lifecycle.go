@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/uplink/setup"
+)
+
+var (
+	lifecycleID                  *string
+	lifecycleExpireDays          *int
+	lifecycleAbortIncompleteDays *int
+	lifecycleRemove              *bool
+)
+
+func init() {
+	lifecycleCmd := addCmd(&cobra.Command{
+		Use:   "lifecycle",
+		Short: "Shows or changes the rules for automatically removing the objects of a bucket",
+		Long: "Without flags the lifecycle rules of the bucket are shown. With --expire-days or --abort-incomplete-days " +
+			"the rule with the given id is added or replaced, it applies to the objects below the path of the bucket. " +
+			"With --remove the rule with the given id is removed.",
+		RunE: lifecycleMain,
+	}, RootCmd)
+	lifecycleID = lifecycleCmd.Flags().String("id", "default", "id of the rule to change")
+	lifecycleExpireDays = lifecycleCmd.Flags().Int("expire-days", 0, "number of days after their creation when objects are deleted")
+	lifecycleAbortIncompleteDays = lifecycleCmd.Flags().Int("abort-incomplete-days", 0, "number of days after which uploads that were never completed are removed")
+	lifecycleRemove = lifecycleCmd.Flags().Bool("remove", false, "if true, remove the rule with the given id")
+}
+
+// lifecycleMain is the function executed when lifecycleCmd is called
+func lifecycleMain(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	dst, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	if dst.IsLocal() {
+		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, err := cfg.GetProject(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := project.Close(); err != nil {
+			fmt.Printf("error closing project: %+v\n", err)
+		}
+	}()
+
+	rules, err := project.GetBucketLifecycle(ctx, dst.Bucket(), access)
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	change := *lifecycleRemove || *lifecycleExpireDays != 0 || *lifecycleAbortIncompleteDays != 0
+	if !change {
+		for _, rule := range rules {
+			fmt.Printf("%s\tsj://%s/%s\texpire-days=%d\tabort-incomplete-days=%d\n",
+				rule.ID, dst.Bucket(), rule.Prefix, rule.ExpirationDays, rule.AbortIncompleteUploadDays)
+		}
+		return nil
+	}
+
+	// the rule with the same id is replaced
+	changed := make([]libuplink.LifecycleRule, 0, len(rules)+1)
+	for _, rule := range rules {
+		if rule.ID != *lifecycleID {
+			changed = append(changed, rule)
+		}
+	}
+	if !*lifecycleRemove {
+		changed = append(changed, libuplink.LifecycleRule{
+			ID:                        *lifecycleID,
+			Prefix:                    dst.Path(),
+			ExpirationDays:            *lifecycleExpireDays,
+			AbortIncompleteUploadDays: *lifecycleAbortIncompleteDays,
+		})
+	}
+
+	err = project.SetBucketLifecycle(ctx, dst.Bucket(), access, changed)
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	if *lifecycleRemove {
+		fmt.Printf("Lifecycle rule %s removed from bucket %s\n", *lifecycleID, dst.Bucket())
+	} else {
+		fmt.Printf("Lifecycle rule %s set for %s\n", *lifecycleID, dst)
+	}
+
+	return nil
+}
@@ -16,12 +16,17 @@ import (
 	"storj.io/storj/pkg/storj"
 )
 
+var (
+	placementFlag *string
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	mbCmd := addCmd(&cobra.Command{
 		Use:   "mb",
 		Short: "Create a new bucket",
 		RunE:  makeBucket,
 	}, RootCmd)
+	placementFlag = mbCmd.Flags().String("placement", "", "placement policy restricting the regions of the nodes storing the data of the bucket, e.g. \"EU\" or \"CH,EU\"")
 }
 
 func makeBucket(cmd *cobra.Command, args []string) error {
@@ -64,6 +69,7 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 		RedundancyScheme: cfg.GetRedundancyScheme(),
 		SegmentsSize:     cfg.GetSegmentSize(),
 	}
+	bucketCfg.Placement = *placementFlag
 
 	_, err = project.CreateBucket(ctx, dst.Bucket(), bucketCfg)
 	if err != nil {
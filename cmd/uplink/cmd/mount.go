@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build linux darwin
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/uplink/mount"
+	"storj.io/storj/uplink/setup"
+)
+
+var mountCfg struct {
+	CacheTTL  time.Duration `default:"10s" help:"how long the metadata of objects and directories is cached"`
+	FuseDebug bool          `default:"false" help:"if true, log the requests of the kernel"`
+}
+
+func init() {
+	mountCmd := addCmd(&cobra.Command{
+		Use:   "mount sj://BUCKET MOUNTPOINT",
+		Short: "Mounts a bucket as a directory",
+		Long: "Mounts a bucket as a directory until the command is interrupted or the directory is unmounted. " +
+			"Files are streamed while they are read and uploaded while they are written, " +
+			"they are committed when they are closed.",
+		RunE: mountMain,
+	}, RootCmd)
+
+	process.Bind(mountCmd, &mountCfg)
+}
+
+// mountMain is the function executed when mountCmd is called
+func mountMain(cmd *cobra.Command, args []string) (err error) {
+	if len(args) != 2 {
+		return fmt.Errorf("Bucket and mountpoint required, use format sj://bucket/ MOUNTPOINT")
+	}
+
+	ctx := process.Ctx(cmd)
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+	if src.IsLocal() || src.Path() != "" {
+		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	mountpoint := args[1]
+	if info, err := os.Stat(mountpoint); err != nil || !info.IsDir() {
+		return fmt.Errorf("Mountpoint %q is not a directory", mountpoint)
+	}
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, src.Bucket(), access)
+	if err != nil {
+		return convertError(err, src)
+	}
+	defer closeProjectAndBucket(project, bucket)
+
+	fs := mount.NewFileSystem(ctx, zap.L().Named("mount"), bucket, mountCfg.CacheTTL)
+
+	fmt.Printf("Mounting %s at %s\n", src, mountpoint)
+	return mount.Mount(ctx, fs, mountpoint, mountCfg.FuseDebug)
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/setup"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "mv",
+		Short: "Moves a Storj object to another location",
+		RunE:  moveObject,
+	}, RootCmd)
+}
+
+// moveObject moves a Storj object to another Storj location without transferring its data
+func moveObject(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No object specified for move")
+	}
+	if len(args) == 1 {
+		return fmt.Errorf("No destination specified")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() {
+		return fmt.Errorf("source must be Storj URL: %s", src)
+	}
+
+	if dst.IsLocal() {
+		return fmt.Errorf("destination must be Storj URL: %s", dst)
+	}
+
+	// if destination object name not specified, default to source object name
+	if strings.HasSuffix(dst.String(), "/") || dst.Path() == "" {
+		dst = dst.Join(src.Base())
+	}
+
+	access, err := setup.LoadEncryptionAccess(ctx, cfg.Enc)
+	if err != nil {
+		return err
+	}
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, src.Bucket(), access)
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	defer closeProjectAndBucket(project, bucket)
+
+	err = bucket.MoveObject(ctx, src.Path(), dst.Bucket(), dst.Path())
+	if err != nil {
+		if storj.ErrObjectAlreadyExists.Has(err) {
+			return fmt.Errorf("Object already exists: %s", dst)
+		}
+		if storj.ErrBucketNotFound.Has(err) {
+			return convertError(err, dst)
+		}
+		return convertError(err, src)
+	}
+
+	fmt.Printf("%s moved to %s\n", src, dst)
+
+	return nil
+}
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -13,6 +14,7 @@ import (
 
 	"storj.io/storj/internal/fpath"
 	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/linksharing"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/uplink/setup"
@@ -28,6 +30,8 @@ var shareCfg struct {
 	NotBefore         string   `help:"disallow access before this time"`
 	NotAfter          string   `help:"disallow access after this time"`
 	AllowedPathPrefix []string `help:"whitelist of bucket path prefixes to require"`
+	URL               bool     `default:"false" help:"if true, print a read only link sharing url for the path given as argument"`
+	BaseURL           string   `default:"https://link.tardigradeshare.io" help:"the url base of the link sharing service"`
 }
 
 func init() {
@@ -35,9 +39,11 @@ func init() {
 	// so that we can open projects and buckets. that pulls in so many unnecessary
 	// flags which makes figuring out the share command really hard. oh well.
 	shareCmd := addCmd(&cobra.Command{
-		Use:   "share",
+		Use:   "share [sj://BUCKET/PATH]",
 		Short: "Creates a possibly restricted api key",
-		RunE:  shareMain,
+		Long: "Creates a possibly restricted api key. With --url a link sharing url is printed, " +
+			"which allows reading the objects below the given path.",
+		RunE: shareMain,
 	}, RootCmd)
 
 	process.Bind(shareCmd, &shareCfg)
@@ -79,6 +85,24 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	var shared fpath.FPath
+	if shareCfg.URL {
+		if len(args) == 0 {
+			return errs.New("no path specified for the url, use format sj://bucket/path")
+		}
+		shared, err = fpath.New(args[0])
+		if err != nil {
+			return err
+		}
+		if shared.IsLocal() || shared.Bucket() == "" {
+			return errs.New("shared path must be remote: %q", args[0])
+		}
+
+		// a link must neither give write access nor access to other paths
+		shareCfg.Readonly = true
+		shareCfg.AllowedPathPrefix = append(shareCfg.AllowedPathPrefix, args[0])
+	}
+
 	var restrictions []libuplink.EncryptionRestriction
 	for _, path := range shareCfg.AllowedPathPrefix {
 		p, err := fpath.New(path)
@@ -153,5 +177,29 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 
 	fmt.Println("api key:", key.Serialize())
 	fmt.Println("enc ctx:", accessData)
+
+	if shareCfg.URL {
+		scope := &libuplink.Scope{
+			SatelliteAddr:    cfg.Client.SatelliteAddr,
+			APIKey:           key,
+			EncryptionAccess: access,
+		}
+		scopeData, err := scope.Serialize()
+		if err != nil {
+			return err
+		}
+
+		// a trailing slash lets the service render a listing of the prefix
+		sharedPath := shared.Path()
+		if sharedPath != "" && strings.HasSuffix(args[0], "/") {
+			sharedPath += "/"
+		}
+
+		url, err := linksharing.MakeURL(shareCfg.BaseURL, scopeData, shared.Bucket(), sharedPath)
+		if err != nil {
+			return err
+		}
+		fmt.Println("url:", url)
+	}
 	return nil
 }
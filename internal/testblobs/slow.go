@@ -77,12 +77,42 @@ func (slow *SlowBlobs) Delete(ctx context.Context, ref storage.BlobRef) error {
 	return slow.blobs.Delete(ctx, ref)
 }
 
+// Trash moves the blob with the namespace and key into the trash.
+func (slow *SlowBlobs) Trash(ctx context.Context, ref storage.BlobRef) error {
+	slow.sleep()
+	return slow.blobs.Trash(ctx, ref)
+}
+
+// OpenTrashed opens a reader for the blob with the namespace and key in the trash.
+func (slow *SlowBlobs) OpenTrashed(ctx context.Context, ref storage.BlobRef) (storage.BlobReader, error) {
+	slow.sleep()
+	return slow.blobs.OpenTrashed(ctx, ref)
+}
+
+// RestoreTrash moves all blobs of the namespace from the trash back into storage.
+func (slow *SlowBlobs) RestoreTrash(ctx context.Context, namespace []byte) error {
+	slow.sleep()
+	return slow.blobs.RestoreTrash(ctx, namespace)
+}
+
+// EmptyTrash deletes blobs which were moved to the trash before trashedBefore.
+func (slow *SlowBlobs) EmptyTrash(ctx context.Context, trashedBefore time.Time) (int64, error) {
+	slow.sleep()
+	return slow.blobs.EmptyTrash(ctx, trashedBefore)
+}
+
 // FreeSpace return how much free space left for writing.
 func (slow *SlowBlobs) FreeSpace() (int64, error) {
 	slow.sleep()
 	return slow.blobs.FreeSpace()
 }
 
+// SpaceUsed returns how much space is used by the stored blobs.
+func (slow *SlowBlobs) SpaceUsed(ctx context.Context) (int64, error) {
+	slow.sleep()
+	return slow.blobs.SpaceUsed(ctx)
+}
+
 // SetLatency configures the blob store to sleep for delay duration for all
 // operations. A zero or negative delay means no sleep.
 func (slow *SlowBlobs) SetLatency(delay time.Duration) {
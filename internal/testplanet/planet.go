@@ -276,6 +276,11 @@ func (planet *Planet) Reconnect(ctx context.Context) {
 	}
 
 	_ = group.Wait() // none of the goroutines return an error
+
+	// make sure the satellites know the current info of the storage nodes
+	for _, storageNode := range planet.StorageNodes {
+		storageNode.Contact.Chore.Loop.TriggerWait()
+	}
 }
 
 // StopPeer stops a single peer in the planet
@@ -64,9 +64,6 @@ func Run(t *testing.T, config Config, test func(t *testing.T, ctx *testcontext.C
 
 			planet.Start(ctx)
 
-			// make sure nodes are refreshed in db
-			planet.Satellites[0].Discovery.Service.Refresh.TriggerWait()
-
 			test(t, ctx, planet)
 		})
 	}
@@ -16,7 +16,6 @@ import (
 	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/datarepair/repairer"
-	"storj.io/storj/pkg/discovery"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/peertls/extensions"
@@ -25,6 +24,9 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/marketingweb"
 	"storj.io/storj/satellite/metainfo"
@@ -123,12 +125,6 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 					UptimeReputationDQ:           0.6,
 				},
 			},
-			Discovery: discovery.Config{
-				DiscoveryInterval:  1 * time.Second,
-				RefreshInterval:    1 * time.Second,
-				RefreshLimit:       100,
-				RefreshConcurrency: 2,
-			},
 			Metainfo: metainfo.Config{
 				DatabaseURL:          "bolt://" + filepath.Join(storageDir, "pointers.db"),
 				MinRemoteSegmentSize: 0, // TODO: fix tests to work with 1024
@@ -166,6 +162,23 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MinBytesPerSecond:  1 * memory.KB,
 				MinDownloadTimeout: 5 * time.Second,
 			},
+			GarbageCollection: gc.Config{
+				Interval:          1 * time.Minute,
+				Active:            true,
+				InitialPieces:     10,
+				FalsePositiveRate: 0.1,
+				ConcurrentSends:   1,
+			},
+			GracefulExit: gracefulexit.Config{
+				ChoreInterval:                1 * time.Minute,
+				ChoreBatchSize:               10,
+				EndpointBatchSize:            100,
+				MaxFailuresPerPiece:          3,
+				OverallMaxFailuresPercentage: 10,
+			},
+			Lifecycle: lifecycle.Config{
+				Interval: 1 * time.Minute,
+			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
 			},
@@ -20,10 +20,13 @@ import (
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trash"
 	"storj.io/storj/storagenode/vouchers"
 )
 
@@ -108,6 +111,10 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 			Collector: collector.Config{
 				Interval: time.Minute,
 			},
+			Trash: trash.Config{
+				Interval:  time.Minute,
+				Retention: time.Hour,
+			},
 			Console: consoleserver.Config{
 				Address:   "127.0.0.1:0",
 				StaticDir: filepath.Join(developmentRoot, "web/operator/"),
@@ -128,6 +135,13 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 			Vouchers: vouchers.Config{
 				Interval: time.Hour,
 			},
+			Contact: contact.Config{
+				Interval: time.Hour,
+			},
+			GracefulExit: gracefulexit.Config{
+				ChoreInterval:     time.Minute,
+				TransferBatchSize: 10,
+			},
 			Version: planet.NewVersionConfig(),
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
@@ -78,9 +78,6 @@ func TestDownloadWithSomeNodesOffline(t *testing.T) {
 		ul := planet.Uplinks[0]
 		satellite := planet.Satellites[0]
 
-		// stop discovery service so that we do not get a race condition when we delete nodes from overlay cache
-		satellite.Discovery.Service.Discovery.Stop()
-
 		testData := testrand.Bytes(memory.MiB)
 
 		err := ul.UploadWithConfig(ctx, satellite, &uplink.RSConfig{
@@ -163,6 +160,9 @@ func (mock *piecestoreMock) Delete(ctx context.Context, delete *pb.PieceDeleteRe
 func (mock *piecestoreMock) Retain(ctx context.Context, retain *pb.RetainRequest) (_ *pb.RetainResponse, err error) {
 	return nil, nil
 }
+func (mock *piecestoreMock) RestoreTrash(ctx context.Context, restoreTrash *pb.RestoreTrashRequest) (_ *pb.RestoreTrashResponse, err error) {
+	return nil, nil
+}
 
 func TestDownloadFromUnresponsiveNode(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
@@ -6,6 +6,7 @@ package uplink
 import (
 	"context"
 	"io"
+	"os"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -25,6 +26,11 @@ type Bucket struct {
 	bucket   storj.Bucket
 	metainfo *kvmetainfo.DB
 	streams  streams.Store
+	project  *Project
+	access   *EncryptionAccess
+
+	maxInlineSize int64
+	maxPackedSize int64
 }
 
 // TODO: move the object related OpenObject to object.go
@@ -38,10 +44,28 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 		return nil, err
 	}
 
+	return b.newObject(info), nil
+}
+
+// OpenObjectVersion returns an Object handle for a version of an object, if
+// authorized. The version ids of an object are returned by ListObjectVersions.
+func (b *Bucket) OpenObjectVersion(ctx context.Context, path storj.Path, versionID string) (o *Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := b.metainfo.GetObjectVersion(ctx, b.Name, path, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.newObject(info), nil
+}
+
+func (b *Bucket) newObject(info storj.Object) *Object {
 	return &Object{
 		Meta: ObjectMeta{
 			Bucket:      info.Bucket.Name,
 			Path:        info.Path,
+			VersionID:   info.VersionID,
 			IsPrefix:    info.IsPrefix,
 			ContentType: info.ContentType,
 			Metadata:    info.Metadata,
@@ -61,8 +85,8 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 			},
 		},
 		metainfoDB: b.metainfo,
-		streams:    b.streams,
-	}, nil
+		bucket:     b,
+	}
 }
 
 // UploadOptions controls options about uploading a new Object, if authorized.
@@ -91,6 +115,12 @@ type UploadOptions struct {
 		// Error Correction encoding parameters to be used for this
 		// Object.
 		RedundancyScheme storj.RedundancyScheme
+
+		// Parallelism is the number of segments of the Object that are
+		// uploaded at once. The segments share the memory limit of the
+		// uplink. If set to zero, the segments are uploaded one after
+		// another.
+		Parallelism int
 	}
 }
 
@@ -114,6 +144,47 @@ func (b *Bucket) DeleteObject(ctx context.Context, path storj.Path) (err error)
 	return b.metainfo.DeleteObject(ctx, b.bucket.Name, path)
 }
 
+// DeleteObjectVersion permanently removes a version of an object, if
+// authorized. Deleting the current version makes the previous version the
+// current one.
+func (b *Bucket) DeleteObjectVersion(ctx context.Context, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.DeleteObjectVersion(ctx, b.bucket.Name, path, versionID)
+}
+
+// ListObjectVersions lists the versions of an object, including the delete
+// markers, starting with the newest one, if authorized.
+func (b *Bucket) ListObjectVersions(ctx context.Context, path storj.Path) (versions []storj.ObjectVersion, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.ListObjectVersions(ctx, b.bucket.Name, path)
+}
+
+// CopyOptions controls options about copying an Object, if authorized.
+type CopyOptions struct {
+	// Replace, if set, replaces an existing Object at the new path. The
+	// existing Object is only replaced once the copy is complete.
+	Replace bool
+}
+
+// CopyObject copies an object to newPath in the bucket named newBucket, if
+// authorized. The data of the object isn't transferred, the copy refers to
+// the same pieces as the original object.
+func (b *Bucket) CopyObject(ctx context.Context, path storj.Path, newBucket string, newPath storj.Path, opts *CopyOptions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts == nil {
+		opts = &CopyOptions{}
+	}
+	return b.metainfo.CopyObject(ctx, b.bucket.Name, path, newBucket, newPath, opts.Replace)
+}
+
+// MoveObject moves an object to newPath in the bucket named newBucket, if
+// authorized. The data of the object isn't transferred.
+func (b *Bucket) MoveObject(ctx context.Context, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.MoveObject(ctx, b.bucket.Name, path, newBucket, newPath)
+}
+
 // ListOptions controls options for the ListObjects() call.
 type ListOptions = storj.ListOptions
 
@@ -126,10 +197,50 @@ func (b *Bucket) ListObjects(ctx context.Context, cfg *ListOptions) (list storj.
 	return b.metainfo.ListObjects(ctx, b.bucket.Name, *cfg)
 }
 
+// ResumeUpload uploads a new object like UploadObject and records the
+// progress of the upload in the journal file. When the journal file contains
+// the progress of an earlier upload of the object, which failed, the upload
+// continues with the first segment that has not been uploaded, in which case
+// data has to be the same as before. Source describes the file that data is
+// read from, the upload isn't continued and fails with ErrSourceChanged when
+// the size or the modification time of the file have changed. The satellite
+// keeps the uploaded segments only for a limited time. The journal file is
+// removed once the object has been uploaded, if authorized.
+func (b *Bucket) ResumeUpload(ctx context.Context, path storj.Path, data io.ReadSeeker, source os.FileInfo, journalPath string, opts *UploadOptions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mutableStream, streamStore, err := b.createStream(ctx, path, opts)
+	if err != nil {
+		return err
+	}
+
+	journal := &fileJournal{path: journalPath, bucket: b.Name, object: path, source: source}
+	err = stream.Resume(ctx, mutableStream, streamStore, data, journal)
+	if err != nil {
+		return err
+	}
+
+	return journal.remove()
+}
+
 // NewWriter creates a writer which uploads the object.
 func (b *Bucket) NewWriter(ctx context.Context, path storj.Path, opts *UploadOptions) (_ io.WriteCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	mutableStream, streamStore, err := b.createStream(ctx, path, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := stream.NewUpload(ctx, mutableStream, streamStore)
+	return upload, nil
+}
+
+// createStream creates the stream of a new object and returns the store to
+// upload it with.
+func (b *Bucket) createStream(ctx context.Context, path storj.Path, opts *UploadOptions) (_ storj.MutableStream, _ streams.Store, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if opts == nil {
 		opts = &UploadOptions{}
 	}
@@ -168,16 +279,36 @@ func (b *Bucket) NewWriter(ctx context.Context, path storj.Path, opts *UploadOpt
 
 	obj, err := b.metainfo.CreateObject(ctx, b.Name, path, &createInfo)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	mutableStream, err := obj.CreateStream(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	upload := stream.NewUpload(ctx, mutableStream, b.streams)
-	return upload, nil
+	streamStore, err := b.parallelStreams(opts.Volatile.Parallelism)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mutableStream, streamStore, nil
+}
+
+// parallelStreams returns the stream store for transfers of up to
+// parallelism segments at once, which share the memory limit for their read
+// buffers.
+func (b *Bucket) parallelStreams(parallelism int) (streams.Store, error) {
+	if parallelism <= 1 {
+		return b.streams, nil
+	}
+
+	memoryLimit := b.project.uplinkCfg.Volatile.MaxMemory.Int() / parallelism
+	streamStore, _, err := b.project.newStreamStore(&b.BucketConfig, b.access, memoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	return streamStore.WithParallelism(parallelism), nil
 }
 
 // ReadSeekCloser combines interfaces io.Reader, io.Seeker, io.Closer
@@ -13,4 +13,8 @@ var (
 
 	// Error is the toplevel class of errors for the uplink library.
 	Error = errs.Class("libuplink")
+
+	// ErrSourceChanged is returned when an upload can't be resumed, because
+	// the source of the data has changed since the upload began.
+	ErrSourceChanged = errs.Class("source changed")
 )
@@ -10,7 +10,6 @@ import (
 
 	"storj.io/storj/internal/readcloser"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
-	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/stream"
 )
@@ -26,6 +25,9 @@ type ObjectMeta struct {
 	// Object, but to some arbitrary point in the path hierarchy. This would
 	// be called a "folder" or "directory" in a typical filesystem.
 	IsPrefix bool
+	// VersionID identifies the version of the Object, it is empty for
+	// Objects uploaded while versioning wasn't enabled for the bucket.
+	VersionID string
 
 	// ContentType, if set, gives a MIME content-type for the Object, as
 	// set when the object was created.
@@ -75,20 +77,49 @@ type Object struct {
 	Meta ObjectMeta
 
 	metainfoDB *kvmetainfo.DB
-	streams    streams.Store
+	bucket     *Bucket
+}
+
+// DownloadOptions controls options about downloading an Object.
+type DownloadOptions struct {
+	// Volatile groups config values that are likely to change semantics
+	// or go away entirely between releases. Be careful when using them!
+	Volatile struct {
+		// Parallelism is the number of segments of the Object that are
+		// downloaded at once. The segments that are downloaded ahead are
+		// buffered in temporary files. If set to zero, the segments are
+		// downloaded one after another.
+		Parallelism int
+	}
 }
 
 // DownloadRange returns an Object's data. A length of -1 will mean
 // (Object.Size - offset).
 func (o *Object) DownloadRange(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return o.DownloadRangeWithOptions(ctx, offset, length, nil)
+}
+
+// DownloadRangeWithOptions returns an Object's data like DownloadRange,
+// using the given options.
+func (o *Object) DownloadRangeWithOptions(ctx context.Context, offset, length int64, opts *DownloadOptions) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+
+	readOnlyStream, err := o.metainfoDB.GetObjectVersionStream(ctx, o.Meta.Bucket, o.Meta.Path, o.Meta.VersionID)
+	if err != nil {
+		return nil, err
+	}
 
-	readOnlyStream, err := o.metainfoDB.GetObjectStream(ctx, o.Meta.Bucket, o.Meta.Path)
+	streamStore, err := o.bucket.parallelStreams(opts.Volatile.Parallelism)
 	if err != nil {
 		return nil, err
 	}
 
-	download := stream.NewDownload(ctx, readOnlyStream, o.streams)
+	download := stream.NewDownload(ctx, readOnlyStream, streamStore)
 	_, err = download.Seek(offset, io.SeekStart)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// PackedUpload uploads many small objects to a bucket. Objects that are too
+// large to be stored inline are collected in memory and packed into a single
+// remote segment, every object refers to its own range of the segment. This
+// avoids storing a set of tiny pieces on the storage nodes for every object.
+//
+// The collected objects become visible once the packed segment is full or
+// the upload is committed. A PackedUpload is not safe for concurrent use.
+type PackedUpload struct {
+	bucket *Bucket
+
+	objects    []streams.PackedObject
+	size       int64
+	expiration time.Time
+}
+
+// NewPackedUpload starts an upload session for small objects.
+func (b *Bucket) NewPackedUpload() *PackedUpload {
+	return &PackedUpload{bucket: b}
+}
+
+// UploadObject adds an object to the upload, if authorized. Objects that are
+// stored inline, that don't fit into a packed segment or that have their own
+// volatile options are uploaded right away.
+func (u *PackedUpload) UploadObject(ctx context.Context, path storj.Path, data io.Reader, opts *UploadOptions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+
+	// reading one byte more than fits tells whether the object is too large
+	buf, err := ioutil.ReadAll(io.LimitReader(data, u.bucket.maxPackedSize+1))
+	if err != nil {
+		return err
+	}
+
+	size := int64(len(buf))
+	encryptedSize, err := encryption.CalcEncryptedSize(size, u.bucket.EncryptionParameters)
+	if err != nil {
+		return err
+	}
+
+	custom := !opts.Volatile.RedundancyScheme.IsZero() || !opts.Volatile.EncryptionParameters.IsZero()
+	if size <= u.bucket.maxInlineSize || encryptedSize > u.bucket.maxPackedSize || custom {
+		return u.bucket.UploadObject(ctx, path, io.MultiReader(bytes.NewReader(buf), data), opts)
+	}
+
+	// the objects of a packed segment share its expiration
+	if len(u.objects) > 0 && (u.size+encryptedSize > u.bucket.maxPackedSize || !opts.Expires.Equal(u.expiration)) {
+		err = u.Commit(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	metadata, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: opts.ContentType,
+		UserDefined: opts.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	u.objects = append(u.objects, streams.PackedObject{
+		Path:     path,
+		Data:     buf,
+		Metadata: metadata,
+	})
+	u.size += encryptedSize
+	u.expiration = opts.Expires
+	return nil
+}
+
+// Commit uploads the collected objects, they are visible afterwards. The
+// upload can be used for further objects.
+func (u *PackedUpload) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(u.objects) == 0 {
+		return nil
+	}
+
+	objects := u.objects
+	u.objects, u.size = nil, 0
+
+	_, err = u.bucket.streams.PutPacked(ctx, u.bucket.Name, u.bucket.bucket.PathCipher, objects, u.expiration)
+	return err
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+func TestPackedUpload(t *testing.T) {
+	var (
+		access       = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName   = "thumbnails"
+		bucketConfig = uplink.BucketConfig{
+			PathCipher: storj.EncSecretBox,
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   4 * memory.KiB.Int32(),
+			},
+		}
+	)
+	bucketConfig.Volatile.RedundancyScheme = storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      memory.KiB.Int32(),
+		RequiredShares: 2,
+		RepairShares:   3,
+		OptimalShares:  4,
+		TotalShares:    5,
+	}
+	bucketConfig.Volatile.SegmentsSize = memory.MiB
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &bucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			objects := map[storj.Path][]byte{
+				"inline":  testrand.Bytes(memory.KiB),
+				"small/1": testrand.Bytes(10 * memory.KiB),
+				"small/2": testrand.Bytes(20 * memory.KiB),
+				"small/3": testrand.Bytes(5 * memory.KiB),
+			}
+
+			upload := bucket.NewPackedUpload()
+			for path, data := range objects {
+				err = upload.UploadObject(ctx, path, bytes.NewReader(data), &uplink.UploadOptions{
+					ContentType: "image/png",
+				})
+				require.NoError(t, err)
+			}
+
+			// the packed objects are visible only after the commit
+			_, err = bucket.OpenObject(ctx, "small/1")
+			require.True(t, storj.ErrObjectNotFound.Has(err), err)
+
+			err = upload.Commit(ctx)
+			require.NoError(t, err)
+
+			for path, data := range objects {
+				object, err := bucket.OpenObject(ctx, path)
+				require.NoError(t, err)
+				assert.Equal(t, "image/png", object.Meta.ContentType)
+				assert.Equal(t, int64(len(data)), object.Meta.Size)
+
+				downloaded := downloadObject(t, ctx, object)
+				assert.Equal(t, data, downloaded, path)
+			}
+
+			// the packed segment is stored once and all the packed objects refer to it
+			segmentPath, segment := packedSegment(t, ctx, planet)
+			require.NotNil(t, segment)
+			assert.EqualValues(t, 3, segment.References)
+			assert.Nil(t, segment.Packed)
+
+			keys, err := storage.ListKeys(ctx, planet.Satellites[0].Metainfo.Database, nil, 0)
+			require.NoError(t, err)
+
+			var references int
+			for _, key := range keys {
+				pointer := getPointer(t, ctx, planet, key)
+				if pointer.Packed != nil {
+					assert.Equal(t, segmentPath, pointer.Packed.Segment)
+					assert.Nil(t, pointer.Remote)
+					references++
+				}
+			}
+			assert.Equal(t, 3, references)
+
+			// the checker finds the packed segment only once
+			checker := planet.Satellites[0].Repair.Checker
+			checker.Loop.Pause()
+			planet.Satellites[0].Repair.Repairer.Loop.Pause()
+			offline := len(segment.Remote.RemotePieces) - int(segment.Remote.Redundancy.RepairThreshold)
+			for _, piece := range segment.Remote.RemotePieces[:offline] {
+				_, err = planet.Satellites[0].Overlay.Service.UpdateUptime(ctx, piece.NodeId, false)
+				require.NoError(t, err)
+			}
+
+			err = checker.IdentifyInjuredSegments(ctx)
+			require.NoError(t, err)
+
+			injured, err := planet.Satellites[0].DB.RepairQueue().SelectN(ctx, 10)
+			require.NoError(t, err)
+			require.Len(t, injured, 1)
+			assert.Equal(t, segmentPath, string(injured[0].Path))
+
+			for _, piece := range segment.Remote.RemotePieces[:offline] {
+				_, err = planet.Satellites[0].Overlay.Service.UpdateUptime(ctx, piece.NodeId, true)
+				require.NoError(t, err)
+			}
+
+			// deleting a packed object keeps the data of the others
+			err = bucket.DeleteObject(ctx, "small/2")
+			require.NoError(t, err)
+
+			object, err := bucket.OpenObject(ctx, "small/3")
+			require.NoError(t, err)
+			assert.Equal(t, objects["small/3"], downloadObject(t, ctx, object))
+
+			_, segment = packedSegment(t, ctx, planet)
+			require.NotNil(t, segment)
+			assert.EqualValues(t, 2, segment.References)
+
+			// the packed segment is deleted along with the last packed object
+			for _, path := range []storj.Path{"small/1", "small/3"} {
+				err = bucket.DeleteObject(ctx, path)
+				require.NoError(t, err)
+			}
+
+			_, segment = packedSegment(t, ctx, planet)
+			assert.Nil(t, segment)
+		})
+}
+
+// packedSegment returns the only packed segment stored by the satellite.
+func packedSegment(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) (path string, segment *pb.Pointer) {
+	keys, err := storage.ListKeys(ctx, planet.Satellites[0].Metainfo.Database, nil, 0)
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		pointer := getPointer(t, ctx, planet, key)
+		if pointer.References > 0 {
+			require.Nil(t, segment, "more than one packed segment")
+			path, segment = key.String(), pointer
+		}
+	}
+	return path, segment
+}
+
+func getPointer(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, key storage.Key) *pb.Pointer {
+	value, err := planet.Satellites[0].Metainfo.Database.Get(ctx, key)
+	require.NoError(t, err)
+	pointer := &pb.Pointer{}
+	require.NoError(t, proto.Unmarshal(value, pointer))
+	return pointer
+}
+
+func downloadObject(t *testing.T, ctx *testcontext.Context, object *uplink.Object) []byte {
+	reader, err := object.DownloadRange(ctx, 0, -1)
+	require.NoError(t, err)
+	defer ctx.Check(reader.Close)
+
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestParallelTransfers(t *testing.T) {
+	var (
+		access       = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName   = "parallel"
+		bucketConfig = uplink.BucketConfig{
+			PathCipher: storj.EncSecretBox,
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   4 * memory.KiB.Int32(),
+			},
+		}
+	)
+	bucketConfig.Volatile.RedundancyScheme = storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      memory.KiB.Int32(),
+		RequiredShares: 2,
+		RepairShares:   3,
+		OptimalShares:  4,
+		TotalShares:    5,
+	}
+	bucketConfig.Volatile.SegmentsSize = 20 * memory.KiB
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &bucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			data := testrand.Bytes(130 * memory.KiB)
+
+			for _, parallelism := range []int{0, 1, 3, 10} {
+				path := storj.Path("object")
+
+				opts := &uplink.UploadOptions{}
+				opts.Volatile.Parallelism = parallelism
+				err = bucket.UploadObject(ctx, path, bytes.NewReader(data), opts)
+				require.NoError(t, err, parallelism)
+
+				object, err := bucket.OpenObject(ctx, path)
+				require.NoError(t, err, parallelism)
+				assert.Equal(t, int64(len(data)), object.Meta.Size)
+
+				downloadOpts := &uplink.DownloadOptions{}
+				downloadOpts.Volatile.Parallelism = parallelism
+
+				for _, tt := range []struct{ offset, length int64 }{
+					{0, int64(len(data))}, {10 * 1024, 50 * 1024}, {100, 30 * 1024},
+				} {
+					reader, err := object.DownloadRangeWithOptions(ctx, tt.offset, tt.length, downloadOpts)
+					require.NoError(t, err, parallelism)
+
+					downloaded, err := ioutil.ReadAll(reader)
+					require.NoError(t, err, parallelism)
+					require.NoError(t, reader.Close())
+
+					assert.Equal(t, data[tt.offset:tt.offset+tt.length], downloaded, parallelism)
+				}
+			}
+		})
+}
@@ -43,6 +43,13 @@ type BucketConfig struct {
 	// be used for data encryption of new Objects in this bucket.
 	EncryptionParameters storj.EncryptionParameters
 
+	// Placement is the placement policy of the bucket, which restricts the
+	// regions of the storage nodes storing its data, e.g. "EU" for the member
+	// states of the European Union or a comma separated list of country codes.
+	// The policy can't be changed after the bucket is created. If not set,
+	// the data may be stored on any node.
+	Placement string
+
 	// Volatile groups config values that are likely to change semantics
 	// or go away entirely between releases. Be careful when using them!
 	Volatile struct {
@@ -106,6 +113,7 @@ func (p *Project) CreateBucket(ctx context.Context, name string, cfg *BucketConf
 		DefaultEncryptionParameters: cfg.EncryptionParameters,
 		DefaultRedundancyScheme:     cfg.Volatile.RedundancyScheme,
 		DefaultSegmentsSize:         cfg.Volatile.SegmentsSize.Int64(),
+		Placement:                   cfg.Placement,
 	}
 	return p.project.CreateBucket(ctx, name, &bucket)
 }
@@ -117,6 +125,32 @@ func (p *Project) DeleteBucket(ctx context.Context, bucket string) (err error) {
 	return p.project.DeleteBucket(ctx, bucket)
 }
 
+// SetBucketVersioning enables or disables keeping the previous versions of
+// the objects in a bucket, if authorized. Disabling versioning keeps the
+// existing versions.
+func (p *Project) SetBucketVersioning(ctx context.Context, bucket string, versioning bool) (b storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return p.project.SetBucketVersioning(ctx, bucket, versioning)
+}
+
+// LifecycleRule describes when the objects of a bucket are removed automatically.
+type LifecycleRule = storj.LifecycleRule
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket, if authorized.
+// The satellite periodically deletes the objects matching the rules. The
+// prefixes of the rules are encrypted with the given EncryptionAccess, so they
+// only match whole path components.
+func (p *Project) SetBucketLifecycle(ctx context.Context, bucket string, access *EncryptionAccess, rules []LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return kvmetainfo.New(p.project, p.metainfo, nil, nil, access.store).SetBucketLifecycle(ctx, bucket, rules)
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket, if authorized.
+func (p *Project) GetBucketLifecycle(ctx context.Context, bucket string, access *EncryptionAccess) (rules []LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return kvmetainfo.New(p.project, p.metainfo, nil, nil, access.store).GetBucketLifecycle(ctx, bucket)
+}
+
 // BucketListOptions controls options to the ListBuckets() call.
 type BucketListOptions = storj.BucketListOptions
 
@@ -139,6 +173,7 @@ func (p *Project) GetBucketInfo(ctx context.Context, bucket string) (b storj.Buc
 	cfg := &BucketConfig{
 		PathCipher:           b.PathCipher,
 		EncryptionParameters: b.DefaultEncryptionParameters,
+		Placement:            b.Placement,
 	}
 	cfg.Volatile.RedundancyScheme = b.DefaultRedundancyScheme
 	cfg.Volatile.SegmentsSize = memory.Size(b.DefaultSegmentsSize)
@@ -157,41 +192,67 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 		return nil, err
 	}
 
+	streamStore, segmentStore, err := p.newStreamStore(cfg, access, p.uplinkCfg.Volatile.MaxMemory.Int())
+	if err != nil {
+		return nil, err
+	}
+
+	maxEncryptedSegmentSize, err := encryption.CalcEncryptedSize(cfg.Volatile.SegmentsSize.Int64(),
+		cfg.EncryptionParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPackedSize := p.uplinkCfg.Volatile.MaxPackedSegmentSize.Int64()
+	if maxPackedSize > maxEncryptedSegmentSize {
+		maxPackedSize = maxEncryptedSegmentSize
+	}
+
+	return &Bucket{
+		BucketConfig:  *cfg,
+		Name:          bucketInfo.Name,
+		Created:       bucketInfo.Created,
+		bucket:        bucketInfo,
+		metainfo:      kvmetainfo.New(p.project, p.metainfo, streamStore, segmentStore, access.store),
+		streams:       streamStore,
+		project:       p,
+		access:        access,
+		maxInlineSize: p.maxInlineSize.Int64(),
+		maxPackedSize: maxPackedSize,
+	}, nil
+}
+
+// newStreamStore creates the stores for the objects of a bucket, which
+// allocate up to memoryLimit for the read buffers of a segment.
+func (p *Project) newStreamStore(cfg *BucketConfig, access *EncryptionAccess, memoryLimit int) (streams.Store, segments.Store, error) {
 	encryptionParameters := cfg.EncryptionParameters
 
-	ec := ecclient.NewClient(p.uplinkCfg.Volatile.Log.Named("ecclient"), p.tc, p.uplinkCfg.Volatile.MaxMemory.Int())
+	ec := ecclient.NewClient(p.uplinkCfg.Volatile.Log.Named("ecclient"), p.tc, memoryLimit)
 	fc, err := infectious.NewFEC(int(cfg.Volatile.RedundancyScheme.RequiredShares), int(cfg.Volatile.RedundancyScheme.TotalShares))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	rs, err := eestream.NewRedundancyStrategy(
 		eestream.NewRSScheme(fc, int(cfg.Volatile.RedundancyScheme.ShareSize)),
 		int(cfg.Volatile.RedundancyScheme.RepairShares),
 		int(cfg.Volatile.RedundancyScheme.OptimalShares))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	maxEncryptedSegmentSize, err := encryption.CalcEncryptedSize(cfg.Volatile.SegmentsSize.Int64(),
 		cfg.EncryptionParameters)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	segmentStore := segments.NewSegmentStore(p.metainfo, ec, rs, p.maxInlineSize.Int(), maxEncryptedSegmentSize)
 
 	streamStore, err := streams.NewStreamStore(segmentStore, cfg.Volatile.SegmentsSize.Int64(), access.store, int(encryptionParameters.BlockSize), encryptionParameters.CipherSuite, p.maxInlineSize.Int())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &Bucket{
-		BucketConfig: *cfg,
-		Name:         bucketInfo.Name,
-		Created:      bucketInfo.Created,
-		bucket:       bucketInfo,
-		metainfo:     kvmetainfo.New(p.project, p.metainfo, streamStore, segmentStore, access.store),
-		streams:      streamStore,
-	}, nil
+	return streamStore, segmentStore, nil
 }
 
 func (p *Project) retrieveSalt(ctx context.Context) (salt []byte, err error) {
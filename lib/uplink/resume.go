@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// journalEntry is the content of an upload journal file
+type journalEntry struct {
+	Bucket   string              `json:"bucket"`
+	Path     storj.Path          `json:"path"`
+	Size     int64               `json:"size"`
+	Modified time.Time           `json:"modified"`
+	Upload   streams.UploadState `json:"upload"`
+}
+
+// fileJournal records the progress of the upload of an object in a file
+type fileJournal struct {
+	path   string
+	bucket string
+	object storj.Path
+	source os.FileInfo
+}
+
+// Load reads the progress of the upload from the file, a missing file is an
+// upload that has not begun.
+func (journal *fileJournal) Load(ctx context.Context) (_ streams.UploadState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := ioutil.ReadFile(journal.path)
+	if os.IsNotExist(err) {
+		return streams.UploadState{}, nil
+	}
+	if err != nil {
+		return streams.UploadState{}, Error.Wrap(err)
+	}
+
+	var entry journalEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return streams.UploadState{}, Error.New("invalid upload journal %q: %v", journal.path, err)
+	}
+	if entry.Bucket != journal.bucket || entry.Path != journal.object {
+		return streams.UploadState{}, Error.New("upload journal %q belongs to %s/%s", journal.path, entry.Bucket, entry.Path)
+	}
+
+	// the uploaded segments only fit the source as it was when the upload began
+	if entry.Size != journal.source.Size() || !entry.Modified.Equal(journal.source.ModTime()) {
+		return streams.UploadState{}, ErrSourceChanged.New("%s/%s was uploaded from %d bytes modified at %v, the source has %d bytes modified at %v",
+			entry.Bucket, entry.Path, entry.Size, entry.Modified, journal.source.Size(), journal.source.ModTime())
+	}
+	return entry.Upload, nil
+}
+
+// Save writes the progress of the upload to the file. The file is replaced
+// at once, so that it stays intact when the uplink is interrupted.
+func (journal *fileJournal) Save(ctx context.Context, state streams.UploadState) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(journalEntry{
+		Bucket:   journal.bucket,
+		Path:     journal.object,
+		Size:     journal.source.Size(),
+		Modified: journal.source.ModTime(),
+		Upload:   state,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(journal.path), filepath.Base(journal.path)+".*")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = file.Write(data)
+	err = errs.Combine(err, file.Sync(), file.Close())
+	if err == nil {
+		err = os.Rename(file.Name(), journal.path)
+	}
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, os.Remove(file.Name())))
+	}
+	return nil
+}
+
+// remove deletes the file once the upload is finished
+func (journal *fileJournal) remove() error {
+	err := os.Remove(journal.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return Error.Wrap(err)
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestResumeUpload(t *testing.T) {
+	var (
+		access       = uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+		bucketName   = "resume"
+		bucketConfig = uplink.BucketConfig{
+			PathCipher: storj.EncSecretBox,
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   4 * memory.KiB.Int32(),
+			},
+		}
+	)
+	bucketConfig.Volatile.RedundancyScheme = storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      memory.KiB.Int32(),
+		RequiredShares: 2,
+		RepairShares:   3,
+		OptimalShares:  4,
+		TotalShares:    5,
+	}
+	bucketConfig.Volatile.SegmentsSize = 20 * memory.KiB
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			_, err := proj.CreateBucket(ctx, bucketName, &bucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, bucketName, access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			for _, tt := range []struct {
+				name        string
+				size        memory.Size
+				failAfter   memory.Size
+				parallelism int
+			}{
+				{"partial last segment", 130 * memory.KiB, 70 * memory.KiB, 0},
+				{"full last segment", 120 * memory.KiB, 50 * memory.KiB, 0},
+				{"parallel", 130 * memory.KiB, 90 * memory.KiB, 3},
+				{"before first segment", 130 * memory.KiB, 10 * memory.KiB, 0},
+			} {
+				path := storj.Path(tt.name)
+				journalPath := ctx.File("journal", tt.name)
+				data := testrand.Bytes(tt.size)
+				source := writeSource(t, ctx, tt.name, data)
+
+				opts := &uplink.UploadOptions{}
+				opts.Volatile.Parallelism = tt.parallelism
+
+				failing := &failingReader{ReadSeeker: bytes.NewReader(data), remaining: tt.failAfter.Int64()}
+				err = bucket.ResumeUpload(ctx, path, failing, source, journalPath, opts)
+				require.Error(t, err, tt.name)
+				_, err = os.Stat(journalPath)
+				require.NoError(t, err, tt.name)
+
+				// the uploaded segments are not read again
+				counting := &countingReader{ReadSeeker: bytes.NewReader(data)}
+				err = bucket.ResumeUpload(ctx, path, counting, source, journalPath, opts)
+				require.NoError(t, err, tt.name)
+				assert.True(t, counting.read < int64(len(data)) || tt.failAfter < bucketConfig.Volatile.SegmentsSize, tt.name)
+
+				_, err = os.Stat(journalPath)
+				assert.True(t, os.IsNotExist(err), tt.name)
+
+				object, err := bucket.OpenObject(ctx, path)
+				require.NoError(t, err, tt.name)
+				assert.Equal(t, int64(len(data)), object.Meta.Size, tt.name)
+
+				reader, err := object.DownloadRange(ctx, 0, object.Meta.Size)
+				require.NoError(t, err, tt.name)
+				downloaded, err := ioutil.ReadAll(reader)
+				require.NoError(t, err, tt.name)
+				require.NoError(t, reader.Close(), tt.name)
+				assert.Equal(t, data, downloaded, tt.name)
+			}
+
+			// an upload isn't continued when its source has changed
+			journalPath := ctx.File("journal", "changed")
+			data := testrand.Bytes(130 * memory.KiB)
+			source := writeSource(t, ctx, "changed", data)
+
+			failing := &failingReader{ReadSeeker: bytes.NewReader(data), remaining: (70 * memory.KiB).Int64()}
+			err = bucket.ResumeUpload(ctx, "changed", failing, source, journalPath, nil)
+			require.Error(t, err)
+
+			// the file is rewritten, its modification time is moved
+			// forward in case the clock is too coarse to notice
+			copy(data, testrand.Bytes(memory.KiB))
+			writeSource(t, ctx, "changed", data)
+			require.NoError(t, os.Chtimes(ctx.File("source", "changed"), time.Now(), source.ModTime().Add(time.Second)))
+			changed, err := os.Stat(ctx.File("source", "changed"))
+			require.NoError(t, err)
+
+			counting := &countingReader{ReadSeeker: bytes.NewReader(data)}
+			err = bucket.ResumeUpload(ctx, "changed", counting, changed, journalPath, nil)
+			require.True(t, uplink.ErrSourceChanged.Has(err), err)
+			assert.Zero(t, counting.read)
+
+			_, err = os.Stat(journalPath)
+			require.NoError(t, err)
+		})
+}
+
+// writeSource writes the data of an upload to a file and returns its info
+func writeSource(t *testing.T, ctx *testcontext.Context, name string, data []byte) os.FileInfo {
+	path := ctx.File("source", name)
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	return info
+}
+
+// failingReader fails after reading the remaining bytes
+type failingReader struct {
+	io.ReadSeeker
+	remaining int64
+}
+
+func (reader *failingReader) Read(p []byte) (n int, err error) {
+	if reader.remaining <= 0 {
+		return 0, errors.New("read failed")
+	}
+	if int64(len(p)) > reader.remaining {
+		p = p[:reader.remaining]
+	}
+	n, err = reader.ReadSeeker.Read(p)
+	reader.remaining -= int64(n)
+	return n, err
+}
+
+// countingReader counts the bytes that are read
+type countingReader struct {
+	io.ReadSeeker
+	read int64
+}
+
+func (reader *countingReader) Read(p []byte) (n int, err error) {
+	n, err = reader.ReadSeeker.Read(p)
+	reader.read += int64(n)
+	return n, err
+}
@@ -59,6 +59,12 @@ type Config struct {
 		// smallest amount of memory it can.
 		MaxMemory memory.Size
 
+		// MaxPackedSegmentSize is the size up to which the objects of
+		// a PackedUpload are packed into a single remote segment. If
+		// set to zero, the library default (4 MiB) will be used. It
+		// is limited by the segment size of the bucket.
+		MaxPackedSegmentSize memory.Size
+
 		// PartnerID is the identity given to the partner for value
 		// attribution
 		PartnerID string
@@ -79,6 +85,9 @@ func (cfg *Config) setDefaults(ctx context.Context) error {
 	} else if cfg.Volatile.MaxMemory.Int() < 0 {
 		cfg.Volatile.MaxMemory = 0
 	}
+	if cfg.Volatile.MaxPackedSegmentSize == 0 {
+		cfg.Volatile.MaxPackedSegmentSize = 4 * memory.MiB
+	}
 	if cfg.Volatile.Log == nil {
 		cfg.Volatile.Log = zap.NewNop()
 	}
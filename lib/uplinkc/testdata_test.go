@@ -36,9 +36,6 @@ func RunPlanet(t *testing.T, run func(ctx *testcontext.Context, planet *testplan
 
 	planet.Start(ctx)
 
-	// make sure nodes are refreshed in db
-	planet.Satellites[0].Discovery.Service.Refresh.TriggerWait()
-
 	run(ctx, planet)
 }
 
@@ -0,0 +1,308 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"html/template"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// Error is the default error class for the linksharing package.
+	Error = errs.Class("linksharing")
+
+	errBadRequest = errs.Class("bad request")
+	errNotFound   = errs.Class("not found")
+
+	mon = monkit.Package()
+)
+
+// HandlerConfig specifies the handler configuration
+type HandlerConfig struct {
+	// URLBase is the base URL of the link sharing handler, at which the
+	// shared links are published. It should be a fully formed URL. The
+	// handler serves the requests below its path and links to the URL in
+	// directory listings.
+	URLBase string
+}
+
+// Handler implements the link sharing HTTP handler. Request URLs contain
+// the serialized scope, so neither URLs nor scopes are ever logged.
+type Handler struct {
+	log     *zap.Logger
+	uplink  *uplink.Uplink
+	urlBase *url.URL
+}
+
+// NewHandler creates a new link sharing HTTP handler
+func NewHandler(log *zap.Logger, uplink *uplink.Uplink, config HandlerConfig) (*Handler, error) {
+	urlBase, err := parseURLBase(config.URLBase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{
+		log:     log,
+		uplink:  uplink,
+		urlBase: urlBase,
+	}, nil
+}
+
+// ServeHTTP handles link sharing requests
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	// HEAD requests get the same headers as GET requests without the body
+	switch r.Method {
+	case http.MethodHead, http.MethodGet:
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := handler.serveHTTP(ctx, w, r)
+	if err != nil {
+		handler.handleError(w, err)
+	}
+}
+
+func (handler *Handler) serveHTTP(ctx context.Context, w http.ResponseWriter, r *http.Request) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// requests are forwarded to the handler below the path of the URL base
+	basePath := strings.TrimSuffix(handler.urlBase.Path, "/")
+	if !strings.HasPrefix(r.URL.Path, basePath+"/") {
+		return errNotFound.New("request outside of the URL base")
+	}
+
+	scopeb58, bucketName, objectPath, err := parseRequestPath(strings.TrimPrefix(r.URL.Path, basePath))
+	if err != nil {
+		return errBadRequest.Wrap(err)
+	}
+
+	scope, err := uplink.ParseScope(scopeb58)
+	if err != nil {
+		return errBadRequest.Wrap(err)
+	}
+
+	project, err := handler.uplink.OpenProject(ctx, scope.SatelliteAddr, scope.APIKey)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := project.Close(); err != nil {
+			handler.log.With(zap.Error(err)).Warn("unable to close project")
+		}
+	}()
+
+	bucket, err := project.OpenBucket(ctx, bucketName, scope.EncryptionAccess)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := bucket.Close(); err != nil {
+			handler.log.With(zap.Error(err)).Warn("unable to close bucket")
+		}
+	}()
+
+	if objectPath == "" || strings.HasSuffix(objectPath, "/") {
+		return handler.serveListing(ctx, w, scopeb58, bucket, objectPath)
+	}
+
+	object, err := bucket.OpenObject(ctx, objectPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := object.Close(); err != nil {
+			handler.log.With(zap.Error(err)).Warn("unable to close object")
+		}
+	}()
+
+	contentType := object.Meta.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(objectPath))
+	}
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	ranger.ServeContent(ctx, w, r, objectPath, object.Meta.Modified, newObjectRanger(object))
+	return nil
+}
+
+// serveListing renders the objects and prefixes directly below prefix, they
+// are linked with their URL at the URL base.
+func (handler *Handler) serveListing(ctx context.Context, w http.ResponseWriter, scope string, bucket *uplink.Bucket, prefix storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	listing := listingPage{
+		Bucket: bucket.Name,
+		Prefix: prefix,
+	}
+
+	opts := &uplink.ListOptions{
+		Prefix:    strings.TrimSuffix(prefix, "/"),
+		Direction: storj.After,
+	}
+	for {
+		list, err := bucket.ListObjects(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range list.Items {
+			// the paths of prefixes end with a slash
+			listing.Entries = append(listing.Entries, listingEntry{
+				Name:     item.Path,
+				Href:     makeLocation(handler.urlBase, "/"+scope+"/"+bucket.Name+"/"+prefix+item.Path),
+				IsPrefix: item.IsPrefix,
+				Size:     item.Size,
+			})
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			break
+		}
+		opts.Cursor = list.Items[len(list.Items)-1].Path
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	return listingTemplate.Execute(w, listing)
+}
+
+// handleError writes the HTTP response for an error. The error messages
+// are logged without the request, as it contains the scope.
+func (handler *Handler) handleError(w http.ResponseWriter, err error) {
+	switch {
+	case errBadRequest.Has(err):
+		http.Error(w, "malformed request", http.StatusBadRequest)
+	case errNotFound.Has(err), storj.ErrBucketNotFound.Has(err), storj.ErrObjectNotFound.Has(err):
+		http.Error(w, "not found", http.StatusNotFound)
+	default:
+		handler.log.Error("unable to handle request", zap.Error(err))
+		http.Error(w, "unable to handle request", http.StatusInternalServerError)
+	}
+}
+
+// parseRequestPath splits a request path into the serialized scope, the
+// bucket and the path of the object or prefix within the bucket.
+func parseRequestPath(requestPath string) (scope, bucket string, objectPath storj.Path, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(requestPath, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", errs.New("missing scope or bucket")
+	}
+	if len(parts) == 3 {
+		objectPath = parts[2]
+	}
+	return parts[0], parts[1], objectPath, nil
+}
+
+func parseURLBase(s string) (*url.URL, error) {
+	// Go's URL parsing is pretty liberal and basically only fails on
+	// malformed escape sequences, so do some additional validation
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return nil, Error.New("URL base must be http:// or https://")
+	case u.Host == "":
+		return nil, Error.New("URL base must contain host")
+	case u.User != nil:
+		return nil, Error.New("URL base must not contain user info")
+	case u.RawQuery != "":
+		return nil, Error.New("URL base must not contain query values")
+	case u.Fragment != "":
+		return nil, Error.New("URL base must not contain a fragment")
+	}
+	return u, nil
+}
+
+// makeLocation returns the download URL for a request path.
+func makeLocation(base *url.URL, reqPath string) string {
+	location := *base
+	// the path is not cleaned, as object paths may contain empty or dot components
+	location.Path = strings.TrimSuffix(location.Path, "/") + reqPath
+	return location.String()
+}
+
+// MakeURL returns the URL at which the link sharing service at urlBase
+// serves the object or prefix objectPath of a bucket using the serialized
+// scope.
+func MakeURL(urlBase, scope, bucket string, objectPath storj.Path) (string, error) {
+	base, err := parseURLBase(urlBase)
+	if err != nil {
+		return "", err
+	}
+	reqPath := "/" + scope + "/" + bucket + "/" + objectPath
+	return makeLocation(base, reqPath), nil
+}
+
+// objectRanger is a ranger.Ranger which downloads the ranges of an object.
+type objectRanger struct {
+	object *uplink.Object
+}
+
+func newObjectRanger(object *uplink.Object) ranger.Ranger {
+	return &objectRanger{object: object}
+}
+
+// Size returns the size of the object.
+func (rr *objectRanger) Size() int64 {
+	return rr.object.Meta.Size
+}
+
+// Range downloads length bytes of the object starting at offset.
+func (rr *objectRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return rr.object.DownloadRange(ctx, offset, length)
+}
+
+type listingPage struct {
+	Bucket  string
+	Prefix  storj.Path
+	Entries []listingEntry
+}
+
+type listingEntry struct {
+	Name     string
+	Href     string
+	IsPrefix bool
+	Size     int64
+}
+
+var listingTemplate = template.Must(template.New("listing").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Bucket}}/{{.Prefix}}</title></head>
+<body>
+<h1>{{.Bucket}}/{{.Prefix}}</h1>
+<ul>
+{{- if .Prefix}}
+<li><a href="../">../</a></li>
+{{- end}}
+{{- range .Entries}}
+<li><a href="{{.Href}}">{{.Name}}</a>{{if not .IsPrefix}} ({{.Size}} bytes){{end}}</li>
+{{- end}}
+</ul>
+</body>
+</html>
+`))
@@ -0,0 +1,144 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/linksharing"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestNewHandler(t *testing.T) {
+	for _, urlBase := range []string{"", "ftp://localhost", "http://", "http://user@localhost", "http://localhost?a=b", "http://localhost#a"} {
+		_, err := linksharing.NewHandler(zaptest.NewLogger(t), nil, linksharing.HandlerConfig{URLBase: urlBase})
+		assert.Error(t, err, urlBase)
+	}
+
+	_, err := linksharing.NewHandler(zaptest.NewLogger(t), nil, linksharing.HandlerConfig{URLBase: "https://localhost/share"})
+	assert.NoError(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		err := upl.Upload(ctx, satellite, "testbucket", "docs/readme.txt", []byte("shared content"))
+		require.NoError(t, err)
+
+		apiKey, err := uplink.ParseAPIKey(upl.APIKey[satellite.ID()])
+		require.NoError(t, err)
+
+		scope := &uplink.Scope{
+			SatelliteAddr:    satellite.Addr(),
+			APIKey:           apiKey,
+			EncryptionAccess: uplink.NewEncryptionAccessWithDefaultKey(storj.Key{}),
+		}
+		serializedScope, err := scope.Serialize()
+		require.NoError(t, err)
+
+		uplinkConfig := uplink.Config{}
+		uplinkConfig.Volatile.TLS.SkipPeerCAWhitelist = true
+		sharingUplink, err := uplink.NewUplink(ctx, &uplinkConfig)
+		require.NoError(t, err)
+		defer ctx.Check(sharingUplink.Close)
+
+		handler, err := linksharing.NewHandler(zaptest.NewLogger(t), sharingUplink, linksharing.HandlerConfig{
+			URLBase: "https://links.example.test/share",
+		})
+		require.NoError(t, err)
+
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		do := func(method, path string, header http.Header) (*http.Response, string) {
+			req, err := http.NewRequest(method, server.URL+path, nil)
+			require.NoError(t, err)
+			for name, values := range header {
+				req.Header[name] = values
+			}
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp, string(body)
+		}
+
+		objectPath := "/share/" + serializedScope + "/testbucket/docs/readme.txt"
+
+		t.Run("head object", func(t *testing.T) {
+			resp, body := do(http.MethodHead, objectPath, nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Empty(t, body)
+			assert.Empty(t, resp.Header.Get("Location"))
+			assert.Equal(t, "text/plain; charset=utf-8", resp.Header.Get("Content-Type"))
+			assert.Equal(t, "14", resp.Header.Get("Content-Length"))
+			assert.Equal(t, "bytes", resp.Header.Get("Accept-Ranges"))
+		})
+
+		t.Run("get object", func(t *testing.T) {
+			resp, body := do(http.MethodGet, objectPath, nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "shared content", body)
+			assert.Equal(t, "text/plain; charset=utf-8", resp.Header.Get("Content-Type"))
+		})
+
+		t.Run("get range", func(t *testing.T) {
+			resp, body := do(http.MethodGet, objectPath, http.Header{"Range": {"bytes=7-"}})
+			assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+			assert.Equal(t, "content", body)
+			assert.Equal(t, "bytes 7-13/14", resp.Header.Get("Content-Range"))
+		})
+
+		t.Run("get listing", func(t *testing.T) {
+			resp, body := do(http.MethodGet, "/share/"+serializedScope+"/testbucket/", nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.True(t, strings.Contains(body, `href="https://links.example.test/share/`+serializedScope+`/testbucket/docs/"`), body)
+
+			resp, body = do(http.MethodGet, "/share/"+serializedScope+"/testbucket/docs/", nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.True(t, strings.Contains(body, `href="https://links.example.test/share/`+serializedScope+`/testbucket/docs/readme.txt"`), body)
+		})
+
+		t.Run("missing object", func(t *testing.T) {
+			resp, _ := do(http.MethodGet, "/share/"+serializedScope+"/testbucket/missing", nil)
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+			resp, _ = do(http.MethodGet, "/"+serializedScope+"/testbucket/docs/readme.txt", nil)
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		})
+
+		t.Run("malformed requests", func(t *testing.T) {
+			resp, _ := do(http.MethodGet, "/share/"+serializedScope, nil)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+			resp, _ = do(http.MethodGet, "/share/invalidscope/testbucket/docs/readme.txt", nil)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+			resp, _ = do(http.MethodPost, objectPath, nil)
+			assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		})
+	})
+}
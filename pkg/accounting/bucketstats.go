@@ -61,9 +61,15 @@ func (s *BucketTally) AddSegment(pointer *pb.Pointer, last bool) {
 		s.MetadataSize += int64(len(pointer.Metadata))
 
 	case pb.Pointer_REMOTE:
+		segmentSize := pointer.GetSegmentSize()
+		// a packed or copied segment doesn't belong to a bucket, every object
+		// referring to it accounts for the range of its own data
+		if packed := pointer.GetPacked(); packed.GetSegment() != "" {
+			segmentSize = packed.GetLength()
+		}
 		s.RemoteSegments++
-		s.RemoteBytes += pointer.GetSegmentSize()
-		s.Bytes += pointer.GetSegmentSize()
+		s.RemoteBytes += segmentSize
+		s.Bytes += segmentSize
 		s.MetadataSize += int64(len(pointer.Metadata))
 	default:
 		s.UnknownSegments++
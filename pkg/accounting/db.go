@@ -87,4 +87,12 @@ type ProjectAccounting interface {
 	GetStorageTotals(ctx context.Context, projectID uuid.UUID) (int64, int64, error)
 	// GetProjectUsageLimits returns project usage limit
 	GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (memory.Size, error)
+	// GetProjectBandwidthLimit returns the egress limit configured for the project, 0 when there is none
+	GetProjectBandwidthLimit(ctx context.Context, projectID uuid.UUID) (memory.Size, error)
+	// GetBucketAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a bucket in the past time frame
+	GetBucketAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, bucketName []byte, from time.Time) (int64, error)
+	// GetBucketStorageTotals returns the current inline and remote storage usage for a bucket
+	GetBucketStorageTotals(ctx context.Context, projectID uuid.UUID, bucketName []byte) (int64, int64, error)
+	// GetBucketLimits returns the storage and egress limits configured for the bucket, 0 when there is none
+	GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (storage memory.Size, egress memory.Size, err error)
 }
@@ -7,15 +7,19 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 )
 
+var mon = monkit.Package()
+
 // Config contains configurable values for the live accounting service.
 type Config struct {
-	StorageBackend string `help:"what to use for storing real-time accounting data" default:"plainmemory"`
+	StorageBackend string `help:"what to use for storing real-time accounting data (plainmemory, or redis://<host>:<port>?db=<db> to share it between satellite processes)" default:"plainmemory"`
 }
 
 // Service represents the external interface to the live accounting
@@ -23,12 +27,31 @@ type Config struct {
 type Service interface {
 	GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (int64, int64, error)
 	AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, inlineSpaceUsed, remoteSpaceUsed int64) error
-	ResetTotals()
+	// GetBucketStorageUsage returns the inline and remote storage added to the
+	// bucket since the last accounting tally.
+	GetBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (int64, int64, error)
+	// AddBucketStorageUsage adds storage usage to the bucket and its project.
+	AddBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, inlineSpaceUsed, remoteSpaceUsed int64) error
+	// GetBandwidthUsage returns the tracked egress of the project, or of its
+	// bucket when bucketName isn't empty, and whether it is tracked at all.
+	GetBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (egress int64, tracked bool, err error)
+	// InitBandwidthUsage starts tracking the egress of the project, or of its
+	// bucket, unless it is already tracked, and returns the tracked egress.
+	InitBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, egress int64) (int64, error)
+	// AddBandwidthUsage adds egress to the bucket and its project, when they
+	// are tracked.
+	AddBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, egress int64) error
+	// ResetTotals resets the space used and stops tracking the egress of
+	// all projects and buckets.
+	ResetTotals(ctx context.Context) error
+	// Close releases the resources of the backend.
+	Close() error
 }
 
 // New creates a new live.Service instance of the type specified in
-// the provided config.
-func New(log *zap.Logger, config Config) (Service, error) {
+// the provided config. Backends shared between processes reset their
+// totals every resetInterval, which should be the accounting tally interval.
+func New(log *zap.Logger, config Config, resetInterval time.Duration) (Service, error) {
 	parts := strings.SplitN(config.StorageBackend, ":", 2)
 	var backendType string
 	if len(parts) == 0 || parts[0] == "" {
@@ -36,8 +59,11 @@ func New(log *zap.Logger, config Config) (Service, error) {
 	} else {
 		backendType = parts[0]
 	}
-	if backendType == "plainmemory" {
+	switch backendType {
+	case "plainmemory":
 		return newPlainMemoryLiveAccounting(log)
+	case "redis":
+		return newRedisLiveAccounting(log, config.StorageBackend, resetInterval)
 	}
 	return nil, errs.New("unrecognized live accounting backend specifier %q", backendType)
 }
@@ -54,7 +80,14 @@ type plainMemoryLiveAccounting struct {
 	log *zap.Logger
 
 	spaceMapLock sync.RWMutex
-	spaceDeltas  map[uuid.UUID]spaceUsedAccounting
+	spaceDeltas  map[usageKey]spaceUsedAccounting
+	egress       map[usageKey]int64
+}
+
+// usageKey identifies a project, when bucketName is empty, or a bucket.
+type usageKey struct {
+	projectID  uuid.UUID
+	bucketName string
 }
 
 type spaceUsedAccounting struct {
@@ -63,8 +96,11 @@ type spaceUsedAccounting struct {
 }
 
 func newPlainMemoryLiveAccounting(log *zap.Logger) (*plainMemoryLiveAccounting, error) {
-	pmac := &plainMemoryLiveAccounting{log: log}
-	pmac.ResetTotals()
+	pmac := &plainMemoryLiveAccounting{
+		log:         log,
+		spaceDeltas: make(map[usageKey]spaceUsedAccounting),
+		egress:      make(map[usageKey]int64),
+	}
 	return pmac, nil
 }
 
@@ -73,7 +109,7 @@ func newPlainMemoryLiveAccounting(log *zap.Logger) (*plainMemoryLiveAccounting,
 func (pmac *plainMemoryLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (inlineTotal, remoteTotal int64, err error) {
 	pmac.spaceMapLock.Lock()
 	defer pmac.spaceMapLock.Unlock()
-	curVal := pmac.spaceDeltas[projectID]
+	curVal := pmac.spaceDeltas[usageKey{projectID: projectID}]
 	return curVal.inlineSpace, curVal.remoteSpace, nil
 }
 
@@ -83,19 +119,96 @@ func (pmac *plainMemoryLiveAccounting) GetProjectStorageUsage(ctx context.Contex
 func (pmac *plainMemoryLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, inlineSpaceUsed, remoteSpaceUsed int64) error {
 	pmac.spaceMapLock.Lock()
 	defer pmac.spaceMapLock.Unlock()
-	curVal := pmac.spaceDeltas[projectID]
+	pmac.addSpace(usageKey{projectID: projectID}, inlineSpaceUsed, remoteSpaceUsed)
+	return nil
+}
+
+// GetBucketStorageUsage gets inline and remote storage totals for a given
+// bucket, back to the time of the last accounting tally.
+func (pmac *plainMemoryLiveAccounting) GetBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (inlineTotal, remoteTotal int64, err error) {
+	pmac.spaceMapLock.Lock()
+	defer pmac.spaceMapLock.Unlock()
+	curVal := pmac.spaceDeltas[usageKey{projectID: projectID, bucketName: string(bucketName)}]
+	return curVal.inlineSpace, curVal.remoteSpace, nil
+}
+
+// AddBucketStorageUsage lets the live accounting know that the given
+// bucket has just added inlineSpaceUsed bytes of inline space usage
+// and remoteSpaceUsed bytes of remote space usage. The usage is added
+// to the project of the bucket as well.
+func (pmac *plainMemoryLiveAccounting) AddBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, inlineSpaceUsed, remoteSpaceUsed int64) error {
+	pmac.spaceMapLock.Lock()
+	defer pmac.spaceMapLock.Unlock()
+	pmac.addSpace(usageKey{projectID: projectID}, inlineSpaceUsed, remoteSpaceUsed)
+	if len(bucketName) > 0 {
+		pmac.addSpace(usageKey{projectID: projectID, bucketName: string(bucketName)}, inlineSpaceUsed, remoteSpaceUsed)
+	}
+	return nil
+}
+
+func (pmac *plainMemoryLiveAccounting) addSpace(key usageKey, inlineSpaceUsed, remoteSpaceUsed int64) {
+	curVal := pmac.spaceDeltas[key]
 	curVal.inlineSpace += inlineSpaceUsed
 	curVal.remoteSpace += remoteSpaceUsed
-	pmac.spaceDeltas[projectID] = curVal
+	pmac.spaceDeltas[key] = curVal
+}
+
+// GetBandwidthUsage gets the egress of a project, or of a bucket when
+// bucketName isn't empty, since it started to be tracked.
+func (pmac *plainMemoryLiveAccounting) GetBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (egress int64, tracked bool, err error) {
+	pmac.spaceMapLock.Lock()
+	defer pmac.spaceMapLock.Unlock()
+	egress, tracked = pmac.egress[usageKey{projectID: projectID, bucketName: string(bucketName)}]
+	return egress, tracked, nil
+}
+
+// InitBandwidthUsage starts tracking the egress of a project, or of a bucket
+// when bucketName isn't empty, from the given egress. When it is tracked
+// already, the tracked egress is kept.
+func (pmac *plainMemoryLiveAccounting) InitBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, egress int64) (int64, error) {
+	pmac.spaceMapLock.Lock()
+	defer pmac.spaceMapLock.Unlock()
+	key := usageKey{projectID: projectID, bucketName: string(bucketName)}
+	if tracked, ok := pmac.egress[key]; ok {
+		return tracked, nil
+	}
+	pmac.egress[key] = egress
+	return egress, nil
+}
+
+// AddBandwidthUsage lets the live accounting know that the given bucket
+// has just been allocated egress bytes. Projects and buckets whose egress
+// isn't tracked yet are skipped.
+func (pmac *plainMemoryLiveAccounting) AddBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, egress int64) error {
+	pmac.spaceMapLock.Lock()
+	defer pmac.spaceMapLock.Unlock()
+	keys := []usageKey{{projectID: projectID}}
+	if len(bucketName) > 0 {
+		keys = append(keys, usageKey{projectID: projectID, bucketName: string(bucketName)})
+	}
+	for _, key := range keys {
+		if tracked, ok := pmac.egress[key]; ok {
+			pmac.egress[key] = tracked + egress
+		}
+	}
 	return nil
 }
 
 // ResetTotals reset all space-used totals for all projects back to zero. This
 // would normally be done in concert with calculating new tally counts in the
-// accountingDB.
-func (pmac *plainMemoryLiveAccounting) ResetTotals() {
+// accountingDB. The egress stops being tracked, so that it's read again from
+// the accountingDB.
+func (pmac *plainMemoryLiveAccounting) ResetTotals(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	pmac.log.Info("Resetting real-time accounting data")
 	pmac.spaceMapLock.Lock()
-	pmac.spaceDeltas = make(map[uuid.UUID]spaceUsedAccounting)
+	pmac.spaceDeltas = make(map[usageKey]spaceUsedAccounting)
+	pmac.egress = make(map[usageKey]int64)
 	pmac.spaceMapLock.Unlock()
+	return nil
+}
+
+// Close is a no-op, plain memory doesn't hold any resources.
+func (pmac *plainMemoryLiveAccounting) Close() error {
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
@@ -15,23 +16,54 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/storage/redis/redisserver"
 )
 
-func TestPlainMemoryLiveAccounting(t *testing.T) {
+// runTests runs the test against every live accounting backend.
+func runTests(t *testing.T, test func(t *testing.T, service Service)) {
+	t.Run("PlainMemory", func(t *testing.T) {
+		service, err := New(zap.L().Named("live-accounting"), Config{
+			StorageBackend: "plainmemory:",
+		}, time.Hour)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, service.Close()) }()
+
+		// ensure we are using the expected underlying type
+		_, ok := service.(*plainMemoryLiveAccounting)
+		require.True(t, ok)
+
+		test(t, service)
+	})
+
+	t.Run("Redis", func(t *testing.T) {
+		addr, cleanup, err := redisserver.Start()
+		require.NoError(t, err)
+		defer cleanup()
+
+		service, err := New(zap.L().Named("live-accounting"), Config{
+			StorageBackend: "redis://" + addr + "?db=0",
+		}, time.Hour)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, service.Close()) }()
+
+		// ensure we are using the expected underlying type
+		_, ok := service.(*redisLiveAccounting)
+		require.True(t, ok)
+
+		test(t, service)
+	})
+}
+
+func TestLiveAccounting(t *testing.T) {
+	runTests(t, testLiveAccounting)
+}
+
+func testLiveAccounting(t *testing.T, service Service) {
 	const (
 		valuesListSize  = 1000
 		valueMultiplier = 4096
 		numProjects     = 200
 	)
-	config := Config{
-		StorageBackend: "plainmemory:",
-	}
-	service, err := New(zap.L().Named("live-accounting"), config)
-	require.NoError(t, err)
-
-	// ensure we are using the expected underlying type
-	_, ok := service.(*plainMemoryLiveAccounting)
-	require.True(t, ok)
 
 	// make a largish list of varying values
 	someValues := make([]int64, valuesListSize)
@@ -80,19 +112,86 @@ func TestPlainMemoryLiveAccounting(t *testing.T) {
 }
 
 func TestResetTotals(t *testing.T) {
-	config := Config{
-		StorageBackend: "plainmemory:",
-	}
-	service, err := New(zap.L().Named("live-accounting"), config)
-	require.NoError(t, err)
+	runTests(t, func(t *testing.T, service Service) {
+		ctx := context.Background()
 
-	// ensure we are using the expected underlying type
-	_, ok := service.(*plainMemoryLiveAccounting)
-	require.True(t, ok)
+		projectID := testrand.UUID()
+		err := service.AddProjectStorageUsage(ctx, projectID, 0, -20)
+		require.NoError(t, err)
 
-	ctx := context.Background()
+		err = service.ResetTotals(ctx)
+		require.NoError(t, err)
+
+		inline, remote, err := service.GetProjectStorageUsage(ctx, projectID)
+		require.NoError(t, err)
+		assert.Zero(t, inline)
+		assert.Zero(t, remote)
+	})
+}
+
+func TestBucketUsage(t *testing.T) {
+	runTests(t, testBucketUsage)
+}
 
+func testBucketUsage(t *testing.T, service Service) {
+	ctx := context.Background()
 	projectID := testrand.UUID()
-	err = service.AddProjectStorageUsage(ctx, projectID, 0, -20)
+
+	err := service.AddBucketStorageUsage(ctx, projectID, []byte("bucket1"), 10, 100)
+	require.NoError(t, err)
+	err = service.AddBucketStorageUsage(ctx, projectID, []byte("bucket2"), 20, 200)
+	require.NoError(t, err)
+
+	inline, remote, err := service.GetBucketStorageUsage(ctx, projectID, []byte("bucket1"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, inline)
+	assert.EqualValues(t, 100, remote)
+
+	inline, remote, err = service.GetProjectStorageUsage(ctx, projectID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 30, inline)
+	assert.EqualValues(t, 300, remote)
+
+	// egress isn't tracked until it's initialized
+	err = service.AddBandwidthUsage(ctx, projectID, []byte("bucket1"), 1000)
+	require.NoError(t, err)
+	_, tracked, err := service.GetBandwidthUsage(ctx, projectID, []byte("bucket1"))
+	require.NoError(t, err)
+	assert.False(t, tracked)
+
+	egress, err := service.InitBandwidthUsage(ctx, projectID, []byte("bucket1"), 500)
+	require.NoError(t, err)
+	assert.EqualValues(t, 500, egress)
+	egress, err = service.InitBandwidthUsage(ctx, projectID, nil, 5000)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5000, egress)
+
+	err = service.AddBandwidthUsage(ctx, projectID, []byte("bucket1"), 1000)
+	require.NoError(t, err)
+
+	egress, tracked, err = service.GetBandwidthUsage(ctx, projectID, []byte("bucket1"))
+	require.NoError(t, err)
+	assert.True(t, tracked)
+	assert.EqualValues(t, 1500, egress)
+
+	egress, tracked, err = service.GetBandwidthUsage(ctx, projectID, nil)
+	require.NoError(t, err)
+	assert.True(t, tracked)
+	assert.EqualValues(t, 6000, egress)
+
+	// initializing again keeps the tracked egress
+	egress, err = service.InitBandwidthUsage(ctx, projectID, []byte("bucket1"), 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1500, egress)
+
+	err = service.ResetTotals(ctx)
+	require.NoError(t, err)
+
+	_, tracked, err = service.GetBandwidthUsage(ctx, projectID, []byte("bucket1"))
+	require.NoError(t, err)
+	assert.False(t, tracked)
+	inline, remote, err = service.GetBucketStorageUsage(ctx, projectID, []byte("bucket1"))
 	require.NoError(t, err)
+	assert.Zero(t, inline)
+	assert.Zero(t, remote)
 }
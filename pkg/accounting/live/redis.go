@@ -0,0 +1,204 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/redis"
+)
+
+// redisKeyPrefix is the prefix of all the keys used by the redis live accounting.
+const redisKeyPrefix = "live-accounting/"
+
+const (
+	inlineCounter = "inline"
+	remoteCounter = "remote"
+	egressCounter = "egress"
+)
+
+// redisLiveAccounting represents a live.Service-implementing instance using
+// redis, so that several satellite processes share the same real-time
+// accounting data.
+//
+// Every counter expires resetInterval after it has been created, so that the
+// totals are reset along with the accounting tally even when it runs in
+// another process.
+type redisLiveAccounting struct {
+	log    *zap.Logger
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func newRedisLiveAccounting(log *zap.Logger, address string, resetInterval time.Duration) (*redisLiveAccounting, error) {
+	client, err := redis.NewClientFrom(address)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &redisLiveAccounting{
+		log:    log,
+		client: client,
+		ttl:    resetInterval,
+	}, nil
+}
+
+// counterKey returns the key of the counter of a project, when bucketName is
+// empty, or of a bucket.
+func counterKey(projectID uuid.UUID, bucketName []byte, counter string) storage.Key {
+	return storage.Key(redisKeyPrefix + projectID.String() + "/" + string(bucketName) + "/" + counter)
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (rla *redisLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (inlineTotal, remoteTotal int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return rla.getSpace(ctx, projectID, nil)
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added inlineSpaceUsed bytes of inline space usage
+// and remoteSpaceUsed bytes of remote space usage.
+func (rla *redisLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, inlineSpaceUsed, remoteSpaceUsed int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return rla.addSpace(ctx, projectID, nil, inlineSpaceUsed, remoteSpaceUsed)
+}
+
+// GetBucketStorageUsage gets inline and remote storage totals for a given
+// bucket, back to the time of the last accounting tally.
+func (rla *redisLiveAccounting) GetBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (inlineTotal, remoteTotal int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return rla.getSpace(ctx, projectID, bucketName)
+}
+
+// AddBucketStorageUsage lets the live accounting know that the given
+// bucket has just added inlineSpaceUsed bytes of inline space usage
+// and remoteSpaceUsed bytes of remote space usage. The usage is added
+// to the project of the bucket as well.
+func (rla *redisLiveAccounting) AddBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, inlineSpaceUsed, remoteSpaceUsed int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := rla.addSpace(ctx, projectID, nil, inlineSpaceUsed, remoteSpaceUsed); err != nil {
+		return err
+	}
+	return rla.addSpace(ctx, projectID, bucketName, inlineSpaceUsed, remoteSpaceUsed)
+}
+
+func (rla *redisLiveAccounting) getSpace(ctx context.Context, projectID uuid.UUID, bucketName []byte) (inlineTotal, remoteTotal int64, err error) {
+	values, err := rla.client.GetAll(ctx, storage.Keys{
+		counterKey(projectID, bucketName, inlineCounter),
+		counterKey(projectID, bucketName, remoteCounter),
+	})
+	if err != nil {
+		return 0, 0, errs.Wrap(err)
+	}
+
+	totals := make([]int64, len(values))
+	for i, value := range values {
+		totals[i], err = parseCounter(value)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return totals[0], totals[1], nil
+}
+
+func (rla *redisLiveAccounting) addSpace(ctx context.Context, projectID uuid.UUID, bucketName []byte, inlineSpaceUsed, remoteSpaceUsed int64) error {
+	_, err := rla.client.Increment(ctx, counterKey(projectID, bucketName, inlineCounter), inlineSpaceUsed, rla.ttl)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	_, err = rla.client.Increment(ctx, counterKey(projectID, bucketName, remoteCounter), remoteSpaceUsed, rla.ttl)
+	return errs.Wrap(err)
+}
+
+// GetBandwidthUsage gets the egress of a project, or of a bucket when
+// bucketName isn't empty, since it started to be tracked.
+func (rla *redisLiveAccounting) GetBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (egress int64, tracked bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	value, err := rla.client.Get(ctx, counterKey(projectID, bucketName, egressCounter))
+	if storage.ErrKeyNotFound.Has(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errs.Wrap(err)
+	}
+
+	egress, err = parseCounter(value)
+	return egress, err == nil, err
+}
+
+// InitBandwidthUsage starts tracking the egress of a project, or of a bucket
+// when bucketName isn't empty, from the given egress. When it is tracked
+// already, the tracked egress is kept.
+func (rla *redisLiveAccounting) InitBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, egress int64) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := counterKey(projectID, bucketName, egressCounter)
+	stored, err := rla.client.SetIfNotExists(ctx, key, storage.Value(strconv.FormatInt(egress, 10)), rla.ttl)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if stored {
+		return egress, nil
+	}
+
+	tracked, isTracked, err := rla.GetBandwidthUsage(ctx, projectID, bucketName)
+	if err != nil || !isTracked {
+		// the egress stopped being tracked in the meantime
+		return egress, err
+	}
+	return tracked, nil
+}
+
+// AddBandwidthUsage lets the live accounting know that the given bucket
+// has just been allocated egress bytes. Projects and buckets whose egress
+// isn't tracked yet are skipped.
+func (rla *redisLiveAccounting) AddBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, egress int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keys := storage.Keys{counterKey(projectID, nil, egressCounter)}
+	if len(bucketName) > 0 {
+		keys = append(keys, counterKey(projectID, bucketName, egressCounter))
+	}
+	for _, key := range keys {
+		if _, _, err := rla.client.IncrementExisting(ctx, key, egress); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// ResetTotals reset all space-used totals for all projects back to zero. This
+// would normally be done in concert with calculating new tally counts in the
+// accountingDB. The egress stops being tracked, so that it's read again from
+// the accountingDB.
+func (rla *redisLiveAccounting) ResetTotals(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	rla.log.Info("Resetting real-time accounting data")
+	return errs.Wrap(rla.client.DeletePrefix(ctx, storage.Key(redisKeyPrefix)))
+}
+
+// Close closes the redis client.
+func (rla *redisLiveAccounting) Close() error {
+	return rla.client.Close()
+}
+
+// parseCounter parses the value of a counter, a missing counter is 0.
+func parseCounter(value storage.Value) (int64, error) {
+	if value == nil {
+		return 0, nil
+	}
+	counter, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, errs.New("invalid counter %q: %v", value, err)
+	}
+	return counter, nil
+}
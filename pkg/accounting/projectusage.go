@@ -4,6 +4,7 @@
 package accounting
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -45,28 +46,28 @@ func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.
 }
 
 // ExceedsBandwidthUsage returns true if the bandwidth usage limits have been exceeded
-// for a project in the past month (30 days). The usage limit is (e.g 25GB) multiplied by the redundancy
-// expansion factor, so that the uplinks have a raw limit.
+// for a project or its bucket in the past month (30 days). The usage limit is (e.g 25GB) multiplied by the redundancy
+// expansion factor, so that the uplinks have a raw limit. The egress limit configured for the project
+// can only lower its usage limit, the bucket is limited only when an egress limit is configured for it.
 // Ref: https://storjlabs.atlassian.net/browse/V3-1274
 func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketID []byte) (_ bool, limit memory.Size, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var group errgroup.Group
+	var usageLimit, bandwidthLimit memory.Size
 	var bandwidthGetTotal int64
-	limit = usage.maxAlphaUsage
 
 	// TODO(michal): to reduce db load, consider using a cache to retrieve the project.UsageLimit value if needed
-	group.Go(func() error {
-		projectLimit, err := usage.projectAccountingDB.GetProjectUsageLimits(ctx, projectID)
-		if projectLimit > 0 {
-			limit = projectLimit
-		}
+	group.Go(func() (err error) {
+		usageLimit, err = usage.projectAccountingDB.GetProjectUsageLimits(ctx, projectID)
 		return err
 	})
-	group.Go(func() error {
-		var err error
-		from := time.Now().AddDate(0, 0, -AverageDaysInMonth) // past 30 days
-		bandwidthGetTotal, err = usage.projectAccountingDB.GetAllocatedBandwidthTotal(ctx, projectID, from)
+	group.Go(func() (err error) {
+		bandwidthLimit, err = usage.projectAccountingDB.GetProjectBandwidthLimit(ctx, projectID)
+		return err
+	})
+	group.Go(func() (err error) {
+		bandwidthGetTotal, err = usage.getBandwidthTotal(ctx, projectID, nil)
 		return err
 	})
 
@@ -75,11 +76,32 @@ func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID
 		return false, 0, ErrProjectUsage.Wrap(err)
 	}
 
-	maxUsage := limit.Int64() * int64(ExpansionFactor)
-	if bandwidthGetTotal >= maxUsage {
+	limit = usage.projectLimit(usageLimit, bandwidthLimit)
+	if exceedsLimit(bandwidthGetTotal, limit) {
 		return true, limit, nil
 	}
 
+	bucketName := bucketNameFromID(bucketID)
+	if len(bucketName) == 0 {
+		return false, limit, nil
+	}
+
+	_, bucketLimit, err := usage.projectAccountingDB.GetBucketLimits(ctx, projectID, bucketName)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if bucketLimit == 0 {
+		return false, limit, nil
+	}
+
+	bucketTotal, err := usage.getBandwidthTotal(ctx, projectID, bucketName)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if exceedsLimit(bucketTotal, bucketLimit) {
+		return true, bucketLimit, nil
+	}
+
 	return false, limit, nil
 }
 
@@ -120,6 +142,94 @@ func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uu
 	return false, limit, nil
 }
 
+// ExceedsBucketStorageUsage returns true if the storage usage limits of the project
+// or the storage limit configured for its bucket have been exceeded. The bucket limit is
+// multiplied by the redundancy expansion factor as well, buckets without a configured
+// limit are only limited by their project.
+func (usage *ProjectUsage) ExceedsBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := usage.ExceedsStorageUsage(ctx, projectID)
+	if err != nil || exceeded {
+		return exceeded, limit, err
+	}
+
+	bucketLimit, _, err := usage.projectAccountingDB.GetBucketLimits(ctx, projectID, bucketName)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if bucketLimit == 0 {
+		return false, limit, nil
+	}
+
+	lastCountInline, lastCountRemote, err := usage.projectAccountingDB.GetBucketStorageTotals(ctx, projectID, bucketName)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	rtInline, rtRemote, err := usage.liveAccounting.GetBucketStorageUsage(ctx, projectID, bucketName)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	if exceedsLimit(lastCountInline+rtInline+lastCountRemote+rtRemote, bucketLimit) {
+		return true, bucketLimit, nil
+	}
+
+	return false, limit, nil
+}
+
+// projectLimit returns the usage limit of the project, or the default limit
+// when it has none, lowered to the configured limit when there is one.
+func (usage *ProjectUsage) projectLimit(usageLimit, configuredLimit memory.Size) memory.Size {
+	limit := usage.maxAlphaUsage
+	if usageLimit > 0 {
+		limit = usageLimit
+	}
+	if configuredLimit > 0 && configuredLimit < limit {
+		limit = configuredLimit
+	}
+	return limit
+}
+
+// exceedsLimit returns whether the raw usage reached the limit
+// multiplied by the redundancy expansion factor.
+func exceedsLimit(used int64, limit memory.Size) bool {
+	return used >= limit.Int64()*int64(ExpansionFactor)
+}
+
+// bucketNameFromID returns the bucket name of a bucket ID,
+// which has the format projectID/bucketName.
+func bucketNameFromID(bucketID []byte) []byte {
+	i := bytes.IndexByte(bucketID, '/')
+	if i < 0 {
+		return nil
+	}
+	return bucketID[i+1:]
+}
+
+// getBandwidthTotal returns the egress of the project, or of its bucket when
+// bucketName isn't empty, in the past month (30 days). The egress is read from
+// the accounting DB when the live accounting doesn't track it yet.
+func (usage *ProjectUsage) getBandwidthTotal(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, tracked, err := usage.liveAccounting.GetBandwidthUsage(ctx, projectID, bucketName)
+	if err != nil || tracked {
+		return total, err
+	}
+
+	from := time.Now().AddDate(0, 0, -AverageDaysInMonth) // past 30 days
+	if len(bucketName) == 0 {
+		total, err = usage.projectAccountingDB.GetAllocatedBandwidthTotal(ctx, projectID, from)
+	} else {
+		total, err = usage.projectAccountingDB.GetBucketAllocatedBandwidthTotal(ctx, projectID, bucketName, from)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return usage.liveAccounting.InitBandwidthUsage(ctx, projectID, bucketName, total)
+}
+
 func (usage *ProjectUsage) getProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (inline int64, remote int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -134,10 +244,17 @@ func (usage *ProjectUsage) getProjectStorageTotals(ctx context.Context, projectI
 	return lastCountInline + rtInline, lastCountRemote + rtRemote, nil
 }
 
-// AddProjectStorageUsage lets the live accounting know that the given
-// project has just added inlineSpaceUsed bytes of inline space usage
+// AddBucketStorageUsage lets the live accounting know that the given
+// bucket of the project has just added inlineSpaceUsed bytes of inline space usage
 // and remoteSpaceUsed bytes of remote space usage.
-func (usage *ProjectUsage) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, inlineSpaceUsed, remoteSpaceUsed int64) (err error) {
+func (usage *ProjectUsage) AddBucketStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, inlineSpaceUsed, remoteSpaceUsed int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return usage.liveAccounting.AddBucketStorageUsage(ctx, projectID, bucketName, inlineSpaceUsed, remoteSpaceUsed)
+}
+
+// AddBandwidthUsage lets the live accounting know that egress bytes
+// have just been allocated for downloads from the given bucket of the project.
+func (usage *ProjectUsage) AddBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte, egress int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return usage.liveAccounting.AddProjectStorageUsage(ctx, projectID, inlineSpaceUsed, remoteSpaceUsed)
+	return usage.liveAccounting.AddBandwidthUsage(ctx, projectID, bucketName, egress)
 }
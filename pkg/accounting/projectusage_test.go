@@ -20,6 +20,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -285,3 +286,63 @@ func TestProjectUsageCustomLimit(t *testing.T) {
 		assert.Error(t, actualErr)
 	})
 }
+
+func TestBucketUsageLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satDB := planet.Satellites[0].DB
+		bucketLimits := satDB.Console().BucketLimits()
+
+		projects, err := satDB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		projectUsage := planet.Satellites[0].Accounting.ProjectUsage
+
+		expectedData := testrand.Bytes(50 * memory.KiB)
+		for _, bucketName := range []string{"limitedbucket", "otherbucket"} {
+			err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], bucketName, "test/path", expectedData)
+			require.NoError(t, err)
+		}
+
+		// Setup: limit the storage and egress of a single bucket
+		err = bucketLimits.Update(ctx, console.BucketLimit{
+			ProjectID:    projectID,
+			BucketName:   "limitedbucket",
+			StorageLimit: 10 * memory.KiB.Int64(),
+			EgressLimit:  10 * memory.KiB.Int64(),
+		})
+		require.NoError(t, err)
+
+		exceeded, limit, err := projectUsage.ExceedsBucketStorageUsage(ctx, projectID, []byte("limitedbucket"))
+		require.NoError(t, err)
+		require.True(t, exceeded)
+		require.Equal(t, 10*memory.KiB, limit)
+
+		exceeded, _, err = projectUsage.ExceedsBucketStorageUsage(ctx, projectID, []byte("otherbucket"))
+		require.NoError(t, err)
+		require.False(t, exceeded)
+
+		// Setup: allocate more egress than the limit of the bucket
+		now := time.Now().UTC()
+		err = satDB.Orders().UpdateBucketBandwidthAllocation(ctx, projectID, []byte("limitedbucket"), pb.PieceAction_GET, memory.MiB.Int64(), now)
+		require.NoError(t, err)
+
+		exceeded, limit, err = projectUsage.ExceedsBandwidthUsage(ctx, projectID, createBucketID(projectID, []byte("limitedbucket")))
+		require.NoError(t, err)
+		require.True(t, exceeded)
+		require.Equal(t, 10*memory.KiB, limit)
+
+		// Execute test: only the limited bucket rejects uploads and downloads
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "limitedbucket", "test/path2", expectedData)
+		require.Error(t, err)
+		_, err = planet.Uplinks[0].Download(ctx, planet.Satellites[0], "limitedbucket", "test/path")
+		require.Error(t, err)
+
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "otherbucket", "test/path2", expectedData)
+		require.NoError(t, err)
+		_, err = planet.Uplinks[0].Download(ctx, planet.Satellites[0], "otherbucket", "test/path")
+		require.NoError(t, err)
+	})
+}
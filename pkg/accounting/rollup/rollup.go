@@ -173,6 +173,8 @@ func (r *Service) RollupBW(ctx context.Context, lastRollup time.Time, rollupStat
 			rollupStats[day][nodeID].GetRepairTotal += int64(row.Settled)
 		case uint(pb.PieceAction_PUT_REPAIR):
 			rollupStats[day][nodeID].PutRepairTotal += int64(row.Settled)
+		case uint(pb.PieceAction_PUT_GRACEFUL_EXIT):
+			// transfers during graceful exit are not paid for
 		default:
 			r.logger.Info("delete order type")
 		}
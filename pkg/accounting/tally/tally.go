@@ -79,7 +79,10 @@ func (t *Service) Tally(ctx context.Context) (err error) {
 	// double-counted (counted in the tally and also counted as a delta to
 	// the tally). If that happens, it will be fixed at the time of the next
 	// tally run.
-	t.liveAccounting.ResetTotals()
+	err = t.liveAccounting.ResetTotals(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
 
 	var errAtRest, errBucketInfo error
 	latestTally, nodeData, bucketData, err := t.CalculateAtRestData(ctx)
@@ -131,7 +134,9 @@ func (t *Service) CalculateAtRestData(ctx context.Context) (latestTally time.Tim
 				pathElements := storj.SplitPath(storj.Path(item.Key))
 				// check to make sure there are at least *4* path elements. the first three
 				// are project, segment, and bucket name, but we want to make sure we're talking
-				// about an actual object, and that there's an object name specified
+				// about an actual object, and that there's an object name specified.
+				// packed and copied segments are accounted for by the objects referring
+				// to them
 				if len(pathElements) >= 4 {
 					project, segment, bucketName := pathElements[0], pathElements[1], pathElements[2]
 
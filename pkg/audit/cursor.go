@@ -131,7 +131,8 @@ func (cursor *Cursor) getRandomValidPointer(ctx context.Context, pointerItems []
 			continue
 		}
 
-		if pointer.GetType() != pb.Pointer_REMOTE || pointer.GetSegmentSize() == 0 {
+		// packed objects have no pieces of their own, their packed segment is audited
+		if pointer.GetType() != pb.Pointer_REMOTE || pointer.GetRemote() == nil || pointer.GetSegmentSize() == 0 {
 			continue
 		}
 
@@ -594,6 +594,10 @@ func createBucketID(path storj.Path) []byte {
 	if len(comps) < 3 {
 		return nil
 	}
+	// packed and copied segments don't belong to a bucket
+	if comps[1] == "c" {
+		return []byte(storj.JoinPaths(comps[0], ""))
+	}
 	// project_id/bucket_name
 	return []byte(storj.JoinPaths(comps[0], comps[2]))
 }
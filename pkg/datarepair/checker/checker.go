@@ -5,6 +5,7 @@ package checker
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/storage"
 )
@@ -51,27 +53,36 @@ type Checker struct {
 	metainfo        *metainfo.Service
 	lastChecked     string
 	repairQueue     queue.RepairQueue
+	overlay         *overlay.Cache
 	nodestate       *ReliabilityCache
 	irrdb           irreparable.DB
+	gcService       *gc.Service
 	logger          *zap.Logger
 	Loop            sync2.Cycle
 	IrreparableLoop sync2.Cycle
 	monStats        durabilityStats
+
+	// placements caches the placement policies of the buckets during a pass
+	placementsMu sync.Mutex
+	placements   map[string]string
 }
 
 // NewChecker creates a new instance of checker
-func NewChecker(metainfo *metainfo.Service, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, limit int, logger *zap.Logger, config Config) *Checker {
+func NewChecker(metainfo *metainfo.Service, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, gcService *gc.Service, limit int, logger *zap.Logger, config Config) *Checker {
 	// TODO: reorder arguments
 	return &Checker{
 		metainfo:        metainfo,
 		lastChecked:     "",
 		repairQueue:     repairQueue,
+		overlay:         overlay,
 		nodestate:       NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
 		irrdb:           irrdb,
+		gcService:       gcService,
 		logger:          logger,
 		Loop:            *sync2.NewCycle(config.Interval),
 		IrreparableLoop: *sync2.NewCycle(config.IrreparableInterval),
 		monStats:        durabilityStats{},
+		placements:      map[string]string{},
 	}
 }
 
@@ -107,6 +118,11 @@ func (checker *Checker) Close() error {
 func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// the piece tracker only collects pieces when it's time to send garbage collection filters
+	pieceTracker := checker.gcService.NewPieceTracker()
+	// a pass resumed after an error hasn't seen the beginning of the keyspace
+	fullPass := checker.lastChecked == ""
+
 	err = checker.metainfo.Iterate(ctx, "", checker.lastChecked, true, false,
 		func(ctx context.Context, it storage.Iterator) error {
 			var item storage.ListItem
@@ -127,6 +143,11 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 
 					// reset durability stats for next iteration
 					checker.monStats = durabilityStats{}
+
+					// bucket placements are looked up again in the next pass
+					checker.placementsMu.Lock()
+					checker.placements = map[string]string{}
+					checker.placementsMu.Unlock()
 				}
 			}()
 
@@ -146,6 +167,14 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 				if err != nil {
 					return err
 				}
+
+				for _, piece := range remote.GetRemotePieces() {
+					pieceID := remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum)
+					err = pieceTracker.Add(ctx, piece.NodeId, pieceID)
+					if err != nil {
+						return Error.New("error adding piece to garbage collection tracker %s", err)
+					}
+				}
 			}
 			return nil
 		},
@@ -154,9 +183,67 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		return err
 	}
 
+	// filters may only be sent after a complete pass, otherwise nodes would delete live pieces
+	if fullPass && checker.lastChecked == "" {
+		err = checker.gcService.Send(ctx, pieceTracker)
+		if err != nil {
+			checker.logger.Error("error sending garbage collection filters", zap.Error(err))
+		}
+	}
+
 	return nil
 }
 
+// healthyPieces returns the pieces whose numbers aren't in missing.
+func healthyPieces(pieces []*pb.RemotePiece, missing []int32) []*pb.RemotePiece {
+	missingSet := make(map[int32]bool, len(missing))
+	for _, pieceNum := range missing {
+		missingSet[pieceNum] = true
+	}
+
+	var healthy []*pb.RemotePiece
+	for _, piece := range pieces {
+		if !missingSet[piece.GetPieceNum()] {
+			healthy = append(healthy, piece)
+		}
+	}
+	return healthy
+}
+
+// segmentPlacement returns the placement policy of the bucket of the segment.
+func (checker *Checker) segmentPlacement(ctx context.Context, path string, pointer *pb.Pointer) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// paths have the format projectID/segment/bucket/encryptedPath
+	pathElements := storj.SplitPath(path)
+	if len(pathElements) < 3 {
+		return "", nil
+	}
+	// packed and copied segments don't belong to a bucket, they keep their
+	// own placement
+	if pathElements[1] == "c" {
+		return pointer.GetPlacement(), nil
+	}
+	key := storj.JoinPaths(pathElements[0], pathElements[2])
+
+	checker.placementsMu.Lock()
+	placement, ok := checker.placements[key]
+	checker.placementsMu.Unlock()
+	if ok {
+		return placement, nil
+	}
+
+	placement, err = checker.metainfo.SegmentPlacement(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	checker.placementsMu.Lock()
+	checker.placements[key] = placement
+	checker.placementsMu.Unlock()
+	return placement, nil
+}
+
 // checks for a string in slice
 func contains(a []string, x string) bool {
 	for _, n := range a {
@@ -196,18 +283,42 @@ func (checker *Checker) updateSegmentStatus(ctx context.Context, pointer *pb.Poi
 	mon.IntVal("checker_segment_total_count").Observe(int64(len(pieces)))
 	mon.IntVal("checker_segment_healthy_count").Observe(int64(numHealthy))
 
+	// pieces which violate the placement policy are replaced by repair,
+	// but they still count towards the pieces needed to recover the segment
+	placement, err := checker.segmentPlacement(ctx, path, pointer)
+	if err != nil {
+		return Error.New("error getting bucket placement %s", err)
+	}
+	misplacedPieces, err := checker.overlay.MisplacedPieces(ctx, placement, healthyPieces(pieces, missingPieces))
+	if err != nil {
+		if !overlay.ErrInvalidPlacement.Has(err) {
+			return Error.New("error getting misplaced pieces %s", err)
+		}
+		checker.logger.Warn("placement policy of the segment can't be enforced", zap.String("Path", path), zap.Error(err))
+	}
+	if len(misplacedPieces) > 0 {
+		mon.IntVal("checker_segment_misplaced_count").Observe(int64(len(misplacedPieces)))
+	}
+
 	// we repair when the number of healthy pieces is less than or equal to the repair threshold
 	// except for the case when the repair and success thresholds are the same (a case usually seen during testing)
-	if numHealthy > redundancy.MinReq && numHealthy <= redundancy.RepairThreshold && numHealthy < redundancy.SuccessThreshold {
+	needsRepair := numHealthy <= redundancy.RepairThreshold && numHealthy < redundancy.SuccessThreshold
+	if numHealthy > redundancy.MinReq && (needsRepair || len(misplacedPieces) > 0) {
+		missingPieces = append(missingPieces, misplacedPieces...)
 		if len(missingPieces) == 0 {
 			checker.logger.Warn("Missing pieces is zero in checker, but this should be impossible -- bad redundancy scheme.")
 			return nil
 		}
 		monStats.remoteSegmentsNeedingRepair++
+		// the redundancy margin is the number of pieces which can be lost before the segment is lost
+		redundancyMargin := numHealthy - redundancy.MinReq
+		mon.IntVal("checker_injured_segment_redundancy_margin").Observe(int64(redundancyMargin))
 		err = checker.repairQueue.Insert(ctx, &pb.InjuredSegment{
-			Path:         []byte(path),
-			LostPieces:   missingPieces,
-			InsertedTime: time.Now().UTC(),
+			Path:             []byte(path),
+			LostPieces:       missingPieces,
+			InsertedTime:     time.Now().UTC(),
+			NumHealthyPieces: numHealthy,
+			RedundancyMargin: redundancyMargin,
 		})
 		if err != nil {
 			return Error.New("error adding injured segment to queue %s", err)
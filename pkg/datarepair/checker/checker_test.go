@@ -12,11 +12,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/datarepair/checker"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
@@ -163,6 +165,41 @@ func TestIdentifyIrreparableSegments(t *testing.T) {
 	})
 }
 
+func TestIdentifyMisplacedSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Repair.Checker.Loop.Stop()
+
+		// all nodes of testplanet are in the same subnet
+		preferences := overlay.NodeSelectionConfig{
+			OnlineWindow:       time.Hour,
+			MaxPiecesPerSubnet: 2,
+		}
+		cache := overlay.NewCache(zaptest.NewLogger(t), satellite.DB.OverlayCache(), preferences)
+
+		repairQueue := satellite.DB.RepairQueue()
+		config := checker.Config{
+			Interval:                  30 * time.Second,
+			IrreparableInterval:       15 * time.Second,
+			ReliabilityCacheStaleness: 5 * time.Minute,
+		}
+		c := checker.NewChecker(satellite.Metainfo.Service, repairQueue, cache, satellite.DB.Irreparable(), nil, 0, zaptest.NewLogger(t), config)
+
+		// all pieces are healthy, but only two of them are placed correctly
+		makePointer(t, planet, "a", false)
+
+		err := c.IdentifyInjuredSegments(ctx)
+		require.NoError(t, err)
+
+		injuredSegment, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []byte("a"), injuredSegment.Path)
+		require.Equal(t, []int32{2, 3}, injuredSegment.LostPieces)
+	})
+}
+
 func makePointer(t *testing.T, planet *testplanet.Planet, pieceID string, createLost bool) {
 	ctx := context.TODO()
 	numOfStorageNodes := len(planet.StorageNodes)
@@ -217,7 +254,7 @@ func TestCheckerResume(t *testing.T) {
 			IrreparableInterval:       15 * time.Second,
 			ReliabilityCacheStaleness: 5 * time.Minute,
 		}
-		c := checker.NewChecker(planet.Satellites[0].Metainfo.Service, repairQueue, planet.Satellites[0].Overlay.Service, irrepairQueue, 0, nil, config)
+		c := checker.NewChecker(planet.Satellites[0].Metainfo.Service, repairQueue, planet.Satellites[0].Overlay.Service, irrepairQueue, nil, 0, nil, config)
 
 		// create pointer that needs repair
 		makePointer(t, planet, "a", true)
@@ -10,15 +10,19 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/datarepair/repairer"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink"
 )
 
@@ -48,9 +52,6 @@ func TestDataRepair(t *testing.T) {
 		// first, upload some remote data
 		ul := planet.Uplinks[0]
 		satellite := planet.Satellites[0]
-		// stop discovery service so that we do not get a race condition when we delete nodes from overlay cache
-		satellite.Discovery.Service.Discovery.Stop()
-		satellite.Discovery.Service.Refresh.Stop()
 		// stop audit to prevent possible interactions i.e. repair timeout problems
 		satellite.Audit.Service.Loop.Stop()
 
@@ -175,9 +176,6 @@ func TestRepairMultipleDisqualified(t *testing.T) {
 		// first, upload some remote data
 		ul := planet.Uplinks[0]
 		satellite := planet.Satellites[0]
-		// stop discovery service so that we do not get a race condition when we delete nodes from overlay cache
-		satellite.Discovery.Service.Discovery.Stop()
-		satellite.Discovery.Service.Refresh.Stop()
 
 		satellite.Repair.Checker.Loop.Pause()
 		satellite.Repair.Repairer.Loop.Pause()
@@ -293,9 +291,6 @@ func TestDataRepairUploadLimit(t *testing.T) {
 		},
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 		satellite := planet.Satellites[0]
-		// stop discovery service so that we do not get a race condition when we delete nodes from overlay cache
-		satellite.Discovery.Service.Discovery.Stop()
-		satellite.Discovery.Service.Refresh.Stop()
 		// stop audit to prevent possible interactions i.e. repair timeout problems
 		satellite.Audit.Service.Loop.Stop()
 		satellite.Repair.Checker.Loop.Pause()
@@ -400,6 +395,187 @@ func TestDataRepairUploadLimit(t *testing.T) {
 	})
 }
 
+// TestDataRepairInvalidPlacement does the following:
+// - Uploads test data to a bucket with a placement policy which can't be
+//   enforced, because the satellite has no region database
+// - Kills some nodes
+// - Triggers data repair, which repairs the data ignoring the placement policy
+func TestDataRepairInvalidPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 12,
+		UplinkCount:      1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Service.Loop.Stop()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := ul.UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     3,
+			RepairThreshold:  5,
+			SuccessThreshold: 7,
+			MaxThreshold:     7,
+		}, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		// the placement policy was valid when the bucket was created
+		buckets := satellite.DB.Buckets()
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+		bucket, err := buckets.GetBucket(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.NoError(t, buckets.DeleteBucket(ctx, []byte("testbucket"), projectID))
+		bucket.Placement = "US"
+		_, err = buckets.CreateBucket(ctx, bucket)
+		require.NoError(t, err)
+
+		pointer, path := getRemoteSegment(t, ctx, satellite)
+		remotePieces := pointer.GetRemote().GetRemotePieces()
+		toKill := len(remotePieces) - int(pointer.GetRemote().GetRedundancy().GetMinReq()+1)
+
+		nodesToKill := make(map[storj.NodeID]bool)
+		for _, piece := range remotePieces[:toKill] {
+			nodesToKill[piece.NodeId] = true
+			stopNodeByID(t, ctx, planet, piece.NodeId)
+		}
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.Limiter.Wait()
+
+		// repaired segment should not contain any piece in the killed nodes
+		pointer, err = satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+
+		remotePieces = pointer.GetRemote().GetRemotePieces()
+		require.True(t, len(remotePieces) > int(pointer.GetRemote().GetRedundancy().GetRepairThreshold()))
+		for _, piece := range remotePieces {
+			require.NotContains(t, nodesToKill, piece.NodeId, "there shouldn't be pieces in killed nodes")
+		}
+
+		newData, err := ul.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, newData, testData)
+	})
+}
+
+// TestDataRepairReferencesChanged checks that a copy of an object made while
+// its segment is repaired keeps referring to the repaired segment.
+func TestDataRepairReferencesChanged(t *testing.T) {
+	var repairerConfig repairer.Config
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 12,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.OnlineWindow = 0
+				repairerConfig = config.Repairer
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Service.Loop.Stop()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := ul.UploadWithConfig(ctx, satellite, &uplink.RSConfig{
+			MinThreshold:     3,
+			RepairThreshold:  5,
+			SuccessThreshold: 7,
+			MaxThreshold:     7,
+		}, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		// copying the object moves its segment to a shared path
+		_, path := getRemoteSegment(t, ctx, satellite)
+		service := satellite.Metainfo.Service
+		_, err = service.Copy(ctx, []string{path}, []string{path + "-copy"}, [][]byte{nil})
+		require.NoError(t, err)
+
+		pointer, err := service.Get(ctx, path)
+		require.NoError(t, err)
+		sharedPath := pointer.GetPacked().GetSegment()
+		require.NotEmpty(t, sharedPath)
+
+		segment, err := service.Get(ctx, sharedPath)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, segment.References)
+
+		remotePieces := segment.GetRemote().GetRemotePieces()
+		toKill := len(remotePieces) - int(segment.GetRemote().GetRedundancy().GetMinReq()+1)
+
+		nodesToKill := make(map[storj.NodeID]bool)
+		for _, piece := range remotePieces[:toKill] {
+			nodesToKill[piece.NodeId] = true
+			stopNodeByID(t, ctx, planet, piece.NodeId)
+			_, err = satellite.Overlay.Service.UpdateUptime(ctx, piece.NodeId, false)
+			require.NoError(t, err)
+		}
+
+		// the object is copied again right after the repairer read the segment
+		store := &racingStore{KeyValueStore: satellite.Metainfo.Database, key: sharedPath}
+		store.change = func(ctx context.Context) error {
+			_, err := service.Copy(ctx, []string{path}, []string{path + "-second-copy"}, [][]byte{nil})
+			return err
+		}
+		racingService := metainfo.NewService(zaptest.NewLogger(t), store, satellite.DB.Buckets(), satellite.DB.PendingObjects())
+
+		segmentRepairer, err := repairerConfig.GetSegmentRepairer(ctx, zaptest.NewLogger(t),
+			satellite.Transport, racingService, satellite.Orders.Service, satellite.Overlay.Service, satellite.Identity)
+		require.NoError(t, err)
+		require.NoError(t, segmentRepairer.Repair(ctx, sharedPath))
+
+		segment, err = service.Get(ctx, sharedPath)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, segment.References)
+
+		remotePieces = segment.GetRemote().GetRemotePieces()
+		require.True(t, len(remotePieces) > int(segment.GetRemote().GetRedundancy().GetRepairThreshold()))
+		for _, piece := range remotePieces {
+			require.NotContains(t, nodesToKill, piece.NodeId, "there shouldn't be pieces in killed nodes")
+		}
+
+		newData, err := ul.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, newData, testData)
+	})
+}
+
+// racingStore changes the value of key right after it has been read for the
+// first time, like a concurrent update would.
+type racingStore struct {
+	storage.KeyValueStore
+	key    string
+	change func(ctx context.Context) error
+}
+
+// Get gets the value and applies the change afterwards.
+func (store *racingStore) Get(ctx context.Context, key storage.Key) (storage.Value, error) {
+	value, err := store.KeyValueStore.Get(ctx, key)
+	if err == nil && store.change != nil && key.String() == store.key {
+		change := store.change
+		store.change = nil
+		err = change(ctx)
+	}
+	return value, err
+}
+
 func isDisqualified(t *testing.T, ctx *testcontext.Context, satellite *satellite.Peer, nodeID storj.NodeID) bool {
 	node, err := satellite.Overlay.Service.Get(ctx, nodeID)
 	require.NoError(t, err)
@@ -12,9 +12,9 @@ import (
 // RepairQueue implements queueing for segments that need repairing.
 // Implementation can be found at satellite/satellitedb/repairqueue.go.
 type RepairQueue interface {
-	// Insert adds an injured segment.
+	// Insert adds an injured segment, or updates the health of an already queued segment.
 	Insert(ctx context.Context, s *pb.InjuredSegment) error
-	// Select gets an injured segment.
+	// Select gets the injured segment with the smallest redundancy margin.
 	Select(ctx context.Context) (*pb.InjuredSegment, error)
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s *pb.InjuredSegment) error
@@ -54,6 +54,59 @@ func TestInsertDuplicate(t *testing.T) {
 		require.NoError(t, err)
 		err = q.Insert(ctx, seg)
 		require.NoError(t, err)
+
+		// inserting the segment again updates its lost pieces
+		updated := &pb.InjuredSegment{
+			Path:       []byte("abc"),
+			LostPieces: []int32{int32(1), int32(3), int32(4)},
+		}
+		err = q.Insert(ctx, updated)
+		require.NoError(t, err)
+
+		s, err := q.Select(ctx)
+		require.NoError(t, err)
+		require.True(t, pb.Equal(s, updated))
+	})
+}
+
+func TestOrderByHealth(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		margins := []int32{3, 1, 4, 2, 5}
+		for i, margin := range margins {
+			err := q.Insert(ctx, &pb.InjuredSegment{
+				Path:             []byte(strconv.Itoa(i)),
+				NumHealthyPieces: 10 + margin,
+				RedundancyMargin: margin,
+			})
+			require.NoError(t, err)
+		}
+
+		// reinserting a segment updates its health
+		err := q.Insert(ctx, &pb.InjuredSegment{
+			Path:             []byte("4"),
+			NumHealthyPieces: 10,
+			RedundancyMargin: 0,
+		})
+		require.NoError(t, err)
+
+		for _, expected := range []struct {
+			path   string
+			margin int32
+		}{{"4", 0}, {"1", 1}, {"3", 2}, {"0", 3}, {"2", 4}} {
+			s, err := q.Select(ctx)
+			require.NoError(t, err)
+			require.Equal(t, expected.path, string(s.Path))
+			require.Equal(t, expected.margin, s.RedundancyMargin)
+			require.Equal(t, 10+expected.margin, s.NumHealthyPieces)
+		}
+
+		_, err = q.Select(ctx)
+		require.True(t, storage.ErrEmptyQueue.Has(err), "all segments should be attempted")
 	})
 }
 
@@ -25,9 +25,17 @@ func TestMergePlanets(t *testing.T) {
 
 	log := zaptest.NewLogger(t)
 
+	// the storage nodes check in with the satellites of the other planet too
+	trustAll := func(index int, config *storagenode.Config) {
+		config.Storage.SatelliteIDRestriction = false
+	}
+
 	alpha, err := testplanet.NewCustom(log.Named("A"), testplanet.Config{
 		SatelliteCount:   2,
 		StorageNodeCount: 5,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: trustAll,
+		},
 	})
 	require.NoError(t, err)
 
@@ -39,6 +47,7 @@ func TestMergePlanets(t *testing.T) {
 			Bootstrap: func(index int, config *bootstrap.Config) {
 				config.Kademlia.BootstrapAddr = alpha.Bootstrap.Addr()
 			},
+			StorageNode: trustAll,
 		},
 	})
 	require.NoError(t, err)
@@ -71,6 +80,18 @@ func TestMergePlanets(t *testing.T) {
 	}
 	_ = group.Wait()
 
+	// the storage nodes learn about the other satellites from their order
+	// limits, until then they only check in with their own satellites
+	allStorageNodes := []*storagenode.Peer{}
+	allStorageNodes = append(allStorageNodes, alpha.StorageNodes...)
+	allStorageNodes = append(allStorageNodes, beta.StorageNodes...)
+	for _, storageNode := range allStorageNodes {
+		for _, satellite := range allSatellites {
+			storageNode.Storage2.Trust.AddAddress(ctx, satellite.ID(), satellite.Addr())
+		}
+		storageNode.Contact.Chore.Loop.TriggerWait()
+	}
+
 	test := func(tag string, satellites []*satellite.Peer, storageNodes []*storagenode.Peer) string {
 		found, missing := 0, 0
 		for _, satellite := range satellites {
@@ -119,6 +119,22 @@ func (db *Project) GetBucket(ctx context.Context, bucketName string) (_ storj.Bu
 	return bucket, nil
 }
 
+// SetBucketVersioning enables or disables versioning for a bucket
+func (db *Project) SetBucketVersioning(ctx context.Context, bucketName string, versioning bool) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucketName == "" {
+		return storj.Bucket{}, storj.ErrNoBucket.New("")
+	}
+
+	bucket, err := db.buckets.SetVersioning(ctx, bucketName, versioning)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+
+	return bucket, nil
+}
+
 // ListBuckets lists buckets
 func (db *Project) ListBuckets(ctx context.Context, listOpts storj.BucketListOptions) (_ storj.BucketList, err error) {
 	defer mon.Task()(&ctx)(&err)
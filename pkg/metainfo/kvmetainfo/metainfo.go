@@ -5,12 +5,14 @@ package kvmetainfo
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
@@ -77,3 +79,62 @@ func (db *DB) GetBucket(ctx context.Context, bucketName string) (bucketInfo stor
 func (db *DB) ListBuckets(ctx context.Context, options storj.BucketListOptions) (list storj.BucketList, err error) {
 	return db.project.ListBuckets(ctx, options)
 }
+
+// SetBucketVersioning enables or disables versioning for a bucket
+func (db *DB) SetBucketVersioning(ctx context.Context, bucketName string, versioning bool) (bucketInfo storj.Bucket, err error) {
+	return db.project.SetBucketVersioning(ctx, bucketName, versioning)
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket
+func (db *DB) SetBucketLifecycle(ctx context.Context, bucketName string, rules []storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+
+	encrypted := make([]storj.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		// prefixes are encrypted per path component, so they can only
+		// match whole components
+		prefix := strings.TrimSuffix(rule.Prefix, "/")
+		if prefix != "" {
+			encPrefix, err := encryption.EncryptPath(bucketName, paths.NewUnencrypted(prefix), bucketInfo.PathCipher, db.encStore)
+			if err != nil {
+				return err
+			}
+			rule.Prefix = encPrefix.Raw()
+		}
+		encrypted = append(encrypted, rule)
+	}
+
+	return db.metainfo.SetBucketLifecycle(ctx, bucketName, encrypted)
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket
+func (db *DB) GetBucketLifecycle(ctx context.Context, bucketName string) (rules []storj.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err = db.metainfo.GetBucketLifecycle(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, rule := range rules {
+		if rule.Prefix == "" {
+			continue
+		}
+		prefix, err := encryption.DecryptPath(bucketName, paths.NewEncrypted(rule.Prefix), bucketInfo.PathCipher, db.encStore)
+		if err != nil {
+			return nil, err
+		}
+		rules[i].Prefix = prefix.Raw()
+	}
+	return rules, nil
+}
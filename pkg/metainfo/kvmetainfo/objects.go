@@ -5,6 +5,7 @@ package kvmetainfo
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 
 	"github.com/gogo/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
+	"storj.io/storj/uplink/metainfo"
 )
 
 // DefaultRS default values for RedundancyScheme
@@ -50,8 +52,23 @@ func (db *DB) GetObject(ctx context.Context, bucket string, path storj.Path) (in
 // GetObjectStream returns interface for reading the object stream
 func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Path) (stream storj.ReadOnlyStream, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return db.GetObjectVersionStream(ctx, bucket, path, "")
+}
+
+// GetObjectVersion returns information about a version of an object
+func (db *DB) GetObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, info, err = db.getVersionInfo(ctx, bucket, path, versionID)
+
+	return info, err
+}
 
-	meta, info, err := db.getInfo(ctx, bucket, path)
+// GetObjectVersionStream returns interface for reading the stream of a version of an object
+func (db *DB) GetObjectVersionStream(ctx context.Context, bucket string, path storj.Path, versionID string) (stream storj.ReadOnlyStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	meta, info, err := db.getVersionInfo(ctx, bucket, path, versionID)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +160,235 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 		store:  objects.NewStore(db.streams, bucketInfo.PathCipher),
 		prefix: bucket,
 	}
-	return prefixed.Delete(ctx, path)
+	err = prefixed.Delete(ctx, path)
+	if err != nil || !bucketInfo.Versioning {
+		return err
+	}
+
+	// the deleted object is kept as a previous version, the delete marker
+	// hides it from reads of the current version
+	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+	_, err = db.metainfo.CreateDeleteMarker(ctx, bucket, encPath.Raw())
+	return err
+}
+
+// DeleteObjectVersion permanently deletes a version of an object
+func (db *DB) DeleteObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := db.metainfo.DeleteObjectVersion(ctx, bucket, encPath.Raw(), versionID)
+	db.segments.DeletePieces(ctx, deleted)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
+// ListObjectVersions lists all versions of an object, starting with the newest one
+func (db *DB) ListObjectVersions(ctx context.Context, bucket string, path storj.Path) (versions []storj.ObjectVersion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		return nil, storj.ErrNoPath.New("")
+	}
+
+	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := db.metainfo.ListObjectVersions(ctx, bucket, encPath.Raw())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		version := storj.ObjectVersion{
+			Object: storj.Object{
+				VersionID: item.VersionID,
+				Bucket:    bucketInfo,
+				Path:      path,
+				Created:   item.Pointer.GetCreationDate(),
+				Modified:  item.Pointer.GetCreationDate(),
+			},
+			IsLatest:       item.IsLatest,
+			IsDeleteMarker: item.IsDeleteMarker,
+		}
+
+		if !item.IsDeleteMarker {
+			_, version.Object, err = db.objectFromPointer(ctx, bucketInfo, path, encPath, item.Pointer)
+			if err != nil {
+				return nil, err
+			}
+			version.VersionID = item.VersionID
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// CopyObject copies an object to a new bucket and path without transferring its data,
+// an existing object at the new path is replaced when replace is set
+func (db *DB) CopyObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, replace bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.relocateObject(ctx, bucket, path, newBucket, newPath, false, replace)
+}
+
+// MoveObject moves an object to a new bucket and path without transferring its data
+func (db *DB) MoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.relocateObject(ctx, bucket, path, newBucket, newPath, true, false)
+}
+
+// relocateObject re-encrypts the content keys of all segments for the new path
+// and lets the satellite copy or move the segments
+func (db *DB) relocateObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, move, replace bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if newPath == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	obj, _, err := db.getInfo(ctx, bucket, path)
+	if err != nil {
+		return err
+	}
+
+	newBucketInfo, err := db.GetBucket(ctx, newBucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return err
+	}
+
+	newEncPath, err := encryption.EncryptPath(newBucket, paths.NewUnencrypted(newPath), newBucketInfo.PathCipher, db.encStore)
+	if err != nil {
+		return err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(bucket, paths.NewUnencrypted(path), db.encStore)
+	if err != nil {
+		return err
+	}
+	newDerivedKey, err := encryption.DeriveContentKey(newBucket, paths.NewUnencrypted(newPath), db.encStore)
+	if err != nil {
+		return err
+	}
+
+	cipher := storj.CipherSuite(obj.streamMeta.EncryptionType)
+
+	var segments []*pb.ObjectSegmentMetadata
+	for index := int64(0); index < obj.streamInfo.NumberOfSegments-1; index++ {
+		pointer, err := db.metainfo.SegmentInfo(ctx, bucket, obj.encPath.Raw(), index)
+		if err != nil {
+			return err
+		}
+
+		metadata := pointer.GetMetadata()
+		if cipher != storj.EncNull {
+			segmentMeta := pb.SegmentMeta{}
+			err = proto.Unmarshal(metadata, &segmentMeta)
+			if err != nil {
+				return err
+			}
+			err = reencryptSegmentMeta(&segmentMeta, cipher, derivedKey, newDerivedKey)
+			if err != nil {
+				return err
+			}
+			metadata, err = proto.Marshal(&segmentMeta)
+			if err != nil {
+				return err
+			}
+		}
+
+		segments = append(segments, &pb.ObjectSegmentMetadata{
+			Segment:           index,
+			EncryptedMetadata: metadata,
+		})
+	}
+
+	streamMeta := obj.streamMeta
+	if streamMeta.LastSegmentMeta != nil {
+		lastSegmentMeta := *streamMeta.LastSegmentMeta
+		err = reencryptSegmentMeta(&lastSegmentMeta, cipher, derivedKey, newDerivedKey)
+		if err != nil {
+			return err
+		}
+		streamMeta.LastSegmentMeta = &lastSegmentMeta
+	}
+	lastMetadata, err := proto.Marshal(&streamMeta)
+	if err != nil {
+		return err
+	}
+	segments = append(segments, &pb.ObjectSegmentMetadata{
+		Segment:           -1,
+		EncryptedMetadata: lastMetadata,
+	})
+
+	if move {
+		err = db.metainfo.MoveObject(ctx, bucket, obj.encPath.Raw(), newBucket, newEncPath.Raw(), segments)
+	} else {
+		var deleted []metainfo.DeletedSegment
+		deleted, err = db.metainfo.CopyObject(ctx, bucket, obj.encPath.Raw(), newBucket, newEncPath.Raw(), segments, replace)
+		db.segments.DeletePieces(ctx, deleted)
+	}
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
+// reencryptSegmentMeta replaces the content key of the segment, encrypted with
+// the key derived from the old path, with the one encrypted with newDerivedKey
+func reencryptSegmentMeta(segmentMeta *pb.SegmentMeta, cipher storj.CipherSuite, derivedKey, newDerivedKey *storj.Key) error {
+	var keyNonce storj.Nonce
+	copy(keyNonce[:], segmentMeta.KeyNonce)
+
+	contentKey, err := encryption.DecryptKey(segmentMeta.EncryptedKey, cipher, derivedKey, &keyNonce)
+	if err != nil {
+		return err
+	}
+
+	// generate a new nonce for encrypting the content key
+	var newKeyNonce storj.Nonce
+	_, err = rand.Read(newKeyNonce[:])
+	if err != nil {
+		return err
+	}
+
+	encryptedKey, err := encryption.EncryptKey(contentKey, cipher, newDerivedKey, &newKeyNonce)
+	if err != nil {
+		return err
+	}
+
+	segmentMeta.EncryptedKey = encryptedKey
+	segmentMeta.KeyNonce = newKeyNonce[:]
+	return nil
 }
 
 // ModifyPendingObject creates an interface for updating a partially uploaded object
@@ -225,6 +470,11 @@ type object struct {
 
 func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj object, info storj.Object, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return db.getVersionInfo(ctx, bucket, path, "")
+}
+
+func (db *DB) getVersionInfo(ctx context.Context, bucket string, path storj.Path, versionID string) (obj object, info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	// TODO: we shouldn't need to go load the bucket metadata every time we get object info
 	bucketInfo, err := db.GetBucket(ctx, bucket)
@@ -236,14 +486,12 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 		return object{}, storj.Object{}, storj.ErrNoPath.New("")
 	}
 
-	fullpath := streams.CreatePath(bucket, paths.NewUnencrypted(path))
-
 	encPath, err := encryption.EncryptPath(bucket, paths.NewUnencrypted(path), bucketInfo.PathCipher, db.encStore)
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
 
-	pointer, err := db.metainfo.SegmentInfo(ctx, bucket, encPath.Raw(), -1)
+	pointer, err := db.metainfo.SegmentInfoVersion(ctx, bucket, encPath.Raw(), -1, versionID)
 	if err != nil {
 		if storage.ErrKeyNotFound.Has(err) {
 			err = storj.ErrObjectNotFound.Wrap(err)
@@ -251,6 +499,26 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 		return object{}, storj.Object{}, err
 	}
 
+	obj, info, err = db.objectFromPointer(ctx, bucketInfo, path, encPath, pointer)
+	if err != nil {
+		return object{}, storj.Object{}, err
+	}
+
+	// the null version is stored without a version id
+	if versionID != "" {
+		info.VersionID = versionID
+	}
+
+	return obj, info, nil
+}
+
+// objectFromPointer decrypts the stream information of the last segment of an object.
+func (db *DB) objectFromPointer(ctx context.Context, bucketInfo storj.Bucket, path storj.Path, encPath paths.Encrypted, pointer *pb.Pointer) (obj object, info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := bucketInfo.Name
+	fullpath := streams.CreatePath(bucket, paths.NewUnencrypted(path))
+
 	var redundancyScheme *pb.RedundancyScheme
 	if pointer.GetType() == pb.Pointer_REMOTE {
 		redundancyScheme = pointer.GetRemote().GetRedundancy()
@@ -288,6 +556,7 @@ func (db *DB) getInfo(ctx context.Context, bucket string, path storj.Path) (obj
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
+	info.VersionID = pointer.GetVersionId()
 
 	return object{
 		fullpath:        fullpath,
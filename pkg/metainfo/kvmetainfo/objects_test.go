@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
@@ -654,3 +656,208 @@ func optionsRecursive(prefix, cursor string, direction storj.ListDirection, limi
 		Recursive: true,
 	}
 }
+
+func TestCopyAndMoveObject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		// use small segments, so the object consists of multiple segments
+		config := uplink.GetConfig(satellite)
+		config.Client.SegmentSize = 20 * memory.KiB
+
+		db, streams, cleanup, err := testplanet.DialMetainfo(ctx, uplink.Log, config, uplink.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		for _, name := range []string{"source", "destination"} {
+			_, err = db.CreateBucket(ctx, name, &storj.Bucket{PathCipher: storj.EncAESGCM})
+			require.NoError(t, err)
+		}
+
+		data := testrand.Bytes(50 * memory.KiB)
+
+		obj, err := db.CreateObject(ctx, "source", "file", &storj.CreateObject{
+			RedundancyScheme:     config.GetRedundancyScheme(),
+			EncryptionParameters: config.GetEncryptionParameters(),
+		})
+		require.NoError(t, err)
+		mutableStream, err := obj.CreateStream(ctx)
+		require.NoError(t, err)
+		upload := stream.NewUpload(ctx, mutableStream, streams)
+		_, err = upload.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, upload.Close())
+		require.NoError(t, obj.Commit(ctx))
+
+		info, err := db.GetObject(ctx, "source", "file")
+		require.NoError(t, err)
+		require.EqualValues(t, 3, info.SegmentCount)
+
+		download := func(bucket string, path storj.Path) []byte {
+			readOnly, err := db.GetObjectStream(ctx, bucket, path)
+			require.NoError(t, err)
+			download := stream.NewDownload(ctx, readOnly, streams)
+			defer func() { require.NoError(t, download.Close()) }()
+			downloaded, err := ioutil.ReadAll(download)
+			require.NoError(t, err)
+			return downloaded
+		}
+
+		err = db.CopyObject(ctx, "source", "file", "destination", "copy", false)
+		require.NoError(t, err)
+		require.Equal(t, data, download("source", "file"))
+		require.Equal(t, data, download("destination", "copy"))
+
+		err = db.CopyObject(ctx, "source", "file", "destination", "copy", false)
+		require.True(t, storj.ErrObjectAlreadyExists.Has(err))
+
+		// the existing copy is replaced, without losing the segments it shares
+		err = db.CopyObject(ctx, "source", "file", "destination", "copy", true)
+		require.NoError(t, err)
+		require.Equal(t, data, download("source", "file"))
+		require.Equal(t, data, download("destination", "copy"))
+
+		err = db.CopyObject(ctx, "source", "non-existing-file", "destination", "other", false)
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+
+		err = db.CopyObject(ctx, "source", "file", "non-existing-bucket", "copy", false)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		// the copy still has its data after the original is deleted
+		require.NoError(t, db.DeleteObject(ctx, "source", "file"))
+		require.Equal(t, data, download("destination", "copy"))
+
+		err = db.MoveObject(ctx, "destination", "copy", "source", "moved")
+		require.NoError(t, err)
+		require.Equal(t, data, download("source", "moved"))
+
+		_, err = db.GetObject(ctx, "destination", "copy")
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+
+		err = db.MoveObject(ctx, "destination", "copy", "source", "moved-again")
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+	})
+}
+
+func TestObjectVersioning(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		// use small segments, so the versions consist of multiple segments
+		config := uplink.GetConfig(satellite)
+		config.Client.SegmentSize = 20 * memory.KiB
+
+		db, streams, cleanup, err := testplanet.DialMetainfo(ctx, uplink.Log, config, uplink.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		_, err = db.CreateBucket(ctx, "versioned", &storj.Bucket{PathCipher: storj.EncAESGCM})
+		require.NoError(t, err)
+
+		bucket, err := db.SetBucketVersioning(ctx, "versioned", true)
+		require.NoError(t, err)
+		require.True(t, bucket.Versioning)
+
+		bucket, err = db.GetBucket(ctx, "versioned")
+		require.NoError(t, err)
+		require.True(t, bucket.Versioning)
+
+		uploadVersion := func(data []byte) string {
+			obj, err := db.CreateObject(ctx, "versioned", "file", &storj.CreateObject{
+				RedundancyScheme:     config.GetRedundancyScheme(),
+				EncryptionParameters: config.GetEncryptionParameters(),
+			})
+			require.NoError(t, err)
+			mutableStream, err := obj.CreateStream(ctx)
+			require.NoError(t, err)
+			upload := stream.NewUpload(ctx, mutableStream, streams)
+			_, err = upload.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, upload.Close())
+			require.NoError(t, obj.Commit(ctx))
+
+			info, err := db.GetObject(ctx, "versioned", "file")
+			require.NoError(t, err)
+			require.NotEmpty(t, info.VersionID)
+			return info.VersionID
+		}
+
+		download := func(versionID string) []byte {
+			readOnly, err := db.GetObjectVersionStream(ctx, "versioned", "file", versionID)
+			require.NoError(t, err)
+			download := stream.NewDownload(ctx, readOnly, streams)
+			defer func() { require.NoError(t, download.Close()) }()
+			downloaded, err := ioutil.ReadAll(download)
+			require.NoError(t, err)
+			return downloaded
+		}
+
+		first := testrand.Bytes(50 * memory.KiB)
+		second := testrand.Bytes(30 * memory.KiB)
+
+		firstID := uploadVersion(first)
+		secondID := uploadVersion(second)
+		require.NotEqual(t, firstID, secondID)
+
+		// overwriting keeps the previous version
+		versions, err := db.ListObjectVersions(ctx, "versioned", "file")
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		require.Equal(t, secondID, versions[0].VersionID)
+		require.True(t, versions[0].IsLatest)
+		require.EqualValues(t, len(second), versions[0].Size)
+		require.Equal(t, firstID, versions[1].VersionID)
+		require.False(t, versions[1].IsLatest)
+		require.EqualValues(t, len(first), versions[1].Size)
+
+		require.Equal(t, second, download(""))
+		require.Equal(t, first, download(firstID))
+
+		// deleting creates a delete marker
+		require.NoError(t, db.DeleteObject(ctx, "versioned", "file"))
+
+		_, err = db.GetObject(ctx, "versioned", "file")
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+
+		versions, err = db.ListObjectVersions(ctx, "versioned", "file")
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		require.True(t, versions[0].IsDeleteMarker)
+		require.True(t, versions[0].IsLatest)
+		require.Equal(t, second, download(secondID))
+
+		_, err = db.GetObjectVersion(ctx, "versioned", "file", versions[0].VersionID)
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+
+		// removing the delete marker brings back the object
+		require.NoError(t, db.DeleteObjectVersion(ctx, "versioned", "file", versions[0].VersionID))
+
+		info, err := db.GetObject(ctx, "versioned", "file")
+		require.NoError(t, err)
+		require.Equal(t, secondID, info.VersionID)
+		require.Equal(t, second, download(""))
+
+		// deleting the current version makes the previous one current
+		require.NoError(t, db.DeleteObjectVersion(ctx, "versioned", "file", secondID))
+
+		info, err = db.GetObject(ctx, "versioned", "file")
+		require.NoError(t, err)
+		require.Equal(t, firstID, info.VersionID)
+		require.Equal(t, first, download(""))
+
+		require.NoError(t, db.DeleteObjectVersion(ctx, "versioned", "file", firstID))
+
+		versions, err = db.ListObjectVersions(ctx, "versioned", "file")
+		require.NoError(t, err)
+		require.Empty(t, versions)
+
+		err = db.DeleteObjectVersion(ctx, "versioned", "file", firstID)
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+	})
+}
@@ -48,7 +48,7 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 	isLastSegment := segment.Index+1 == stream.info.SegmentCount
 	if !isLastSegment {
 		segmentPath := getSegmentPath(storj.JoinPaths(stream.bucket, stream.encPath), index)
-		_, meta, err := stream.db.segments.Get(ctx, segmentPath)
+		_, meta, err := stream.db.segments.GetVersion(ctx, segmentPath, stream.info.VersionID)
 		if err != nil {
 			return segment, err
 		}
@@ -83,7 +83,7 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 		index = -1
 	}
 
-	pointer, err := stream.db.metainfo.SegmentInfo(ctx, stream.bucket, stream.encPath, index)
+	pointer, err := stream.db.metainfo.SegmentInfoVersion(ctx, stream.bucket, stream.encPath, index, stream.info.VersionID)
 	if err != nil {
 		return segment, err
 	}
@@ -135,62 +135,12 @@ func (layer *gatewayLayer) GetBucketInfo(ctx context.Context, bucketName string)
 
 func (layer *gatewayLayer) GetObject(ctx context.Context, bucketName, objectPath string, startOffset int64, length int64, writer io.Writer, etag string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
-	if err != nil {
-		return convertError(err, bucketName, "")
-	}
-	defer func() { err = errs.Combine(err, bucket.Close()) }()
-
-	object, err := bucket.OpenObject(ctx, objectPath)
-	if err != nil {
-		return convertError(err, bucketName, objectPath)
-	}
-	defer func() { err = errs.Combine(err, object.Close()) }()
-
-	if startOffset < 0 || length < -1 || startOffset+length > object.Meta.Size {
-		return minio.InvalidRange{
-			OffsetBegin:  startOffset,
-			OffsetEnd:    startOffset + length,
-			ResourceSize: object.Meta.Size,
-		}
-	}
-
-	reader, err := object.DownloadRange(ctx, startOffset, length)
-	if err != nil {
-		return convertError(err, bucketName, objectPath)
-	}
-	defer func() { err = errs.Combine(err, reader.Close()) }()
-
-	_, err = io.Copy(writer, reader)
-
-	return err
+	return layer.GetObjectVersion(ctx, bucketName, objectPath, "", startOffset, length, writer)
 }
 
 func (layer *gatewayLayer) GetObjectInfo(ctx context.Context, bucketName, objectPath string) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
-
-	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
-	if err != nil {
-		return minio.ObjectInfo{}, convertError(err, bucketName, "")
-	}
-	defer func() { err = errs.Combine(err, bucket.Close()) }()
-
-	object, err := bucket.OpenObject(ctx, objectPath)
-	if err != nil {
-		return minio.ObjectInfo{}, convertError(err, bucketName, objectPath)
-	}
-	defer func() { err = errs.Combine(err, object.Close()) }()
-
-	return minio.ObjectInfo{
-		Name:        object.Meta.Path,
-		Bucket:      object.Meta.Bucket,
-		ModTime:     object.Meta.Modified,
-		Size:        object.Meta.Size,
-		ETag:        hex.EncodeToString(object.Meta.Checksum),
-		ContentType: object.Meta.ContentType,
-		UserDefined: object.Meta.Metadata,
-	}, err
+	return layer.GetObjectVersionInfo(ctx, bucketName, objectPath, "")
 }
 
 func (layer *gatewayLayer) ListBuckets(ctx context.Context) (bucketItems []minio.BucketInfo, err error) {
@@ -402,27 +352,21 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 	}
 	defer func() { err = errs.Combine(err, bucket.Close()) }()
 
-	object, err := bucket.OpenObject(ctx, srcObject)
-	if err != nil {
-		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
+	if srcBucket == destBucket && srcObject == destObject {
+		// copying an object onto itself doesn't change anything
+		return layer.GetObjectInfo(ctx, srcBucket, srcObject)
 	}
-	defer func() { err = errs.Combine(err, object.Close()) }()
 
-	reader, err := object.DownloadRange(ctx, 0, -1)
+	// the destination object is replaced by the copy
+	err = bucket.CopyObject(ctx, srcObject, destBucket, destObject, &uplink.CopyOptions{Replace: true})
 	if err != nil {
+		if storj.ErrNoBucket.Has(err) || storj.ErrBucketNotFound.Has(err) || (srcObject != "" && storj.ErrNoPath.Has(err)) {
+			return minio.ObjectInfo{}, convertError(err, destBucket, destObject)
+		}
 		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
 	}
-	defer func() { err = errs.Combine(err, reader.Close()) }()
-
-	opts := uplink.UploadOptions{
-		ContentType: object.Meta.ContentType,
-		Metadata:    object.Meta.Metadata,
-		Expires:     object.Meta.Expires,
-	}
-	opts.Volatile.EncryptionParameters = object.Meta.Volatile.EncryptionParameters
-	opts.Volatile.RedundancyScheme = object.Meta.Volatile.RedundancyScheme
 
-	return layer.putObject(ctx, destBucket, destObject, reader, &opts)
+	return layer.GetObjectInfo(ctx, destBucket, destObject)
 }
 
 func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath string, reader io.Reader, opts *uplink.UploadOptions) (objInfo minio.ObjectInfo, err error) {
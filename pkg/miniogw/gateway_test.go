@@ -395,6 +395,17 @@ func TestCopyObject(t *testing.T) {
 			assert.Equal(t, info.ContentType, obj.ContentType)
 			assert.Equal(t, info.UserDefined, obj.Metadata)
 		}
+
+		// Copy the object over the existing destination object using the Minio API
+		info, err = layer.CopyObject(ctx, TestBucket, TestFile, DestBucket, DestFile, srcInfo)
+		if assert.NoError(t, err) {
+			assert.Equal(t, DestFile, info.Name)
+			assert.Equal(t, obj.Size, info.Size)
+		}
+
+		// Check that the source object is kept using the Metainfo API
+		_, err = m.GetObject(ctx, TestBucket, TestFile)
+		assert.NoError(t, err)
 	})
 }
 
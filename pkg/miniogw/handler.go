@@ -0,0 +1,506 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/auth"
+	"go.uber.org/zap"
+)
+
+// s3Namespace is the XML namespace of the S3 responses
+const s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// nullVersionID is the S3 version id of objects stored without versioning
+const nullVersionID = "null"
+
+// Handler serves the S3 calls for bucket versioning, object versions and
+// bucket lifecycle configurations, which the minio router has no routes for,
+// and passes all other requests on to the minio gateway. Only path-style
+// requests are served, like by the minio gateway without a domain.
+type Handler struct {
+	log   *zap.Logger
+	layer *gatewayLayer
+	creds auth.Credentials
+	next  http.Handler
+}
+
+// NewHandler creates a Handler in front of next, the requests are
+// authenticated with creds, the credentials of the minio gateway.
+func NewHandler(log *zap.Logger, gateway *Gateway, creds auth.Credentials, next http.Handler) *Handler {
+	return &Handler{
+		log:   log,
+		layer: &gatewayLayer{gateway: gateway},
+		creds: creds,
+		next:  next,
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, object := splitRequestPath(r.URL.Path)
+	query := r.URL.Query()
+
+	var serve func(w http.ResponseWriter, r *http.Request, bucket, object string) error
+	switch {
+	case bucket == "":
+	case object == "" && hasQuery(query, "versioning"):
+		switch r.Method {
+		case http.MethodGet:
+			serve = handler.getBucketVersioning
+		case http.MethodPut:
+			serve = handler.putBucketVersioning
+		}
+	case object == "" && hasQuery(query, "versions"):
+		if r.Method == http.MethodGet {
+			serve = handler.listObjectVersions
+		}
+	case object == "" && hasQuery(query, "lifecycle"):
+		switch r.Method {
+		case http.MethodGet:
+			serve = handler.getBucketLifecycle
+		case http.MethodPut:
+			serve = handler.putBucketLifecycle
+		case http.MethodDelete:
+			serve = handler.deleteBucketLifecycle
+		}
+	case object != "" && hasQuery(query, "versionId"):
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			serve = handler.getObjectVersion
+		case http.MethodDelete:
+			serve = handler.deleteObjectVersion
+		}
+	}
+	if serve == nil {
+		handler.next.ServeHTTP(w, r)
+		return
+	}
+
+	err := verifySignature(r, handler.creds)
+	if err == nil {
+		err = serve(w, r, bucket, object)
+	}
+	if err != nil {
+		handler.writeError(w, r, err)
+	}
+}
+
+// getBucketVersioning serves GET /bucket?versioning
+func (handler *Handler) getBucketVersioning(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	enabled, err := handler.layer.GetBucketVersioning(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	// a bucket without versioning can't tell whether it has been suspended
+	config := versioningConfiguration{Namespace: s3Namespace}
+	if enabled {
+		config.Status = versioningEnabled
+	}
+	return writeXML(w, http.StatusOK, config)
+}
+
+// putBucketVersioning serves PUT /bucket?versioning
+func (handler *Handler) putBucketVersioning(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	var config versioningConfiguration
+	if err := xml.NewDecoder(r.Body).Decode(&config); err != nil {
+		return errMalformedXML
+	}
+	if config.Status != versioningEnabled && config.Status != versioningSuspended {
+		return errMalformedXML
+	}
+
+	err = handler.layer.SetBucketVersioning(ctx, bucket, config.Status == versioningEnabled)
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// listObjectVersions serves GET /bucket?versions. The versions of the objects
+// under the prefix are listed, an object which only has previous versions is
+// listed when the prefix is its path. All versions are returned at once.
+func (handler *Handler) listObjectVersions(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := r.URL.Query().Get("prefix")
+
+	paths := []string{}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		paths = append(paths, prefix)
+	}
+	marker := ""
+	for {
+		list, err := handler.layer.ListObjects(ctx, bucket, prefix, marker, "", maxObjectList)
+		if err != nil {
+			return err
+		}
+		for _, info := range list.Objects {
+			if info.Name != prefix {
+				paths = append(paths, info.Name)
+			}
+		}
+		if !list.IsTruncated {
+			break
+		}
+		marker = list.NextMarker
+	}
+
+	result := listVersionsResult{
+		Namespace: s3Namespace,
+		Name:      bucket,
+		Prefix:    prefix,
+		MaxKeys:   maxObjectList,
+	}
+	for _, path := range paths {
+		versions, err := handler.layer.ListObjectVersions(ctx, bucket, path)
+		if err != nil {
+			if _, ok := err.(minio.ObjectNotFound); ok {
+				continue
+			}
+			return err
+		}
+		for _, version := range versions {
+			entry := objectVersion{
+				Key:          path,
+				VersionID:    s3VersionID(version.VersionID),
+				IsLatest:     version.IsLatest,
+				LastModified: version.ModTime.UTC().Format(time.RFC3339),
+			}
+			if version.IsDeleteMarker {
+				entry.XMLName.Local = "DeleteMarker"
+			} else {
+				entry.XMLName.Local = "Version"
+				entry.ETag = `"` + version.ETag + `"`
+				entry.Size = version.Size
+				entry.StorageClass = "STANDARD"
+			}
+			result.Versions = append(result.Versions, entry)
+		}
+	}
+	return writeXML(w, http.StatusOK, result)
+}
+
+// getObjectVersion serves GET and HEAD /bucket/object?versionId=id
+func (handler *Handler) getObjectVersion(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	versionID := storjVersionID(r.URL.Query().Get("versionId"))
+	info, err := handler.layer.GetObjectVersionInfo(ctx, bucket, object, versionID)
+	if err != nil {
+		return err
+	}
+
+	offset, length, err := parseRange(r.Header.Get("Range"), info.Size)
+	if err != nil {
+		return err
+	}
+
+	header := w.Header()
+	header.Set("Last-Modified", info.ModTime.UTC().Format(http.TimeFormat))
+	header.Set("ETag", `"`+info.ETag+`"`)
+	header.Set("x-amz-version-id", s3VersionID(versionID))
+	header.Set("Accept-Ranges", "bytes")
+	if info.ContentType != "" {
+		header.Set("Content-Type", info.ContentType)
+	}
+	for key, value := range info.UserDefined {
+		if key != "content-type" {
+			header.Set("x-amz-meta-"+key, value)
+		}
+	}
+	header.Set("Content-Length", strconv.FormatInt(length, 10))
+
+	status := http.StatusOK
+	if length != info.Size {
+		status = http.StatusPartialContent
+		header.Set("Content-Range", "bytes "+strconv.FormatInt(offset, 10)+"-"+strconv.FormatInt(offset+length-1, 10)+"/"+strconv.FormatInt(info.Size, 10))
+	}
+	w.WriteHeader(status)
+
+	if r.Method == http.MethodHead || length == 0 {
+		return nil
+	}
+	err = handler.layer.GetObjectVersion(ctx, bucket, object, versionID, offset, length, w)
+	if err != nil {
+		// the response has been started already
+		handler.log.Error("unable to download object version", zap.String("bucket", bucket), zap.String("object", object), zap.Error(err))
+	}
+	return nil
+}
+
+// deleteObjectVersion serves DELETE /bucket/object?versionId=id
+func (handler *Handler) deleteObjectVersion(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	versionID := storjVersionID(r.URL.Query().Get("versionId"))
+	if versionID == "" {
+		err = handler.layer.DeleteObject(ctx, bucket, object)
+	} else {
+		err = handler.layer.DeleteObjectVersion(ctx, bucket, object, versionID)
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("x-amz-version-id", s3VersionID(versionID))
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// getBucketLifecycle serves GET /bucket?lifecycle
+func (handler *Handler) getBucketLifecycle(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	config, err := handler.layer.GetBucketLifecycleConfiguration(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if len(config.Rules) == 0 {
+		return errNoSuchLifecycleConfiguration
+	}
+
+	config.Namespace = s3Namespace
+	return writeXML(w, http.StatusOK, config)
+}
+
+// putBucketLifecycle serves PUT /bucket?lifecycle
+func (handler *Handler) putBucketLifecycle(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	var config LifecycleConfiguration
+	if err := xml.NewDecoder(r.Body).Decode(&config); err != nil {
+		return errMalformedXML
+	}
+
+	err = handler.layer.PutBucketLifecycleConfiguration(ctx, bucket, &config)
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// deleteBucketLifecycle serves DELETE /bucket?lifecycle
+func (handler *Handler) deleteBucketLifecycle(w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	err = handler.layer.PutBucketLifecycleConfiguration(ctx, bucket, nil)
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// writeError writes err as an S3 error response.
+func (handler *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, code := http.StatusInternalServerError, "InternalError"
+	switch {
+	case ErrAccessDenied.Has(err):
+		status, code = http.StatusForbidden, "AccessDenied"
+	case ErrUnsupportedSignature.Has(err):
+		status, code = http.StatusNotImplemented, "NotImplemented"
+	default:
+		switch err := err.(type) {
+		case s3Error:
+			status, code = err.status, err.code
+		case minio.BucketNotFound:
+			status, code = http.StatusNotFound, "NoSuchBucket"
+		case minio.BucketNameInvalid:
+			status, code = http.StatusBadRequest, "InvalidBucketName"
+		case minio.ObjectNotFound:
+			status, code = http.StatusNotFound, "NoSuchKey"
+			if hasQuery(r.URL.Query(), "versionId") {
+				code = "NoSuchVersion"
+			}
+		case minio.ObjectNameInvalid:
+			status, code = http.StatusBadRequest, "InvalidArgument"
+		case minio.InvalidRange:
+			status, code = http.StatusRequestedRangeNotSatisfiable, "InvalidRange"
+		case minio.NotImplemented:
+			status, code = http.StatusNotImplemented, "NotImplemented"
+		default:
+			handler.log.Error("gateway error:", zap.Error(err))
+		}
+	}
+
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+	writeErr := writeXML(w, status, errorResponse{
+		Code:     code,
+		Message:  err.Error(),
+		Resource: r.URL.Path,
+	})
+	if writeErr != nil {
+		handler.log.Debug("unable to write error response", zap.Error(writeErr))
+	}
+}
+
+// maxObjectList is the number of objects listed at once
+const maxObjectList = 1000
+
+const (
+	versioningEnabled   = "Enabled"
+	versioningSuspended = "Suspended"
+)
+
+// versioningConfiguration is the body of the S3 bucket versioning calls.
+type versioningConfiguration struct {
+	XMLName   xml.Name `xml:"VersioningConfiguration"`
+	Namespace string   `xml:"xmlns,attr,omitempty"`
+	Status    string   `xml:"Status,omitempty"`
+}
+
+// listVersionsResult is the response of the S3 ListObjectVersions call.
+type listVersionsResult struct {
+	XMLName     xml.Name `xml:"ListVersionsResult"`
+	Namespace   string   `xml:"xmlns,attr,omitempty"`
+	Name        string   `xml:"Name"`
+	Prefix      string   `xml:"Prefix"`
+	MaxKeys     int      `xml:"MaxKeys"`
+	IsTruncated bool     `xml:"IsTruncated"`
+	// the versions and delete markers of an object are listed in order
+	Versions []objectVersion `xml:",any"`
+}
+
+// objectVersion is a Version or a DeleteMarker of listVersionsResult.
+type objectVersion struct {
+	XMLName      xml.Name
+	Key          string `xml:"Key"`
+	VersionID    string `xml:"VersionId"`
+	IsLatest     bool   `xml:"IsLatest"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag,omitempty"`
+	Size         int64  `xml:"Size,omitempty"`
+	StorageClass string `xml:"StorageClass,omitempty"`
+}
+
+// errorResponse is the body of an S3 error response.
+type errorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+// s3Error is an error with its S3 error code.
+type s3Error struct {
+	status  int
+	code    string
+	message string
+}
+
+func (err s3Error) Error() string { return err.message }
+
+var (
+	errMalformedXML                 = s3Error{http.StatusBadRequest, "MalformedXML", "the XML is not well-formed or doesn't validate"}
+	errNoSuchLifecycleConfiguration = s3Error{http.StatusNotFound, "NoSuchLifecycleConfiguration", "the lifecycle configuration does not exist"}
+)
+
+// writeXML writes value as the XML body of a response.
+func writeXML(w http.ResponseWriter, status int, value interface{}) error {
+	body, err := xml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(len(xml.Header)+len(body)))
+	w.WriteHeader(status)
+	_, err = io.WriteString(w, xml.Header+string(body))
+	return err
+}
+
+// splitRequestPath splits the path of a path-style request into the bucket
+// and the object.
+func splitRequestPath(path string) (bucket, object string) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	bucket = parts[0]
+	if len(parts) > 1 {
+		object = parts[1]
+	}
+	return bucket, object
+}
+
+// hasQuery returns whether the query contains key, with or without a value.
+func hasQuery(query map[string][]string, key string) bool {
+	_, ok := query[key]
+	return ok
+}
+
+// s3VersionID converts a version id of an object to its S3 version id.
+func s3VersionID(versionID string) string {
+	if versionID == "" {
+		return nullVersionID
+	}
+	return versionID
+}
+
+// storjVersionID converts an S3 version id to the version id of an object.
+func storjVersionID(versionID string) string {
+	if versionID == nullVersionID {
+		return ""
+	}
+	return versionID
+}
+
+var rangePattern = regexp.MustCompile(`^bytes=(\d*)-(\d*)$`)
+
+// parseRange parses a single byte range of the Range header.
+func parseRange(header string, size int64) (offset, length int64, err error) {
+	if header == "" {
+		return 0, size, nil
+	}
+
+	invalid := minio.InvalidRange{ResourceSize: size}
+	match := rangePattern.FindStringSubmatch(header)
+	if match == nil || (match[1] == "" && match[2] == "") {
+		return 0, 0, invalid
+	}
+
+	start, startErr := strconv.ParseInt(match[1], 10, 64)
+	end, endErr := strconv.ParseInt(match[2], 10, 64)
+	switch {
+	case match[1] == "":
+		// the suffix of the object
+		if endErr != nil || end == 0 {
+			return 0, 0, invalid
+		}
+		if end > size {
+			end = size
+		}
+		return size - end, end, nil
+	case startErr != nil || start >= size:
+		return 0, 0, invalid
+	case match[2] == "" || end >= size:
+		return start, size - start, nil
+	case endErr != nil || end < start:
+		return 0, 0, invalid
+	}
+	return start, end - start + 1, nil
+}
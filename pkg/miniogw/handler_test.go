@@ -0,0 +1,264 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/minio-go/pkg/s3signer"
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestHandlerVersioning(t *testing.T) {
+	runHandlerTest(t, func(ctx context.Context, layer minio.ObjectLayer, do requestFunc) {
+		_, err := do(http.MethodPut, "/"+TestBucket+"?versioning", []byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`))
+		assert.Equal(t, "NoSuchBucket", errorCode(t, err))
+
+		require.NoError(t, layer.MakeBucketWithLocation(ctx, TestBucket, ""))
+
+		body, err := do(http.MethodGet, "/"+TestBucket+"?versioning", nil)
+		require.NoError(t, err)
+		var config versioningConfiguration
+		require.NoError(t, xml.Unmarshal(body, &config))
+		assert.Equal(t, "", config.Status)
+
+		_, err = do(http.MethodPut, "/"+TestBucket+"?versioning", []byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`))
+		require.NoError(t, err)
+
+		body, err = do(http.MethodGet, "/"+TestBucket+"?versioning", nil)
+		require.NoError(t, err)
+		require.NoError(t, xml.Unmarshal(body, &config))
+		assert.Equal(t, versioningEnabled, config.Status)
+
+		// the object is overwritten, the first version is kept
+		for _, data := range []string{"first version", "second version"} {
+			reader, err := hash.NewReader(bytes.NewReader([]byte(data)), int64(len(data)), "", "")
+			require.NoError(t, err)
+			_, err = layer.PutObject(ctx, TestBucket, TestFile, reader, nil)
+			require.NoError(t, err)
+		}
+
+		listVersions := func() listVersionsResult {
+			body, err := do(http.MethodGet, "/"+TestBucket+"?versions&prefix="+TestFile, nil)
+			require.NoError(t, err)
+			var result listVersionsResult
+			require.NoError(t, xml.Unmarshal(body, &result))
+			return result
+		}
+
+		versions := listVersions().Versions
+		require.Len(t, versions, 2)
+		assert.Equal(t, "Version", versions[0].XMLName.Local)
+		assert.True(t, versions[0].IsLatest)
+		assert.Equal(t, TestFile, versions[1].Key)
+		assert.False(t, versions[1].IsLatest)
+		assert.EqualValues(t, len("first version"), versions[1].Size)
+
+		body, err = do(http.MethodGet, "/"+TestBucket+"/"+TestFile+"?versionId="+versions[1].VersionID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "first version", string(body))
+
+		body, err = do(http.MethodGet, "/"+TestBucket+"/"+TestFile+"?versionId="+versions[0].VersionID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "second version", string(body))
+
+		// the previous version is deleted permanently
+		_, err = do(http.MethodDelete, "/"+TestBucket+"/"+TestFile+"?versionId="+versions[1].VersionID, nil)
+		require.NoError(t, err)
+
+		_, err = do(http.MethodGet, "/"+TestBucket+"/"+TestFile+"?versionId="+versions[1].VersionID, nil)
+		assert.Equal(t, "NoSuchVersion", errorCode(t, err))
+
+		versions = listVersions().Versions
+		require.Len(t, versions, 1)
+		assert.True(t, versions[0].IsLatest)
+	})
+}
+
+func TestHandlerLifecycle(t *testing.T) {
+	runHandlerTest(t, func(ctx context.Context, layer minio.ObjectLayer, do requestFunc) {
+		require.NoError(t, layer.MakeBucketWithLocation(ctx, TestBucket, ""))
+
+		_, err := do(http.MethodGet, "/"+TestBucket+"?lifecycle", nil)
+		assert.Equal(t, "NoSuchLifecycleConfiguration", errorCode(t, err))
+
+		_, err = do(http.MethodPut, "/"+TestBucket+"?lifecycle", []byte(`<LifecycleConfiguration>
+			<Rule><ID>logs</ID><Status>Enabled</Status><Filter><Prefix>logs/</Prefix></Filter><Expiration><Days>30</Days></Expiration></Rule>
+			<Rule><ID>uploads</ID><Status>Enabled</Status><AbortIncompleteMultipartUpload><DaysAfterInitiation>7</DaysAfterInitiation></AbortIncompleteMultipartUpload></Rule>
+		</LifecycleConfiguration>`))
+		require.NoError(t, err)
+
+		body, err := do(http.MethodGet, "/"+TestBucket+"?lifecycle", nil)
+		require.NoError(t, err)
+		var config LifecycleConfiguration
+		require.NoError(t, xml.Unmarshal(body, &config))
+		require.Len(t, config.Rules, 2)
+		assert.Equal(t, "logs", config.Rules[0].ID)
+		// prefixes only match whole path components
+		assert.Equal(t, "logs", config.Rules[0].Filter.Prefix)
+		assert.Equal(t, 30, config.Rules[0].Expiration.Days)
+		assert.Equal(t, 7, config.Rules[1].AbortIncompleteMultipartUpload.DaysAfterInitiation)
+
+		// transitions aren't supported
+		_, err = do(http.MethodPut, "/"+TestBucket+"?lifecycle", []byte(`<LifecycleConfiguration>
+			<Rule><Status>Enabled</Status><Transition><Days>30</Days><StorageClass>GLACIER</StorageClass></Transition></Rule>
+		</LifecycleConfiguration>`))
+		assert.Equal(t, "NotImplemented", errorCode(t, err))
+
+		_, err = do(http.MethodDelete, "/"+TestBucket+"?lifecycle", nil)
+		require.NoError(t, err)
+
+		_, err = do(http.MethodGet, "/"+TestBucket+"?lifecycle", nil)
+		assert.Equal(t, "NoSuchLifecycleConfiguration", errorCode(t, err))
+	})
+}
+
+func TestHandlerAuthentication(t *testing.T) {
+	var passed []string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		passed = append(passed, r.Method+" "+r.URL.String())
+		w.WriteHeader(http.StatusTeapot)
+	})
+	server := httptest.NewServer(NewHandler(zaptest.NewLogger(t), &Gateway{}, handlerCreds, next))
+	defer server.Close()
+
+	// the requests minio supports are passed on without checking them
+	for _, path := range []string{"/", "/bucket", "/bucket/object", "/bucket?policy"} {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+	}
+	assert.Len(t, passed, 4)
+
+	// unsigned requests and requests signed with other credentials are denied
+	resp, err := http.Get(server.URL + "/bucket?versioning")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/bucket?versioning", nil)
+	require.NoError(t, err)
+	request.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+	request = s3signer.SignV4(*request, handlerCreds.AccessKey, "other-secret-key", "", "us-east-1")
+	resp, err = http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// a signed body can't be replaced
+	request, err = http.NewRequest(http.MethodPut, server.URL+"/bucket?versioning", bytes.NewReader([]byte("replaced")))
+	require.NoError(t, err)
+	request.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sha256.New().Sum(nil)))
+	request = s3signer.SignV4(*request, handlerCreds.AccessKey, handlerCreds.SecretKey, "", "us-east-1")
+	resp, err = http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	assert.Len(t, passed, 4)
+}
+
+func TestParseRange(t *testing.T) {
+	for _, test := range []struct {
+		header         string
+		offset, length int64
+		invalid        bool
+	}{
+		{header: "", offset: 0, length: 10},
+		{header: "bytes=0-9", offset: 0, length: 10},
+		{header: "bytes=2-4", offset: 2, length: 3},
+		{header: "bytes=2-", offset: 2, length: 8},
+		{header: "bytes=5-20", offset: 5, length: 5},
+		{header: "bytes=-3", offset: 7, length: 3},
+		{header: "bytes=-20", offset: 0, length: 10},
+		{header: "bytes=10-", invalid: true},
+		{header: "bytes=4-2", invalid: true},
+		{header: "bytes=-", invalid: true},
+		{header: "bytes=0-1,3-4", invalid: true},
+	} {
+		offset, length, err := parseRange(test.header, 10)
+		if test.invalid {
+			assert.Error(t, err, test.header)
+			continue
+		}
+		if assert.NoError(t, err, test.header) {
+			assert.Equal(t, test.offset, offset, test.header)
+			assert.Equal(t, test.length, length, test.header)
+		}
+	}
+}
+
+var handlerCreds = auth.Credentials{AccessKey: "access-key", SecretKey: "secret-key"}
+
+// requestFunc sends a signed request to the handler and returns the body of
+// the response, responses with an error status are returned as s3Error.
+type requestFunc func(method, path string, body []byte) ([]byte, error)
+
+func runHandlerTest(t *testing.T, test func(context.Context, minio.ObjectLayer, requestFunc)) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, m storj.Metainfo, strms streams.Store) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("request passed on to minio: %s %s", r.Method, r.URL)
+		})
+		handler := NewHandler(zaptest.NewLogger(t), layer.(*gatewayLayer).gateway, handlerCreds, next)
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		do := func(method, path string, body []byte) ([]byte, error) {
+			request, err := http.NewRequest(method, server.URL+path, bytes.NewReader(body))
+			if err != nil {
+				return nil, err
+			}
+			payloadHash := sha256.Sum256(body)
+			request.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+			request = s3signer.SignV4(*request, handlerCreds.AccessKey, handlerCreds.SecretKey, "", "us-east-1")
+
+			resp, err := http.DefaultClient.Do(request)
+			if err != nil {
+				return nil, err
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			respBody, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			if resp.StatusCode >= 300 {
+				var errResp errorResponse
+				if err := xml.Unmarshal(respBody, &errResp); err != nil {
+					return nil, err
+				}
+				return nil, s3Error{status: resp.StatusCode, code: errResp.Code, message: errResp.Message}
+			}
+			return respBody, nil
+		}
+
+		test(ctx, layer, do)
+	})
+}
+
+// errorCode returns the S3 error code of err.
+func errorCode(t *testing.T, err error) string {
+	s3Err, ok := err.(s3Error)
+	if !ok {
+		t.Fatalf("expected an S3 error, got %v", err)
+	}
+	return s3Err.code
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"encoding/xml"
+
+	minio "github.com/minio/minio/cmd"
+
+	"storj.io/storj/lib/uplink"
+)
+
+// The minio object layer has no notion of bucket lifecycle configurations,
+// so like the versioning calls they are provided by the gateway layer in
+// addition to the minio.ObjectLayer methods. Only expiration after a number
+// of days and aborting incomplete multipart uploads are supported.
+
+// LifecycleConfiguration is the lifecycle configuration of a bucket as sent
+// with the S3 PutBucketLifecycleConfiguration call.
+type LifecycleConfiguration struct {
+	XMLName   xml.Name        `xml:"LifecycleConfiguration"`
+	Namespace string          `xml:"xmlns,attr,omitempty"`
+	Rules     []LifecycleRule `xml:"Rule"`
+}
+
+// LifecycleRule is a single rule of a LifecycleConfiguration.
+type LifecycleRule struct {
+	ID     string `xml:"ID,omitempty"`
+	Status string `xml:"Status"`
+	// Prefix is the deprecated way of limiting a rule to the objects below a path
+	Prefix string           `xml:"Prefix,omitempty"`
+	Filter *LifecycleFilter `xml:"Filter,omitempty"`
+
+	Expiration                     *LifecycleExpiration            `xml:"Expiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+	Transitions                    []xml.Name                      `xml:"Transition,omitempty"`
+}
+
+// LifecycleFilter selects the objects a LifecycleRule applies to.
+type LifecycleFilter struct {
+	Prefix string `xml:"Prefix,omitempty"`
+}
+
+// LifecycleExpiration describes when the objects matching a LifecycleRule expire.
+type LifecycleExpiration struct {
+	Days int    `xml:"Days,omitempty"`
+	Date string `xml:"Date,omitempty"`
+}
+
+// AbortIncompleteMultipartUpload describes when uploads that were never completed are removed.
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+const lifecycleEnabled = "Enabled"
+
+// PutBucketLifecycleConfiguration replaces the lifecycle rules of a bucket.
+func (layer *gatewayLayer) PutBucketLifecycleConfiguration(ctx context.Context, bucketName string, config *LifecycleConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rules []uplink.LifecycleRule
+	if config != nil {
+		for _, rule := range config.Rules {
+			// the satellite keeps neither disabled rules nor transitions
+			// and expiration dates
+			if rule.Status != lifecycleEnabled || len(rule.Transitions) > 0 ||
+				(rule.Expiration != nil && rule.Expiration.Date != "") {
+				return minio.NotImplemented{}
+			}
+
+			prefix := rule.Prefix
+			if rule.Filter != nil {
+				prefix = rule.Filter.Prefix
+			}
+
+			converted := uplink.LifecycleRule{
+				ID:     rule.ID,
+				Prefix: prefix,
+			}
+			if rule.Expiration != nil {
+				converted.ExpirationDays = rule.Expiration.Days
+			}
+			if rule.AbortIncompleteMultipartUpload != nil {
+				converted.AbortIncompleteUploadDays = rule.AbortIncompleteMultipartUpload.DaysAfterInitiation
+			}
+			rules = append(rules, converted)
+		}
+	}
+
+	err = layer.gateway.project.SetBucketLifecycle(ctx, bucketName, layer.gateway.access, rules)
+
+	return convertError(err, bucketName, "")
+}
+
+// GetBucketLifecycleConfiguration returns the lifecycle rules of a bucket.
+func (layer *gatewayLayer) GetBucketLifecycleConfiguration(ctx context.Context, bucketName string) (config *LifecycleConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := layer.gateway.project.GetBucketLifecycle(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return nil, convertError(err, bucketName, "")
+	}
+
+	config = &LifecycleConfiguration{}
+	for _, rule := range rules {
+		converted := LifecycleRule{
+			ID:     rule.ID,
+			Status: lifecycleEnabled,
+			Filter: &LifecycleFilter{Prefix: rule.Prefix},
+		}
+		if rule.ExpirationDays > 0 {
+			converted.Expiration = &LifecycleExpiration{Days: rule.ExpirationDays}
+		}
+		if rule.AbortIncompleteUploadDays > 0 {
+			converted.AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: rule.AbortIncompleteUploadDays,
+			}
+		}
+		config.Rules = append(config.Rules, converted)
+	}
+	return config, nil
+}
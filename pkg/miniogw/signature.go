@@ -0,0 +1,160 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/pkg/s3utils"
+	"github.com/minio/minio/pkg/auth"
+	"github.com/zeebo/errs"
+)
+
+const (
+	signV4Algorithm   = "AWS4-HMAC-SHA256"
+	iso8601Format     = "20060102T150405Z"
+	yyyymmdd          = "20060102"
+	unsignedPayload   = "UNSIGNED-PAYLOAD"
+	maxRequestSkew    = 15 * time.Minute
+	maxSignedBodySize = 1 << 20
+)
+
+var (
+	// ErrAccessDenied is returned for requests which aren't signed with the
+	// credentials of the gateway
+	ErrAccessDenied = errs.Class("access denied")
+	// ErrUnsupportedSignature is returned for requests which aren't signed
+	// with an AWS signature version 4 in the Authorization header
+	ErrUnsupportedSignature = errs.Class("unsupported signature")
+)
+
+// verifySignature checks that the request is signed with an AWS signature
+// version 4 for creds. The signed body of the request is read and replaced,
+// so it can be read again. Presigned requests and streaming signatures aren't
+// supported, the minio gateway handles those for the calls it supports.
+func verifySignature(r *http.Request, creds auth.Credentials) error {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, signV4Algorithm+" ") {
+		return ErrUnsupportedSignature.New("only %s in the Authorization header is supported", signV4Algorithm)
+	}
+
+	fields := make(map[string]string)
+	for _, field := range strings.Split(strings.TrimPrefix(authorization, signV4Algorithm+" "), ",") {
+		keyValue := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		if len(keyValue) != 2 {
+			return ErrAccessDenied.New("malformed Authorization header")
+		}
+		fields[keyValue[0]] = keyValue[1]
+	}
+
+	// the credential is accessKey/date/region/service/aws4_request
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[4] != "aws4_request" {
+		return ErrAccessDenied.New("malformed credential")
+	}
+	if !hmac.Equal([]byte(credential[0]), []byte(creds.AccessKey)) {
+		return ErrAccessDenied.New("invalid access key")
+	}
+
+	date, err := time.Parse(iso8601Format, r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return ErrAccessDenied.New("missing or malformed X-Amz-Date")
+	}
+	if skew := time.Since(date); skew > maxRequestSkew || skew < -maxRequestSkew {
+		return ErrAccessDenied.New("request time is too skewed")
+	}
+	if credential[1] != date.Format(yyyymmdd) {
+		return ErrAccessDenied.New("credential date doesn't match X-Amz-Date")
+	}
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	switch payloadHash {
+	case unsignedPayload:
+	case "":
+		return ErrAccessDenied.New("missing X-Amz-Content-Sha256")
+	default:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxSignedBodySize))
+		if err != nil {
+			return ErrAccessDenied.Wrap(err)
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		sum := sha256.Sum256(body)
+		if payloadHash != hex.EncodeToString(sum[:]) {
+			return ErrAccessDenied.New("body doesn't match X-Amz-Content-Sha256")
+		}
+	}
+
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	if !sort.StringsAreSorted(signedHeaders) || !containsString(signedHeaders, "host") {
+		return ErrAccessDenied.New("malformed signed headers")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		var value string
+		switch name {
+		case "host":
+			value = r.Host
+		case "content-length":
+			value = strconv.FormatInt(r.ContentLength, 10)
+		default:
+			value = strings.Join(r.Header[http.CanonicalHeaderKey(name)], ",")
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.Join(strings.Fields(value), " ") + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		s3utils.EncodePath(r.URL.Path),
+		strings.Replace(r.URL.Query().Encode(), "+", "%20", -1),
+		canonicalHeaders.String(),
+		fields["SignedHeaders"],
+		payloadHash,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := strings.Join(credential[1:], "/")
+	stringToSign := strings.Join([]string{
+		signV4Algorithm,
+		date.Format(iso8601Format),
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + creds.SecretKey)
+	for _, part := range credential[1:] {
+		key = sumHMAC(key, []byte(part))
+	}
+	signature := hex.EncodeToString(sumHMAC(key, []byte(stringToSign)))
+
+	if !hmac.Equal([]byte(signature), []byte(fields["Signature"])) {
+		return ErrAccessDenied.New("signature doesn't match")
+	}
+	return nil
+}
+
+func sumHMAC(key, data []byte) []byte {
+	hash := hmac.New(sha256.New, key)
+	_, _ = hash.Write(data)
+	return hash.Sum(nil)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/zeebo/errs"
+)
+
+// The minio object layer predates S3 versioning, so the versioning calls
+// are provided by the gateway layer in addition to the minio.ObjectLayer
+// methods. An empty version id refers to the current version of an object.
+
+// ObjectVersionInfo contains information about a version of an object.
+type ObjectVersionInfo struct {
+	minio.ObjectInfo
+
+	VersionID      string
+	IsLatest       bool
+	IsDeleteMarker bool
+}
+
+// SetBucketVersioning enables or suspends versioning for a bucket.
+func (layer *gatewayLayer) SetBucketVersioning(ctx context.Context, bucketName string, enabled bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = layer.gateway.project.SetBucketVersioning(ctx, bucketName, enabled)
+
+	return convertError(err, bucketName, "")
+}
+
+// GetBucketVersioning returns whether versioning is enabled for a bucket.
+func (layer *gatewayLayer) GetBucketVersioning(ctx context.Context, bucketName string) (enabled bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, _, err := layer.gateway.project.GetBucketInfo(ctx, bucketName)
+	if err != nil {
+		return false, convertError(err, bucketName, "")
+	}
+
+	return bucket.Versioning, nil
+}
+
+// ListObjectVersions lists the versions of an object, starting with the newest one.
+func (layer *gatewayLayer) ListObjectVersions(ctx context.Context, bucketName, objectPath string) (versions []ObjectVersionInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return nil, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	list, err := bucket.ListObjectVersions(ctx, objectPath)
+	if err != nil {
+		return nil, convertError(err, bucketName, objectPath)
+	}
+
+	for _, version := range list {
+		versions = append(versions, ObjectVersionInfo{
+			ObjectInfo: minio.ObjectInfo{
+				Name:        version.Path,
+				Bucket:      version.Bucket.Name,
+				ModTime:     version.Modified,
+				Size:        version.Size,
+				ETag:        hex.EncodeToString(version.Checksum),
+				ContentType: version.ContentType,
+				UserDefined: version.Metadata,
+			},
+			VersionID:      version.VersionID,
+			IsLatest:       version.IsLatest,
+			IsDeleteMarker: version.IsDeleteMarker,
+		})
+	}
+
+	return versions, nil
+}
+
+// GetObjectVersion writes the data of a version of an object to writer.
+func (layer *gatewayLayer) GetObjectVersion(ctx context.Context, bucketName, objectPath, versionID string, startOffset int64, length int64, writer io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	object, err := bucket.OpenObjectVersion(ctx, objectPath, versionID)
+	if err != nil {
+		return convertError(err, bucketName, objectPath)
+	}
+	defer func() { err = errs.Combine(err, object.Close()) }()
+
+	if startOffset < 0 || length < -1 || startOffset+length > object.Meta.Size {
+		return minio.InvalidRange{
+			OffsetBegin:  startOffset,
+			OffsetEnd:    startOffset + length,
+			ResourceSize: object.Meta.Size,
+		}
+	}
+
+	reader, err := object.DownloadRange(ctx, startOffset, length)
+	if err != nil {
+		return convertError(err, bucketName, objectPath)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	_, err = io.Copy(writer, reader)
+
+	return err
+}
+
+// GetObjectVersionInfo returns information about a version of an object.
+func (layer *gatewayLayer) GetObjectVersionInfo(ctx context.Context, bucketName, objectPath, versionID string) (objInfo minio.ObjectInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	object, err := bucket.OpenObjectVersion(ctx, objectPath, versionID)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucketName, objectPath)
+	}
+	defer func() { err = errs.Combine(err, object.Close()) }()
+
+	return minio.ObjectInfo{
+		Name:        object.Meta.Path,
+		Bucket:      object.Meta.Bucket,
+		ModTime:     object.Meta.Modified,
+		Size:        object.Meta.Size,
+		ETag:        hex.EncodeToString(object.Meta.Checksum),
+		ContentType: object.Meta.ContentType,
+		UserDefined: object.Meta.Metadata,
+	}, err
+}
+
+// DeleteObjectVersion permanently deletes a version of an object.
+func (layer *gatewayLayer) DeleteObjectVersion(ctx context.Context, bucketName, objectPath, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := layer.gateway.project.OpenBucket(ctx, bucketName, layer.gateway.access)
+	if err != nil {
+		return convertError(err, bucketName, "")
+	}
+	defer func() { err = errs.Combine(err, bucket.Close()) }()
+
+	err = bucket.DeleteObjectVersion(ctx, objectPath, versionID)
+
+	return convertError(err, bucketName, objectPath)
+}
@@ -53,6 +53,8 @@ type DB interface {
 	KnownUnreliableOrOffline(context.Context, *NodeCriteria, storj.NodeIDList) (storj.NodeIDList, error)
 	// Reliable returns all nodes that are reliable
 	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// GetPlacements returns the attributes of the nodes limited by the diversity constraints
+	GetPlacements(ctx context.Context, nodeIDs storj.NodeIDList) ([]*NodePlacement, error)
 	// Paginate will page through the database nodes
 	Paginate(ctx context.Context, offset int64, limit int) ([]*NodeDossier, bool, error)
 	// PaginateQualified will page through the qualified nodes
@@ -76,7 +78,12 @@ type FindStorageNodesRequest struct {
 	FreeBandwidth        int64
 	FreeDisk             int64
 	ExcludedNodes        []storj.NodeID
-	MinimumVersion       string // semver or empty
+	// PlacedNodes are the nodes already storing pieces of the segment,
+	// they count towards the limits of the diversity constraints.
+	PlacedNodes []storj.NodeID
+	// Placement is the placement policy of the bucket, see ParsePlacement.
+	Placement      string
+	MinimumVersion string // semver or empty
 }
 
 // NodeCriteria are the requirements for selecting nodes
@@ -141,14 +148,22 @@ type Cache struct {
 	log         *zap.Logger
 	db          DB
 	preferences NodeSelectionConfig
+	regions     RegionLookup
 }
 
 // NewCache returns a new Cache
 func NewCache(log *zap.Logger, db DB, preferences NodeSelectionConfig) *Cache {
+	return NewCacheWithRegions(log, db, preferences, nil)
+}
+
+// NewCacheWithRegions returns a new Cache, which uses regions to look up
+// the region of nodes for the per region limit of node selection.
+func NewCacheWithRegions(log *zap.Logger, db DB, preferences NodeSelectionConfig, regions RegionLookup) *Cache {
 	return &Cache{
 		log:         log,
 		db:          db,
 		preferences: preferences,
+		regions:     regions,
 	}
 }
 
@@ -208,6 +223,24 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 
 	excludedNodes := req.ExcludedNodes
 
+	placement, err := cache.parsePlacement(req.Placement)
+	if err != nil {
+		return nil, err
+	}
+
+	// the nodes which are already storing pieces count towards the diversity limits
+	var placed *diversity
+	if placement != nil || preferences.diversityEnabled() {
+		placed = newDiversity(preferences, cache.regions, placement)
+		placements, err := cache.placements(ctx, req.PlacedNodes)
+		if err != nil {
+			return nil, err
+		}
+		for _, placement := range placements {
+			placed.add(placement)
+		}
+	}
+
 	newNodeCount := 0
 	if preferences.NewNodePercentage > 0 {
 		newNodeCount = int(float64(reputableNodeCount) * preferences.NewNodePercentage)
@@ -215,7 +248,7 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 
 	var newNodes []*pb.Node
 	if newNodeCount > 0 {
-		newNodes, err = cache.db.SelectNewStorageNodes(ctx, newNodeCount, &NodeCriteria{
+		newNodes, err = cache.selectNodes(ctx, newNodeCount, placed, &NodeCriteria{
 			FreeBandwidth:  req.FreeBandwidth,
 			FreeDisk:       req.FreeDisk,
 			AuditCount:     preferences.AuditCount,
@@ -223,7 +256,7 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 			MinimumVersion: preferences.MinimumVersion,
 			OnlineWindow:   preferences.OnlineWindow,
 			DistinctIP:     preferences.DistinctIP,
-		})
+		}, cache.db.SelectNewStorageNodes)
 		if err != nil {
 			return nil, err
 		}
@@ -249,7 +282,7 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		OnlineWindow:   preferences.OnlineWindow,
 		DistinctIP:     preferences.DistinctIP,
 	}
-	reputableNodes, err := cache.db.SelectStorageNodes(ctx, reputableNodeCount-len(newNodes), &criteria)
+	reputableNodes, err := cache.selectNodes(ctx, reputableNodeCount-len(newNodes), placed, &criteria, cache.db.SelectStorageNodes)
 	if err != nil {
 		return nil, err
 	}
@@ -387,7 +387,7 @@ func TestNodeInfo(t *testing.T) {
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 		planet.StorageNodes[0].Storage2.Monitor.Loop.Pause()
-		planet.Satellites[0].Discovery.Service.Refresh.Pause()
+		planet.StorageNodes[0].Contact.Chore.Loop.Pause()
 
 		node, err := planet.Satellites[0].Overlay.Service.Get(ctx, planet.StorageNodes[0].ID())
 		require.NoError(t, err)
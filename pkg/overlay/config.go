@@ -32,6 +32,11 @@ type NodeSelectionConfig struct {
 	OnlineWindow      time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"1h"`
 	DistinctIP        bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
 
+	MaxPiecesPerSubnet   int    `help:"the maximum number of pieces of a segment stored on nodes in the same subnet, 0 means no limit" default:"0"`
+	MaxPiecesPerOperator int    `help:"the maximum number of pieces of a segment stored on nodes with the same operator wallet or email, 0 means no limit" default:"0"`
+	MaxPiecesPerRegion   int    `help:"the maximum number of pieces of a segment stored on nodes in the same country, 0 means no limit" default:"0"`
+	RegionDatabase       string `help:"path to a CSV file of IP ranges and their country codes, required for the per region limit" default:""`
+
 	AuditReputationRepairWeight  float64 `help:"weight to apply to audit reputation for total repair reputation calculation" default:"1.0"`
 	AuditReputationUplinkWeight  float64 `help:"weight to apply to audit reputation for total uplink reputation calculation" default:"1.0"`
 	AuditReputationAlpha0        float64 `help:"the initial shape 'alpha' used to calculate audit SNs reputation" default:"1.0"`
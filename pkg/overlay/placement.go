@@ -0,0 +1,311 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// diversityOversampling is how many more candidates are selected from the
+// database than needed, when the selected nodes must be diverse.
+const diversityOversampling = 3
+
+// NodePlacement contains the attributes of a node which are limited by the
+// diversity constraints of node selection.
+type NodePlacement struct {
+	ID      storj.NodeID
+	LastNet string
+	Wallet  string
+	Email   string
+}
+
+// RegionLookup looks up the region of an IP address.
+type RegionLookup interface {
+	// Region returns the region, e.g. the country code, of the IP address or
+	// an empty string when it's unknown.
+	Region(ip net.IP) string
+}
+
+// ErrInvalidPlacement is returned when a placement policy is invalid or can't be enforced.
+var ErrInvalidPlacement = errs.Class("invalid placement")
+
+// euCountries are the country codes of the member states of the European Union.
+var euCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+	"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+// Placement is a placement policy, which restricts the regions of the nodes
+// storing the pieces of a segment.
+type Placement struct {
+	countries map[string]bool
+}
+
+// ParsePlacement parses a placement policy, a comma separated list of country
+// codes and regions, e.g. "EU" or "CH,EU". The region "EU" contains the member
+// states of the European Union. An empty policy returns nil, which allows any node.
+func ParsePlacement(policy string) (*Placement, error) {
+	if strings.TrimSpace(policy) == "" {
+		return nil, nil
+	}
+
+	placement := &Placement{countries: map[string]bool{}}
+	for _, code := range strings.Split(policy, ",") {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		switch {
+		case code == "EU":
+			for _, country := range euCountries {
+				placement.countries[country] = true
+			}
+		case len(code) == 2 && isLetter(code[0]) && isLetter(code[1]):
+			placement.countries[code] = true
+		default:
+			return nil, ErrInvalidPlacement.New("unknown region %q in policy %q", code, policy)
+		}
+	}
+	return placement, nil
+}
+
+func isLetter(b byte) bool { return 'A' <= b && b <= 'Z' }
+
+// Allows returns whether a node in the region may store pieces. Nodes in an
+// unknown region are only allowed without a placement policy.
+func (placement *Placement) Allows(region string) bool {
+	if placement == nil {
+		return true
+	}
+	return placement.countries[region]
+}
+
+// diversityEnabled returns whether any diversity constraint is configured.
+func (config *NodeSelectionConfig) diversityEnabled() bool {
+	return config.MaxPiecesPerSubnet > 0 || config.MaxPiecesPerOperator > 0 || config.MaxPiecesPerRegion > 0
+}
+
+// diversity counts the pieces of a segment per subnet, operator and region.
+type diversity struct {
+	config    *NodeSelectionConfig
+	regions   RegionLookup
+	placement *Placement
+
+	subnets   map[string]int
+	operators map[string]int
+	countries map[string]int
+}
+
+func newDiversity(config *NodeSelectionConfig, regions RegionLookup, placement *Placement) *diversity {
+	return &diversity{
+		config:    config,
+		regions:   regions,
+		placement: placement,
+		subnets:   map[string]int{},
+		operators: map[string]int{},
+		countries: map[string]int{},
+	}
+}
+
+// keys returns the groups of the node, nodes without a known
+// subnet, operator or region aren't limited by that constraint.
+func (diversity *diversity) keys(node *NodePlacement) (subnet string, operators []string, region string) {
+	subnet = node.LastNet
+	if node.Wallet != "" {
+		operators = append(operators, "wallet:"+node.Wallet)
+	}
+	if node.Email != "" {
+		operators = append(operators, "email:"+node.Email)
+	}
+	if diversity.regions != nil && node.LastNet != "" {
+		if ip := net.ParseIP(node.LastNet); ip != nil {
+			region = diversity.regions.Region(ip)
+		}
+	}
+	return subnet, operators, region
+}
+
+// fits returns whether a piece can be stored on the node without exceeding the limits
+// and without violating the placement policy.
+func (diversity *diversity) fits(node *NodePlacement) bool {
+	subnet, operators, region := diversity.keys(node)
+
+	if !diversity.placement.Allows(region) {
+		return false
+	}
+	if limit := diversity.config.MaxPiecesPerSubnet; limit > 0 && subnet != "" && diversity.subnets[subnet] >= limit {
+		return false
+	}
+	if limit := diversity.config.MaxPiecesPerOperator; limit > 0 {
+		for _, operator := range operators {
+			if diversity.operators[operator] >= limit {
+				return false
+			}
+		}
+	}
+	if limit := diversity.config.MaxPiecesPerRegion; limit > 0 && region != "" && diversity.countries[region] >= limit {
+		return false
+	}
+	return true
+}
+
+// add counts a piece stored on the node.
+func (diversity *diversity) add(node *NodePlacement) {
+	subnet, operators, region := diversity.keys(node)
+
+	if subnet != "" {
+		diversity.subnets[subnet]++
+	}
+	for _, operator := range operators {
+		diversity.operators[operator]++
+	}
+	if region != "" {
+		diversity.countries[region]++
+	}
+}
+
+// placements returns the placement of the nodes mapped by their ID.
+func (cache *Cache) placements(ctx context.Context, nodeIDs storj.NodeIDList) (_ map[storj.NodeID]*NodePlacement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	placements := map[storj.NodeID]*NodePlacement{}
+	if len(nodeIDs) == 0 {
+		return placements, nil
+	}
+
+	nodes, err := cache.db.GetPlacements(ctx, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		placements[node.ID] = node
+	}
+	return placements, nil
+}
+
+// selectNodes selects count nodes with selectFn. Unless placed is nil, the
+// number of pieces per subnet, operator and region is kept within the limits.
+// The candidates which don't fit are excluded and more candidates are selected,
+// until enough nodes are found or no candidates are left, so nodes in regions
+// with few nodes are found as well.
+func (cache *Cache) selectNodes(ctx context.Context, count int, placed *diversity, criteria *NodeCriteria,
+	selectFn func(context.Context, int, *NodeCriteria) ([]*pb.Node, error)) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if placed == nil {
+		return selectFn(ctx, count, criteria)
+	}
+
+	// the criteria of the caller are left unchanged
+	selection := *criteria
+	selection.ExcludedNodes = append(storj.NodeIDList(nil), criteria.ExcludedNodes...)
+	selection.ExcludedIPs = append([]string(nil), criteria.ExcludedIPs...)
+
+	var nodes []*pb.Node
+	for sample := count * diversityOversampling; len(nodes) < count; sample *= 2 {
+		candidates, err := selectFn(ctx, sample, &selection)
+		if err != nil {
+			return nil, err
+		}
+
+		var candidateIDs storj.NodeIDList
+		for _, candidate := range candidates {
+			candidateIDs = append(candidateIDs, candidate.Id)
+		}
+		placements, err := cache.placements(ctx, candidateIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, candidate := range candidates {
+			if len(nodes) >= count {
+				break
+			}
+			selection.ExcludedNodes = append(selection.ExcludedNodes, candidate.Id)
+
+			placement, ok := placements[candidate.Id]
+			if !ok {
+				placement = &NodePlacement{ID: candidate.Id, LastNet: candidate.LastIp}
+			}
+			if !placed.fits(placement) {
+				continue
+			}
+			placed.add(placement)
+			nodes = append(nodes, candidate)
+			if selection.DistinctIP {
+				selection.ExcludedIPs = append(selection.ExcludedIPs, candidate.LastIp)
+			}
+		}
+
+		// fewer candidates than requested are left
+		if len(candidates) < sample {
+			break
+		}
+	}
+	return nodes, nil
+}
+
+// MisplacedPieces returns the numbers of the pieces which violate the placement
+// policy or exceed the limits of the diversity constraints. The pieces are
+// counted in order, so the last pieces in the same subnet, of the same operator
+// or in the same region are reported.
+func (cache *Cache) MisplacedPieces(ctx context.Context, placementPolicy string, pieces []*pb.RemotePiece) (misplaced []int32, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	placement, err := cache.parsePlacement(placementPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if (placement == nil && !cache.preferences.diversityEnabled()) || len(pieces) == 0 {
+		return nil, nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, piece := range pieces {
+		nodeIDs = append(nodeIDs, piece.NodeId)
+	}
+	placements, err := cache.placements(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.New("error getting node placements %s", err)
+	}
+
+	placed := newDiversity(&cache.preferences, cache.regions, placement)
+	for _, piece := range pieces {
+		placement, ok := placements[piece.NodeId]
+		if !ok {
+			// nodes unknown to the overlay are reported as missing
+			continue
+		}
+		if !placed.fits(placement) {
+			misplaced = append(misplaced, piece.GetPieceNum())
+			continue
+		}
+		placed.add(placement)
+	}
+	return misplaced, nil
+}
+
+// ValidatePlacement returns an error when the placement policy is invalid
+// or can't be enforced by the node selection.
+func (cache *Cache) ValidatePlacement(policy string) error {
+	_, err := cache.parsePlacement(policy)
+	return err
+}
+
+// parsePlacement parses the placement policy, which can only be enforced
+// when the regions of the nodes are known.
+func (cache *Cache) parsePlacement(policy string) (*Placement, error) {
+	placement, err := ParsePlacement(policy)
+	if err != nil {
+		return nil, err
+	}
+	if placement != nil && cache.regions == nil {
+		return nil, ErrInvalidPlacement.New("policy %q requires a region database", policy)
+	}
+	return placement, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// RegionDB looks up the country of IP addresses in a list of IP ranges.
+type RegionDB struct {
+	ranges []ipRange
+}
+
+type ipRange struct {
+	first, last net.IP
+	region      string
+}
+
+var _ RegionLookup = (*RegionDB)(nil)
+
+// OpenRegionDB loads the IP ranges from a local CSV database file.
+func OpenRegionDB(path string) (_ *RegionDB, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	return ParseRegionDB(file)
+}
+
+// ParseRegionDB parses IP ranges in the CSV format
+// "first ip,last ip,country code", which is used by the common IP-to-country
+// databases. Additional columns are ignored.
+func ParseRegionDB(r io.Reader) (*RegionDB, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	db := &RegionDB{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if len(record) < 3 {
+			return nil, Error.New("invalid region record %q", record)
+		}
+
+		first, last := net.ParseIP(strings.TrimSpace(record[0])), net.ParseIP(strings.TrimSpace(record[1]))
+		if first == nil || last == nil {
+			return nil, Error.New("invalid IP range %q-%q", record[0], record[1])
+		}
+		db.ranges = append(db.ranges, ipRange{
+			first:  first.To16(),
+			last:   last.To16(),
+			region: strings.ToUpper(strings.TrimSpace(record[2])),
+		})
+	}
+
+	sort.Slice(db.ranges, func(i, k int) bool {
+		return bytes.Compare(db.ranges[i].first, db.ranges[k].first) < 0
+	})
+	return db, nil
+}
+
+// Region returns the country code of the IP address, or an empty string
+// when it isn't in any of the ranges.
+func (db *RegionDB) Region(ip net.IP) string {
+	ip = ip.To16()
+	if ip == nil {
+		return ""
+	}
+
+	// find the last range starting before the address
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].first, ip) > 0
+	}) - 1
+	if i < 0 || bytes.Compare(ip, db.ranges[i].last) > 0 {
+		return ""
+	}
+	return db.ranges[i].region
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestRegionDB(t *testing.T) {
+	db, err := overlay.ParseRegionDB(strings.NewReader(`
+# first ip,last ip,country
+8.8.8.0,8.8.8.255,us
+1.0.0.0,1.0.0.255,AU,Australia
+2001:db8::,2001:db8::ffff,DE
+`))
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		ip     string
+		region string
+	}{
+		{"8.8.8.0", "US"},
+		{"8.8.8.8", "US"},
+		{"8.8.9.0", ""},
+		{"1.0.0.1", "AU"},
+		{"0.255.255.255", ""},
+		{"2001:db8::1", "DE"},
+		{"2001:db8::1:0", ""},
+	} {
+		assert.Equal(t, tt.region, db.Region(net.ParseIP(tt.ip)), tt.ip)
+	}
+
+	_, err = overlay.ParseRegionDB(strings.NewReader("8.8.8.0,invalid,US\n"))
+	assert.Error(t, err)
+}
+
+func TestParsePlacement(t *testing.T) {
+	placement, err := overlay.ParsePlacement("")
+	require.NoError(t, err)
+	assert.Nil(t, placement)
+	assert.True(t, placement.Allows(""))
+	assert.True(t, placement.Allows("US"))
+
+	placement, err = overlay.ParsePlacement("eu, ch")
+	require.NoError(t, err)
+	assert.True(t, placement.Allows("DE"))
+	assert.True(t, placement.Allows("CH"))
+	assert.False(t, placement.Allows("US"))
+	assert.False(t, placement.Allows(""))
+
+	for _, invalid := range []string{"EUROPE", "D1", "DE,", "DE;FR"} {
+		_, err := overlay.ParsePlacement(invalid)
+		assert.True(t, overlay.ErrInvalidPlacement.Has(err), invalid)
+	}
+}
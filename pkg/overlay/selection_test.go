@@ -4,16 +4,20 @@
 package overlay_test
 
 import (
+	"context"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -259,3 +263,152 @@ func TestAddrtoNetwork_Conversion(t *testing.T) {
 	require.Equal(t, "fc00::", network)
 	require.NoError(t, err)
 }
+
+func TestDiversity(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 10, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		// all nodes of testplanet are in the same subnet and have the same wallet
+		regions, err := overlay.ParseRegionDB(strings.NewReader("127.0.0.0,127.255.255.255,ZZ\n"))
+		require.NoError(t, err)
+
+		limits := func(subnet, operator, region int) overlay.NodeSelectionConfig {
+			preferences := testNodeSelectionConfig(0, 0, false)
+			preferences.MaxPiecesPerSubnet = subnet
+			preferences.MaxPiecesPerOperator = operator
+			preferences.MaxPiecesPerRegion = region
+			return preferences
+		}
+
+		for i, tt := range []struct {
+			preferences   overlay.NodeSelectionConfig
+			placedCount   int
+			requestCount  int
+			expectedCount int
+		}{
+			{preferences: limits(0, 0, 0), requestCount: 8, expectedCount: 8},
+			{preferences: limits(4, 0, 0), requestCount: 8, expectedCount: 4},
+			{preferences: limits(0, 3, 0), requestCount: 8, expectedCount: 3},
+			{preferences: limits(0, 0, 2), requestCount: 8, expectedCount: 2},
+			{preferences: limits(4, 0, 0), placedCount: 3, requestCount: 8, expectedCount: 1},
+		} {
+			t.Logf("#%2d. %+v", i, tt)
+
+			var placed []storj.NodeID
+			for _, node := range planet.StorageNodes[:tt.placedCount] {
+				placed = append(placed, node.ID())
+			}
+
+			cache := overlay.NewCacheWithRegions(zaptest.NewLogger(t), satellite.DB.OverlayCache(), tt.preferences, regions)
+			response, err := cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: tt.requestCount,
+				ExcludedNodes:  placed,
+				PlacedNodes:    placed,
+			})
+			if tt.expectedCount < tt.requestCount {
+				assert.True(t, overlay.ErrNotEnoughNodes.Has(err))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, response, tt.expectedCount)
+		}
+	})
+}
+
+func TestMisplacedPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		var pieces []*pb.RemotePiece
+		for i, node := range planet.StorageNodes {
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: node.ID()})
+		}
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), satellite.DB.OverlayCache(), testNodeSelectionConfig(0, 0, false))
+		misplaced, err := cache.MisplacedPieces(ctx, "", pieces)
+		require.NoError(t, err)
+		assert.Empty(t, misplaced)
+
+		preferences := testNodeSelectionConfig(0, 0, false)
+		preferences.MaxPiecesPerSubnet = 3
+		cache = overlay.NewCache(zaptest.NewLogger(t), satellite.DB.OverlayCache(), preferences)
+		misplaced, err = cache.MisplacedPieces(ctx, "", pieces)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{3, 4}, misplaced)
+
+		// placement policies need the regions of the nodes
+		_, err = cache.MisplacedPieces(ctx, "US", pieces)
+		require.True(t, overlay.ErrInvalidPlacement.Has(err))
+
+		regions, err := overlay.ParseRegionDB(strings.NewReader("127.0.0.0,127.255.255.255,US"))
+		require.NoError(t, err)
+		cache = overlay.NewCacheWithRegions(zaptest.NewLogger(t), satellite.DB.OverlayCache(), testNodeSelectionConfig(0, 0, false), regions)
+
+		misplaced, err = cache.MisplacedPieces(ctx, "US", pieces)
+		require.NoError(t, err)
+		assert.Empty(t, misplaced)
+
+		misplaced, err = cache.MisplacedPieces(ctx, "EU", pieces)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{0, 1, 2, 3, 4}, misplaced)
+	})
+}
+
+func TestPlacementMinorityRegion(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 20, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		regions, err := overlay.ParseRegionDB(strings.NewReader("10.0.0.0,10.0.0.255,US\n10.0.1.0,10.0.1.255,CH\n"))
+		require.NoError(t, err)
+
+		// only two of the nodes are in the region of the placement
+		db := &regionsDB{DB: satellite.DB.OverlayCache(), lastNets: map[storj.NodeID]string{}}
+		for i, node := range planet.StorageNodes {
+			db.lastNets[node.ID()] = "10.0.0.1"
+			if i < 2 {
+				db.lastNets[node.ID()] = "10.0.1.1"
+			}
+		}
+
+		cache := overlay.NewCacheWithRegions(zaptest.NewLogger(t), db, testNodeSelectionConfig(0, 0, false), regions)
+		for i := 0; i < 5; i++ {
+			nodes, err := cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: 2,
+				Placement:      "CH",
+			})
+			require.NoError(t, err)
+			require.Len(t, nodes, 2)
+			for _, node := range nodes {
+				assert.Equal(t, "10.0.1.1", db.lastNets[node.Id])
+			}
+		}
+
+		nodes, err := cache.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 3,
+			Placement:      "CH",
+		})
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err))
+		assert.Len(t, nodes, 2)
+	})
+}
+
+// regionsDB places the nodes in the subnets given by lastNets, as all nodes
+// of testplanet share the same address.
+type regionsDB struct {
+	overlay.DB
+	lastNets map[storj.NodeID]string
+}
+
+func (db *regionsDB) GetPlacements(ctx context.Context, nodeIDs storj.NodeIDList) ([]*overlay.NodePlacement, error) {
+	placements, err := db.DB.GetPlacements(ctx, nodeIDs)
+	for _, placement := range placements {
+		placement.LastNet = db.lastNets[placement.ID]
+	}
+	return placements, err
+}
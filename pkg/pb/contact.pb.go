@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contact.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type CheckInRequest struct {
+	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Capacity             *NodeCapacity `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Operator             *NodeOperator `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Version              *NodeVersion  `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CheckInRequest) Reset()         { *m = CheckInRequest{} }
+func (m *CheckInRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckInRequest) ProtoMessage()    {}
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{0}
+}
+func (m *CheckInRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInRequest.Unmarshal(m, b)
+}
+func (m *CheckInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInRequest.Merge(m, src)
+}
+func (m *CheckInRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckInRequest.Size(m)
+}
+func (m *CheckInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInRequest proto.InternalMessageInfo
+
+func (m *CheckInRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CheckInRequest) GetCapacity() *NodeCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *CheckInRequest) GetOperator() *NodeOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *CheckInRequest) GetVersion() *NodeVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+type CheckInResponse struct {
+	PingNodeSuccess      bool     `protobuf:"varint,1,opt,name=ping_node_success,json=pingNodeSuccess,proto3" json:"ping_node_success,omitempty"`
+	PingErrorMessage     string   `protobuf:"bytes,2,opt,name=ping_error_message,json=pingErrorMessage,proto3" json:"ping_error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckInResponse) Reset()         { *m = CheckInResponse{} }
+func (m *CheckInResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckInResponse) ProtoMessage()    {}
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{1}
+}
+func (m *CheckInResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInResponse.Unmarshal(m, b)
+}
+func (m *CheckInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInResponse.Merge(m, src)
+}
+func (m *CheckInResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckInResponse.Size(m)
+}
+func (m *CheckInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInResponse proto.InternalMessageInfo
+
+func (m *CheckInResponse) GetPingNodeSuccess() bool {
+	if m != nil {
+		return m.PingNodeSuccess
+	}
+	return false
+}
+
+func (m *CheckInResponse) GetPingErrorMessage() string {
+	if m != nil {
+		return m.PingErrorMessage
+	}
+	return ""
+}
+
+type ContactPingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactPingRequest) Reset()         { *m = ContactPingRequest{} }
+func (m *ContactPingRequest) String() string { return proto.CompactTextString(m) }
+func (*ContactPingRequest) ProtoMessage()    {}
+func (*ContactPingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{2}
+}
+func (m *ContactPingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactPingRequest.Unmarshal(m, b)
+}
+func (m *ContactPingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactPingRequest.Marshal(b, m, deterministic)
+}
+func (m *ContactPingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactPingRequest.Merge(m, src)
+}
+func (m *ContactPingRequest) XXX_Size() int {
+	return xxx_messageInfo_ContactPingRequest.Size(m)
+}
+func (m *ContactPingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactPingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactPingRequest proto.InternalMessageInfo
+
+type ContactPingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactPingResponse) Reset()         { *m = ContactPingResponse{} }
+func (m *ContactPingResponse) String() string { return proto.CompactTextString(m) }
+func (*ContactPingResponse) ProtoMessage()    {}
+func (*ContactPingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{3}
+}
+func (m *ContactPingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactPingResponse.Unmarshal(m, b)
+}
+func (m *ContactPingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactPingResponse.Marshal(b, m, deterministic)
+}
+func (m *ContactPingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactPingResponse.Merge(m, src)
+}
+func (m *ContactPingResponse) XXX_Size() int {
+	return xxx_messageInfo_ContactPingResponse.Size(m)
+}
+func (m *ContactPingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactPingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactPingResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CheckInRequest)(nil), "contact.CheckInRequest")
+	proto.RegisterType((*CheckInResponse)(nil), "contact.CheckInResponse")
+	proto.RegisterType((*ContactPingRequest)(nil), "contact.ContactPingRequest")
+	proto.RegisterType((*ContactPingResponse)(nil), "contact.ContactPingResponse")
+}
+
+func init() { proto.RegisterFile("contact.proto", fileDescriptor_a5036fff2565fb15) }
+
+var fileDescriptor_a5036fff2565fb15 = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0x02, 0x31,
+	0x10, 0x86, 0xb3, 0x48, 0x5c, 0x18, 0xa3, 0xc8, 0xa8, 0x71, 0x83, 0x1e, 0xc8, 0x9e, 0x88, 0x9a,
+	0x3d, 0xe0, 0xd5, 0x93, 0x84, 0x18, 0x0f, 0x28, 0xa9, 0x89, 0x07, 0x2f, 0x64, 0xe9, 0x4e, 0x70,
+	0x43, 0x6c, 0x6b, 0x5b, 0x4c, 0x7c, 0x32, 0x5f, 0xcf, 0x74, 0xdb, 0x85, 0x20, 0x1e, 0xfb, 0x7f,
+	0x5f, 0x27, 0x7f, 0xa7, 0x70, 0xc8, 0xa5, 0xb0, 0x39, 0xb7, 0x99, 0xd2, 0xd2, 0x4a, 0x8c, 0xc3,
+	0xb1, 0x07, 0x42, 0x16, 0xe4, 0xc3, 0xf4, 0x27, 0x82, 0xa3, 0xd1, 0x3b, 0xf1, 0xe5, 0xa3, 0x60,
+	0xf4, 0xb9, 0x22, 0x63, 0x31, 0x81, 0x38, 0x2f, 0x0a, 0x4d, 0xc6, 0x24, 0x51, 0x3f, 0x1a, 0xb4,
+	0x59, 0x7d, 0xc4, 0x0c, 0x5a, 0x3c, 0x57, 0x39, 0x2f, 0xed, 0x77, 0xd2, 0xe8, 0x47, 0x83, 0x83,
+	0x21, 0x66, 0xd5, 0xac, 0x27, 0x59, 0xd0, 0x28, 0x10, 0xb6, 0x76, 0x9c, 0x2f, 0x15, 0xe9, 0xdc,
+	0x4a, 0x9d, 0xec, 0xfd, 0xf5, 0x9f, 0x03, 0x61, 0x6b, 0x07, 0xaf, 0x21, 0xfe, 0x22, 0x6d, 0x4a,
+	0x29, 0x92, 0x66, 0xa5, 0x77, 0x37, 0xfa, 0xab, 0x07, 0xac, 0x36, 0xd2, 0x25, 0x74, 0xd6, 0xc5,
+	0x8d, 0x92, 0xc2, 0x10, 0x5e, 0x41, 0x57, 0x95, 0x62, 0x31, 0x73, 0x97, 0x66, 0x66, 0xc5, 0x79,
+	0xfd, 0x86, 0x16, 0xeb, 0x38, 0xe0, 0xe6, 0xbc, 0xf8, 0x18, 0x6f, 0x00, 0x2b, 0x97, 0xb4, 0x96,
+	0x7a, 0xf6, 0x41, 0xc6, 0xe4, 0x0b, 0xaa, 0x5e, 0xd5, 0x66, 0xc7, 0x8e, 0x8c, 0x1d, 0x98, 0xf8,
+	0x3c, 0x3d, 0x05, 0x1c, 0xf9, 0xed, 0x4d, 0x4b, 0xb1, 0x08, 0x9b, 0x4a, 0xcf, 0xe0, 0x64, 0x2b,
+	0xf5, 0x35, 0x86, 0x0f, 0x10, 0x87, 0x18, 0xef, 0x20, 0x0e, 0x25, 0xf1, 0x3c, 0xab, 0xbf, 0x63,
+	0x7b, 0xdf, 0xbd, 0x64, 0x17, 0x84, 0x41, 0x13, 0x68, 0xba, 0xca, 0x38, 0x86, 0xd6, 0x34, 0xd4,
+	0xc7, 0x8b, 0x8d, 0xbd, 0x53, 0xa8, 0x77, 0xf9, 0x3f, 0xf4, 0xe3, 0xee, 0x9b, 0x6f, 0x0d, 0x35,
+	0x9f, 0xef, 0x57, 0x1f, 0x7f, 0xfb, 0x3b, 0x00, 0xcf, 0xe2, 0x7f, 0x3b, 0x1e, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ContactClient is the client API for Contact service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ContactClient interface {
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+}
+
+type contactClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewContactClient(cc *grpc.ClientConn) ContactClient {
+	return &contactClient{cc}
+}
+
+func (c *contactClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, "/contact.Contact/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContactServer is the server API for Contact service.
+type ContactServer interface {
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+}
+
+func RegisterContactServer(s *grpc.Server, srv ContactServer) {
+	s.RegisterService(&_Contact_serviceDesc, srv)
+}
+
+func _Contact_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contact.Contact/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Contact_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "contact.Contact",
+	HandlerType: (*ContactServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIn",
+			Handler:    _Contact_CheckIn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "contact.proto",
+}
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	PingNode(ctx context.Context, in *ContactPingRequest, opts ...grpc.CallOption) (*ContactPingResponse, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) PingNode(ctx context.Context, in *ContactPingRequest, opts ...grpc.CallOption) (*ContactPingResponse, error) {
+	out := new(ContactPingResponse)
+	err := c.cc.Invoke(ctx, "/contact.Node/PingNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	PingNode(context.Context, *ContactPingRequest) (*ContactPingResponse, error)
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_PingNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).PingNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contact.Node/PingNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).PingNode(ctx, req.(*ContactPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "contact.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PingNode",
+			Handler:    _Node_PingNode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "contact.proto",
+}
@@ -26,12 +26,16 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // InjuredSegment is the queue item used for the data repair queue
 type InjuredSegment struct {
-	Path                 []byte    `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	LostPieces           []int32   `protobuf:"varint,2,rep,packed,name=lost_pieces,json=lostPieces,proto3" json:"lost_pieces,omitempty"`
-	InsertedTime         time.Time `protobuf:"bytes,3,opt,name=inserted_time,json=insertedTime,proto3,stdtime" json:"inserted_time"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Path         []byte    `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	LostPieces   []int32   `protobuf:"varint,2,rep,packed,name=lost_pieces,json=lostPieces,proto3" json:"lost_pieces,omitempty"`
+	InsertedTime time.Time `protobuf:"bytes,3,opt,name=inserted_time,json=insertedTime,proto3,stdtime" json:"inserted_time"`
+	// num_healthy_pieces is the number of healthy pieces when the segment was checked
+	NumHealthyPieces int32 `protobuf:"varint,4,opt,name=num_healthy_pieces,json=numHealthyPieces,proto3" json:"num_healthy_pieces,omitempty"`
+	// redundancy_margin is the number of pieces which can be lost before the segment is lost
+	RedundancyMargin     int32    `protobuf:"varint,5,opt,name=redundancy_margin,json=redundancyMargin,proto3" json:"redundancy_margin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InjuredSegment) Reset()         { *m = InjuredSegment{} }
@@ -79,6 +83,20 @@ func (m *InjuredSegment) GetInsertedTime() time.Time {
 	return time.Time{}
 }
 
+func (m *InjuredSegment) GetNumHealthyPieces() int32 {
+	if m != nil {
+		return m.NumHealthyPieces
+	}
+	return 0
+}
+
+func (m *InjuredSegment) GetRedundancyMargin() int32 {
+	if m != nil {
+		return m.RedundancyMargin
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*InjuredSegment)(nil), "repair.InjuredSegment")
 }
@@ -86,18 +104,21 @@ func init() {
 func init() { proto.RegisterFile("datarepair.proto", fileDescriptor_b1b08e6fe9398aa6) }
 
 var fileDescriptor_b1b08e6fe9398aa6 = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x49, 0x2c, 0x49,
-	0x2c, 0x4a, 0x2d, 0x48, 0xcc, 0x2c, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0,
-	0xa4, 0xb8, 0xd2, 0xf3, 0xd3, 0xf3, 0x21, 0x62, 0x52, 0xf2, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9,
-	0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e, 0x01,
-	0x44, 0x81, 0xd2, 0x04, 0x46, 0x2e, 0x3e, 0xcf, 0xbc, 0xac, 0xd2, 0xa2, 0xd4, 0x94, 0xe0, 0xd4,
-	0xf4, 0xdc, 0xd4, 0xbc, 0x12, 0x21, 0x21, 0x2e, 0x96, 0x82, 0xc4, 0x92, 0x0c, 0x09, 0x46, 0x05,
-	0x46, 0x0d, 0x9e, 0x20, 0x30, 0x5b, 0x48, 0x9e, 0x8b, 0x3b, 0x27, 0xbf, 0xb8, 0x24, 0xbe, 0x20,
-	0x33, 0x35, 0x39, 0xb5, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0x35, 0x88, 0x0b, 0x24, 0x14, 0x00,
-	0x16, 0x11, 0xf2, 0xe4, 0xe2, 0xcd, 0xcc, 0x2b, 0x4e, 0x2d, 0x2a, 0x49, 0x4d, 0x89, 0x07, 0xd9,
-	0x21, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa5, 0x07, 0x71, 0x80, 0x1e, 0xcc, 0x01, 0x7a,
-	0x21, 0x30, 0x07, 0x38, 0x71, 0x9c, 0xb8, 0x27, 0xcf, 0x30, 0xe1, 0xbe, 0x3c, 0x63, 0x10, 0x0f,
-	0x4c, 0x2b, 0x48, 0xd2, 0x89, 0x25, 0x8a, 0xa9, 0x20, 0x29, 0x89, 0x0d, 0xac, 0xc3, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0x66, 0xb5, 0xcc, 0x1f, 0xe8, 0x00, 0x00, 0x00,
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x8e, 0x3f, 0x4e, 0xc3, 0x30,
+	0x18, 0xc5, 0x71, 0x9b, 0x54, 0xc8, 0x2d, 0xa8, 0x78, 0x8a, 0xb2, 0x24, 0x62, 0x8a, 0x04, 0x4a,
+	0x25, 0xb8, 0x41, 0x27, 0x3a, 0x20, 0xa1, 0xc0, 0xc4, 0x12, 0x39, 0xf5, 0x87, 0x63, 0x14, 0xff,
+	0x91, 0x63, 0x0f, 0xbd, 0x05, 0xc7, 0xe2, 0x14, 0x70, 0x00, 0x2e, 0x81, 0x62, 0x13, 0xb1, 0xf9,
+	0xfb, 0xbd, 0x67, 0xbd, 0x1f, 0xde, 0x32, 0xea, 0xa8, 0x05, 0x43, 0x85, 0xad, 0x8d, 0xd5, 0x4e,
+	0x93, 0x55, 0xbc, 0x72, 0xcc, 0x35, 0xd7, 0x91, 0xe5, 0x05, 0xd7, 0x9a, 0x0f, 0xb0, 0x0b, 0x57,
+	0xe7, 0xdf, 0x76, 0x4e, 0x48, 0x18, 0x1d, 0x95, 0x26, 0x16, 0xae, 0x7f, 0x10, 0xbe, 0x3c, 0xa8,
+	0x77, 0x6f, 0x81, 0x3d, 0x03, 0x97, 0xa0, 0x1c, 0x21, 0x38, 0x31, 0xd4, 0xf5, 0x19, 0x2a, 0x51,
+	0xb5, 0x69, 0xc2, 0x9b, 0x14, 0x78, 0x3d, 0xe8, 0xd1, 0xb5, 0x46, 0xc0, 0x11, 0xc6, 0x6c, 0x51,
+	0x2e, 0xab, 0xb4, 0xc1, 0x13, 0x7a, 0x0a, 0x84, 0x1c, 0xf0, 0x85, 0x50, 0x23, 0x58, 0x07, 0xac,
+	0x9d, 0x36, 0xb2, 0x65, 0x89, 0xaa, 0xf5, 0x5d, 0x5e, 0x47, 0x81, 0x7a, 0x16, 0xa8, 0x5f, 0x66,
+	0x81, 0xfd, 0xf9, 0xe7, 0x57, 0x71, 0xf6, 0xf1, 0x5d, 0xa0, 0x66, 0x33, 0x7f, 0x9d, 0x42, 0x72,
+	0x8b, 0x89, 0xf2, 0xb2, 0xed, 0x81, 0x0e, 0xae, 0x3f, 0xcd, 0x93, 0x49, 0x89, 0xaa, 0xb4, 0xd9,
+	0x2a, 0x2f, 0x1f, 0x62, 0xf0, 0x37, 0x7c, 0x83, 0xaf, 0x2c, 0x30, 0xaf, 0x18, 0x55, 0xc7, 0x53,
+	0x2b, 0xa9, 0xe5, 0x42, 0x65, 0x69, 0x2c, 0xff, 0x07, 0x8f, 0x81, 0xef, 0x93, 0xd7, 0x85, 0xe9,
+	0xba, 0x55, 0x90, 0xb9, 0xff, 0x1d, 0x00, 0xfe, 0x34, 0x5b, 0xaa, 0x43, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,1058 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gracefulexit.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	math "math"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type TransferFailed_Error int32
+
+const (
+	TransferFailed_NOT_FOUND                TransferFailed_Error = 0
+	TransferFailed_STORAGE_NODE_UNAVAILABLE TransferFailed_Error = 1
+	TransferFailed_HASH_VERIFICATION        TransferFailed_Error = 2
+	TransferFailed_UNKNOWN                  TransferFailed_Error = 3
+)
+
+var TransferFailed_Error_name = map[int32]string{
+	0: "NOT_FOUND",
+	1: "STORAGE_NODE_UNAVAILABLE",
+	2: "HASH_VERIFICATION",
+	3: "UNKNOWN",
+}
+
+var TransferFailed_Error_value = map[string]int32{
+	"NOT_FOUND":                0,
+	"STORAGE_NODE_UNAVAILABLE": 1,
+	"HASH_VERIFICATION":        2,
+	"UNKNOWN":                  3,
+}
+
+func (x TransferFailed_Error) String() string {
+	return proto.EnumName(TransferFailed_Error_name, int32(x))
+}
+
+func (TransferFailed_Error) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{12, 0}
+}
+
+type GetNonExitingSatellitesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNonExitingSatellitesRequest) Reset()         { *m = GetNonExitingSatellitesRequest{} }
+func (m *GetNonExitingSatellitesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNonExitingSatellitesRequest) ProtoMessage()    {}
+func (*GetNonExitingSatellitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{0}
+}
+func (m *GetNonExitingSatellitesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNonExitingSatellitesRequest.Unmarshal(m, b)
+}
+func (m *GetNonExitingSatellitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNonExitingSatellitesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNonExitingSatellitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNonExitingSatellitesRequest.Merge(m, src)
+}
+func (m *GetNonExitingSatellitesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNonExitingSatellitesRequest.Size(m)
+}
+func (m *GetNonExitingSatellitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNonExitingSatellitesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNonExitingSatellitesRequest proto.InternalMessageInfo
+
+type InitiateGracefulExitRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateGracefulExitRequest) Reset()         { *m = InitiateGracefulExitRequest{} }
+func (m *InitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitRequest) ProtoMessage()    {}
+func (*InitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{1}
+}
+func (m *InitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *InitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Size(m)
+}
+func (m *InitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitRequest proto.InternalMessageInfo
+
+// NonExitingSatellite contains information that's needed for a storagenode to start graceful exit
+type NonExitingSatellite struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	DomainName           string   `protobuf:"bytes,2,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	SpaceUsed            float64  `protobuf:"fixed64,3,opt,name=space_used,json=spaceUsed,proto3" json:"space_used,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NonExitingSatellite) Reset()         { *m = NonExitingSatellite{} }
+func (m *NonExitingSatellite) String() string { return proto.CompactTextString(m) }
+func (*NonExitingSatellite) ProtoMessage()    {}
+func (*NonExitingSatellite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{2}
+}
+func (m *NonExitingSatellite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NonExitingSatellite.Unmarshal(m, b)
+}
+func (m *NonExitingSatellite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NonExitingSatellite.Marshal(b, m, deterministic)
+}
+func (m *NonExitingSatellite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonExitingSatellite.Merge(m, src)
+}
+func (m *NonExitingSatellite) XXX_Size() int {
+	return xxx_messageInfo_NonExitingSatellite.Size(m)
+}
+func (m *NonExitingSatellite) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonExitingSatellite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonExitingSatellite proto.InternalMessageInfo
+
+func (m *NonExitingSatellite) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *NonExitingSatellite) GetSpaceUsed() float64 {
+	if m != nil {
+		return m.SpaceUsed
+	}
+	return 0
+}
+
+type GetNonExitingSatellitesResponse struct {
+	Satellites           []*NonExitingSatellite `protobuf:"bytes,1,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetNonExitingSatellitesResponse) Reset()         { *m = GetNonExitingSatellitesResponse{} }
+func (m *GetNonExitingSatellitesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNonExitingSatellitesResponse) ProtoMessage()    {}
+func (*GetNonExitingSatellitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{3}
+}
+func (m *GetNonExitingSatellitesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNonExitingSatellitesResponse.Unmarshal(m, b)
+}
+func (m *GetNonExitingSatellitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNonExitingSatellitesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNonExitingSatellitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNonExitingSatellitesResponse.Merge(m, src)
+}
+func (m *GetNonExitingSatellitesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNonExitingSatellitesResponse.Size(m)
+}
+func (m *GetNonExitingSatellitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNonExitingSatellitesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNonExitingSatellitesResponse proto.InternalMessageInfo
+
+func (m *GetNonExitingSatellitesResponse) GetSatellites() []*NonExitingSatellite {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+type GetExitProgressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExitProgressRequest) Reset()         { *m = GetExitProgressRequest{} }
+func (m *GetExitProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExitProgressRequest) ProtoMessage()    {}
+func (*GetExitProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{4}
+}
+func (m *GetExitProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExitProgressRequest.Unmarshal(m, b)
+}
+func (m *GetExitProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExitProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExitProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExitProgressRequest.Merge(m, src)
+}
+func (m *GetExitProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExitProgressRequest.Size(m)
+}
+func (m *GetExitProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExitProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExitProgressRequest proto.InternalMessageInfo
+
+type GetExitProgressResponse struct {
+	Progress             []*ExitProgress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetExitProgressResponse) Reset()         { *m = GetExitProgressResponse{} }
+func (m *GetExitProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExitProgressResponse) ProtoMessage()    {}
+func (*GetExitProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{5}
+}
+func (m *GetExitProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExitProgressResponse.Unmarshal(m, b)
+}
+func (m *GetExitProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExitProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExitProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExitProgressResponse.Merge(m, src)
+}
+func (m *GetExitProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExitProgressResponse.Size(m)
+}
+func (m *GetExitProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExitProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExitProgressResponse proto.InternalMessageInfo
+
+func (m *GetExitProgressResponse) GetProgress() []*ExitProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type ExitProgress struct {
+	DomainName           string   `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	PercentComplete      float32  `protobuf:"fixed32,3,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	Finished             bool     `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`
+	Successful           bool     `protobuf:"varint,5,opt,name=successful,proto3" json:"successful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExitProgress) Reset()         { *m = ExitProgress{} }
+func (m *ExitProgress) String() string { return proto.CompactTextString(m) }
+func (*ExitProgress) ProtoMessage()    {}
+func (*ExitProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{6}
+}
+func (m *ExitProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitProgress.Unmarshal(m, b)
+}
+func (m *ExitProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitProgress.Marshal(b, m, deterministic)
+}
+func (m *ExitProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitProgress.Merge(m, src)
+}
+func (m *ExitProgress) XXX_Size() int {
+	return xxx_messageInfo_ExitProgress.Size(m)
+}
+func (m *ExitProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitProgress proto.InternalMessageInfo
+
+func (m *ExitProgress) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *ExitProgress) GetPercentComplete() float32 {
+	if m != nil {
+		return m.PercentComplete
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *ExitProgress) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+type InitiateExitRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateExitRequest) Reset()         { *m = InitiateExitRequest{} }
+func (m *InitiateExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateExitRequest) ProtoMessage()    {}
+func (*InitiateExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{7}
+}
+func (m *InitiateExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateExitRequest.Merge(m, src)
+}
+func (m *InitiateExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateExitRequest.Size(m)
+}
+func (m *InitiateExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateExitRequest proto.InternalMessageInfo
+
+type InitiateExitResponse struct {
+	InitiatedAt          time.Time `protobuf:"bytes,1,opt,name=initiated_at,json=initiatedAt,proto3,stdtime" json:"initiated_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *InitiateExitResponse) Reset()         { *m = InitiateExitResponse{} }
+func (m *InitiateExitResponse) String() string { return proto.CompactTextString(m) }
+func (*InitiateExitResponse) ProtoMessage()    {}
+func (*InitiateExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{8}
+}
+func (m *InitiateExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateExitResponse.Unmarshal(m, b)
+}
+func (m *InitiateExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateExitResponse.Marshal(b, m, deterministic)
+}
+func (m *InitiateExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateExitResponse.Merge(m, src)
+}
+func (m *InitiateExitResponse) XXX_Size() int {
+	return xxx_messageInfo_InitiateExitResponse.Size(m)
+}
+func (m *InitiateExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateExitResponse proto.InternalMessageInfo
+
+func (m *InitiateExitResponse) GetInitiatedAt() time.Time {
+	if m != nil {
+		return m.InitiatedAt
+	}
+	return time.Time{}
+}
+
+type GetTransfersRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransfersRequest) Reset()         { *m = GetTransfersRequest{} }
+func (m *GetTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransfersRequest) ProtoMessage()    {}
+func (*GetTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{9}
+}
+func (m *GetTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransfersRequest.Unmarshal(m, b)
+}
+func (m *GetTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransfersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransfersRequest.Merge(m, src)
+}
+func (m *GetTransfersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransfersRequest.Size(m)
+}
+func (m *GetTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransfersRequest proto.InternalMessageInfo
+
+func (m *GetTransfersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// TransferPiece describes where a single piece should be uploaded to
+type TransferPiece struct {
+	OriginalPieceId PieceID         `protobuf:"bytes,1,opt,name=original_piece_id,json=originalPieceId,proto3,customtype=PieceID" json:"original_piece_id"`
+	PrivateKey      PiecePrivateKey `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3,customtype=PiecePrivateKey" json:"private_key"`
+	// addressed_order_limit contains the new piece id and the node to upload it to
+	AddressedOrderLimit  *AddressedOrderLimit `protobuf:"bytes,3,opt,name=addressed_order_limit,json=addressedOrderLimit,proto3" json:"addressed_order_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferPiece) Reset()         { *m = TransferPiece{} }
+func (m *TransferPiece) String() string { return proto.CompactTextString(m) }
+func (*TransferPiece) ProtoMessage()    {}
+func (*TransferPiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{10}
+}
+func (m *TransferPiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferPiece.Unmarshal(m, b)
+}
+func (m *TransferPiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferPiece.Marshal(b, m, deterministic)
+}
+func (m *TransferPiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPiece.Merge(m, src)
+}
+func (m *TransferPiece) XXX_Size() int {
+	return xxx_messageInfo_TransferPiece.Size(m)
+}
+func (m *TransferPiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPiece proto.InternalMessageInfo
+
+func (m *TransferPiece) GetAddressedOrderLimit() *AddressedOrderLimit {
+	if m != nil {
+		return m.AddressedOrderLimit
+	}
+	return nil
+}
+
+type GetTransfersResponse struct {
+	Pieces []*TransferPiece `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	// pending is set while the satellite is still collecting the pieces of the node
+	Pending bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// finished is set when there is nothing left to transfer
+	Finished             bool     `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`
+	Successful           bool     `protobuf:"varint,4,opt,name=successful,proto3" json:"successful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransfersResponse) Reset()         { *m = GetTransfersResponse{} }
+func (m *GetTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransfersResponse) ProtoMessage()    {}
+func (*GetTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{11}
+}
+func (m *GetTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransfersResponse.Unmarshal(m, b)
+}
+func (m *GetTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransfersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransfersResponse.Merge(m, src)
+}
+func (m *GetTransfersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransfersResponse.Size(m)
+}
+func (m *GetTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransfersResponse proto.InternalMessageInfo
+
+func (m *GetTransfersResponse) GetPieces() []*TransferPiece {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
+}
+
+func (m *GetTransfersResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *GetTransfersResponse) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *GetTransfersResponse) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+type TransferFailed struct {
+	Error                TransferFailed_Error `protobuf:"varint,1,opt,name=error,proto3,enum=gracefulexit.TransferFailed_Error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferFailed) Reset()         { *m = TransferFailed{} }
+func (m *TransferFailed) String() string { return proto.CompactTextString(m) }
+func (*TransferFailed) ProtoMessage()    {}
+func (*TransferFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{12}
+}
+func (m *TransferFailed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferFailed.Unmarshal(m, b)
+}
+func (m *TransferFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferFailed.Marshal(b, m, deterministic)
+}
+func (m *TransferFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFailed.Merge(m, src)
+}
+func (m *TransferFailed) XXX_Size() int {
+	return xxx_messageInfo_TransferFailed.Size(m)
+}
+func (m *TransferFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFailed proto.InternalMessageInfo
+
+func (m *TransferFailed) GetError() TransferFailed_Error {
+	if m != nil {
+		return m.Error
+	}
+	return TransferFailed_NOT_FOUND
+}
+
+type ReportTransferRequest struct {
+	OriginalPieceId PieceID `protobuf:"bytes,1,opt,name=original_piece_id,json=originalPieceId,proto3,customtype=PieceID" json:"original_piece_id"`
+	// failed is set when the transfer did not succeed, the remaining fields are ignored in that case
+	Failed *TransferFailed `protobuf:"bytes,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// original_piece_hash is the hash signed by the uplink that uploaded the piece
+	OriginalPieceHash  *PieceHash  `protobuf:"bytes,3,opt,name=original_piece_hash,json=originalPieceHash,proto3" json:"original_piece_hash,omitempty"`
+	OriginalOrderLimit *OrderLimit `protobuf:"bytes,4,opt,name=original_order_limit,json=originalOrderLimit,proto3" json:"original_order_limit,omitempty"`
+	// replacement_piece_hash is the hash signed by the node that received the piece
+	ReplacementPieceHash  *PieceHash  `protobuf:"bytes,5,opt,name=replacement_piece_hash,json=replacementPieceHash,proto3" json:"replacement_piece_hash,omitempty"`
+	ReplacementOrderLimit *OrderLimit `protobuf:"bytes,6,opt,name=replacement_order_limit,json=replacementOrderLimit,proto3" json:"replacement_order_limit,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}    `json:"-"`
+	XXX_unrecognized      []byte      `json:"-"`
+	XXX_sizecache         int32       `json:"-"`
+}
+
+func (m *ReportTransferRequest) Reset()         { *m = ReportTransferRequest{} }
+func (m *ReportTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportTransferRequest) ProtoMessage()    {}
+func (*ReportTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{13}
+}
+func (m *ReportTransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportTransferRequest.Unmarshal(m, b)
+}
+func (m *ReportTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportTransferRequest.Marshal(b, m, deterministic)
+}
+func (m *ReportTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportTransferRequest.Merge(m, src)
+}
+func (m *ReportTransferRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportTransferRequest.Size(m)
+}
+func (m *ReportTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportTransferRequest proto.InternalMessageInfo
+
+func (m *ReportTransferRequest) GetFailed() *TransferFailed {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+func (m *ReportTransferRequest) GetOriginalPieceHash() *PieceHash {
+	if m != nil {
+		return m.OriginalPieceHash
+	}
+	return nil
+}
+
+func (m *ReportTransferRequest) GetOriginalOrderLimit() *OrderLimit {
+	if m != nil {
+		return m.OriginalOrderLimit
+	}
+	return nil
+}
+
+func (m *ReportTransferRequest) GetReplacementPieceHash() *PieceHash {
+	if m != nil {
+		return m.ReplacementPieceHash
+	}
+	return nil
+}
+
+func (m *ReportTransferRequest) GetReplacementOrderLimit() *OrderLimit {
+	if m != nil {
+		return m.ReplacementOrderLimit
+	}
+	return nil
+}
+
+type ReportTransferResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportTransferResponse) Reset()         { *m = ReportTransferResponse{} }
+func (m *ReportTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*ReportTransferResponse) ProtoMessage()    {}
+func (*ReportTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{14}
+}
+func (m *ReportTransferResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportTransferResponse.Unmarshal(m, b)
+}
+func (m *ReportTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportTransferResponse.Marshal(b, m, deterministic)
+}
+func (m *ReportTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportTransferResponse.Merge(m, src)
+}
+func (m *ReportTransferResponse) XXX_Size() int {
+	return xxx_messageInfo_ReportTransferResponse.Size(m)
+}
+func (m *ReportTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportTransferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("gracefulexit.TransferFailed_Error", TransferFailed_Error_name, TransferFailed_Error_value)
+	proto.RegisterType((*GetNonExitingSatellitesRequest)(nil), "gracefulexit.GetNonExitingSatellitesRequest")
+	proto.RegisterType((*InitiateGracefulExitRequest)(nil), "gracefulexit.InitiateGracefulExitRequest")
+	proto.RegisterType((*NonExitingSatellite)(nil), "gracefulexit.NonExitingSatellite")
+	proto.RegisterType((*GetNonExitingSatellitesResponse)(nil), "gracefulexit.GetNonExitingSatellitesResponse")
+	proto.RegisterType((*GetExitProgressRequest)(nil), "gracefulexit.GetExitProgressRequest")
+	proto.RegisterType((*GetExitProgressResponse)(nil), "gracefulexit.GetExitProgressResponse")
+	proto.RegisterType((*ExitProgress)(nil), "gracefulexit.ExitProgress")
+	proto.RegisterType((*InitiateExitRequest)(nil), "gracefulexit.InitiateExitRequest")
+	proto.RegisterType((*InitiateExitResponse)(nil), "gracefulexit.InitiateExitResponse")
+	proto.RegisterType((*GetTransfersRequest)(nil), "gracefulexit.GetTransfersRequest")
+	proto.RegisterType((*TransferPiece)(nil), "gracefulexit.TransferPiece")
+	proto.RegisterType((*GetTransfersResponse)(nil), "gracefulexit.GetTransfersResponse")
+	proto.RegisterType((*TransferFailed)(nil), "gracefulexit.TransferFailed")
+	proto.RegisterType((*ReportTransferRequest)(nil), "gracefulexit.ReportTransferRequest")
+	proto.RegisterType((*ReportTransferResponse)(nil), "gracefulexit.ReportTransferResponse")
+}
+
+func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_8f0acbf2ce5fa631) }
+
+var fileDescriptor_8f0acbf2ce5fa631 = []byte{
+	// 994 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x5e, 0x27, 0x4d, 0xda, 0x3d, 0xc9, 0xb6, 0xe9, 0x24, 0x69, 0xad, 0xec, 0x4f, 0xb2, 0x66,
+	0x11, 0x59, 0x01, 0x59, 0xa9, 0x8b, 0x10, 0x12, 0x57, 0xee, 0x26, 0x4d, 0xc3, 0x56, 0x4e, 0x77,
+	0x9a, 0x2c, 0x08, 0x04, 0x96, 0x1b, 0x9f, 0xa4, 0x23, 0x1c, 0xdb, 0xd8, 0xce, 0x6a, 0xf7, 0x86,
+	0x67, 0xe0, 0x15, 0x40, 0x5c, 0xf0, 0x16, 0xdc, 0xc2, 0x23, 0xc0, 0xc5, 0xf2, 0x2a, 0xc8, 0xe3,
+	0xb1, 0x6b, 0x27, 0x69, 0x58, 0x89, 0x3b, 0xfb, 0x9b, 0xef, 0x9c, 0x39, 0xe7, 0x9b, 0x6f, 0xe6,
+	0x00, 0x99, 0x79, 0xc6, 0x04, 0xa7, 0x0b, 0x0b, 0x5f, 0xb3, 0xa0, 0xe3, 0x7a, 0x4e, 0xe0, 0x90,
+	0x72, 0x1a, 0x6b, 0xc0, 0xcc, 0x99, 0x39, 0xd1, 0x4a, 0xa3, 0x39, 0x73, 0x9c, 0x99, 0x85, 0x4f,
+	0xf8, 0xdf, 0xe5, 0x62, 0xfa, 0x24, 0x60, 0x73, 0xf4, 0x03, 0x63, 0xee, 0x0a, 0xc2, 0xee, 0x1c,
+	0x03, 0x83, 0xd9, 0xd3, 0x38, 0xa0, 0xec, 0x78, 0x26, 0x7a, 0x7e, 0xf4, 0xa7, 0xb4, 0xe0, 0x41,
+	0x1f, 0x03, 0xcd, 0xb1, 0x7b, 0xaf, 0x59, 0xc0, 0xec, 0xd9, 0x85, 0x11, 0xa0, 0x65, 0xb1, 0x00,
+	0x7d, 0x8a, 0x3f, 0x2c, 0xd0, 0x0f, 0x94, 0x13, 0xb8, 0x3b, 0xb0, 0x59, 0xc0, 0x8c, 0x00, 0xfb,
+	0xa2, 0x88, 0x90, 0x2b, 0x96, 0xc9, 0x07, 0xb0, 0x6d, 0x3b, 0x26, 0xea, 0xcc, 0x94, 0xa5, 0x96,
+	0xd4, 0x2e, 0x1f, 0xef, 0xfe, 0xf1, 0xb6, 0x79, 0xeb, 0xef, 0xb7, 0xcd, 0xa2, 0xe6, 0x98, 0x38,
+	0xe8, 0xd2, 0x62, 0xb8, 0x3c, 0x30, 0x95, 0x1f, 0xa1, 0xba, 0x66, 0x9b, 0x77, 0x8e, 0x27, 0x4d,
+	0x28, 0x99, 0xce, 0xdc, 0x60, 0xb6, 0x6e, 0x1b, 0x73, 0x94, 0x73, 0x2d, 0xa9, 0x7d, 0x9b, 0x42,
+	0x04, 0x69, 0xc6, 0x1c, 0xc9, 0x7d, 0x00, 0xdf, 0x35, 0x26, 0xa8, 0x2f, 0x7c, 0x34, 0xe5, 0x7c,
+	0x4b, 0x6a, 0x4b, 0xf4, 0x36, 0x47, 0xc6, 0x3e, 0x9a, 0x8a, 0x09, 0xcd, 0x1b, 0x3b, 0xf5, 0x5d,
+	0xc7, 0xf6, 0x91, 0xa8, 0x00, 0x7e, 0x82, 0xca, 0x52, 0x2b, 0xdf, 0x2e, 0x1d, 0x3d, 0xec, 0x64,
+	0x8e, 0x63, 0x4d, 0x3c, 0x4d, 0x05, 0x29, 0x32, 0x1c, 0xf4, 0x31, 0x08, 0x29, 0xe7, 0x9e, 0x33,
+	0xf3, 0xd0, 0x4f, 0x74, 0x7c, 0x01, 0x87, 0x2b, 0x2b, 0x62, 0xdf, 0x4f, 0x61, 0xc7, 0x15, 0x98,
+	0xd8, 0xb5, 0x91, 0xdd, 0x35, 0x13, 0x95, 0x70, 0x95, 0xdf, 0x25, 0x28, 0xa7, 0x97, 0x96, 0x35,
+	0x92, 0x56, 0x34, 0x4a, 0xa9, 0x9d, 0xdb, 0xa8, 0xf6, 0x63, 0xa8, 0xb8, 0xe8, 0x4d, 0xd0, 0x0e,
+	0xf4, 0x89, 0x33, 0x77, 0x2d, 0x0c, 0x90, 0x4b, 0x9a, 0xa3, 0x7b, 0x02, 0x7f, 0x26, 0x60, 0xd2,
+	0x80, 0x9d, 0x29, 0xb3, 0x99, 0x7f, 0x85, 0xa6, 0xbc, 0xd5, 0x92, 0xda, 0x3b, 0x34, 0xf9, 0x27,
+	0x0f, 0x00, 0xfc, 0xc5, 0x64, 0x82, 0xbe, 0x3f, 0x5d, 0x58, 0x72, 0x81, 0xaf, 0xa6, 0x10, 0xa5,
+	0x0e, 0xd5, 0xd8, 0x5c, 0x29, 0x53, 0x29, 0x3a, 0xd4, 0xb2, 0xb0, 0x10, 0xaa, 0x0f, 0x65, 0x26,
+	0x70, 0x53, 0x37, 0x02, 0xde, 0x20, 0x17, 0x8b, 0xdf, 0x81, 0x4e, 0x7c, 0x07, 0x3a, 0xa3, 0xf8,
+	0x0e, 0x1c, 0xef, 0x84, 0xfd, 0xfd, 0xf4, 0x4f, 0x53, 0xa2, 0xa5, 0x24, 0x52, 0x0d, 0x94, 0x0f,
+	0xa1, 0xda, 0xc7, 0x60, 0xe4, 0x19, 0xb6, 0x3f, 0x45, 0x2f, 0x3e, 0x23, 0x52, 0x83, 0x82, 0xc5,
+	0xe6, 0x2c, 0x4a, 0x5c, 0xa0, 0xd1, 0x8f, 0xf2, 0x97, 0x04, 0x77, 0x62, 0xea, 0x39, 0xc3, 0x09,
+	0x92, 0xcf, 0x61, 0xdf, 0xf1, 0xd8, 0x8c, 0xd9, 0x86, 0xa5, 0xbb, 0x21, 0x72, 0x6d, 0xdf, 0x3d,
+	0x21, 0xe8, 0x36, 0x67, 0x0e, 0xba, 0x74, 0x2f, 0x66, 0x46, 0x80, 0x49, 0x3e, 0x83, 0x92, 0xeb,
+	0xb1, 0x57, 0x46, 0x80, 0xfa, 0xf7, 0xf8, 0x46, 0x9c, 0xc3, 0xa1, 0x08, 0xdb, 0xe3, 0xac, 0xf3,
+	0x68, 0xfd, 0x39, 0xbe, 0xa1, 0xe0, 0x26, 0xdf, 0xe4, 0x05, 0xd4, 0x0d, 0xd3, 0x0c, 0x4f, 0x1a,
+	0x4d, 0x9d, 0x5f, 0x63, 0x3d, 0x2a, 0x37, 0xcf, 0x75, 0xb8, 0xdf, 0x49, 0xae, 0xba, 0x1a, 0xd3,
+	0x86, 0x21, 0xeb, 0x2c, 0x24, 0xd1, 0xaa, 0xb1, 0x0a, 0x2a, 0x3f, 0x4b, 0x50, 0xcb, 0x2a, 0x21,
+	0xa4, 0x7e, 0x0a, 0x45, 0xde, 0x59, 0xec, 0xc8, 0xbb, 0x59, 0x47, 0x66, 0xf4, 0xa0, 0x82, 0x4a,
+	0x64, 0xd8, 0x76, 0xd1, 0x36, 0x99, 0x3d, 0xe3, 0x6d, 0xed, 0xd0, 0xf8, 0x37, 0x63, 0x92, 0xfc,
+	0x46, 0x93, 0x6c, 0xad, 0x98, 0xe4, 0x57, 0x09, 0x76, 0xe3, 0xfd, 0x4e, 0x0c, 0x66, 0x61, 0xa8,
+	0x61, 0x01, 0x3d, 0xcf, 0xf1, 0xb8, 0xe8, 0xbb, 0x47, 0xca, 0xfa, 0xe2, 0x22, 0x72, 0xa7, 0x17,
+	0x32, 0x69, 0x14, 0xa0, 0x7c, 0x05, 0x05, 0xfe, 0x4f, 0xee, 0xc0, 0x6d, 0x6d, 0x38, 0xd2, 0x4f,
+	0x86, 0x63, 0xad, 0x5b, 0xb9, 0x45, 0xee, 0x81, 0x7c, 0x31, 0x1a, 0x52, 0xb5, 0xdf, 0xd3, 0xb5,
+	0x61, 0xb7, 0xa7, 0x8f, 0x35, 0xf5, 0xa5, 0x3a, 0x38, 0x53, 0x8f, 0xcf, 0x7a, 0x15, 0x89, 0xd4,
+	0x61, 0xff, 0x54, 0xbd, 0x38, 0xd5, 0x5f, 0xf6, 0xe8, 0xe0, 0x64, 0xf0, 0x4c, 0x1d, 0x0d, 0x86,
+	0x5a, 0x25, 0x47, 0x4a, 0xb0, 0x3d, 0xd6, 0x9e, 0x6b, 0xc3, 0x2f, 0xb5, 0x4a, 0x5e, 0xf9, 0x2d,
+	0x0f, 0x75, 0x8a, 0xae, 0xe3, 0x25, 0x6a, 0xc6, 0xb6, 0xfa, 0x5f, 0x76, 0xf9, 0x04, 0x8a, 0x53,
+	0xde, 0x07, 0x97, 0xb4, 0x74, 0x74, 0x6f, 0x53, 0xaf, 0x54, 0x70, 0x89, 0x0a, 0xd5, 0xa5, 0x2d,
+	0xaf, 0x0c, 0xff, 0x4a, 0x18, 0x65, 0xbf, 0x23, 0x66, 0x00, 0xdf, 0xe3, 0xd4, 0xf0, 0xaf, 0xe8,
+	0x7e, 0x66, 0xdb, 0x10, 0x22, 0x5d, 0xa8, 0x25, 0x29, 0xd2, 0x66, 0xdb, 0xe2, 0x39, 0x48, 0x9c,
+	0x23, 0xe5, 0x30, 0x12, 0xf3, 0xaf, 0x31, 0xd2, 0x87, 0x03, 0x0f, 0x5d, 0xcb, 0x98, 0xe0, 0x3c,
+	0x7c, 0x4c, 0x52, 0xb5, 0x14, 0x6e, 0xaa, 0xa5, 0x96, 0x0a, 0xb8, 0x2e, 0xe7, 0x0b, 0x38, 0x4c,
+	0x27, 0x4a, 0x57, 0x54, 0xbc, 0xb1, 0xa2, 0x7a, 0x2a, 0x24, 0xe5, 0x7a, 0x19, 0x0e, 0x96, 0x4f,
+	0x2a, 0xb2, 0xfd, 0xd1, 0x9f, 0x39, 0xa8, 0x84, 0x4f, 0x61, 0x7a, 0xd4, 0x91, 0x57, 0xfc, 0xe9,
+	0x5e, 0x37, 0x3a, 0xc8, 0x47, 0xd9, 0xd3, 0xd8, 0x3c, 0x4b, 0x1b, 0x1f, 0xbf, 0x23, 0x5b, 0xdc,
+	0xc1, 0x6f, 0xaf, 0x9f, 0xc1, 0x4c, 0x3d, 0x8f, 0xb3, 0x69, 0x36, 0x8c, 0xe7, 0xc6, 0x86, 0x41,
+	0x42, 0xbe, 0x83, 0xbd, 0xa5, 0x89, 0x44, 0x1e, 0xad, 0x14, 0xb8, 0x66, 0x94, 0x35, 0xde, 0xff,
+	0x0f, 0x96, 0xd0, 0xf2, 0x97, 0x1c, 0xd4, 0x93, 0xae, 0x32, 0x0d, 0x8c, 0xa1, 0x9c, 0x7e, 0xdf,
+	0xc9, 0xc3, 0xf5, 0x0d, 0xa5, 0x1b, 0x51, 0x36, 0x51, 0x84, 0x5e, 0x63, 0x28, 0xa7, 0xdf, 0xb2,
+	0xe5, 0xb4, 0x6b, 0x5e, 0xfc, 0x86, 0xb2, 0x89, 0x22, 0xd2, 0x7e, 0x03, 0xbb, 0x59, 0xb7, 0x90,
+	0xf7, 0xb2, 0x51, 0x6b, 0x6f, 0x7d, 0xe3, 0xd1, 0x66, 0x52, 0x94, 0xfc, 0x78, 0xeb, 0xeb, 0x9c,
+	0x7b, 0x79, 0x59, 0xe4, 0xa3, 0xeb, 0xe9, 0xbf, 0x03, 0x00, 0x2d, 0xd2, 0x59, 0x87, 0xfc, 0x09,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeGracefulExitClient is the client API for NodeGracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeGracefulExitClient interface {
+	// GetNonExitingSatellites returns a list of satellites that the storagenode has not begun a graceful exit for.
+	GetNonExitingSatellites(ctx context.Context, in *GetNonExitingSatellitesRequest, opts ...grpc.CallOption) (*GetNonExitingSatellitesResponse, error)
+	// InitiateGracefulExit updates a satellite in the storagenode's database to be gracefully exiting.
+	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest, opts ...grpc.CallOption) (*ExitProgress, error)
+	// GetExitProgress returns graceful exit status on each satellite for a given storagenode.
+	GetExitProgress(ctx context.Context, in *GetExitProgressRequest, opts ...grpc.CallOption) (*GetExitProgressResponse, error)
+}
+
+type nodeGracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeGracefulExitClient(cc *grpc.ClientConn) NodeGracefulExitClient {
+	return &nodeGracefulExitClient{cc}
+}
+
+func (c *nodeGracefulExitClient) GetNonExitingSatellites(ctx context.Context, in *GetNonExitingSatellitesRequest, opts ...grpc.CallOption) (*GetNonExitingSatellitesResponse, error) {
+	out := new(GetNonExitingSatellitesResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.NodeGracefulExit/GetNonExitingSatellites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeGracefulExitClient) InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest, opts ...grpc.CallOption) (*ExitProgress, error) {
+	out := new(ExitProgress)
+	err := c.cc.Invoke(ctx, "/gracefulexit.NodeGracefulExit/InitiateGracefulExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeGracefulExitClient) GetExitProgress(ctx context.Context, in *GetExitProgressRequest, opts ...grpc.CallOption) (*GetExitProgressResponse, error) {
+	out := new(GetExitProgressResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.NodeGracefulExit/GetExitProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeGracefulExitServer is the server API for NodeGracefulExit service.
+type NodeGracefulExitServer interface {
+	// GetNonExitingSatellites returns a list of satellites that the storagenode has not begun a graceful exit for.
+	GetNonExitingSatellites(context.Context, *GetNonExitingSatellitesRequest) (*GetNonExitingSatellitesResponse, error)
+	// InitiateGracefulExit updates a satellite in the storagenode's database to be gracefully exiting.
+	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*ExitProgress, error)
+	// GetExitProgress returns graceful exit status on each satellite for a given storagenode.
+	GetExitProgress(context.Context, *GetExitProgressRequest) (*GetExitProgressResponse, error)
+}
+
+func RegisterNodeGracefulExitServer(s *grpc.Server, srv NodeGracefulExitServer) {
+	s.RegisterService(&_NodeGracefulExit_serviceDesc, srv)
+}
+
+func _NodeGracefulExit_GetNonExitingSatellites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNonExitingSatellitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeGracefulExitServer).GetNonExitingSatellites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.NodeGracefulExit/GetNonExitingSatellites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeGracefulExitServer).GetNonExitingSatellites(ctx, req.(*GetNonExitingSatellitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeGracefulExit_InitiateGracefulExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateGracefulExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeGracefulExitServer).InitiateGracefulExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.NodeGracefulExit/InitiateGracefulExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeGracefulExitServer).InitiateGracefulExit(ctx, req.(*InitiateGracefulExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeGracefulExit_GetExitProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExitProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeGracefulExitServer).GetExitProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.NodeGracefulExit/GetExitProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeGracefulExitServer).GetExitProgress(ctx, req.(*GetExitProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeGracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.NodeGracefulExit",
+	HandlerType: (*NodeGracefulExitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNonExitingSatellites",
+			Handler:    _NodeGracefulExit_GetNonExitingSatellites_Handler,
+		},
+		{
+			MethodName: "InitiateGracefulExit",
+			Handler:    _NodeGracefulExit_InitiateGracefulExit_Handler,
+		},
+		{
+			MethodName: "GetExitProgress",
+			Handler:    _NodeGracefulExit_GetExitProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gracefulexit.proto",
+}
+
+// SatelliteGracefulExitClient is the client API for SatelliteGracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SatelliteGracefulExitClient interface {
+	// InitiateExit marks the calling storagenode as gracefully exiting.
+	InitiateExit(ctx context.Context, in *InitiateExitRequest, opts ...grpc.CallOption) (*InitiateExitResponse, error)
+	// GetTransfers returns the next batch of pieces the calling storagenode should transfer.
+	GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error)
+	// ReportTransfer reports the result of transferring a single piece.
+	ReportTransfer(ctx context.Context, in *ReportTransferRequest, opts ...grpc.CallOption) (*ReportTransferResponse, error)
+}
+
+type satelliteGracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSatelliteGracefulExitClient(cc *grpc.ClientConn) SatelliteGracefulExitClient {
+	return &satelliteGracefulExitClient{cc}
+}
+
+func (c *satelliteGracefulExitClient) InitiateExit(ctx context.Context, in *InitiateExitRequest, opts ...grpc.CallOption) (*InitiateExitResponse, error) {
+	out := new(InitiateExitResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.SatelliteGracefulExit/InitiateExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteGracefulExitClient) GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error) {
+	out := new(GetTransfersResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.SatelliteGracefulExit/GetTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteGracefulExitClient) ReportTransfer(ctx context.Context, in *ReportTransferRequest, opts ...grpc.CallOption) (*ReportTransferResponse, error) {
+	out := new(ReportTransferResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.SatelliteGracefulExit/ReportTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SatelliteGracefulExitServer is the server API for SatelliteGracefulExit service.
+type SatelliteGracefulExitServer interface {
+	// InitiateExit marks the calling storagenode as gracefully exiting.
+	InitiateExit(context.Context, *InitiateExitRequest) (*InitiateExitResponse, error)
+	// GetTransfers returns the next batch of pieces the calling storagenode should transfer.
+	GetTransfers(context.Context, *GetTransfersRequest) (*GetTransfersResponse, error)
+	// ReportTransfer reports the result of transferring a single piece.
+	ReportTransfer(context.Context, *ReportTransferRequest) (*ReportTransferResponse, error)
+}
+
+func RegisterSatelliteGracefulExitServer(s *grpc.Server, srv SatelliteGracefulExitServer) {
+	s.RegisterService(&_SatelliteGracefulExit_serviceDesc, srv)
+}
+
+func _SatelliteGracefulExit_InitiateExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteGracefulExitServer).InitiateExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.SatelliteGracefulExit/InitiateExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteGracefulExitServer).InitiateExit(ctx, req.(*InitiateExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteGracefulExit_GetTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteGracefulExitServer).GetTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.SatelliteGracefulExit/GetTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteGracefulExitServer).GetTransfers(ctx, req.(*GetTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteGracefulExit_ReportTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteGracefulExitServer).ReportTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.SatelliteGracefulExit/ReportTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteGracefulExitServer).ReportTransfer(ctx, req.(*ReportTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SatelliteGracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.SatelliteGracefulExit",
+	HandlerType: (*SatelliteGracefulExitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitiateExit",
+			Handler:    _SatelliteGracefulExit_InitiateExit_Handler,
+		},
+		{
+			MethodName: "GetTransfers",
+			Handler:    _SatelliteGracefulExit_GetTransfers_Handler,
+		},
+		{
+			MethodName: "ReportTransfer",
+			Handler:    _SatelliteGracefulExit_ReportTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gracefulexit.proto",
+}
@@ -1111,6 +1111,7 @@ type DashboardResponse struct {
 	Uptime               *duration.Duration   `protobuf:"bytes,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
 	LastPinged           time.Time            `protobuf:"bytes,9,opt,name=last_pinged,json=lastPinged,proto3,stdtime" json:"last_pinged"`
 	LastQueried          time.Time            `protobuf:"bytes,10,opt,name=last_queried,json=lastQueried,proto3,stdtime" json:"last_queried"`
+	Disks                []*DiskUsage         `protobuf:"bytes,11,rep,name=disks,proto3" json:"disks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1203,6 +1204,75 @@ func (m *DashboardResponse) GetLastQueried() time.Time {
 	return time.Time{}
 }
 
+func (m *DashboardResponse) GetDisks() []*DiskUsage {
+	if m != nil {
+		return m.Disks
+	}
+	return nil
+}
+
+type DiskUsage struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	UsedSpace            int64    `protobuf:"varint,2,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
+	AvailableSpace       int64    `protobuf:"varint,3,opt,name=available_space,json=availableSpace,proto3" json:"available_space,omitempty"`
+	AllocatedSpace       int64    `protobuf:"varint,4,opt,name=allocated_space,json=allocatedSpace,proto3" json:"allocated_space,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiskUsage) Reset()         { *m = DiskUsage{} }
+func (m *DiskUsage) String() string { return proto.CompactTextString(m) }
+func (*DiskUsage) ProtoMessage()    {}
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+}
+func (m *DiskUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskUsage.Unmarshal(m, b)
+}
+func (m *DiskUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskUsage.Marshal(b, m, deterministic)
+}
+func (m *DiskUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskUsage.Merge(m, src)
+}
+func (m *DiskUsage) XXX_Size() int {
+	return xxx_messageInfo_DiskUsage.Size(m)
+}
+func (m *DiskUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskUsage proto.InternalMessageInfo
+
+func (m *DiskUsage) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DiskUsage) GetUsedSpace() int64 {
+	if m != nil {
+		return m.UsedSpace
+	}
+	return 0
+}
+
+func (m *DiskUsage) GetAvailableSpace() int64 {
+	if m != nil {
+		return m.AvailableSpace
+	}
+	return 0
+}
+
+func (m *DiskUsage) GetAllocatedSpace() int64 {
+	if m != nil {
+		return m.AllocatedSpace
+	}
+	return 0
+}
+
 type SegmentHealthRequest struct {
 	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
@@ -1217,7 +1287,7 @@ func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
 func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthRequest) ProtoMessage()    {}
 func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+	return fileDescriptor_a07d9034b2dd9d26, []int{27}
 }
 func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
@@ -1279,7 +1349,7 @@ func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
 func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealth) ProtoMessage()    {}
 func (*SegmentHealth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{27}
+	return fileDescriptor_a07d9034b2dd9d26, []int{28}
 }
 func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
@@ -1318,7 +1388,7 @@ func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
 func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthResponse) ProtoMessage()    {}
 func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{28}
+	return fileDescriptor_a07d9034b2dd9d26, []int{29}
 }
 func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
@@ -1368,7 +1438,7 @@ func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
 func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthRequest) ProtoMessage()    {}
 func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{29}
+	return fileDescriptor_a07d9034b2dd9d26, []int{30}
 }
 func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
@@ -1442,7 +1512,7 @@ func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
 func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthResponse) ProtoMessage()    {}
 func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{30}
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
 }
 func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
@@ -1504,6 +1574,7 @@ func init() {
 	proto.RegisterType((*StatSummaryResponse)(nil), "inspector.StatSummaryResponse")
 	proto.RegisterType((*DashboardRequest)(nil), "inspector.DashboardRequest")
 	proto.RegisterType((*DashboardResponse)(nil), "inspector.DashboardResponse")
+	proto.RegisterType((*DiskUsage)(nil), "inspector.DiskUsage")
 	proto.RegisterType((*SegmentHealthRequest)(nil), "inspector.SegmentHealthRequest")
 	proto.RegisterType((*SegmentHealth)(nil), "inspector.SegmentHealth")
 	proto.RegisterType((*SegmentHealthResponse)(nil), "inspector.SegmentHealthResponse")
@@ -1514,112 +1585,115 @@ func init() {
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x39, 0x73, 0x1b, 0x47,
-	0x16, 0xe6, 0xe0, 0x22, 0xf0, 0x00, 0xe2, 0x68, 0x52, 0x12, 0x16, 0x92, 0x08, 0xee, 0xec, 0xa1,
-	0x83, 0xbb, 0xa0, 0x16, 0xab, 0x0d, 0x54, 0x5b, 0x0e, 0x08, 0x52, 0x07, 0x4a, 0xb2, 0x44, 0x0d,
-	0x65, 0x07, 0x2e, 0x95, 0x51, 0x0d, 0x74, 0x03, 0x1c, 0x13, 0x98, 0x1e, 0xcd, 0x34, 0x64, 0xe1,
-	0x0f, 0xb8, 0xec, 0xc8, 0x4a, 0x1c, 0x38, 0x76, 0xf9, 0x1f, 0x38, 0x72, 0xea, 0xc4, 0xbf, 0xc1,
-	0x81, 0x9c, 0xd9, 0xb9, 0x33, 0x67, 0xae, 0x3e, 0xe6, 0xc2, 0x61, 0xd2, 0x65, 0x3b, 0xc3, 0xbc,
-	0xef, 0xeb, 0xd7, 0xef, 0xbd, 0x3e, 0xde, 0xd7, 0x80, 0x8a, 0xed, 0xf8, 0x2e, 0x1d, 0x70, 0xe6,
-	0xb5, 0x5c, 0x8f, 0x71, 0x86, 0x0a, 0xa1, 0xa1, 0x01, 0x23, 0x36, 0x62, 0xca, 0xdc, 0x00, 0x87,
-	0x11, 0xaa, 0x7f, 0x57, 0x5c, 0x66, 0x3b, 0x9c, 0x7a, 0xa4, 0xaf, 0x0d, 0xdb, 0x23, 0xc6, 0x46,
-	0x63, 0xba, 0x27, 0xbf, 0xfa, 0xd3, 0xe1, 0x1e, 0x99, 0x7a, 0x98, 0xdb, 0xcc, 0xd1, 0x78, 0x73,
-	0x1e, 0xe7, 0xf6, 0x84, 0xfa, 0x1c, 0x4f, 0x5c, 0x45, 0x30, 0x1f, 0xc3, 0xf6, 0x23, 0xdb, 0xe7,
-	0x5d, 0xcf, 0xa3, 0x2e, 0xf6, 0x70, 0x7f, 0x4c, 0x8f, 0xe9, 0x68, 0x42, 0x1d, 0xee, 0x5b, 0xf4,
-	0xc5, 0x94, 0xfa, 0x1c, 0x6d, 0x41, 0x76, 0x6c, 0x4f, 0x6c, 0x5e, 0x37, 0x76, 0x8c, 0xeb, 0x59,
-	0x4b, 0x7d, 0xa0, 0x8b, 0x90, 0x63, 0xc3, 0xa1, 0x4f, 0x79, 0x3d, 0x25, 0xcd, 0xfa, 0xcb, 0xfc,
-	0xc1, 0x00, 0xb4, 0xe8, 0x0c, 0x21, 0xc8, 0xb8, 0x98, 0x9f, 0x48, 0x1f, 0x25, 0x4b, 0xfe, 0x46,
-	0x77, 0xa0, 0xec, 0x2b, 0xb8, 0x47, 0x28, 0xc7, 0xf6, 0x58, 0xba, 0x2a, 0xb6, 0x51, 0x2b, 0xca,
-	0xf2, 0x48, 0xfd, 0xb2, 0x36, 0x34, 0xf3, 0x50, 0x12, 0x51, 0x13, 0x8a, 0x63, 0xe6, 0xf3, 0x9e,
-	0x6b, 0xd3, 0x01, 0xf5, 0xeb, 0x69, 0x19, 0x02, 0x08, 0xd3, 0x91, 0xb4, 0xa0, 0x16, 0x6c, 0x8e,
-	0xb1, 0xcf, 0x7b, 0x22, 0x10, 0xdb, 0xeb, 0x61, 0xce, 0xe9, 0xc4, 0xe5, 0xf5, 0xcc, 0x8e, 0x71,
-	0x3d, 0x6d, 0xd5, 0x04, 0x64, 0x49, 0x64, 0x5f, 0x01, 0xe8, 0x16, 0x6c, 0x25, 0xa9, 0xbd, 0x01,
-	0x9b, 0x3a, 0xbc, 0x9e, 0x95, 0x03, 0x90, 0x17, 0x27, 0x1f, 0x08, 0xc4, 0x7c, 0x0e, 0xcd, 0x95,
-	0x85, 0xf3, 0x5d, 0xe6, 0xf8, 0x14, 0xdd, 0x81, 0xbc, 0x0e, 0xdb, 0xaf, 0x1b, 0x3b, 0xe9, 0xeb,
-	0xc5, 0xf6, 0xd5, 0x56, 0xb4, 0xe8, 0x8b, 0x23, 0xad, 0x90, 0x6e, 0xde, 0x04, 0x24, 0xa7, 0x79,
-	0xcc, 0x08, 0x8d, 0x1c, 0x6e, 0x41, 0x56, 0x85, 0x65, 0xc8, 0xb0, 0xd4, 0x87, 0xb9, 0x09, 0xb5,
-	0x38, 0x57, 0xae, 0x9a, 0x79, 0x11, 0xb6, 0xee, 0x53, 0xde, 0x99, 0x0e, 0x4e, 0x29, 0x17, 0x71,
-	0x06, 0xf6, 0x9f, 0x0c, 0xb8, 0x30, 0x07, 0x68, 0xe7, 0xfb, 0xb0, 0xde, 0x97, 0xd6, 0x20, 0xd8,
-	0x6b, 0xb1, 0x60, 0x97, 0x0e, 0x69, 0x29, 0x93, 0x15, 0x8c, 0x6b, 0x7c, 0x66, 0x40, 0x4e, 0xd9,
-	0xd0, 0x2e, 0x14, 0x94, 0xb5, 0x67, 0x13, 0xb5, 0xea, 0x9d, 0xf2, 0xb7, 0x6f, 0x9a, 0x6b, 0xdf,
-	0xbd, 0x69, 0xe6, 0x44, 0xa0, 0xdd, 0x43, 0x2b, 0xaf, 0x08, 0x5d, 0x82, 0xf6, 0x60, 0xc3, 0x63,
-	0x53, 0x6e, 0x3b, 0xa3, 0x9e, 0xd8, 0xec, 0x7e, 0x3d, 0x25, 0x03, 0x80, 0x96, 0xdc, 0xfa, 0x82,
-	0x6e, 0x95, 0x34, 0x41, 0x26, 0x89, 0xfe, 0x0d, 0xa5, 0x01, 0x1e, 0x9c, 0x50, 0xa2, 0xf9, 0xe9,
-	0x05, 0x7e, 0x51, 0xe1, 0x92, 0x2e, 0x2a, 0x14, 0x26, 0x10, 0x56, 0xe8, 0x01, 0xa0, 0xb8, 0x31,
-	0x2a, 0x31, 0x67, 0x1c, 0x8f, 0x83, 0x12, 0xcb, 0x0f, 0x74, 0x05, 0xd2, 0x36, 0x51, 0x61, 0x95,
-	0x3a, 0x10, 0xcb, 0x41, 0x98, 0xcd, 0x36, 0x54, 0x43, 0x4f, 0xc1, 0xa9, 0xd9, 0x86, 0xd4, 0xca,
-	0xc4, 0x53, 0x36, 0x31, 0xdf, 0x89, 0x85, 0x14, 0x4e, 0x7e, 0xc6, 0x20, 0xb4, 0x03, 0xd9, 0x55,
-	0xf5, 0x51, 0x80, 0xd9, 0x02, 0x88, 0xd6, 0x29, 0xe2, 0x1b, 0xab, 0xf8, 0x0f, 0xa1, 0x72, 0xa4,
-	0xab, 0x7a, 0xce, 0xc8, 0x51, 0x1d, 0xd6, 0x31, 0x21, 0x1e, 0xf5, 0x7d, 0x79, 0x5e, 0x0b, 0x56,
-	0xf0, 0x69, 0x9a, 0x50, 0x8d, 0x9c, 0xe9, 0x94, 0xca, 0x90, 0x62, 0xa7, 0xd2, 0x5b, 0xde, 0x4a,
-	0xb1, 0x53, 0xf3, 0x2d, 0xa8, 0x3d, 0x62, 0xec, 0x74, 0xea, 0xc6, 0xa7, 0x2c, 0x87, 0x53, 0x16,
-	0xce, 0x98, 0xe2, 0x39, 0xa0, 0xf8, 0xf0, 0xb0, 0x6e, 0x19, 0x91, 0x8e, 0xf4, 0x90, 0x4c, 0x53,
-	0xda, 0xd1, 0x3f, 0x21, 0x33, 0xa1, 0x1c, 0x87, 0xf7, 0x4b, 0x88, 0xbf, 0x4d, 0x39, 0x26, 0x98,
-	0x63, 0x4b, 0xe2, 0xe6, 0xfb, 0x50, 0x91, 0x89, 0x3a, 0x43, 0x76, 0xde, 0x6a, 0xec, 0x26, 0x43,
-	0x2d, 0xb6, 0x6b, 0x91, 0xf7, 0x7d, 0x05, 0x44, 0xd1, 0x7f, 0x63, 0x40, 0x35, 0x9a, 0x40, 0x07,
-	0x6f, 0x42, 0x86, 0xcf, 0x5c, 0x15, 0x7c, 0xb9, 0x5d, 0x8e, 0x86, 0x3f, 0x9b, 0xb9, 0xd4, 0x92,
-	0x18, 0x6a, 0x41, 0x9e, 0xb9, 0xd4, 0xc3, 0x9c, 0x79, 0x8b, 0x49, 0x3c, 0xd1, 0x88, 0x15, 0x72,
-	0x04, 0x7f, 0x80, 0x5d, 0x3c, 0xb0, 0xf9, 0x4c, 0x5e, 0x8e, 0x09, 0xfe, 0x81, 0x46, 0xac, 0x90,
-	0x23, 0xb2, 0x78, 0x49, 0x3d, 0xdf, 0x66, 0x8e, 0xbc, 0x22, 0x13, 0x59, 0xbc, 0xab, 0x00, 0x2b,
-	0x60, 0x98, 0x13, 0xa8, 0xdc, 0xb3, 0x1d, 0xf2, 0x98, 0x62, 0xef, 0xbc, 0x55, 0xfa, 0x3b, 0x64,
-	0x7d, 0x8e, 0x3d, 0xd5, 0x2c, 0x16, 0x29, 0x0a, 0x8c, 0x3a, 0x4d, 0x5a, 0x9d, 0x3d, 0xf9, 0x61,
-	0xde, 0x86, 0x6a, 0x34, 0x9d, 0xae, 0xd9, 0xd9, 0x07, 0x01, 0x41, 0xf5, 0x70, 0x3a, 0x71, 0x13,
-	0x77, 0xe2, 0xff, 0xa0, 0x16, 0xb3, 0xcd, 0xbb, 0x5a, 0x79, 0x46, 0xca, 0x50, 0x3a, 0xe6, 0x38,
-	0xba, 0x38, 0x7e, 0x36, 0x60, 0x53, 0x18, 0x8e, 0xa7, 0x93, 0x09, 0xf6, 0x66, 0xa1, 0xa7, 0xab,
-	0x00, 0x53, 0x9f, 0x92, 0x9e, 0xef, 0xe2, 0x01, 0xd5, 0xf7, 0x47, 0x41, 0x58, 0x8e, 0x85, 0x01,
-	0x5d, 0x83, 0x0a, 0x7e, 0x89, 0xed, 0xb1, 0xb8, 0xf0, 0x35, 0x27, 0x25, 0x39, 0xe5, 0xd0, 0xac,
-	0x88, 0x7f, 0x85, 0x92, 0xf4, 0x63, 0x3b, 0x23, 0xb9, 0xaf, 0x54, 0x35, 0x8a, 0xc2, 0xd6, 0x55,
-	0x26, 0xd1, 0xff, 0x24, 0x85, 0x2a, 0x86, 0x6a, 0x6b, 0x72, 0xf6, 0xbb, 0x8a, 0xf0, 0x0f, 0x28,
-	0x4b, 0x42, 0x1f, 0x3b, 0xe4, 0x43, 0x9b, 0xf0, 0x13, 0xdd, 0xc9, 0x36, 0x84, 0xb5, 0x13, 0x18,
-	0xd1, 0x1e, 0x6c, 0x46, 0x31, 0x45, 0xdc, 0x9c, 0xea, 0x7a, 0x21, 0x14, 0x0e, 0x90, 0x65, 0xc5,
-	0xfe, 0x49, 0x9f, 0x61, 0x8f, 0x04, 0xf5, 0x78, 0x9d, 0x81, 0x5a, 0xcc, 0xa8, 0xab, 0x71, 0x0d,
-	0xd6, 0x45, 0xf9, 0x56, 0x5f, 0xff, 0x39, 0x01, 0x77, 0x09, 0xba, 0x01, 0x55, 0x49, 0x1c, 0x30,
-	0xc7, 0xa1, 0x03, 0xa1, 0x5d, 0x7c, 0x5d, 0x98, 0x8a, 0xb0, 0x1f, 0x44, 0x66, 0xb4, 0x0b, 0xb5,
-	0x3e, 0x63, 0xdc, 0xe7, 0x1e, 0x76, 0x7b, 0xc1, 0xb1, 0x4b, 0xcb, 0x1b, 0xa2, 0x1a, 0x02, 0xfa,
-	0xd4, 0x09, 0xbf, 0x52, 0x3b, 0x38, 0x78, 0x1c, 0x72, 0x33, 0x92, 0x5b, 0x09, 0xec, 0x31, 0x2a,
-	0x7d, 0x35, 0x47, 0xcd, 0x2a, 0x6a, 0x60, 0x0f, 0xa8, 0xbb, 0x50, 0x23, 0x41, 0xae, 0x21, 0x37,
-	0xa7, 0x42, 0x08, 0x81, 0x80, 0x7c, 0x5b, 0x6e, 0x7b, 0xee, 0xd7, 0xd7, 0xe5, 0xa1, 0xda, 0x8e,
-	0x35, 0xd4, 0x25, 0x1b, 0xc8, 0x52, 0x64, 0xf4, 0x1f, 0xc8, 0x4d, 0x5d, 0xa1, 0xd3, 0xea, 0x79,
-	0x39, 0xec, 0x2f, 0x2d, 0x25, 0xe2, 0x5a, 0x81, 0x88, 0x6b, 0x1d, 0x6a, 0x91, 0x67, 0x69, 0x22,
-	0xba, 0x0b, 0x45, 0x29, 0x77, 0x5c, 0xdb, 0x19, 0x51, 0x52, 0x2f, 0xc8, 0x71, 0x8d, 0x85, 0x71,
-	0xcf, 0x02, 0xf1, 0xd7, 0xc9, 0x8b, 0xc5, 0x78, 0xfd, 0x7d, 0xd3, 0xb0, 0x40, 0x0c, 0x3c, 0x92,
-	0xe3, 0xd0, 0x7d, 0x28, 0x49, 0x37, 0x2f, 0xa6, 0xd4, 0xb3, 0x29, 0xa9, 0xc3, 0x6f, 0xf0, 0x23,
-	0x03, 0x78, 0xaa, 0x06, 0x9a, 0x9f, 0x1b, 0xb0, 0xa5, 0x45, 0xcd, 0x03, 0x8a, 0xc7, 0xfc, 0x24,
-	0xb8, 0x28, 0x2e, 0x42, 0x4e, 0x75, 0x7d, 0xad, 0x04, 0xf5, 0x97, 0xd8, 0xaf, 0xd4, 0x19, 0x78,
-	0x33, 0x97, 0x53, 0xd2, 0x93, 0x4a, 0x51, 0xde, 0x14, 0xd6, 0x46, 0x68, 0x3d, 0x12, 0x92, 0xf1,
-	0x6f, 0x10, 0x08, 0xc1, 0x9e, 0xed, 0x10, 0xfa, 0x4a, 0x9f, 0x8d, 0x92, 0x36, 0x76, 0x85, 0x4d,
-	0x9c, 0x43, 0xd7, 0x63, 0x1f, 0xd0, 0x81, 0xd4, 0x1e, 0x19, 0xe9, 0xa7, 0xa0, 0x2d, 0x5d, 0x62,
-	0x7e, 0x65, 0xc0, 0x46, 0x22, 0x36, 0xb4, 0x0b, 0xc5, 0x13, 0xf9, 0x6b, 0xd6, 0x13, 0x5d, 0xde,
-	0x58, 0xe8, 0xf2, 0xa0, 0xe1, 0x2e, 0xf1, 0x85, 0x56, 0x99, 0x3a, 0x71, 0xfa, 0xa2, 0x28, 0x28,
-	0x85, 0x04, 0x31, 0x60, 0x17, 0x8a, 0x6c, 0x38, 0x1c, 0xdb, 0x0e, 0x95, 0xf4, 0xf4, 0xa2, 0x77,
-	0x0d, 0x0b, 0x72, 0x1d, 0xd6, 0x75, 0x2e, 0x3a, 0xf0, 0xe0, 0xd3, 0xfc, 0xc8, 0x80, 0x0b, 0x73,
-	0x25, 0xd5, 0x27, 0xed, 0x16, 0xe4, 0xd4, 0x74, 0xba, 0xff, 0xd5, 0xe3, 0xdb, 0x2c, 0x31, 0x42,
-	0xf3, 0xd0, 0xff, 0x01, 0x3c, 0x4a, 0xa6, 0x0e, 0xc1, 0xce, 0x60, 0xa6, 0x1b, 0xca, 0xe5, 0x98,
-	0xea, 0xb6, 0x42, 0xf0, 0x78, 0x70, 0x42, 0x27, 0xd4, 0x8a, 0xd1, 0xcd, 0x1f, 0x0d, 0xd8, 0x7c,
-	0xd2, 0x17, 0xc5, 0x4c, 0x2e, 0xed, 0xe2, 0x12, 0x1a, 0xcb, 0x96, 0x30, 0xda, 0x01, 0xa9, 0xc4,
-	0x0e, 0x48, 0xae, 0x5a, 0x7a, 0x6e, 0xd5, 0x84, 0xa0, 0x97, 0x4d, 0xa2, 0x87, 0x87, 0x9c, 0x7a,
-	0xbd, 0x78, 0x91, 0xd2, 0x56, 0x4d, 0x42, 0xfb, 0x02, 0x09, 0x1e, 0x1c, 0xff, 0x02, 0x44, 0x1d,
-	0xd2, 0xeb, 0xd3, 0x21, 0xf3, 0x68, 0x48, 0x57, 0x97, 0x60, 0x95, 0x3a, 0xa4, 0x23, 0x81, 0x80,
-	0x1d, 0x76, 0x9e, 0x5c, 0xec, 0x8d, 0x63, 0x7e, 0x62, 0xc0, 0x56, 0x32, 0x53, 0x5d, 0xf1, 0xdb,
-	0x0b, 0xc2, 0x7e, 0x75, 0xcd, 0x43, 0xe6, 0xef, 0xaa, 0x7a, 0xfb, 0xd3, 0x0c, 0x94, 0x1e, 0x62,
-	0xd2, 0x0d, 0x66, 0x41, 0x5d, 0x80, 0x48, 0xf5, 0xa3, 0x2b, 0xb1, 0xf9, 0x17, 0x1e, 0x03, 0x8d,
-	0xab, 0x2b, 0x50, 0x9d, 0xce, 0x01, 0xe4, 0x03, 0xdd, 0x86, 0x1a, 0x31, 0xea, 0x9c, 0x32, 0x6c,
-	0x5c, 0x5e, 0x8a, 0x69, 0x27, 0x5d, 0x80, 0x48, 0x99, 0x25, 0xe2, 0x59, 0xd0, 0x7b, 0x89, 0x78,
-	0x96, 0xc8, 0xb9, 0x03, 0xc8, 0x07, 0x2a, 0x29, 0x11, 0xcf, 0x9c, 0x36, 0x4b, 0xc4, 0xb3, 0x20,
-	0xab, 0x0e, 0x20, 0x1f, 0xc8, 0x86, 0x84, 0x93, 0x39, 0xe9, 0x92, 0x70, 0xb2, 0xa0, 0x33, 0xee,
-	0x41, 0x21, 0x54, 0x0c, 0x28, 0xce, 0x9c, 0xd7, 0x16, 0x8d, 0x2b, 0xcb, 0x41, 0xed, 0xc7, 0x82,
-	0x8d, 0xc4, 0x0b, 0x0a, 0x35, 0x57, 0xbf, 0xad, 0x94, 0xbf, 0x9d, 0xb3, 0x1e, 0x5f, 0xed, 0x2f,
-	0x0d, 0xa8, 0x3e, 0x79, 0x49, 0xbd, 0x31, 0x9e, 0xfd, 0x29, 0xbb, 0xe2, 0x0f, 0xca, 0xbd, 0xfd,
-	0x85, 0x01, 0x9b, 0xf2, 0x55, 0x7e, 0xcc, 0x99, 0x47, 0xa3, 0x50, 0x3b, 0x90, 0x95, 0xb2, 0x0a,
-	0x5d, 0x9a, 0x6b, 0x8b, 0xa1, 0xdf, 0x33, 0xfa, 0xa5, 0xb9, 0x86, 0x1e, 0x40, 0x21, 0x54, 0x1e,
-	0xc9, 0x18, 0xe7, 0x44, 0x4a, 0x32, 0xc6, 0x79, 0xb1, 0x62, 0xae, 0xb5, 0x3f, 0x36, 0x60, 0x2b,
-	0xf6, 0x22, 0x8f, 0xc2, 0x74, 0xe1, 0xd2, 0x8a, 0x77, 0x3e, 0xba, 0x11, 0xdf, 0xc6, 0xbf, 0xfa,
-	0x27, 0x4a, 0xe3, 0xe6, 0x79, 0xa8, 0xba, 0x60, 0x5f, 0x1b, 0x50, 0x51, 0x97, 0x47, 0x14, 0xc5,
-	0x53, 0x28, 0xc5, 0x6f, 0x22, 0x14, 0x2f, 0xcd, 0x92, 0xcb, 0xb8, 0xd1, 0x5c, 0x89, 0x87, 0xb5,
-	0x7b, 0x36, 0xdf, 0x06, 0x9b, 0x2b, 0xef, 0xb0, 0x25, 0x7b, 0x72, 0x69, 0x2b, 0x32, 0xd7, 0x3a,
-	0x99, 0xf7, 0x52, 0x6e, 0xbf, 0x9f, 0x93, 0x52, 0xe1, 0xbf, 0xbf, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x3c, 0xf6, 0x3f, 0xd4, 0xe4, 0x12, 0x00, 0x00,
+	// 1724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x73, 0x1b, 0x4b,
+	0x15, 0xce, 0xe8, 0x65, 0xeb, 0x48, 0xd6, 0xa3, 0xad, 0x9b, 0x3b, 0xc8, 0x0f, 0x99, 0xe1, 0x91,
+	0xdc, 0x18, 0xe4, 0x8b, 0x08, 0x8b, 0x5b, 0x14, 0x0b, 0xcb, 0xbe, 0x49, 0x54, 0x09, 0x89, 0x33,
+	0x4e, 0x58, 0x50, 0x29, 0x54, 0xad, 0xe9, 0x96, 0x3c, 0x58, 0x9a, 0x9e, 0xcc, 0xb4, 0x42, 0xf4,
+	0x07, 0x28, 0x58, 0x91, 0x0d, 0x0b, 0xd6, 0x14, 0xff, 0x80, 0x15, 0xc5, 0x8e, 0x0d, 0xbf, 0x81,
+	0x45, 0xd8, 0xc1, 0x9e, 0x1d, 0x3b, 0xaa, 0x1f, 0xf3, 0xd2, 0x03, 0x9b, 0x02, 0x76, 0xd3, 0xdf,
+	0xf7, 0xf5, 0xe9, 0x73, 0x4e, 0xbf, 0x4e, 0x0f, 0xd4, 0x5d, 0x2f, 0xf4, 0xa9, 0xc3, 0x59, 0xd0,
+	0xf5, 0x03, 0xc6, 0x19, 0x2a, 0xc7, 0x40, 0x1b, 0x26, 0x6c, 0xc2, 0x14, 0xdc, 0x06, 0x8f, 0x11,
+	0xaa, 0xbf, 0xeb, 0x3e, 0x73, 0x3d, 0x4e, 0x03, 0x32, 0xd2, 0xc0, 0xe1, 0x84, 0xb1, 0xc9, 0x94,
+	0x9e, 0xc8, 0xd6, 0x68, 0x3e, 0x3e, 0x21, 0xf3, 0x00, 0x73, 0x97, 0x79, 0x9a, 0xef, 0x2c, 0xf3,
+	0xdc, 0x9d, 0xd1, 0x90, 0xe3, 0x99, 0xaf, 0x04, 0xd6, 0x73, 0x38, 0x7c, 0xe6, 0x86, 0x7c, 0x10,
+	0x04, 0xd4, 0xc7, 0x01, 0x1e, 0x4d, 0xe9, 0x25, 0x9d, 0xcc, 0xa8, 0xc7, 0x43, 0x9b, 0xbe, 0x9d,
+	0xd3, 0x90, 0xa3, 0x16, 0x14, 0xa7, 0xee, 0xcc, 0xe5, 0xa6, 0x71, 0x64, 0xdc, 0x2f, 0xda, 0xaa,
+	0x81, 0xee, 0x42, 0x89, 0x8d, 0xc7, 0x21, 0xe5, 0x66, 0x4e, 0xc2, 0xba, 0x65, 0xfd, 0xcd, 0x00,
+	0xb4, 0x6a, 0x0c, 0x21, 0x28, 0xf8, 0x98, 0x5f, 0x49, 0x1b, 0x55, 0x5b, 0x7e, 0xa3, 0x2f, 0xa0,
+	0x16, 0x2a, 0x7a, 0x48, 0x28, 0xc7, 0xee, 0x54, 0x9a, 0xaa, 0xf4, 0x50, 0x37, 0x89, 0xf2, 0x42,
+	0x7d, 0xd9, 0x3b, 0x5a, 0x79, 0x2e, 0x85, 0xa8, 0x03, 0x95, 0x29, 0x0b, 0xf9, 0xd0, 0x77, 0xa9,
+	0x43, 0x43, 0x33, 0x2f, 0x5d, 0x00, 0x01, 0x5d, 0x48, 0x04, 0x75, 0x61, 0x77, 0x8a, 0x43, 0x3e,
+	0x14, 0x8e, 0xb8, 0xc1, 0x10, 0x73, 0x4e, 0x67, 0x3e, 0x37, 0x0b, 0x47, 0xc6, 0xfd, 0xbc, 0xdd,
+	0x14, 0x94, 0x2d, 0x99, 0x53, 0x45, 0xa0, 0xcf, 0xa1, 0x95, 0x95, 0x0e, 0x1d, 0x36, 0xf7, 0xb8,
+	0x59, 0x94, 0x1d, 0x50, 0x90, 0x16, 0x9f, 0x09, 0xc6, 0x7a, 0x03, 0x9d, 0x8d, 0x89, 0x0b, 0x7d,
+	0xe6, 0x85, 0x14, 0x7d, 0x01, 0xdb, 0xda, 0xed, 0xd0, 0x34, 0x8e, 0xf2, 0xf7, 0x2b, 0xbd, 0x83,
+	0x6e, 0x32, 0xe9, 0xab, 0x3d, 0xed, 0x58, 0x6e, 0x3d, 0x00, 0x24, 0x87, 0x79, 0xce, 0x08, 0x4d,
+	0x0c, 0xb6, 0xa0, 0xa8, 0xdc, 0x32, 0xa4, 0x5b, 0xaa, 0x61, 0xed, 0x42, 0x33, 0xad, 0x95, 0xb3,
+	0x66, 0xdd, 0x85, 0xd6, 0x63, 0xca, 0xfb, 0x73, 0xe7, 0x9a, 0x72, 0xe1, 0x67, 0x84, 0xff, 0xc3,
+	0x80, 0x4f, 0x96, 0x08, 0x6d, 0xfc, 0x14, 0xb6, 0x46, 0x12, 0x8d, 0x9c, 0xbd, 0x97, 0x72, 0x76,
+	0x6d, 0x97, 0xae, 0x82, 0xec, 0xa8, 0x5f, 0xfb, 0xd7, 0x06, 0x94, 0x14, 0x86, 0x8e, 0xa1, 0xac,
+	0xd0, 0xa1, 0x4b, 0xd4, 0xac, 0xf7, 0x6b, 0x7f, 0xfe, 0xd8, 0xb9, 0xf3, 0x97, 0x8f, 0x9d, 0x92,
+	0x70, 0x74, 0x70, 0x6e, 0x6f, 0x2b, 0xc1, 0x80, 0xa0, 0x13, 0xd8, 0x09, 0xd8, 0x9c, 0xbb, 0xde,
+	0x64, 0x28, 0x16, 0x7b, 0x68, 0xe6, 0xa4, 0x03, 0xd0, 0x15, 0xad, 0xae, 0x90, 0xdb, 0x55, 0x2d,
+	0x10, 0x8d, 0x10, 0x7d, 0x1b, 0xaa, 0x0e, 0x76, 0xae, 0x28, 0xd1, 0xfa, 0xfc, 0x8a, 0xbe, 0xa2,
+	0x78, 0x29, 0x17, 0x19, 0x8a, 0x03, 0x88, 0x33, 0xf4, 0x04, 0x50, 0x1a, 0x4c, 0x52, 0xcc, 0x19,
+	0xc7, 0xd3, 0x28, 0xc5, 0xb2, 0x81, 0xf6, 0x21, 0xef, 0x12, 0xe5, 0x56, 0xb5, 0x0f, 0xa9, 0x18,
+	0x04, 0x6c, 0xf5, 0xa0, 0x11, 0x5b, 0x8a, 0x76, 0xcd, 0x21, 0xe4, 0x36, 0x06, 0x9e, 0x73, 0x89,
+	0xf5, 0x3a, 0xe5, 0x52, 0x3c, 0xf8, 0x0d, 0x9d, 0xd0, 0x11, 0x14, 0x37, 0xe5, 0x47, 0x11, 0x56,
+	0x17, 0x20, 0x99, 0xa7, 0x44, 0x6f, 0x6c, 0xd2, 0x3f, 0x85, 0xfa, 0x85, 0xce, 0xea, 0x2d, 0x3d,
+	0x47, 0x26, 0x6c, 0x61, 0x42, 0x02, 0x1a, 0x86, 0x72, 0xbf, 0x96, 0xed, 0xa8, 0x69, 0x59, 0xd0,
+	0x48, 0x8c, 0xe9, 0x90, 0x6a, 0x90, 0x63, 0xd7, 0xd2, 0xda, 0xb6, 0x9d, 0x63, 0xd7, 0xd6, 0x0f,
+	0xa0, 0xf9, 0x8c, 0xb1, 0xeb, 0xb9, 0x9f, 0x1e, 0xb2, 0x16, 0x0f, 0x59, 0xbe, 0x61, 0x88, 0x37,
+	0x80, 0xd2, 0xdd, 0xe3, 0xbc, 0x15, 0x44, 0x38, 0xd2, 0x42, 0x36, 0x4c, 0x89, 0xa3, 0x6f, 0x42,
+	0x61, 0x46, 0x39, 0x8e, 0xcf, 0x97, 0x98, 0xff, 0x21, 0xe5, 0x98, 0x60, 0x8e, 0x6d, 0xc9, 0x5b,
+	0x3f, 0x81, 0xba, 0x0c, 0xd4, 0x1b, 0xb3, 0xdb, 0x66, 0xe3, 0x38, 0xeb, 0x6a, 0xa5, 0xd7, 0x4c,
+	0xac, 0x9f, 0x2a, 0x22, 0xf1, 0xfe, 0x4f, 0x06, 0x34, 0x92, 0x01, 0xb4, 0xf3, 0x16, 0x14, 0xf8,
+	0xc2, 0x57, 0xce, 0xd7, 0x7a, 0xb5, 0xa4, 0xfb, 0xab, 0x85, 0x4f, 0x6d, 0xc9, 0xa1, 0x2e, 0x6c,
+	0x33, 0x9f, 0x06, 0x98, 0xb3, 0x60, 0x35, 0x88, 0x17, 0x9a, 0xb1, 0x63, 0x8d, 0xd0, 0x3b, 0xd8,
+	0xc7, 0x8e, 0xcb, 0x17, 0x66, 0x7e, 0x59, 0x7f, 0xa6, 0x19, 0x3b, 0xd6, 0x88, 0x28, 0xde, 0xd1,
+	0x20, 0x74, 0x99, 0x67, 0x16, 0x96, 0xa3, 0xf8, 0x91, 0x22, 0xec, 0x48, 0x61, 0xcd, 0xa0, 0xfe,
+	0xc8, 0xf5, 0xc8, 0x73, 0x8a, 0x83, 0xdb, 0x66, 0xe9, 0xeb, 0x50, 0x0c, 0x39, 0x0e, 0xd4, 0x65,
+	0xb1, 0x2a, 0x51, 0x64, 0x72, 0xd3, 0xe4, 0xd5, 0xde, 0x93, 0x0d, 0xeb, 0x21, 0x34, 0x92, 0xe1,
+	0x74, 0xce, 0x6e, 0xde, 0x08, 0x08, 0x1a, 0xe7, 0xf3, 0x99, 0x9f, 0x39, 0x13, 0xbf, 0x07, 0xcd,
+	0x14, 0xb6, 0x6c, 0x6a, 0xe3, 0x1e, 0xa9, 0x41, 0xf5, 0x92, 0xe3, 0xe4, 0xe0, 0xf8, 0xa7, 0x01,
+	0xbb, 0x02, 0xb8, 0x9c, 0xcf, 0x66, 0x38, 0x58, 0xc4, 0x96, 0x0e, 0x00, 0xe6, 0x21, 0x25, 0xc3,
+	0xd0, 0xc7, 0x0e, 0xd5, 0xe7, 0x47, 0x59, 0x20, 0x97, 0x02, 0x40, 0xf7, 0xa0, 0x8e, 0xdf, 0x61,
+	0x77, 0x2a, 0x0e, 0x7c, 0xad, 0xc9, 0x49, 0x4d, 0x2d, 0x86, 0x95, 0xf0, 0xab, 0x50, 0x95, 0x76,
+	0x5c, 0x6f, 0x22, 0xd7, 0x95, 0xca, 0x46, 0x45, 0x60, 0x03, 0x05, 0x89, 0xfb, 0x4f, 0x4a, 0xa8,
+	0x52, 0xa8, 0x6b, 0x4d, 0x8e, 0xfe, 0xa5, 0x12, 0x7c, 0x03, 0x6a, 0x52, 0x30, 0xc2, 0x1e, 0xf9,
+	0x99, 0x4b, 0xf8, 0x95, 0xbe, 0xc9, 0x76, 0x04, 0xda, 0x8f, 0x40, 0x74, 0x02, 0xbb, 0x89, 0x4f,
+	0x89, 0xb6, 0x24, 0xb5, 0x28, 0xa6, 0xe2, 0x0e, 0x32, 0xad, 0x38, 0xbc, 0x1a, 0x31, 0x1c, 0x90,
+	0x28, 0x1f, 0x7f, 0x2c, 0x40, 0x33, 0x05, 0xea, 0x6c, 0xdc, 0x83, 0x2d, 0x91, 0xbe, 0xcd, 0xc7,
+	0x7f, 0x49, 0xd0, 0x03, 0x82, 0x3e, 0x83, 0x86, 0x14, 0x3a, 0xcc, 0xf3, 0xa8, 0x23, 0x6a, 0x97,
+	0x50, 0x27, 0xa6, 0x2e, 0xf0, 0xb3, 0x04, 0x46, 0xc7, 0xd0, 0x1c, 0x31, 0xc6, 0x43, 0x1e, 0x60,
+	0x7f, 0x18, 0x6d, 0xbb, 0xbc, 0x3c, 0x21, 0x1a, 0x31, 0xa1, 0x77, 0x9d, 0xb0, 0x2b, 0x6b, 0x07,
+	0x0f, 0x4f, 0x63, 0x6d, 0x41, 0x6a, 0xeb, 0x11, 0x9e, 0x92, 0xd2, 0xf7, 0x4b, 0xd2, 0xa2, 0x92,
+	0xd2, 0xf7, 0x59, 0xe9, 0x31, 0x34, 0x49, 0x14, 0x6b, 0xac, 0x2d, 0x29, 0x17, 0x62, 0x22, 0x12,
+	0x3f, 0x94, 0xcb, 0x9e, 0x87, 0xe6, 0x96, 0xdc, 0x54, 0x87, 0xa9, 0x0b, 0x75, 0xcd, 0x02, 0xb2,
+	0x95, 0x18, 0x7d, 0x07, 0x4a, 0x73, 0x5f, 0xd4, 0x69, 0xe6, 0xb6, 0xec, 0xf6, 0x95, 0xae, 0x2a,
+	0xe2, 0xba, 0x51, 0x11, 0xd7, 0x3d, 0xd7, 0x45, 0x9e, 0xad, 0x85, 0xe8, 0x4b, 0xa8, 0xc8, 0x72,
+	0xc7, 0x77, 0xbd, 0x09, 0x25, 0x66, 0x59, 0xf6, 0x6b, 0xaf, 0xf4, 0x7b, 0x15, 0x15, 0x7f, 0xfd,
+	0x6d, 0x31, 0x19, 0x1f, 0xfe, 0xda, 0x31, 0x6c, 0x10, 0x1d, 0x2f, 0x64, 0x3f, 0xf4, 0x18, 0xaa,
+	0xd2, 0xcc, 0xdb, 0x39, 0x0d, 0x5c, 0x4a, 0x4c, 0xf8, 0x0f, 0xec, 0x48, 0x07, 0x5e, 0xaa, 0x8e,
+	0xe8, 0x01, 0x14, 0x89, 0x1b, 0x5e, 0x87, 0x66, 0x45, 0x6e, 0xaa, 0x56, 0x2a, 0xf0, 0x73, 0x37,
+	0xbc, 0x7e, 0x1d, 0xe2, 0x09, 0xb5, 0x95, 0xc4, 0xfa, 0x60, 0x40, 0x39, 0x06, 0x33, 0x85, 0x62,
+	0x59, 0x17, 0x8a, 0xd9, 0x8d, 0x95, 0xbb, 0xc5, 0xc6, 0xca, 0xaf, 0xdd, 0x58, 0x42, 0x38, 0x9d,
+	0x32, 0x07, 0xf3, 0xd8, 0x58, 0x41, 0x0b, 0x23, 0x58, 0x0a, 0xad, 0xdf, 0x18, 0xd0, 0xd2, 0x35,
+	0xd9, 0x13, 0x8a, 0xa7, 0xfc, 0x2a, 0x3a, 0xe7, 0xee, 0x42, 0x49, 0x15, 0x2d, 0xba, 0x90, 0xd5,
+	0x2d, 0xb1, 0xdd, 0xa8, 0xe7, 0x04, 0x0b, 0x5f, 0x58, 0x96, 0xfe, 0xcb, 0x83, 0xce, 0xde, 0x89,
+	0xd1, 0x0b, 0x11, 0xc8, 0xd7, 0x20, 0xaa, 0x63, 0x87, 0xae, 0x47, 0xe8, 0x7b, 0xed, 0x67, 0x55,
+	0x83, 0x03, 0x81, 0x89, 0x68, 0xfd, 0x80, 0xfd, 0x94, 0x3a, 0xb2, 0x74, 0x2a, 0x48, 0x3b, 0x65,
+	0x8d, 0x0c, 0x88, 0xf5, 0x7b, 0x03, 0x76, 0x32, 0xbe, 0xa1, 0x63, 0xa8, 0x5c, 0xc9, 0xaf, 0xc5,
+	0xd0, 0x25, 0xea, 0x1c, 0xcb, 0x16, 0x29, 0xa0, 0xe9, 0x01, 0x09, 0x45, 0xa9, 0x35, 0xf7, 0xd2,
+	0xf2, 0xd5, 0x9a, 0xa6, 0x3a, 0xf7, 0x52, 0x1d, 0x8e, 0xa1, 0xc2, 0xc6, 0xe3, 0xa9, 0xeb, 0x51,
+	0x29, 0xcf, 0xaf, 0x5a, 0xd7, 0xb4, 0x10, 0x9b, 0xb0, 0xa5, 0x63, 0xd1, 0x8e, 0x47, 0x4d, 0xeb,
+	0xe7, 0x06, 0x7c, 0xb2, 0x94, 0x52, 0x7d, 0x50, 0x7c, 0x0e, 0x25, 0x35, 0x9c, 0xbe, 0xbe, 0xcd,
+	0xf4, 0x2e, 0xc9, 0xf4, 0xd0, 0x3a, 0xf4, 0x7d, 0x80, 0x80, 0x92, 0xb9, 0x47, 0xb0, 0xe7, 0x2c,
+	0xf4, 0x7d, 0xb8, 0x97, 0x7a, 0x34, 0xd8, 0x31, 0x79, 0xe9, 0x5c, 0xd1, 0x19, 0xb5, 0x53, 0x72,
+	0xeb, 0xef, 0x06, 0xec, 0xbe, 0x18, 0x89, 0x64, 0x66, 0xa7, 0x76, 0x75, 0x0a, 0x8d, 0x75, 0x53,
+	0x98, 0xac, 0x80, 0x5c, 0x66, 0x05, 0x64, 0x67, 0x2d, 0xbf, 0x34, 0x6b, 0xe2, 0x3d, 0x22, 0xef,
+	0xb8, 0x21, 0x1e, 0x73, 0x1a, 0x0c, 0xd3, 0x49, 0xca, 0xdb, 0x4d, 0x49, 0x9d, 0x0a, 0x26, 0x7a,
+	0x2f, 0x7d, 0x0b, 0x10, 0xf5, 0xc8, 0x70, 0x44, 0xc7, 0x2c, 0xa0, 0xb1, 0x5c, 0x9d, 0xe1, 0x0d,
+	0xea, 0x91, 0xbe, 0x24, 0x22, 0x75, 0x7c, 0x71, 0x96, 0x52, 0x4f, 0x34, 0xeb, 0x97, 0x06, 0xb4,
+	0xb2, 0x91, 0xea, 0x8c, 0x3f, 0x5c, 0x79, 0x97, 0x6c, 0xce, 0x79, 0xac, 0xfc, 0xaf, 0xb2, 0xde,
+	0xfb, 0x55, 0x01, 0xaa, 0x4f, 0x31, 0x19, 0x44, 0xa3, 0xa0, 0x01, 0x40, 0xf2, 0x68, 0x41, 0xfb,
+	0xa9, 0xf1, 0x57, 0xde, 0x32, 0xed, 0x83, 0x0d, 0xac, 0x0e, 0xe7, 0x0c, 0xb6, 0xa3, 0xb2, 0x13,
+	0xb5, 0x53, 0xd2, 0xa5, 0xc2, 0xb6, 0xbd, 0xb7, 0x96, 0xd3, 0x46, 0x06, 0x00, 0x49, 0x61, 0x99,
+	0xf1, 0x67, 0xa5, 0x5c, 0x6d, 0x1f, 0x6c, 0x60, 0x13, 0x7f, 0xa2, 0x22, 0x2f, 0xe3, 0xcf, 0x52,
+	0x69, 0xd9, 0xde, 0x5b, 0xcb, 0x25, 0x46, 0xa2, 0xaa, 0x27, 0x63, 0x64, 0xa9, 0xf2, 0x6a, 0xef,
+	0xad, 0xe5, 0xb4, 0x91, 0x47, 0x50, 0x8e, 0x0b, 0x1e, 0x94, 0x56, 0x2e, 0x97, 0x46, 0xed, 0xfd,
+	0xf5, 0xa4, 0xb6, 0x63, 0xc3, 0x4e, 0xe6, 0x01, 0x88, 0x3a, 0x9b, 0x9f, 0x86, 0xca, 0xde, 0xd1,
+	0x4d, 0x6f, 0xc7, 0xde, 0xef, 0x0c, 0x68, 0xbc, 0x78, 0x47, 0x83, 0x29, 0x5e, 0xfc, 0x5f, 0x56,
+	0xc5, 0xff, 0x28, 0xf6, 0xde, 0x6f, 0x0d, 0xd8, 0x95, 0x3f, 0x15, 0x2e, 0x39, 0x0b, 0x68, 0xe2,
+	0x6a, 0x1f, 0x8a, 0xb2, 0x2a, 0x44, 0x9f, 0x2e, 0xdd, 0xea, 0xb1, 0xdd, 0x1b, 0xae, 0x7b, 0xeb,
+	0x0e, 0x7a, 0x02, 0xe5, 0xb8, 0x70, 0xca, 0xfa, 0xb8, 0x54, 0x63, 0xb5, 0xf7, 0xd7, 0x93, 0x91,
+	0xa5, 0xde, 0x2f, 0x0c, 0x68, 0xa5, 0x7e, 0x28, 0x24, 0x6e, 0xfa, 0xf0, 0xe9, 0x86, 0xdf, 0x14,
+	0xe8, 0xb3, 0xf4, 0x32, 0xfe, 0xb7, 0xff, 0x80, 0xda, 0x0f, 0x6e, 0x23, 0xd5, 0x09, 0xfb, 0x83,
+	0x01, 0x75, 0x75, 0x78, 0x24, 0x5e, 0xbc, 0x84, 0x6a, 0xfa, 0x24, 0x42, 0xe9, 0xd4, 0xac, 0x39,
+	0x8c, 0xdb, 0x9d, 0x8d, 0x7c, 0x9c, 0xbb, 0x57, 0xcb, 0xd7, 0x60, 0x67, 0xe3, 0x19, 0xb6, 0x66,
+	0x4d, 0xae, 0xbd, 0x8a, 0xac, 0x3b, 0xfd, 0xc2, 0x8f, 0x73, 0xfe, 0x68, 0x54, 0x92, 0x95, 0xce,
+	0x77, 0xff, 0x35, 0x00, 0xf8, 0xac, 0x3b, 0xa5, 0xa3, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
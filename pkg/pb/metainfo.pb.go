@@ -33,6 +33,8 @@ type Bucket struct {
 	DefaultSegmentSize          int64                 `protobuf:"varint,4,opt,name=default_segment_size,json=defaultSegmentSize,proto3" json:"default_segment_size,omitempty"`
 	DefaultRedundancyScheme     *RedundancyScheme     `protobuf:"bytes,5,opt,name=default_redundancy_scheme,json=defaultRedundancyScheme,proto3" json:"default_redundancy_scheme,omitempty"`
 	DefaultEncryptionParameters *EncryptionParameters `protobuf:"bytes,6,opt,name=default_encryption_parameters,json=defaultEncryptionParameters,proto3" json:"default_encryption_parameters,omitempty"`
+	Versioning                  bool                  `protobuf:"varint,7,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	Placement                   string                `protobuf:"bytes,8,opt,name=placement,proto3" json:"placement,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{}              `json:"-"`
 	XXX_unrecognized            []byte                `json:"-"`
 	XXX_sizecache               int32                 `json:"-"`
@@ -104,6 +106,20 @@ func (m *Bucket) GetDefaultEncryptionParameters() *EncryptionParameters {
 	return nil
 }
 
+func (m *Bucket) GetVersioning() bool {
+	if m != nil {
+		return m.Versioning
+	}
+	return false
+}
+
+func (m *Bucket) GetPlacement() string {
+	if m != nil {
+		return m.Placement
+	}
+	return ""
+}
+
 type BucketListItem struct {
 	Name                 []byte    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	CreatedAt            time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
@@ -156,6 +172,7 @@ type BucketCreateRequest struct {
 	DefaultSegmentSize          int64                 `protobuf:"varint,3,opt,name=default_segment_size,json=defaultSegmentSize,proto3" json:"default_segment_size,omitempty"`
 	DefaultRedundancyScheme     *RedundancyScheme     `protobuf:"bytes,4,opt,name=default_redundancy_scheme,json=defaultRedundancyScheme,proto3" json:"default_redundancy_scheme,omitempty"`
 	DefaultEncryptionParameters *EncryptionParameters `protobuf:"bytes,5,opt,name=default_encryption_parameters,json=defaultEncryptionParameters,proto3" json:"default_encryption_parameters,omitempty"`
+	Placement                   string                `protobuf:"bytes,6,opt,name=placement,proto3" json:"placement,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{}              `json:"-"`
 	XXX_unrecognized            []byte                `json:"-"`
 	XXX_sizecache               int32                 `json:"-"`
@@ -220,6 +237,13 @@ func (m *BucketCreateRequest) GetDefaultEncryptionParameters() *EncryptionParame
 	return nil
 }
 
+func (m *BucketCreateRequest) GetPlacement() string {
+	if m != nil {
+		return m.Placement
+	}
+	return ""
+}
+
 type BucketCreateResponse struct {
 	Bucket               *Bucket  `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -578,6 +602,342 @@ func (m *BucketSetAttributionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BucketSetAttributionResponse proto.InternalMessageInfo
 
+type BucketSetVersioningRequest struct {
+	Name                 []byte   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Versioning           bool     `protobuf:"varint,2,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetVersioningRequest) Reset()         { *m = BucketSetVersioningRequest{} }
+func (m *BucketSetVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningRequest) ProtoMessage()    {}
+func (*BucketSetVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{12}
+}
+func (m *BucketSetVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningRequest.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningRequest.Merge(m, src)
+}
+func (m *BucketSetVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningRequest.Size(m)
+}
+func (m *BucketSetVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningRequest proto.InternalMessageInfo
+
+func (m *BucketSetVersioningRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetVersioningRequest) GetVersioning() bool {
+	if m != nil {
+		return m.Versioning
+	}
+	return false
+}
+
+type BucketSetVersioningResponse struct {
+	Bucket               *Bucket  `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetVersioningResponse) Reset()         { *m = BucketSetVersioningResponse{} }
+func (m *BucketSetVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningResponse) ProtoMessage()    {}
+func (*BucketSetVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{13}
+}
+func (m *BucketSetVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningResponse.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningResponse.Merge(m, src)
+}
+func (m *BucketSetVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningResponse.Size(m)
+}
+func (m *BucketSetVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningResponse proto.InternalMessageInfo
+
+func (m *BucketSetVersioningResponse) GetBucket() *Bucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type LifecycleRule struct {
+	Id                        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EncryptedPrefix           []byte   `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	ExpirationDays            int32    `protobuf:"varint,3,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	AbortIncompleteUploadDays int32    `protobuf:"varint,4,opt,name=abort_incomplete_upload_days,json=abortIncompleteUploadDays,proto3" json:"abort_incomplete_upload_days,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{14}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetExpirationDays() int32 {
+	if m != nil {
+		return m.ExpirationDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortIncompleteUploadDays() int32 {
+	if m != nil {
+		return m.AbortIncompleteUploadDays
+	}
+	return 0
+}
+
+type BucketLifecycle struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketLifecycle) Reset()         { *m = BucketLifecycle{} }
+func (m *BucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycle) ProtoMessage()    {}
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{15}
+}
+func (m *BucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLifecycle.Unmarshal(m, b)
+}
+func (m *BucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLifecycle.Marshal(b, m, deterministic)
+}
+func (m *BucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycle.Merge(m, src)
+}
+func (m *BucketLifecycle) XXX_Size() int {
+	return xxx_messageInfo_BucketLifecycle.Size(m)
+}
+func (m *BucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycle proto.InternalMessageInfo
+
+func (m *BucketLifecycle) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type BucketSetLifecycleRequest struct {
+	Name                 []byte           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rules                []*LifecycleRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketSetLifecycleRequest) Reset()         { *m = BucketSetLifecycleRequest{} }
+func (m *BucketSetLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetLifecycleRequest) ProtoMessage()    {}
+func (*BucketSetLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{16}
+}
+func (m *BucketSetLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Unmarshal(m, b)
+}
+func (m *BucketSetLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetLifecycleRequest.Merge(m, src)
+}
+func (m *BucketSetLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetLifecycleRequest.Size(m)
+}
+func (m *BucketSetLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetLifecycleRequest proto.InternalMessageInfo
+
+func (m *BucketSetLifecycleRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetLifecycleRequest) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type BucketSetLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetLifecycleResponse) Reset()         { *m = BucketSetLifecycleResponse{} }
+func (m *BucketSetLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetLifecycleResponse) ProtoMessage()    {}
+func (*BucketSetLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{17}
+}
+func (m *BucketSetLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Unmarshal(m, b)
+}
+func (m *BucketSetLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetLifecycleResponse.Merge(m, src)
+}
+func (m *BucketSetLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetLifecycleResponse.Size(m)
+}
+func (m *BucketSetLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetLifecycleResponse proto.InternalMessageInfo
+
+type BucketGetLifecycleRequest struct {
+	Name                 []byte   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketGetLifecycleRequest) Reset()         { *m = BucketGetLifecycleRequest{} }
+func (m *BucketGetLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketGetLifecycleRequest) ProtoMessage()    {}
+func (*BucketGetLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{18}
+}
+func (m *BucketGetLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetLifecycleRequest.Unmarshal(m, b)
+}
+func (m *BucketGetLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketGetLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetLifecycleRequest.Merge(m, src)
+}
+func (m *BucketGetLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketGetLifecycleRequest.Size(m)
+}
+func (m *BucketGetLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetLifecycleRequest proto.InternalMessageInfo
+
+func (m *BucketGetLifecycleRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type BucketGetLifecycleResponse struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketGetLifecycleResponse) Reset()         { *m = BucketGetLifecycleResponse{} }
+func (m *BucketGetLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketGetLifecycleResponse) ProtoMessage()    {}
+func (*BucketGetLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{19}
+}
+func (m *BucketGetLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketGetLifecycleResponse.Unmarshal(m, b)
+}
+func (m *BucketGetLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketGetLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketGetLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketGetLifecycleResponse.Merge(m, src)
+}
+func (m *BucketGetLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketGetLifecycleResponse.Size(m)
+}
+func (m *BucketGetLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketGetLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketGetLifecycleResponse proto.InternalMessageInfo
+
+func (m *BucketGetLifecycleResponse) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
 type AddressedOrderLimit struct {
 	Limit                *OrderLimit  `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	StorageNodeAddress   *NodeAddress `protobuf:"bytes,2,opt,name=storage_node_address,json=storageNodeAddress,proto3" json:"storage_node_address,omitempty"`
@@ -590,7 +950,7 @@ func (m *AddressedOrderLimit) Reset()         { *m = AddressedOrderLimit{} }
 func (m *AddressedOrderLimit) String() string { return proto.CompactTextString(m) }
 func (*AddressedOrderLimit) ProtoMessage()    {}
 func (*AddressedOrderLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{12}
+	return fileDescriptor_631e2f30a93cd64e, []int{20}
 }
 func (m *AddressedOrderLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressedOrderLimit.Unmarshal(m, b)
@@ -640,7 +1000,7 @@ func (m *SegmentWriteRequestOld) Reset()         { *m = SegmentWriteRequestOld{}
 func (m *SegmentWriteRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentWriteRequestOld) ProtoMessage()    {}
 func (*SegmentWriteRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{13}
+	return fileDescriptor_631e2f30a93cd64e, []int{21}
 }
 func (m *SegmentWriteRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentWriteRequestOld.Unmarshal(m, b)
@@ -715,7 +1075,7 @@ func (m *SegmentWriteResponseOld) Reset()         { *m = SegmentWriteResponseOld
 func (m *SegmentWriteResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentWriteResponseOld) ProtoMessage()    {}
 func (*SegmentWriteResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{14}
+	return fileDescriptor_631e2f30a93cd64e, []int{22}
 }
 func (m *SegmentWriteResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentWriteResponseOld.Unmarshal(m, b)
@@ -757,7 +1117,7 @@ func (m *SegmentCommitRequestOld) Reset()         { *m = SegmentCommitRequestOld
 func (m *SegmentCommitRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentCommitRequestOld) ProtoMessage()    {}
 func (*SegmentCommitRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{15}
+	return fileDescriptor_631e2f30a93cd64e, []int{23}
 }
 func (m *SegmentCommitRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentCommitRequestOld.Unmarshal(m, b)
@@ -823,7 +1183,7 @@ func (m *SegmentCommitResponseOld) Reset()         { *m = SegmentCommitResponseO
 func (m *SegmentCommitResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentCommitResponseOld) ProtoMessage()    {}
 func (*SegmentCommitResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{16}
+	return fileDescriptor_631e2f30a93cd64e, []int{24}
 }
 func (m *SegmentCommitResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentCommitResponseOld.Unmarshal(m, b)
@@ -854,6 +1214,7 @@ type SegmentDownloadRequestOld struct {
 	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	Path                 []byte   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	VersionId            string   `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -863,7 +1224,7 @@ func (m *SegmentDownloadRequestOld) Reset()         { *m = SegmentDownloadReques
 func (m *SegmentDownloadRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDownloadRequestOld) ProtoMessage()    {}
 func (*SegmentDownloadRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{17}
+	return fileDescriptor_631e2f30a93cd64e, []int{25}
 }
 func (m *SegmentDownloadRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDownloadRequestOld.Unmarshal(m, b)
@@ -904,6 +1265,13 @@ func (m *SegmentDownloadRequestOld) GetSegment() int64 {
 	return 0
 }
 
+func (m *SegmentDownloadRequestOld) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 type SegmentDownloadResponseOld struct {
 	AddressedLimits      []*AddressedOrderLimit `protobuf:"bytes,1,rep,name=addressed_limits,json=addressedLimits,proto3" json:"addressed_limits,omitempty"`
 	Pointer              *Pointer               `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
@@ -917,7 +1285,7 @@ func (m *SegmentDownloadResponseOld) Reset()         { *m = SegmentDownloadRespo
 func (m *SegmentDownloadResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDownloadResponseOld) ProtoMessage()    {}
 func (*SegmentDownloadResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{18}
+	return fileDescriptor_631e2f30a93cd64e, []int{26}
 }
 func (m *SegmentDownloadResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDownloadResponseOld.Unmarshal(m, b)
@@ -955,6 +1323,7 @@ type SegmentInfoRequestOld struct {
 	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	Path                 []byte   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	VersionId            string   `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -964,7 +1333,7 @@ func (m *SegmentInfoRequestOld) Reset()         { *m = SegmentInfoRequestOld{} }
 func (m *SegmentInfoRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfoRequestOld) ProtoMessage()    {}
 func (*SegmentInfoRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{19}
+	return fileDescriptor_631e2f30a93cd64e, []int{27}
 }
 func (m *SegmentInfoRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentInfoRequestOld.Unmarshal(m, b)
@@ -1005,6 +1374,13 @@ func (m *SegmentInfoRequestOld) GetSegment() int64 {
 	return 0
 }
 
+func (m *SegmentInfoRequestOld) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 type SegmentInfoResponseOld struct {
 	Pointer              *Pointer `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1016,7 +1392,7 @@ func (m *SegmentInfoResponseOld) Reset()         { *m = SegmentInfoResponseOld{}
 func (m *SegmentInfoResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfoResponseOld) ProtoMessage()    {}
 func (*SegmentInfoResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{20}
+	return fileDescriptor_631e2f30a93cd64e, []int{28}
 }
 func (m *SegmentInfoResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentInfoResponseOld.Unmarshal(m, b)
@@ -1056,7 +1432,7 @@ func (m *SegmentDeleteRequestOld) Reset()         { *m = SegmentDeleteRequestOld
 func (m *SegmentDeleteRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDeleteRequestOld) ProtoMessage()    {}
 func (*SegmentDeleteRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{21}
+	return fileDescriptor_631e2f30a93cd64e, []int{29}
 }
 func (m *SegmentDeleteRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDeleteRequestOld.Unmarshal(m, b)
@@ -1109,7 +1485,7 @@ func (m *SegmentDeleteResponseOld) Reset()         { *m = SegmentDeleteResponseO
 func (m *SegmentDeleteResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SegmentDeleteResponseOld) ProtoMessage()    {}
 func (*SegmentDeleteResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{22}
+	return fileDescriptor_631e2f30a93cd64e, []int{30}
 }
 func (m *SegmentDeleteResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDeleteResponseOld.Unmarshal(m, b)
@@ -1153,7 +1529,7 @@ func (m *ListSegmentsRequestOld) Reset()         { *m = ListSegmentsRequestOld{}
 func (m *ListSegmentsRequestOld) String() string { return proto.CompactTextString(m) }
 func (*ListSegmentsRequestOld) ProtoMessage()    {}
 func (*ListSegmentsRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{23}
+	return fileDescriptor_631e2f30a93cd64e, []int{31}
 }
 func (m *ListSegmentsRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListSegmentsRequestOld.Unmarshal(m, b)
@@ -1234,7 +1610,7 @@ func (m *ListSegmentsResponseOld) Reset()         { *m = ListSegmentsResponseOld
 func (m *ListSegmentsResponseOld) String() string { return proto.CompactTextString(m) }
 func (*ListSegmentsResponseOld) ProtoMessage()    {}
 func (*ListSegmentsResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{24}
+	return fileDescriptor_631e2f30a93cd64e, []int{32}
 }
 func (m *ListSegmentsResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListSegmentsResponseOld.Unmarshal(m, b)
@@ -1281,7 +1657,7 @@ func (m *ListSegmentsResponseOld_Item) Reset()         { *m = ListSegmentsRespon
 func (m *ListSegmentsResponseOld_Item) String() string { return proto.CompactTextString(m) }
 func (*ListSegmentsResponseOld_Item) ProtoMessage()    {}
 func (*ListSegmentsResponseOld_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{24, 0}
+	return fileDescriptor_631e2f30a93cd64e, []int{32, 0}
 }
 func (m *ListSegmentsResponseOld_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListSegmentsResponseOld_Item.Unmarshal(m, b)
@@ -1334,7 +1710,7 @@ func (m *SetAttributionRequestOld) Reset()         { *m = SetAttributionRequestO
 func (m *SetAttributionRequestOld) String() string { return proto.CompactTextString(m) }
 func (*SetAttributionRequestOld) ProtoMessage()    {}
 func (*SetAttributionRequestOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{25}
+	return fileDescriptor_631e2f30a93cd64e, []int{33}
 }
 func (m *SetAttributionRequestOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAttributionRequestOld.Unmarshal(m, b)
@@ -1378,7 +1754,7 @@ func (m *SetAttributionResponseOld) Reset()         { *m = SetAttributionRespons
 func (m *SetAttributionResponseOld) String() string { return proto.CompactTextString(m) }
 func (*SetAttributionResponseOld) ProtoMessage()    {}
 func (*SetAttributionResponseOld) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{26}
+	return fileDescriptor_631e2f30a93cd64e, []int{34}
 }
 func (m *SetAttributionResponseOld) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAttributionResponseOld.Unmarshal(m, b)
@@ -1408,7 +1784,7 @@ func (m *ProjectInfoRequest) Reset()         { *m = ProjectInfoRequest{} }
 func (m *ProjectInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoRequest) ProtoMessage()    {}
 func (*ProjectInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{27}
+	return fileDescriptor_631e2f30a93cd64e, []int{35}
 }
 func (m *ProjectInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoRequest.Unmarshal(m, b)
@@ -1439,7 +1815,7 @@ func (m *ProjectInfoResponse) Reset()         { *m = ProjectInfoResponse{} }
 func (m *ProjectInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoResponse) ProtoMessage()    {}
 func (*ProjectInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{28}
+	return fileDescriptor_631e2f30a93cd64e, []int{36}
 }
 func (m *ProjectInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoResponse.Unmarshal(m, b)
@@ -1466,137 +1842,2554 @@ func (m *ProjectInfoResponse) GetProjectSalt() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Bucket)(nil), "metainfo.Bucket")
-	proto.RegisterType((*BucketListItem)(nil), "metainfo.BucketListItem")
-	proto.RegisterType((*BucketCreateRequest)(nil), "metainfo.BucketCreateRequest")
-	proto.RegisterType((*BucketCreateResponse)(nil), "metainfo.BucketCreateResponse")
-	proto.RegisterType((*BucketGetRequest)(nil), "metainfo.BucketGetRequest")
-	proto.RegisterType((*BucketGetResponse)(nil), "metainfo.BucketGetResponse")
-	proto.RegisterType((*BucketDeleteRequest)(nil), "metainfo.BucketDeleteRequest")
-	proto.RegisterType((*BucketDeleteResponse)(nil), "metainfo.BucketDeleteResponse")
-	proto.RegisterType((*BucketListRequest)(nil), "metainfo.BucketListRequest")
-	proto.RegisterType((*BucketListResponse)(nil), "metainfo.BucketListResponse")
-	proto.RegisterType((*BucketSetAttributionRequest)(nil), "metainfo.BucketSetAttributionRequest")
-	proto.RegisterType((*BucketSetAttributionResponse)(nil), "metainfo.BucketSetAttributionResponse")
-	proto.RegisterType((*AddressedOrderLimit)(nil), "metainfo.AddressedOrderLimit")
-	proto.RegisterType((*SegmentWriteRequestOld)(nil), "metainfo.SegmentWriteRequestOld")
-	proto.RegisterType((*SegmentWriteResponseOld)(nil), "metainfo.SegmentWriteResponseOld")
-	proto.RegisterType((*SegmentCommitRequestOld)(nil), "metainfo.SegmentCommitRequestOld")
-	proto.RegisterType((*SegmentCommitResponseOld)(nil), "metainfo.SegmentCommitResponseOld")
-	proto.RegisterType((*SegmentDownloadRequestOld)(nil), "metainfo.SegmentDownloadRequestOld")
-	proto.RegisterType((*SegmentDownloadResponseOld)(nil), "metainfo.SegmentDownloadResponseOld")
-	proto.RegisterType((*SegmentInfoRequestOld)(nil), "metainfo.SegmentInfoRequestOld")
-	proto.RegisterType((*SegmentInfoResponseOld)(nil), "metainfo.SegmentInfoResponseOld")
-	proto.RegisterType((*SegmentDeleteRequestOld)(nil), "metainfo.SegmentDeleteRequestOld")
-	proto.RegisterType((*SegmentDeleteResponseOld)(nil), "metainfo.SegmentDeleteResponseOld")
-	proto.RegisterType((*ListSegmentsRequestOld)(nil), "metainfo.ListSegmentsRequestOld")
-	proto.RegisterType((*ListSegmentsResponseOld)(nil), "metainfo.ListSegmentsResponseOld")
-	proto.RegisterType((*ListSegmentsResponseOld_Item)(nil), "metainfo.ListSegmentsResponseOld.Item")
-	proto.RegisterType((*SetAttributionRequestOld)(nil), "metainfo.SetAttributionRequestOld")
-	proto.RegisterType((*SetAttributionResponseOld)(nil), "metainfo.SetAttributionResponseOld")
-	proto.RegisterType((*ProjectInfoRequest)(nil), "metainfo.ProjectInfoRequest")
-	proto.RegisterType((*ProjectInfoResponse)(nil), "metainfo.ProjectInfoResponse")
+// ObjectSegmentMetadata contains the metadata of a segment re-encrypted for the new path.
+type ObjectSegmentMetadata struct {
+	Segment              int64    `protobuf:"varint,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	EncryptedMetadata    []byte   `protobuf:"bytes,2,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
-
-var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 1448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6f, 0xdb, 0x46,
-	0x13, 0xff, 0x68, 0x5b, 0xb2, 0x3d, 0x52, 0x6c, 0x67, 0xe3, 0xcf, 0x56, 0xe8, 0x28, 0x56, 0x98,
-	0x2f, 0x81, 0x3e, 0xa0, 0x50, 0x0a, 0xe7, 0x12, 0x20, 0x2d, 0x50, 0x3f, 0xf2, 0x70, 0x9b, 0x87,
-	0x41, 0x15, 0x4d, 0x1a, 0xb4, 0x20, 0x28, 0x71, 0xa4, 0xb0, 0x11, 0x1f, 0x5d, 0xae, 0x52, 0x3b,
-	0xe7, 0x1e, 0x7b, 0xe8, 0x5f, 0x90, 0xbf, 0xa7, 0xe8, 0xa1, 0x87, 0xa2, 0xa7, 0x16, 0x48, 0x6f,
-	0xfd, 0x3b, 0x8a, 0x7d, 0x89, 0xa4, 0x44, 0xd9, 0x49, 0xa0, 0xdc, 0xb8, 0xb3, 0xb3, 0xb3, 0x33,
-	0xbf, 0xdf, 0xcc, 0xec, 0x10, 0x56, 0x02, 0x64, 0xae, 0x1f, 0xf6, 0xa2, 0x56, 0x4c, 0x23, 0x16,
-	0x91, 0x25, 0xbd, 0x36, 0xd7, 0x30, 0xec, 0xd2, 0x93, 0x98, 0xf9, 0x51, 0x28, 0xf7, 0x4c, 0xe8,
-	0x47, 0x7d, 0xa5, 0x67, 0x6e, 0xf7, 0xa3, 0xa8, 0x3f, 0xc0, 0x1b, 0x62, 0xd5, 0x19, 0xf6, 0x6e,
-	0x30, 0x3f, 0xc0, 0x84, 0xb9, 0x41, 0xac, 0x95, 0xc3, 0xc8, 0x43, 0xf5, 0xbd, 0x1a, 0x47, 0x7e,
-	0xc8, 0x90, 0x7a, 0x1d, 0x25, 0xa8, 0x46, 0xd4, 0x43, 0x9a, 0xc8, 0x95, 0xf5, 0xd3, 0x3c, 0x94,
-	0xf7, 0x86, 0xdd, 0x17, 0xc8, 0x08, 0x81, 0x85, 0xd0, 0x0d, 0xb0, 0x66, 0x34, 0x8c, 0x66, 0xd5,
-	0x16, 0xdf, 0xe4, 0x16, 0x54, 0x62, 0x97, 0x3d, 0x77, 0xba, 0x7e, 0xfc, 0x1c, 0x69, 0x6d, 0xae,
-	0x61, 0x34, 0x57, 0x76, 0x36, 0x5b, 0x19, 0xf7, 0xf6, 0xc5, 0x4e, 0x7b, 0xe8, 0x33, 0xb4, 0x81,
-	0xeb, 0x4a, 0x01, 0xd9, 0x07, 0xe8, 0x52, 0x74, 0x19, 0x7a, 0x8e, 0xcb, 0x6a, 0xf3, 0x0d, 0xa3,
-	0x59, 0xd9, 0x31, 0x5b, 0xd2, 0xf3, 0x96, 0xf6, 0xbc, 0xf5, 0xa5, 0xf6, 0x7c, 0x6f, 0xe9, 0x97,
-	0x37, 0xdb, 0xff, 0xf9, 0xf9, 0xef, 0x6d, 0xc3, 0x5e, 0x56, 0xe7, 0x76, 0x19, 0xf9, 0x18, 0xd6,
-	0x3d, 0xec, 0xb9, 0xc3, 0x01, 0x73, 0x12, 0xec, 0x07, 0x18, 0x32, 0x27, 0xf1, 0x5f, 0x61, 0x6d,
-	0xa1, 0x61, 0x34, 0xe7, 0x6d, 0xa2, 0xf6, 0xda, 0x72, 0xab, 0xed, 0xbf, 0x42, 0xf2, 0x04, 0x2e,
-	0xea, 0x13, 0x14, 0xbd, 0x61, 0xe8, 0xb9, 0x61, 0xf7, 0xc4, 0x49, 0xba, 0xcf, 0x31, 0xc0, 0x5a,
-	0x49, 0x78, 0xb1, 0xd5, 0x4a, 0x21, 0xb1, 0x47, 0x3a, 0x6d, 0xa1, 0x62, 0x6f, 0xaa, 0xd3, 0xe3,
-	0x1b, 0xc4, 0x83, 0xba, 0x36, 0x9c, 0x46, 0xef, 0xc4, 0x2e, 0x75, 0x03, 0x64, 0x48, 0x93, 0x5a,
-	0x59, 0x18, 0x6f, 0x64, 0xb1, 0xb9, 0x33, 0xfa, 0x3c, 0x1a, 0xe9, 0xd9, 0x5b, 0xca, 0x4c, 0xd1,
-	0xa6, 0xe5, 0xc3, 0x8a, 0x64, 0xe3, 0x81, 0x9f, 0xb0, 0x43, 0x86, 0x41, 0x21, 0x2b, 0x79, 0x6c,
-	0xe7, 0xde, 0x0b, 0x5b, 0xeb, 0x8f, 0x39, 0xb8, 0x20, 0xef, 0xda, 0x17, 0x32, 0x1b, 0xbf, 0x1f,
-	0x62, 0x32, 0xeb, 0x34, 0x98, 0xc6, 0xe0, 0xfc, 0xfb, 0x31, 0xb8, 0xf0, 0x21, 0x19, 0x2c, 0xcd,
-	0x82, 0xc1, 0xcf, 0x60, 0x3d, 0x8f, 0x6a, 0x12, 0x47, 0x61, 0x82, 0xa4, 0x09, 0xe5, 0x8e, 0x90,
-	0x0b, 0x60, 0x2b, 0x3b, 0x6b, 0xad, 0x51, 0xf5, 0x4b, 0x7d, 0x5b, 0xed, 0x5b, 0xd7, 0x61, 0x4d,
-	0x4a, 0xee, 0x21, 0x3b, 0x85, 0x14, 0xeb, 0x53, 0x38, 0x9f, 0xd1, 0x7b, 0xe7, 0x6b, 0xfe, 0xaf,
-	0xe9, 0x3f, 0xc0, 0x01, 0x9e, 0x4a, 0xbf, 0xb5, 0xa1, 0x63, 0xd2, 0xaa, 0xf2, 0x32, 0xcb, 0xd1,
-	0x1e, 0xf0, 0x6c, 0xd5, 0x06, 0x36, 0xa0, 0xdc, 0x1d, 0xd2, 0x24, 0xa2, 0xca, 0x84, 0x5a, 0x91,
-	0x75, 0x28, 0x0d, 0xfc, 0xc0, 0x97, 0xf9, 0x5a, 0xb2, 0xe5, 0x82, 0x5c, 0x82, 0x65, 0xcf, 0xa7,
-	0xd8, 0xe5, 0x28, 0x8a, 0xa4, 0x28, 0xd9, 0xa9, 0xc0, 0x7a, 0x0a, 0x24, 0x7b, 0x81, 0x8a, 0xb1,
-	0x05, 0x25, 0x9f, 0x61, 0x90, 0xd4, 0x8c, 0xc6, 0x7c, 0xb3, 0xb2, 0x53, 0x1b, 0x0f, 0x51, 0xd7,
-	0x8e, 0x2d, 0xd5, 0x78, 0x48, 0x41, 0x44, 0x51, 0x5c, 0xbc, 0x64, 0x8b, 0x6f, 0xeb, 0x29, 0x6c,
-	0x49, 0xe5, 0x36, 0xb2, 0x5d, 0xc6, 0xa8, 0xdf, 0x19, 0xf2, 0x1b, 0x4f, 0x2b, 0x82, 0x6b, 0xb0,
-	0xe2, 0xa6, 0x9a, 0x8e, 0xef, 0x09, 0x83, 0x55, 0xfb, 0x5c, 0x46, 0x7a, 0xe8, 0x59, 0x97, 0xe1,
-	0x52, 0xb1, 0x65, 0x05, 0xda, 0x8f, 0x06, 0x5c, 0xd8, 0xf5, 0x3c, 0x8a, 0x49, 0x82, 0xde, 0x63,
-	0xde, 0x8b, 0x1f, 0x08, 0x24, 0x9a, 0x1a, 0x1f, 0x49, 0x1c, 0x69, 0xa9, 0x3e, 0x9d, 0xaa, 0x68,
-	0xcc, 0xf6, 0x61, 0x3d, 0x61, 0x11, 0x75, 0xfb, 0xe8, 0xf0, 0x46, 0xef, 0xb8, 0xd2, 0x9a, 0x6a,
-	0x04, 0xe7, 0x5b, 0xa2, 0xfb, 0x3f, 0x8a, 0x3c, 0x54, 0xd7, 0xd8, 0x44, 0xa9, 0x67, 0x64, 0xd6,
-	0xeb, 0x39, 0xd8, 0x50, 0x65, 0xf7, 0x84, 0xfa, 0x23, 0xfe, 0x1f, 0x0f, 0x3c, 0xce, 0x60, 0x26,
-	0x87, 0xaa, 0x3a, 0x63, 0x38, 0x28, 0xbc, 0xb2, 0x55, 0xd8, 0xe2, 0x9b, 0xd4, 0x60, 0x51, 0xd5,
-	0xb5, 0x2a, 0x69, 0xbd, 0x24, 0xb7, 0x01, 0xd2, 0xfa, 0x7d, 0x9b, 0xc2, 0xcd, 0xa8, 0x93, 0xdb,
-	0x60, 0x06, 0xee, 0xb1, 0xae, 0x53, 0xf4, 0xf2, 0xcd, 0xa3, 0x24, 0x6e, 0xda, 0x0c, 0xdc, 0xe3,
-	0x3b, 0x5a, 0x21, 0xdb, 0x41, 0x0e, 0x00, 0xf0, 0x38, 0xf6, 0xa9, 0x2b, 0x92, 0xaa, 0xfc, 0x0e,
-	0xed, 0x31, 0x73, 0xce, 0xfa, 0xdd, 0x80, 0xcd, 0x3c, 0x40, 0x92, 0x40, 0x8e, 0xd0, 0x7d, 0x58,
-	0x73, 0x35, 0x85, 0x8e, 0x20, 0x45, 0x27, 0x63, 0x3d, 0x4d, 0xc6, 0x02, 0x92, 0xed, 0xd5, 0xd1,
-	0x31, 0xb1, 0x4e, 0xc8, 0x4d, 0x38, 0x47, 0xa3, 0x88, 0x39, 0xb1, 0x8f, 0x5d, 0x1c, 0xe5, 0xd4,
-	0xde, 0x2a, 0x77, 0xe9, 0xcf, 0x37, 0xdb, 0x8b, 0x47, 0x5c, 0x7e, 0x78, 0x60, 0x57, 0xb8, 0x96,
-	0x5c, 0x78, 0xa2, 0x1d, 0x53, 0xff, 0xa5, 0xcb, 0xd0, 0x79, 0x81, 0x27, 0x02, 0xf8, 0xea, 0xde,
-	0xa6, 0x3a, 0xb2, 0x2a, 0xb4, 0x8e, 0xe4, 0xfe, 0x17, 0x78, 0x62, 0x43, 0x3c, 0xfa, 0xb6, 0x7e,
-	0x4d, 0x83, 0xda, 0x8f, 0x02, 0xee, 0xd1, 0xac, 0x69, 0xff, 0x08, 0x16, 0x15, 0xc7, 0x8a, 0x73,
-	0x92, 0xe1, 0xfc, 0x48, 0x7e, 0xd9, 0x5a, 0x85, 0xdc, 0x86, 0xd5, 0x88, 0xfa, 0x7d, 0x3f, 0x74,
-	0x07, 0x1a, 0xc7, 0x92, 0xc0, 0xb1, 0x28, 0xfd, 0x57, 0xb4, 0xaa, 0xc4, 0xce, 0xba, 0x0f, 0xb5,
-	0xb1, 0x58, 0x52, 0x86, 0x32, 0x6e, 0x18, 0x67, 0xba, 0x61, 0xb9, 0x70, 0x51, 0x59, 0x3a, 0x88,
-	0x7e, 0x08, 0x07, 0x91, 0xeb, 0xcd, 0x1a, 0x17, 0xeb, 0x37, 0x03, 0xcc, 0x89, 0x3b, 0x3e, 0x44,
-	0x46, 0x65, 0x22, 0x9f, 0x3b, 0x9b, 0x80, 0xf7, 0x4f, 0xa5, 0x6f, 0xe1, 0xbf, 0x2a, 0x9e, 0xc3,
-	0xb0, 0x17, 0xcd, 0x1c, 0xaf, 0xbb, 0xa3, 0xf6, 0x24, 0xcd, 0x17, 0x52, 0x7b, 0x76, 0x80, 0x96,
-	0x33, 0x4a, 0xf8, 0xdc, 0x3b, 0x37, 0x3b, 0x47, 0x5f, 0x1b, 0xa3, 0x34, 0xcc, 0x3f, 0x8f, 0xb3,
-	0xa5, 0x75, 0x8c, 0xa8, 0xb9, 0xb7, 0x27, 0xea, 0x2f, 0x03, 0x36, 0xf8, 0x93, 0xa8, 0x9c, 0x4c,
-	0xde, 0x02, 0x81, 0x0d, 0x28, 0xc7, 0x14, 0x7b, 0xfe, 0xb1, 0xc2, 0x40, 0xad, 0xc8, 0x36, 0x54,
-	0x12, 0xe6, 0x52, 0xe6, 0xb8, 0x3d, 0x0e, 0xbf, 0xc8, 0x16, 0x1b, 0x84, 0x68, 0x97, 0x4b, 0x48,
-	0x1d, 0x00, 0x43, 0xcf, 0xe9, 0x60, 0x8f, 0x3f, 0xb8, 0x0b, 0x62, 0x7f, 0x19, 0x43, 0x6f, 0x4f,
-	0x08, 0xf8, 0x6b, 0x4f, 0x91, 0xcf, 0x03, 0xfe, 0x4b, 0xd9, 0xc5, 0x97, 0xec, 0x54, 0x90, 0x4e,
-	0x08, 0xe5, 0xec, 0x84, 0x50, 0x07, 0xe0, 0x48, 0x39, 0xbd, 0x81, 0xdb, 0x4f, 0x6a, 0x8b, 0x0d,
-	0xa3, 0xb9, 0x68, 0x2f, 0x73, 0xc9, 0x5d, 0x2e, 0x10, 0x6d, 0x3a, 0x1f, 0x5d, 0x8a, 0xfe, 0x27,
-	0xf9, 0x41, 0xe1, 0x7a, 0x0a, 0xf9, 0x94, 0x13, 0xad, 0x33, 0xc6, 0x06, 0x13, 0x61, 0x41, 0x4f,
-	0xe5, 0x22, 0x45, 0x8c, 0x4c, 0x8a, 0xbc, 0x5b, 0xe1, 0x6d, 0xc1, 0xb2, 0x9f, 0x38, 0x0a, 0xe5,
-	0x79, 0x71, 0xc5, 0x92, 0x9f, 0x1c, 0x89, 0xb5, 0xf5, 0x8c, 0xa7, 0x54, 0xc1, 0x5c, 0xc2, 0x83,
-	0xda, 0x86, 0x8a, 0x64, 0xc9, 0xc9, 0x4c, 0x28, 0x20, 0x45, 0x8f, 0xf8, 0x9c, 0x52, 0x07, 0x88,
-	0x5d, 0xca, 0x42, 0xa4, 0xe9, 0x8c, 0xb2, 0xac, 0x24, 0x87, 0x9e, 0xb5, 0xc5, 0x7b, 0x5d, 0xd1,
-	0x64, 0xf2, 0x78, 0xe0, 0x59, 0xeb, 0x40, 0x8e, 0x68, 0xf4, 0x1d, 0x76, 0xb3, 0x45, 0x6d, 0xdd,
-	0x82, 0x0b, 0x39, 0xa9, 0x9a, 0xc3, 0xae, 0x40, 0x35, 0x96, 0x62, 0x27, 0x71, 0x07, 0x3a, 0x87,
-	0x2a, 0x4a, 0xd6, 0x76, 0x07, 0x6c, 0xe7, 0x9f, 0x25, 0x58, 0x7a, 0xa8, 0x40, 0x27, 0x0f, 0xa1,
-	0x2a, 0x87, 0x62, 0xf5, 0xc3, 0x59, 0x1f, 0x1f, 0xdc, 0x72, 0x3f, 0x22, 0xe6, 0xe5, 0x69, 0xdb,
-	0xea, 0xfa, 0x03, 0x58, 0xbe, 0x87, 0x4c, 0xd9, 0x32, 0xc7, 0x95, 0xd3, 0xe1, 0xd9, 0xdc, 0x2a,
-	0xdc, 0x53, 0x56, 0x1e, 0x42, 0x55, 0x96, 0xed, 0x34, 0xa7, 0x72, 0x6d, 0x63, 0xd2, 0xa9, 0x7c,
-	0xcd, 0x93, 0xfb, 0x50, 0xe1, 0xb9, 0x25, 0xf7, 0x12, 0xb2, 0x55, 0x34, 0x9b, 0x6a, 0x5b, 0x97,
-	0x8a, 0x37, 0x95, 0x25, 0x84, 0xf5, 0xb6, 0x0e, 0x2f, 0xc3, 0x16, 0xb9, 0x36, 0x7e, 0xaa, 0x30,
-	0x53, 0xcc, 0xeb, 0x67, 0xa9, 0xa9, 0x6b, 0x9e, 0xc0, 0x9a, 0xc4, 0x55, 0x95, 0x03, 0x4f, 0xb1,
-	0x46, 0x7a, 0xb6, 0x78, 0x44, 0x34, 0xaf, 0x4c, 0xd3, 0x48, 0x8b, 0xef, 0x6b, 0x58, 0x93, 0xcf,
-	0x72, 0xc6, 0xf0, 0xe4, 0xb1, 0xf1, 0x29, 0xc4, 0xb4, 0xa6, 0xaa, 0xa4, 0xa6, 0xdb, 0xb0, 0x92,
-	0x79, 0x1b, 0x44, 0x51, 0x4c, 0x9c, 0xca, 0x3f, 0x4a, 0x66, 0x63, 0x8a, 0x42, 0x6a, 0xd4, 0x01,
-	0xa2, 0x1f, 0xe6, 0x8c, 0xc7, 0x57, 0x27, 0xce, 0x4d, 0x4e, 0x08, 0xe6, 0xff, 0x4e, 0x51, 0xca,
-	0x01, 0x22, 0x93, 0xe5, 0x54, 0x40, 0xc6, 0x5f, 0xa9, 0x02, 0x40, 0x26, 0x9f, 0x99, 0xaf, 0x60,
-	0x35, 0xdb, 0xd1, 0xc6, 0x38, 0x2c, 0x6e, 0xfe, 0x59, 0x0e, 0xa7, 0x35, 0xd0, 0x6f, 0xe0, 0x7c,
-	0x3e, 0x6d, 0xb8, 0x30, 0xe7, 0x50, 0x71, 0x93, 0x32, 0xaf, 0x4e, 0xd7, 0x49, 0xad, 0x7f, 0x0e,
-	0x95, 0x4c, 0x5b, 0x21, 0x99, 0x72, 0x98, 0xec, 0x41, 0x66, 0x7d, 0xca, 0xae, 0x34, 0xb7, 0xb7,
-	0xf0, 0x6c, 0x2e, 0xee, 0x74, 0xca, 0x62, 0xba, 0xbf, 0xf9, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xaf, 0x93, 0xe9, 0x5f, 0x5a, 0x13, 0x00, 0x00,
+func (m *ObjectSegmentMetadata) Reset()         { *m = ObjectSegmentMetadata{} }
+func (m *ObjectSegmentMetadata) String() string { return proto.CompactTextString(m) }
+func (*ObjectSegmentMetadata) ProtoMessage()    {}
+func (*ObjectSegmentMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{37}
+}
+func (m *ObjectSegmentMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSegmentMetadata.Unmarshal(m, b)
+}
+func (m *ObjectSegmentMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSegmentMetadata.Marshal(b, m, deterministic)
+}
+func (m *ObjectSegmentMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSegmentMetadata.Merge(m, src)
+}
+func (m *ObjectSegmentMetadata) XXX_Size() int {
+	return xxx_messageInfo_ObjectSegmentMetadata.Size(m)
+}
+func (m *ObjectSegmentMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSegmentMetadata.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
+var xxx_messageInfo_ObjectSegmentMetadata proto.InternalMessageInfo
+
+func (m *ObjectSegmentMetadata) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *ObjectSegmentMetadata) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+type ObjectCopyRequest struct {
+	Bucket           []byte                   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath    []byte                   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	NewBucket        []byte                   `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedPath []byte                   `protobuf:"bytes,4,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	Segments         []*ObjectSegmentMetadata `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	// replace allows replacing an existing object at the new path.
+	Replace              bool     `protobuf:"varint,6,opt,name=replace,proto3" json:"replace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectCopyRequest) Reset()         { *m = ObjectCopyRequest{} }
+func (m *ObjectCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectCopyRequest) ProtoMessage()    {}
+func (*ObjectCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{38}
+}
+func (m *ObjectCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCopyRequest.Merge(m, src)
+}
+func (m *ObjectCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectCopyRequest.Size(m)
+}
+func (m *ObjectCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectCopyRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetSegments() []*ObjectSegmentMetadata {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ObjectCopyRequest) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
+type ObjectCopyResponse struct {
+	// deleted_segments contains the order limits for deleting the pieces of
+	// the replaced object.
+	DeletedSegments      []*SegmentDeleteResponseOld `protobuf:"bytes,1,rep,name=deleted_segments,json=deletedSegments,proto3" json:"deleted_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ObjectCopyResponse) Reset()         { *m = ObjectCopyResponse{} }
+func (m *ObjectCopyResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectCopyResponse) ProtoMessage()    {}
+func (*ObjectCopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{39}
+}
+func (m *ObjectCopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCopyResponse.Unmarshal(m, b)
+}
+func (m *ObjectCopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCopyResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectCopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCopyResponse.Merge(m, src)
+}
+func (m *ObjectCopyResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectCopyResponse.Size(m)
+}
+func (m *ObjectCopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCopyResponse proto.InternalMessageInfo
+
+func (m *ObjectCopyResponse) GetDeletedSegments() []*SegmentDeleteResponseOld {
+	if m != nil {
+		return m.DeletedSegments
+	}
+	return nil
+}
+
+type ObjectMoveRequest struct {
+	Bucket               []byte                   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte                   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	NewBucket            []byte                   `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedPath     []byte                   `protobuf:"bytes,4,opt,name=new_encrypted_path,json=newEncryptedPath,proto3" json:"new_encrypted_path,omitempty"`
+	Segments             []*ObjectSegmentMetadata `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ObjectMoveRequest) Reset()         { *m = ObjectMoveRequest{} }
+func (m *ObjectMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectMoveRequest) ProtoMessage()    {}
+func (*ObjectMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{40}
+}
+func (m *ObjectMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectMoveRequest.Merge(m, src)
+}
+func (m *ObjectMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectMoveRequest.Size(m)
+}
+func (m *ObjectMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetNewEncryptedPath() []byte {
+	if m != nil {
+		return m.NewEncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectMoveRequest) GetSegments() []*ObjectSegmentMetadata {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type ObjectMoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectMoveResponse) Reset()         { *m = ObjectMoveResponse{} }
+func (m *ObjectMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectMoveResponse) ProtoMessage()    {}
+func (*ObjectMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{41}
+}
+func (m *ObjectMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectMoveResponse.Merge(m, src)
+}
+func (m *ObjectMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectMoveResponse.Size(m)
+}
+func (m *ObjectMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectMoveResponse proto.InternalMessageInfo
+
+type ObjectListVersionsRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectListVersionsRequest) Reset()         { *m = ObjectListVersionsRequest{} }
+func (m *ObjectListVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsRequest) ProtoMessage()    {}
+func (*ObjectListVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{42}
+}
+func (m *ObjectListVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsRequest.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsRequest.Merge(m, src)
+}
+func (m *ObjectListVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsRequest.Size(m)
+}
+func (m *ObjectListVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsRequest proto.InternalMessageInfo
+
+func (m *ObjectListVersionsRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+type ObjectListVersionsResponse struct {
+	Items                []*ObjectListVersionsResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ObjectListVersionsResponse) Reset()         { *m = ObjectListVersionsResponse{} }
+func (m *ObjectListVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsResponse) ProtoMessage()    {}
+func (*ObjectListVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{43}
+}
+func (m *ObjectListVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsResponse.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsResponse.Merge(m, src)
+}
+func (m *ObjectListVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsResponse.Size(m)
+}
+func (m *ObjectListVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsResponse proto.InternalMessageInfo
+
+func (m *ObjectListVersionsResponse) GetItems() []*ObjectListVersionsResponse_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ObjectListVersionsResponse_Item struct {
+	VersionId            string   `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	IsLatest             bool     `protobuf:"varint,2,opt,name=is_latest,json=isLatest,proto3" json:"is_latest,omitempty"`
+	DeleteMarker         bool     `protobuf:"varint,3,opt,name=delete_marker,json=deleteMarker,proto3" json:"delete_marker,omitempty"`
+	Pointer              *Pointer `protobuf:"bytes,4,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectListVersionsResponse_Item) Reset()         { *m = ObjectListVersionsResponse_Item{} }
+func (m *ObjectListVersionsResponse_Item) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsResponse_Item) ProtoMessage()    {}
+func (*ObjectListVersionsResponse_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{43, 0}
+}
+func (m *ObjectListVersionsResponse_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsResponse_Item.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsResponse_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsResponse_Item.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsResponse_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsResponse_Item.Merge(m, src)
+}
+func (m *ObjectListVersionsResponse_Item) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsResponse_Item.Size(m)
+}
+func (m *ObjectListVersionsResponse_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsResponse_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsResponse_Item proto.InternalMessageInfo
+
+func (m *ObjectListVersionsResponse_Item) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *ObjectListVersionsResponse_Item) GetIsLatest() bool {
+	if m != nil {
+		return m.IsLatest
+	}
+	return false
+}
+
+func (m *ObjectListVersionsResponse_Item) GetDeleteMarker() bool {
+	if m != nil {
+		return m.DeleteMarker
+	}
+	return false
+}
+
+func (m *ObjectListVersionsResponse_Item) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+type ObjectDeleteVersionRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	VersionId            string   `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectDeleteVersionRequest) Reset()         { *m = ObjectDeleteVersionRequest{} }
+func (m *ObjectDeleteVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteVersionRequest) ProtoMessage()    {}
+func (*ObjectDeleteVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{44}
+}
+func (m *ObjectDeleteVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Unmarshal(m, b)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteVersionRequest.Merge(m, src)
+}
+func (m *ObjectDeleteVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteVersionRequest.Size(m)
+}
+func (m *ObjectDeleteVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectDeleteVersionRequest proto.InternalMessageInfo
+
+func (m *ObjectDeleteVersionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectDeleteVersionRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectDeleteVersionRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+type ObjectDeleteVersionResponse struct {
+	// deleted_segments contains the order limits for deleting the pieces of
+	// the deleted version.
+	DeletedSegments      []*SegmentDeleteResponseOld `protobuf:"bytes,1,rep,name=deleted_segments,json=deletedSegments,proto3" json:"deleted_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ObjectDeleteVersionResponse) Reset()         { *m = ObjectDeleteVersionResponse{} }
+func (m *ObjectDeleteVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectDeleteVersionResponse) ProtoMessage()    {}
+func (*ObjectDeleteVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{45}
+}
+func (m *ObjectDeleteVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Unmarshal(m, b)
+}
+func (m *ObjectDeleteVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectDeleteVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDeleteVersionResponse.Merge(m, src)
+}
+func (m *ObjectDeleteVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectDeleteVersionResponse.Size(m)
+}
+func (m *ObjectDeleteVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDeleteVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectDeleteVersionResponse proto.InternalMessageInfo
+
+func (m *ObjectDeleteVersionResponse) GetDeletedSegments() []*SegmentDeleteResponseOld {
+	if m != nil {
+		return m.DeletedSegments
+	}
+	return nil
+}
+
+type ObjectCreateDeleteMarkerRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectCreateDeleteMarkerRequest) Reset()         { *m = ObjectCreateDeleteMarkerRequest{} }
+func (m *ObjectCreateDeleteMarkerRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectCreateDeleteMarkerRequest) ProtoMessage()    {}
+func (*ObjectCreateDeleteMarkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{46}
+}
+func (m *ObjectCreateDeleteMarkerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCreateDeleteMarkerRequest.Unmarshal(m, b)
+}
+func (m *ObjectCreateDeleteMarkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCreateDeleteMarkerRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectCreateDeleteMarkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCreateDeleteMarkerRequest.Merge(m, src)
+}
+func (m *ObjectCreateDeleteMarkerRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectCreateDeleteMarkerRequest.Size(m)
+}
+func (m *ObjectCreateDeleteMarkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCreateDeleteMarkerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCreateDeleteMarkerRequest proto.InternalMessageInfo
+
+func (m *ObjectCreateDeleteMarkerRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectCreateDeleteMarkerRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+type ObjectCreateDeleteMarkerResponse struct {
+	VersionId            string   `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectCreateDeleteMarkerResponse) Reset()         { *m = ObjectCreateDeleteMarkerResponse{} }
+func (m *ObjectCreateDeleteMarkerResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectCreateDeleteMarkerResponse) ProtoMessage()    {}
+func (*ObjectCreateDeleteMarkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{47}
+}
+func (m *ObjectCreateDeleteMarkerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCreateDeleteMarkerResponse.Unmarshal(m, b)
+}
+func (m *ObjectCreateDeleteMarkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCreateDeleteMarkerResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectCreateDeleteMarkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCreateDeleteMarkerResponse.Merge(m, src)
+}
+func (m *ObjectCreateDeleteMarkerResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectCreateDeleteMarkerResponse.Size(m)
+}
+func (m *ObjectCreateDeleteMarkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCreateDeleteMarkerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCreateDeleteMarkerResponse proto.InternalMessageInfo
+
+func (m *ObjectCreateDeleteMarkerResponse) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+// Object is a committed object. Its encrypted metadata is the metadata of
+// its last segment.
+type Object struct {
+	Bucket               []byte    `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte    `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	VersionId            string    `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	EncryptedMetadata    []byte    `protobuf:"bytes,4,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	CreatedAt            time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	ExpiresAt            time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Object) Reset()         { *m = Object{} }
+func (m *Object) String() string { return proto.CompactTextString(m) }
+func (*Object) ProtoMessage()    {}
+func (*Object) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{48}
+}
+func (m *Object) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Object.Unmarshal(m, b)
+}
+func (m *Object) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Object.Marshal(b, m, deterministic)
+}
+func (m *Object) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Object.Merge(m, src)
+}
+func (m *Object) XXX_Size() int {
+	return xxx_messageInfo_Object.Size(m)
+}
+func (m *Object) XXX_DiscardUnknown() {
+	xxx_messageInfo_Object.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Object proto.InternalMessageInfo
+
+func (m *Object) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *Object) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *Object) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *Object) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+func (m *Object) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Object) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+type ObjectBeginRequest struct {
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte            `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	RedundancyScheme     *RedundancyScheme `protobuf:"bytes,3,opt,name=redundancy_scheme,json=redundancyScheme,proto3" json:"redundancy_scheme,omitempty"`
+	ExpiresAt            time.Time         `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectBeginRequest) Reset()         { *m = ObjectBeginRequest{} }
+func (m *ObjectBeginRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginRequest) ProtoMessage()    {}
+func (*ObjectBeginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{49}
+}
+func (m *ObjectBeginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginRequest.Merge(m, src)
+}
+func (m *ObjectBeginRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginRequest.Size(m)
+}
+func (m *ObjectBeginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectBeginRequest) GetRedundancyScheme() *RedundancyScheme {
+	if m != nil {
+		return m.RedundancyScheme
+	}
+	return nil
+}
+
+func (m *ObjectBeginRequest) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+type ObjectBeginResponse struct {
+	StreamId             StreamID `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectBeginResponse) Reset()         { *m = ObjectBeginResponse{} }
+func (m *ObjectBeginResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginResponse) ProtoMessage()    {}
+func (*ObjectBeginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{50}
+}
+func (m *ObjectBeginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginResponse.Merge(m, src)
+}
+func (m *ObjectBeginResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginResponse.Size(m)
+}
+func (m *ObjectBeginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginResponse proto.InternalMessageInfo
+
+type ObjectCommitRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	StreamId             StreamID `protobuf:"bytes,3,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id"`
+	SegmentCount         int64    `protobuf:"varint,4,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"`
+	EncryptedMetadata    []byte   `protobuf:"bytes,5,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectCommitRequest) Reset()         { *m = ObjectCommitRequest{} }
+func (m *ObjectCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectCommitRequest) ProtoMessage()    {}
+func (*ObjectCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{51}
+}
+func (m *ObjectCommitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCommitRequest.Unmarshal(m, b)
+}
+func (m *ObjectCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCommitRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCommitRequest.Merge(m, src)
+}
+func (m *ObjectCommitRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectCommitRequest.Size(m)
+}
+func (m *ObjectCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCommitRequest proto.InternalMessageInfo
+
+func (m *ObjectCommitRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectCommitRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectCommitRequest) GetSegmentCount() int64 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *ObjectCommitRequest) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+type ObjectCommitResponse struct {
+	Object *Object `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// deleted_segments contains the order limits for deleting the pieces of
+	// the replaced version of the object.
+	DeletedSegments      []*SegmentDeleteResponseOld `protobuf:"bytes,2,rep,name=deleted_segments,json=deletedSegments,proto3" json:"deleted_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ObjectCommitResponse) Reset()         { *m = ObjectCommitResponse{} }
+func (m *ObjectCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectCommitResponse) ProtoMessage()    {}
+func (*ObjectCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{52}
+}
+func (m *ObjectCommitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectCommitResponse.Unmarshal(m, b)
+}
+func (m *ObjectCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectCommitResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectCommitResponse.Merge(m, src)
+}
+func (m *ObjectCommitResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectCommitResponse.Size(m)
+}
+func (m *ObjectCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectCommitResponse proto.InternalMessageInfo
+
+func (m *ObjectCommitResponse) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *ObjectCommitResponse) GetDeletedSegments() []*SegmentDeleteResponseOld {
+	if m != nil {
+		return m.DeletedSegments
+	}
+	return nil
+}
+
+type ObjectGetRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	VersionId            string   `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectGetRequest) Reset()         { *m = ObjectGetRequest{} }
+func (m *ObjectGetRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetRequest) ProtoMessage()    {}
+func (*ObjectGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{53}
+}
+func (m *ObjectGetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetRequest.Unmarshal(m, b)
+}
+func (m *ObjectGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetRequest.Merge(m, src)
+}
+func (m *ObjectGetRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetRequest.Size(m)
+}
+func (m *ObjectGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetRequest proto.InternalMessageInfo
+
+func (m *ObjectGetRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectGetRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectGetRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+type ObjectGetResponse struct {
+	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectGetResponse) Reset()         { *m = ObjectGetResponse{} }
+func (m *ObjectGetResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetResponse) ProtoMessage()    {}
+func (*ObjectGetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{54}
+}
+func (m *ObjectGetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetResponse.Unmarshal(m, b)
+}
+func (m *ObjectGetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetResponse.Merge(m, src)
+}
+func (m *ObjectGetResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetResponse.Size(m)
+}
+func (m *ObjectGetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetResponse proto.InternalMessageInfo
+
+func (m *ObjectGetResponse) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type ObjectListRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPrefix      []byte   `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	EncryptedStartAfter  []byte   `protobuf:"bytes,3,opt,name=encrypted_start_after,json=encryptedStartAfter,proto3" json:"encrypted_start_after,omitempty"`
+	EncryptedEndBefore   []byte   `protobuf:"bytes,4,opt,name=encrypted_end_before,json=encryptedEndBefore,proto3" json:"encrypted_end_before,omitempty"`
+	Recursive            bool     `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	MetaFlags            uint32   `protobuf:"fixed32,7,opt,name=meta_flags,json=metaFlags,proto3" json:"meta_flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectListRequest) Reset()         { *m = ObjectListRequest{} }
+func (m *ObjectListRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectListRequest) ProtoMessage()    {}
+func (*ObjectListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{55}
+}
+func (m *ObjectListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListRequest.Unmarshal(m, b)
+}
+func (m *ObjectListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListRequest.Merge(m, src)
+}
+func (m *ObjectListRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectListRequest.Size(m)
+}
+func (m *ObjectListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListRequest proto.InternalMessageInfo
+
+func (m *ObjectListRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectListRequest) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *ObjectListRequest) GetEncryptedStartAfter() []byte {
+	if m != nil {
+		return m.EncryptedStartAfter
+	}
+	return nil
+}
+
+func (m *ObjectListRequest) GetEncryptedEndBefore() []byte {
+	if m != nil {
+		return m.EncryptedEndBefore
+	}
+	return nil
+}
+
+func (m *ObjectListRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+func (m *ObjectListRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ObjectListRequest) GetMetaFlags() uint32 {
+	if m != nil {
+		return m.MetaFlags
+	}
+	return 0
+}
+
+type ObjectListResponse struct {
+	Items                []*ObjectListResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More                 bool                       `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ObjectListResponse) Reset()         { *m = ObjectListResponse{} }
+func (m *ObjectListResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectListResponse) ProtoMessage()    {}
+func (*ObjectListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{56}
+}
+func (m *ObjectListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListResponse.Unmarshal(m, b)
+}
+func (m *ObjectListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListResponse.Merge(m, src)
+}
+func (m *ObjectListResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectListResponse.Size(m)
+}
+func (m *ObjectListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListResponse proto.InternalMessageInfo
+
+func (m *ObjectListResponse) GetItems() []*ObjectListResponse_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ObjectListResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ObjectListResponse_Item struct {
+	EncryptedPath        []byte    `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	IsPrefix             bool      `protobuf:"varint,2,opt,name=is_prefix,json=isPrefix,proto3" json:"is_prefix,omitempty"`
+	EncryptedMetadata    []byte    `protobuf:"bytes,3,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	CreatedAt            time.Time `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	ExpiresAt            time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ObjectListResponse_Item) Reset()         { *m = ObjectListResponse_Item{} }
+func (m *ObjectListResponse_Item) String() string { return proto.CompactTextString(m) }
+func (*ObjectListResponse_Item) ProtoMessage()    {}
+func (*ObjectListResponse_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{56, 0}
+}
+func (m *ObjectListResponse_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListResponse_Item.Unmarshal(m, b)
+}
+func (m *ObjectListResponse_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListResponse_Item.Marshal(b, m, deterministic)
+}
+func (m *ObjectListResponse_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListResponse_Item.Merge(m, src)
+}
+func (m *ObjectListResponse_Item) XXX_Size() int {
+	return xxx_messageInfo_ObjectListResponse_Item.Size(m)
+}
+func (m *ObjectListResponse_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListResponse_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListResponse_Item proto.InternalMessageInfo
+
+func (m *ObjectListResponse_Item) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectListResponse_Item) GetIsPrefix() bool {
+	if m != nil {
+		return m.IsPrefix
+	}
+	return false
+}
+
+func (m *ObjectListResponse_Item) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+func (m *ObjectListResponse_Item) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ObjectListResponse_Item) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+type SegmentBeginRequest struct {
+	Bucket                  []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath           []byte   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	StreamId                StreamID `protobuf:"bytes,3,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id"`
+	SegmentIndex            int64    `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	MaxEncryptedSegmentSize int64    `protobuf:"varint,5,opt,name=max_encrypted_segment_size,json=maxEncryptedSegmentSize,proto3" json:"max_encrypted_segment_size,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *SegmentBeginRequest) Reset()         { *m = SegmentBeginRequest{} }
+func (m *SegmentBeginRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentBeginRequest) ProtoMessage()    {}
+func (*SegmentBeginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{57}
+}
+func (m *SegmentBeginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentBeginRequest.Unmarshal(m, b)
+}
+func (m *SegmentBeginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentBeginRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentBeginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentBeginRequest.Merge(m, src)
+}
+func (m *SegmentBeginRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentBeginRequest.Size(m)
+}
+func (m *SegmentBeginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentBeginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentBeginRequest proto.InternalMessageInfo
+
+func (m *SegmentBeginRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentBeginRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *SegmentBeginRequest) GetSegmentIndex() int64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *SegmentBeginRequest) GetMaxEncryptedSegmentSize() int64 {
+	if m != nil {
+		return m.MaxEncryptedSegmentSize
+	}
+	return 0
+}
+
+type SegmentBeginResponse struct {
+	AddressedLimits      []*AddressedOrderLimit `protobuf:"bytes,1,rep,name=addressed_limits,json=addressedLimits,proto3" json:"addressed_limits,omitempty"`
+	RootPieceId          PieceID                `protobuf:"bytes,2,opt,name=root_piece_id,json=rootPieceId,proto3,customtype=PieceID" json:"root_piece_id"`
+	PrivateKey           PiecePrivateKey        `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3,customtype=PiecePrivateKey" json:"private_key"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SegmentBeginResponse) Reset()         { *m = SegmentBeginResponse{} }
+func (m *SegmentBeginResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentBeginResponse) ProtoMessage()    {}
+func (*SegmentBeginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{58}
+}
+func (m *SegmentBeginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentBeginResponse.Unmarshal(m, b)
+}
+func (m *SegmentBeginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentBeginResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentBeginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentBeginResponse.Merge(m, src)
+}
+func (m *SegmentBeginResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentBeginResponse.Size(m)
+}
+func (m *SegmentBeginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentBeginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentBeginResponse proto.InternalMessageInfo
+
+func (m *SegmentBeginResponse) GetAddressedLimits() []*AddressedOrderLimit {
+	if m != nil {
+		return m.AddressedLimits
+	}
+	return nil
+}
+
+type SegmentCommitRequest struct {
+	Bucket               []byte        `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte        `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	StreamId             StreamID      `protobuf:"bytes,3,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id"`
+	SegmentIndex         int64         `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	Pointer              *Pointer      `protobuf:"bytes,5,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	OriginalLimits       []*OrderLimit `protobuf:"bytes,6,rep,name=original_limits,json=originalLimits,proto3" json:"original_limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SegmentCommitRequest) Reset()         { *m = SegmentCommitRequest{} }
+func (m *SegmentCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentCommitRequest) ProtoMessage()    {}
+func (*SegmentCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{59}
+}
+func (m *SegmentCommitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentCommitRequest.Unmarshal(m, b)
+}
+func (m *SegmentCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentCommitRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentCommitRequest.Merge(m, src)
+}
+func (m *SegmentCommitRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentCommitRequest.Size(m)
+}
+func (m *SegmentCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentCommitRequest proto.InternalMessageInfo
+
+func (m *SegmentCommitRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentCommitRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *SegmentCommitRequest) GetSegmentIndex() int64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *SegmentCommitRequest) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+func (m *SegmentCommitRequest) GetOriginalLimits() []*OrderLimit {
+	if m != nil {
+		return m.OriginalLimits
+	}
+	return nil
+}
+
+type SegmentCommitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentCommitResponse) Reset()         { *m = SegmentCommitResponse{} }
+func (m *SegmentCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentCommitResponse) ProtoMessage()    {}
+func (*SegmentCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{60}
+}
+func (m *SegmentCommitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentCommitResponse.Unmarshal(m, b)
+}
+func (m *SegmentCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentCommitResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentCommitResponse.Merge(m, src)
+}
+func (m *SegmentCommitResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentCommitResponse.Size(m)
+}
+func (m *SegmentCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentCommitResponse proto.InternalMessageInfo
+
+// BatchRequest contains requests that are handled one after another in a single call.
+// A segment or object commit without a stream id refers to the object begun
+// last in the same batch.
+type BatchRequest struct {
+	Requests             []*BatchRequestItem `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
+func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()    {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{61}
+}
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRequest.Unmarshal(m, b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(m, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchRequest.Size(m)
+}
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+
+func (m *BatchRequest) GetRequests() []*BatchRequestItem {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type BatchRequestItem struct {
+	// Types that are valid to be assigned to Request:
+	//	*BatchRequestItem_ObjectBegin
+	//	*BatchRequestItem_ObjectCommit
+	//	*BatchRequestItem_ObjectGet
+	//	*BatchRequestItem_ObjectList
+	//	*BatchRequestItem_SegmentBegin
+	//	*BatchRequestItem_SegmentCommit
+	//	*BatchRequestItem_SegmentDelete
+	Request              isBatchRequestItem_Request `protobuf_oneof:"Request"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *BatchRequestItem) Reset()         { *m = BatchRequestItem{} }
+func (m *BatchRequestItem) String() string { return proto.CompactTextString(m) }
+func (*BatchRequestItem) ProtoMessage()    {}
+func (*BatchRequestItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{62}
+}
+func (m *BatchRequestItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRequestItem.Unmarshal(m, b)
+}
+func (m *BatchRequestItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRequestItem.Marshal(b, m, deterministic)
+}
+func (m *BatchRequestItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequestItem.Merge(m, src)
+}
+func (m *BatchRequestItem) XXX_Size() int {
+	return xxx_messageInfo_BatchRequestItem.Size(m)
+}
+func (m *BatchRequestItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequestItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequestItem proto.InternalMessageInfo
+
+type isBatchRequestItem_Request interface {
+	isBatchRequestItem_Request()
+}
+
+type BatchRequestItem_ObjectBegin struct {
+	ObjectBegin *ObjectBeginRequest `protobuf:"bytes,1,opt,name=object_begin,json=objectBegin,proto3,oneof"`
+}
+type BatchRequestItem_ObjectCommit struct {
+	ObjectCommit *ObjectCommitRequest `protobuf:"bytes,2,opt,name=object_commit,json=objectCommit,proto3,oneof"`
+}
+type BatchRequestItem_ObjectGet struct {
+	ObjectGet *ObjectGetRequest `protobuf:"bytes,3,opt,name=object_get,json=objectGet,proto3,oneof"`
+}
+type BatchRequestItem_ObjectList struct {
+	ObjectList *ObjectListRequest `protobuf:"bytes,4,opt,name=object_list,json=objectList,proto3,oneof"`
+}
+type BatchRequestItem_SegmentBegin struct {
+	SegmentBegin *SegmentBeginRequest `protobuf:"bytes,5,opt,name=segment_begin,json=segmentBegin,proto3,oneof"`
+}
+type BatchRequestItem_SegmentCommit struct {
+	SegmentCommit *SegmentCommitRequest `protobuf:"bytes,6,opt,name=segment_commit,json=segmentCommit,proto3,oneof"`
+}
+type BatchRequestItem_SegmentDelete struct {
+	SegmentDelete *SegmentDeleteRequestOld `protobuf:"bytes,7,opt,name=segment_delete,json=segmentDelete,proto3,oneof"`
+}
+
+func (*BatchRequestItem_ObjectBegin) isBatchRequestItem_Request()   {}
+func (*BatchRequestItem_ObjectCommit) isBatchRequestItem_Request()  {}
+func (*BatchRequestItem_ObjectGet) isBatchRequestItem_Request()     {}
+func (*BatchRequestItem_ObjectList) isBatchRequestItem_Request()    {}
+func (*BatchRequestItem_SegmentBegin) isBatchRequestItem_Request()  {}
+func (*BatchRequestItem_SegmentCommit) isBatchRequestItem_Request() {}
+func (*BatchRequestItem_SegmentDelete) isBatchRequestItem_Request() {}
+
+func (m *BatchRequestItem) GetRequest() isBatchRequestItem_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetObjectBegin() *ObjectBeginRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_ObjectBegin); ok {
+		return x.ObjectBegin
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetObjectCommit() *ObjectCommitRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_ObjectCommit); ok {
+		return x.ObjectCommit
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetObjectGet() *ObjectGetRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_ObjectGet); ok {
+		return x.ObjectGet
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetObjectList() *ObjectListRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_ObjectList); ok {
+		return x.ObjectList
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetSegmentBegin() *SegmentBeginRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_SegmentBegin); ok {
+		return x.SegmentBegin
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetSegmentCommit() *SegmentCommitRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_SegmentCommit); ok {
+		return x.SegmentCommit
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetSegmentDelete() *SegmentDeleteRequestOld {
+	if x, ok := m.GetRequest().(*BatchRequestItem_SegmentDelete); ok {
+		return x.SegmentDelete
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*BatchRequestItem) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _BatchRequestItem_OneofMarshaler, _BatchRequestItem_OneofUnmarshaler, _BatchRequestItem_OneofSizer, []interface{}{
+		(*BatchRequestItem_ObjectBegin)(nil),
+		(*BatchRequestItem_ObjectCommit)(nil),
+		(*BatchRequestItem_ObjectGet)(nil),
+		(*BatchRequestItem_ObjectList)(nil),
+		(*BatchRequestItem_SegmentBegin)(nil),
+		(*BatchRequestItem_SegmentCommit)(nil),
+		(*BatchRequestItem_SegmentDelete)(nil),
+	}
+}
+
+func _BatchRequestItem_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*BatchRequestItem)
+	// Request
+	switch x := m.Request.(type) {
+	case *BatchRequestItem_ObjectBegin:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectBegin); err != nil {
+			return err
+		}
+	case *BatchRequestItem_ObjectCommit:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectCommit); err != nil {
+			return err
+		}
+	case *BatchRequestItem_ObjectGet:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectGet); err != nil {
+			return err
+		}
+	case *BatchRequestItem_ObjectList:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectList); err != nil {
+			return err
+		}
+	case *BatchRequestItem_SegmentBegin:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SegmentBegin); err != nil {
+			return err
+		}
+	case *BatchRequestItem_SegmentCommit:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SegmentCommit); err != nil {
+			return err
+		}
+	case *BatchRequestItem_SegmentDelete:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SegmentDelete); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("BatchRequestItem.Request has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _BatchRequestItem_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*BatchRequestItem)
+	switch tag {
+	case 1: // Request.object_begin
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectBeginRequest)
+		err := b.DecodeMessage(msg)
+		m.Request = &BatchRequestItem_ObjectBegin{msg}
+		return true, err
+	case 2: // Request.object_commit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectCommitRequest)
+		err := b.DecodeMessage(msg)
+		m.Request = &BatchRequestItem_ObjectCommit{msg}
+		return true, err
+	case 3: // Request.object_get
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectGetRequest)
+		err := b.DecodeMessage(msg)
+		m.Request = &BatchRequestItem_ObjectGet{msg}
+		return true, err
+	case 4: // Request.object_list
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectListRequest)
+		err := b.DecodeMessage(msg)
+		m.Request = &BatchRequestItem_ObjectList{msg}
+		return true, err
+	case 5: // Request.segment_begin
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SegmentBeginRequest)
+		err := b.DecodeMessage(msg)
+		m.Request = &BatchRequestItem_SegmentBegin{msg}
+		return true, err
+	case 6: // Request.segment_commit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SegmentCommitRequest)
+		err := b.DecodeMessage(msg)
+		m.Request = &BatchRequestItem_SegmentCommit{msg}
+		return true, err
+	case 7: // Request.segment_delete
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SegmentDeleteRequestOld)
+		err := b.DecodeMessage(msg)
+		m.Request = &BatchRequestItem_SegmentDelete{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _BatchRequestItem_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*BatchRequestItem)
+	// Request
+	switch x := m.Request.(type) {
+	case *BatchRequestItem_ObjectBegin:
+		s := proto.Size(x.ObjectBegin)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchRequestItem_ObjectCommit:
+		s := proto.Size(x.ObjectCommit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchRequestItem_ObjectGet:
+		s := proto.Size(x.ObjectGet)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchRequestItem_ObjectList:
+		s := proto.Size(x.ObjectList)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchRequestItem_SegmentBegin:
+		s := proto.Size(x.SegmentBegin)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchRequestItem_SegmentCommit:
+		s := proto.Size(x.SegmentCommit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchRequestItem_SegmentDelete:
+		s := proto.Size(x.SegmentDelete)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type BatchResponse struct {
+	Responses []*BatchResponseItem `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	// error is set when a request of the batch failed, the responses of the
+	// requests before it are still returned
+	Error                *BatchError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
+func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchResponse) ProtoMessage()    {}
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{63}
+}
+func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResponse.Unmarshal(m, b)
+}
+func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResponse.Merge(m, src)
+}
+func (m *BatchResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchResponse.Size(m)
+}
+func (m *BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResponse proto.InternalMessageInfo
+
+func (m *BatchResponse) GetResponses() []*BatchResponseItem {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *BatchResponse) GetError() *BatchError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type BatchError struct {
+	// code is the grpc status code of the failed request
+	Code                 int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchError) Reset()         { *m = BatchError{} }
+func (m *BatchError) String() string { return proto.CompactTextString(m) }
+func (*BatchError) ProtoMessage()    {}
+func (*BatchError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{64}
+}
+func (m *BatchError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchError.Unmarshal(m, b)
+}
+func (m *BatchError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchError.Marshal(b, m, deterministic)
+}
+func (m *BatchError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchError.Merge(m, src)
+}
+func (m *BatchError) XXX_Size() int {
+	return xxx_messageInfo_BatchError.Size(m)
+}
+func (m *BatchError) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchError proto.InternalMessageInfo
+
+func (m *BatchError) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *BatchError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type BatchResponseItem struct {
+	// Types that are valid to be assigned to Response:
+	//	*BatchResponseItem_ObjectBegin
+	//	*BatchResponseItem_ObjectCommit
+	//	*BatchResponseItem_ObjectGet
+	//	*BatchResponseItem_ObjectList
+	//	*BatchResponseItem_SegmentBegin
+	//	*BatchResponseItem_SegmentCommit
+	//	*BatchResponseItem_SegmentDelete
+	Response             isBatchResponseItem_Response `protobuf_oneof:"Response"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *BatchResponseItem) Reset()         { *m = BatchResponseItem{} }
+func (m *BatchResponseItem) String() string { return proto.CompactTextString(m) }
+func (*BatchResponseItem) ProtoMessage()    {}
+func (*BatchResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{65}
+}
+func (m *BatchResponseItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResponseItem.Unmarshal(m, b)
+}
+func (m *BatchResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResponseItem.Marshal(b, m, deterministic)
+}
+func (m *BatchResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResponseItem.Merge(m, src)
+}
+func (m *BatchResponseItem) XXX_Size() int {
+	return xxx_messageInfo_BatchResponseItem.Size(m)
+}
+func (m *BatchResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResponseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResponseItem proto.InternalMessageInfo
+
+type isBatchResponseItem_Response interface {
+	isBatchResponseItem_Response()
+}
+
+type BatchResponseItem_ObjectBegin struct {
+	ObjectBegin *ObjectBeginResponse `protobuf:"bytes,1,opt,name=object_begin,json=objectBegin,proto3,oneof"`
+}
+type BatchResponseItem_ObjectCommit struct {
+	ObjectCommit *ObjectCommitResponse `protobuf:"bytes,2,opt,name=object_commit,json=objectCommit,proto3,oneof"`
+}
+type BatchResponseItem_ObjectGet struct {
+	ObjectGet *ObjectGetResponse `protobuf:"bytes,3,opt,name=object_get,json=objectGet,proto3,oneof"`
+}
+type BatchResponseItem_ObjectList struct {
+	ObjectList *ObjectListResponse `protobuf:"bytes,4,opt,name=object_list,json=objectList,proto3,oneof"`
+}
+type BatchResponseItem_SegmentBegin struct {
+	SegmentBegin *SegmentBeginResponse `protobuf:"bytes,5,opt,name=segment_begin,json=segmentBegin,proto3,oneof"`
+}
+type BatchResponseItem_SegmentCommit struct {
+	SegmentCommit *SegmentCommitResponse `protobuf:"bytes,6,opt,name=segment_commit,json=segmentCommit,proto3,oneof"`
+}
+type BatchResponseItem_SegmentDelete struct {
+	SegmentDelete *SegmentDeleteResponseOld `protobuf:"bytes,7,opt,name=segment_delete,json=segmentDelete,proto3,oneof"`
+}
+
+func (*BatchResponseItem_ObjectBegin) isBatchResponseItem_Response()   {}
+func (*BatchResponseItem_ObjectCommit) isBatchResponseItem_Response()  {}
+func (*BatchResponseItem_ObjectGet) isBatchResponseItem_Response()     {}
+func (*BatchResponseItem_ObjectList) isBatchResponseItem_Response()    {}
+func (*BatchResponseItem_SegmentBegin) isBatchResponseItem_Response()  {}
+func (*BatchResponseItem_SegmentCommit) isBatchResponseItem_Response() {}
+func (*BatchResponseItem_SegmentDelete) isBatchResponseItem_Response() {}
+
+func (m *BatchResponseItem) GetResponse() isBatchResponseItem_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetObjectBegin() *ObjectBeginResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_ObjectBegin); ok {
+		return x.ObjectBegin
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetObjectCommit() *ObjectCommitResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_ObjectCommit); ok {
+		return x.ObjectCommit
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetObjectGet() *ObjectGetResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_ObjectGet); ok {
+		return x.ObjectGet
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetObjectList() *ObjectListResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_ObjectList); ok {
+		return x.ObjectList
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetSegmentBegin() *SegmentBeginResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_SegmentBegin); ok {
+		return x.SegmentBegin
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetSegmentCommit() *SegmentCommitResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_SegmentCommit); ok {
+		return x.SegmentCommit
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetSegmentDelete() *SegmentDeleteResponseOld {
+	if x, ok := m.GetResponse().(*BatchResponseItem_SegmentDelete); ok {
+		return x.SegmentDelete
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*BatchResponseItem) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _BatchResponseItem_OneofMarshaler, _BatchResponseItem_OneofUnmarshaler, _BatchResponseItem_OneofSizer, []interface{}{
+		(*BatchResponseItem_ObjectBegin)(nil),
+		(*BatchResponseItem_ObjectCommit)(nil),
+		(*BatchResponseItem_ObjectGet)(nil),
+		(*BatchResponseItem_ObjectList)(nil),
+		(*BatchResponseItem_SegmentBegin)(nil),
+		(*BatchResponseItem_SegmentCommit)(nil),
+		(*BatchResponseItem_SegmentDelete)(nil),
+	}
+}
+
+func _BatchResponseItem_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*BatchResponseItem)
+	// Response
+	switch x := m.Response.(type) {
+	case *BatchResponseItem_ObjectBegin:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectBegin); err != nil {
+			return err
+		}
+	case *BatchResponseItem_ObjectCommit:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectCommit); err != nil {
+			return err
+		}
+	case *BatchResponseItem_ObjectGet:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectGet); err != nil {
+			return err
+		}
+	case *BatchResponseItem_ObjectList:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectList); err != nil {
+			return err
+		}
+	case *BatchResponseItem_SegmentBegin:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SegmentBegin); err != nil {
+			return err
+		}
+	case *BatchResponseItem_SegmentCommit:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SegmentCommit); err != nil {
+			return err
+		}
+	case *BatchResponseItem_SegmentDelete:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SegmentDelete); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("BatchResponseItem.Response has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _BatchResponseItem_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*BatchResponseItem)
+	switch tag {
+	case 1: // Response.object_begin
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectBeginResponse)
+		err := b.DecodeMessage(msg)
+		m.Response = &BatchResponseItem_ObjectBegin{msg}
+		return true, err
+	case 2: // Response.object_commit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectCommitResponse)
+		err := b.DecodeMessage(msg)
+		m.Response = &BatchResponseItem_ObjectCommit{msg}
+		return true, err
+	case 3: // Response.object_get
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectGetResponse)
+		err := b.DecodeMessage(msg)
+		m.Response = &BatchResponseItem_ObjectGet{msg}
+		return true, err
+	case 4: // Response.object_list
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectListResponse)
+		err := b.DecodeMessage(msg)
+		m.Response = &BatchResponseItem_ObjectList{msg}
+		return true, err
+	case 5: // Response.segment_begin
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SegmentBeginResponse)
+		err := b.DecodeMessage(msg)
+		m.Response = &BatchResponseItem_SegmentBegin{msg}
+		return true, err
+	case 6: // Response.segment_commit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SegmentCommitResponse)
+		err := b.DecodeMessage(msg)
+		m.Response = &BatchResponseItem_SegmentCommit{msg}
+		return true, err
+	case 7: // Response.segment_delete
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SegmentDeleteResponseOld)
+		err := b.DecodeMessage(msg)
+		m.Response = &BatchResponseItem_SegmentDelete{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _BatchResponseItem_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*BatchResponseItem)
+	// Response
+	switch x := m.Response.(type) {
+	case *BatchResponseItem_ObjectBegin:
+		s := proto.Size(x.ObjectBegin)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResponseItem_ObjectCommit:
+		s := proto.Size(x.ObjectCommit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResponseItem_ObjectGet:
+		s := proto.Size(x.ObjectGet)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResponseItem_ObjectList:
+		s := proto.Size(x.ObjectList)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResponseItem_SegmentBegin:
+		s := proto.Size(x.SegmentBegin)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResponseItem_SegmentCommit:
+		s := proto.Size(x.SegmentCommit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResponseItem_SegmentDelete:
+		s := proto.Size(x.SegmentDelete)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// PackedObjectsCommitRequest commits a remote segment that holds the data of
+// several small objects, each of them becomes an object with a single segment.
+type PackedObjectsCommitRequest struct {
+	Bucket               []byte          `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Pointer              *Pointer        `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	OriginalLimits       []*OrderLimit   `protobuf:"bytes,3,rep,name=original_limits,json=originalLimits,proto3" json:"original_limits,omitempty"`
+	Objects              []*PackedObject `protobuf:"bytes,4,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PackedObjectsCommitRequest) Reset()         { *m = PackedObjectsCommitRequest{} }
+func (m *PackedObjectsCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*PackedObjectsCommitRequest) ProtoMessage()    {}
+func (*PackedObjectsCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{66}
+}
+func (m *PackedObjectsCommitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PackedObjectsCommitRequest.Unmarshal(m, b)
+}
+func (m *PackedObjectsCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PackedObjectsCommitRequest.Marshal(b, m, deterministic)
+}
+func (m *PackedObjectsCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackedObjectsCommitRequest.Merge(m, src)
+}
+func (m *PackedObjectsCommitRequest) XXX_Size() int {
+	return xxx_messageInfo_PackedObjectsCommitRequest.Size(m)
+}
+func (m *PackedObjectsCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackedObjectsCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackedObjectsCommitRequest proto.InternalMessageInfo
+
+func (m *PackedObjectsCommitRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *PackedObjectsCommitRequest) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+func (m *PackedObjectsCommitRequest) GetOriginalLimits() []*OrderLimit {
+	if m != nil {
+		return m.OriginalLimits
+	}
+	return nil
+}
+
+func (m *PackedObjectsCommitRequest) GetObjects() []*PackedObject {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type PackedObject struct {
+	EncryptedPath        []byte   `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length               int64    `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	EncryptedMetadata    []byte   `protobuf:"bytes,4,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PackedObject) Reset()         { *m = PackedObject{} }
+func (m *PackedObject) String() string { return proto.CompactTextString(m) }
+func (*PackedObject) ProtoMessage()    {}
+func (*PackedObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{67}
+}
+func (m *PackedObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PackedObject.Unmarshal(m, b)
+}
+func (m *PackedObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PackedObject.Marshal(b, m, deterministic)
+}
+func (m *PackedObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackedObject.Merge(m, src)
+}
+func (m *PackedObject) XXX_Size() int {
+	return xxx_messageInfo_PackedObject.Size(m)
+}
+func (m *PackedObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackedObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackedObject proto.InternalMessageInfo
+
+func (m *PackedObject) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *PackedObject) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PackedObject) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *PackedObject) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+type PackedObjectsCommitResponse struct {
+	Objects []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	// deleted_segments contains the order limits for deleting the pieces of
+	// the replaced versions of the objects.
+	DeletedSegments      []*SegmentDeleteResponseOld `protobuf:"bytes,2,rep,name=deleted_segments,json=deletedSegments,proto3" json:"deleted_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *PackedObjectsCommitResponse) Reset()         { *m = PackedObjectsCommitResponse{} }
+func (m *PackedObjectsCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*PackedObjectsCommitResponse) ProtoMessage()    {}
+func (*PackedObjectsCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{68}
+}
+func (m *PackedObjectsCommitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PackedObjectsCommitResponse.Unmarshal(m, b)
+}
+func (m *PackedObjectsCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PackedObjectsCommitResponse.Marshal(b, m, deterministic)
+}
+func (m *PackedObjectsCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackedObjectsCommitResponse.Merge(m, src)
+}
+func (m *PackedObjectsCommitResponse) XXX_Size() int {
+	return xxx_messageInfo_PackedObjectsCommitResponse.Size(m)
+}
+func (m *PackedObjectsCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackedObjectsCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackedObjectsCommitResponse proto.InternalMessageInfo
+
+func (m *PackedObjectsCommitResponse) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *PackedObjectsCommitResponse) GetDeletedSegments() []*SegmentDeleteResponseOld {
+	if m != nil {
+		return m.DeletedSegments
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Bucket)(nil), "metainfo.Bucket")
+	proto.RegisterType((*BucketListItem)(nil), "metainfo.BucketListItem")
+	proto.RegisterType((*BucketCreateRequest)(nil), "metainfo.BucketCreateRequest")
+	proto.RegisterType((*BucketCreateResponse)(nil), "metainfo.BucketCreateResponse")
+	proto.RegisterType((*BucketGetRequest)(nil), "metainfo.BucketGetRequest")
+	proto.RegisterType((*BucketGetResponse)(nil), "metainfo.BucketGetResponse")
+	proto.RegisterType((*BucketDeleteRequest)(nil), "metainfo.BucketDeleteRequest")
+	proto.RegisterType((*BucketDeleteResponse)(nil), "metainfo.BucketDeleteResponse")
+	proto.RegisterType((*BucketListRequest)(nil), "metainfo.BucketListRequest")
+	proto.RegisterType((*BucketListResponse)(nil), "metainfo.BucketListResponse")
+	proto.RegisterType((*BucketSetAttributionRequest)(nil), "metainfo.BucketSetAttributionRequest")
+	proto.RegisterType((*BucketSetAttributionResponse)(nil), "metainfo.BucketSetAttributionResponse")
+	proto.RegisterType((*BucketSetVersioningRequest)(nil), "metainfo.BucketSetVersioningRequest")
+	proto.RegisterType((*BucketSetVersioningResponse)(nil), "metainfo.BucketSetVersioningResponse")
+	proto.RegisterType((*LifecycleRule)(nil), "metainfo.LifecycleRule")
+	proto.RegisterType((*BucketLifecycle)(nil), "metainfo.BucketLifecycle")
+	proto.RegisterType((*BucketSetLifecycleRequest)(nil), "metainfo.BucketSetLifecycleRequest")
+	proto.RegisterType((*BucketSetLifecycleResponse)(nil), "metainfo.BucketSetLifecycleResponse")
+	proto.RegisterType((*BucketGetLifecycleRequest)(nil), "metainfo.BucketGetLifecycleRequest")
+	proto.RegisterType((*BucketGetLifecycleResponse)(nil), "metainfo.BucketGetLifecycleResponse")
+	proto.RegisterType((*AddressedOrderLimit)(nil), "metainfo.AddressedOrderLimit")
+	proto.RegisterType((*SegmentWriteRequestOld)(nil), "metainfo.SegmentWriteRequestOld")
+	proto.RegisterType((*SegmentWriteResponseOld)(nil), "metainfo.SegmentWriteResponseOld")
+	proto.RegisterType((*SegmentCommitRequestOld)(nil), "metainfo.SegmentCommitRequestOld")
+	proto.RegisterType((*SegmentCommitResponseOld)(nil), "metainfo.SegmentCommitResponseOld")
+	proto.RegisterType((*SegmentDownloadRequestOld)(nil), "metainfo.SegmentDownloadRequestOld")
+	proto.RegisterType((*SegmentDownloadResponseOld)(nil), "metainfo.SegmentDownloadResponseOld")
+	proto.RegisterType((*SegmentInfoRequestOld)(nil), "metainfo.SegmentInfoRequestOld")
+	proto.RegisterType((*SegmentInfoResponseOld)(nil), "metainfo.SegmentInfoResponseOld")
+	proto.RegisterType((*SegmentDeleteRequestOld)(nil), "metainfo.SegmentDeleteRequestOld")
+	proto.RegisterType((*SegmentDeleteResponseOld)(nil), "metainfo.SegmentDeleteResponseOld")
+	proto.RegisterType((*ListSegmentsRequestOld)(nil), "metainfo.ListSegmentsRequestOld")
+	proto.RegisterType((*ListSegmentsResponseOld)(nil), "metainfo.ListSegmentsResponseOld")
+	proto.RegisterType((*ListSegmentsResponseOld_Item)(nil), "metainfo.ListSegmentsResponseOld.Item")
+	proto.RegisterType((*SetAttributionRequestOld)(nil), "metainfo.SetAttributionRequestOld")
+	proto.RegisterType((*SetAttributionResponseOld)(nil), "metainfo.SetAttributionResponseOld")
+	proto.RegisterType((*ProjectInfoRequest)(nil), "metainfo.ProjectInfoRequest")
+	proto.RegisterType((*ProjectInfoResponse)(nil), "metainfo.ProjectInfoResponse")
+	proto.RegisterType((*ObjectSegmentMetadata)(nil), "metainfo.ObjectSegmentMetadata")
+	proto.RegisterType((*ObjectCopyRequest)(nil), "metainfo.ObjectCopyRequest")
+	proto.RegisterType((*ObjectCopyResponse)(nil), "metainfo.ObjectCopyResponse")
+	proto.RegisterType((*ObjectMoveRequest)(nil), "metainfo.ObjectMoveRequest")
+	proto.RegisterType((*ObjectMoveResponse)(nil), "metainfo.ObjectMoveResponse")
+	proto.RegisterType((*ObjectListVersionsRequest)(nil), "metainfo.ObjectListVersionsRequest")
+	proto.RegisterType((*ObjectListVersionsResponse)(nil), "metainfo.ObjectListVersionsResponse")
+	proto.RegisterType((*ObjectListVersionsResponse_Item)(nil), "metainfo.ObjectListVersionsResponse.Item")
+	proto.RegisterType((*ObjectDeleteVersionRequest)(nil), "metainfo.ObjectDeleteVersionRequest")
+	proto.RegisterType((*ObjectDeleteVersionResponse)(nil), "metainfo.ObjectDeleteVersionResponse")
+	proto.RegisterType((*ObjectCreateDeleteMarkerRequest)(nil), "metainfo.ObjectCreateDeleteMarkerRequest")
+	proto.RegisterType((*ObjectCreateDeleteMarkerResponse)(nil), "metainfo.ObjectCreateDeleteMarkerResponse")
+	proto.RegisterType((*Object)(nil), "metainfo.Object")
+	proto.RegisterType((*ObjectBeginRequest)(nil), "metainfo.ObjectBeginRequest")
+	proto.RegisterType((*ObjectBeginResponse)(nil), "metainfo.ObjectBeginResponse")
+	proto.RegisterType((*ObjectCommitRequest)(nil), "metainfo.ObjectCommitRequest")
+	proto.RegisterType((*ObjectCommitResponse)(nil), "metainfo.ObjectCommitResponse")
+	proto.RegisterType((*ObjectGetRequest)(nil), "metainfo.ObjectGetRequest")
+	proto.RegisterType((*ObjectGetResponse)(nil), "metainfo.ObjectGetResponse")
+	proto.RegisterType((*ObjectListRequest)(nil), "metainfo.ObjectListRequest")
+	proto.RegisterType((*ObjectListResponse)(nil), "metainfo.ObjectListResponse")
+	proto.RegisterType((*ObjectListResponse_Item)(nil), "metainfo.ObjectListResponse.Item")
+	proto.RegisterType((*SegmentBeginRequest)(nil), "metainfo.SegmentBeginRequest")
+	proto.RegisterType((*SegmentBeginResponse)(nil), "metainfo.SegmentBeginResponse")
+	proto.RegisterType((*SegmentCommitRequest)(nil), "metainfo.SegmentCommitRequest")
+	proto.RegisterType((*SegmentCommitResponse)(nil), "metainfo.SegmentCommitResponse")
+	proto.RegisterType((*BatchRequest)(nil), "metainfo.BatchRequest")
+	proto.RegisterType((*BatchRequestItem)(nil), "metainfo.BatchRequestItem")
+	proto.RegisterType((*BatchResponse)(nil), "metainfo.BatchResponse")
+	proto.RegisterType((*BatchError)(nil), "metainfo.BatchError")
+	proto.RegisterType((*BatchResponseItem)(nil), "metainfo.BatchResponseItem")
+	proto.RegisterType((*PackedObjectsCommitRequest)(nil), "metainfo.PackedObjectsCommitRequest")
+	proto.RegisterType((*PackedObject)(nil), "metainfo.PackedObject")
+	proto.RegisterType((*PackedObjectsCommitResponse)(nil), "metainfo.PackedObjectsCommitResponse")
+}
+
+func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
+
+var fileDescriptor_631e2f30a93cd64e = []byte{
+	// 3006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xdf, 0x9e, 0xf1, 0x78, 0x67, 0xde, 0x8c, 0xbf, 0xca, 0x5e, 0x7b, 0xb6, 0xc7, 0x5f, 0xdb,
+	0xbb, 0x1b, 0x9c, 0x28, 0x71, 0x22, 0x47, 0x82, 0xc0, 0x26, 0x24, 0xfe, 0x8a, 0xed, 0x64, 0x37,
+	0x6b, 0xb5, 0x21, 0x09, 0x11, 0xa2, 0xd3, 0x9e, 0x2e, 0xcf, 0x36, 0x3b, 0xd3, 0x3d, 0x74, 0xf7,
+	0xec, 0xae, 0x23, 0x0e, 0x48, 0x20, 0x71, 0x0c, 0x87, 0x20, 0x71, 0x0a, 0xff, 0x04, 0x27, 0xfe,
+	0x02, 0x94, 0x03, 0x08, 0x2e, 0x48, 0x80, 0x82, 0x84, 0x84, 0xb8, 0x23, 0xc1, 0x11, 0xa1, 0xfa,
+	0xea, 0xaa, 0xee, 0xe9, 0x9e, 0x19, 0x3b, 0xb3, 0x08, 0xb8, 0x75, 0xbd, 0x7a, 0xf5, 0xaa, 0xde,
+	0x7b, 0xbf, 0x7a, 0xef, 0x55, 0x55, 0xc3, 0x74, 0x07, 0x47, 0xb6, 0xeb, 0x9d, 0xf9, 0x9b, 0xdd,
+	0xc0, 0x8f, 0x7c, 0x54, 0x16, 0x6d, 0x7d, 0x16, 0x7b, 0xcd, 0xe0, 0xbc, 0x1b, 0xb9, 0xbe, 0xc7,
+	0xfa, 0x74, 0x68, 0xf9, 0x2d, 0xce, 0xa7, 0xaf, 0xb5, 0x7c, 0xbf, 0xd5, 0xc6, 0x2f, 0xd2, 0xd6,
+	0x69, 0xef, 0xec, 0xc5, 0xc8, 0xed, 0xe0, 0x30, 0xb2, 0x3b, 0x5d, 0xc1, 0xec, 0xf9, 0x0e, 0xe6,
+	0xdf, 0x33, 0x5d, 0xdf, 0xf5, 0x22, 0x1c, 0x38, 0xa7, 0x9c, 0x50, 0xf3, 0x03, 0x07, 0x07, 0x21,
+	0x6b, 0x19, 0x9f, 0x15, 0x61, 0x72, 0xa7, 0xd7, 0x7c, 0x88, 0x23, 0x84, 0x60, 0xc2, 0xb3, 0x3b,
+	0xb8, 0xae, 0xad, 0x6b, 0x1b, 0x35, 0x93, 0x7e, 0xa3, 0x57, 0xa0, 0xda, 0xb5, 0xa3, 0x07, 0x56,
+	0xd3, 0xed, 0x3e, 0xc0, 0x41, 0xbd, 0xb0, 0xae, 0x6d, 0x4c, 0x6f, 0x2d, 0x6d, 0x2a, 0xcb, 0xdb,
+	0xa5, 0x3d, 0x27, 0x3d, 0x37, 0xc2, 0x26, 0x10, 0x5e, 0x46, 0x40, 0xbb, 0x00, 0xcd, 0x00, 0xdb,
+	0x11, 0x76, 0x2c, 0x3b, 0xaa, 0x17, 0xd7, 0xb5, 0x8d, 0xea, 0x96, 0xbe, 0xc9, 0x56, 0xbe, 0x29,
+	0x56, 0xbe, 0xf9, 0x0d, 0xb1, 0xf2, 0x9d, 0xf2, 0xaf, 0x3e, 0x5f, 0xbb, 0xf2, 0x93, 0x3f, 0xaf,
+	0x69, 0x66, 0x85, 0x8f, 0xdb, 0x8e, 0xd0, 0x4b, 0xb0, 0xe0, 0xe0, 0x33, 0xbb, 0xd7, 0x8e, 0xac,
+	0x10, 0xb7, 0x3a, 0xd8, 0x8b, 0xac, 0xd0, 0xfd, 0x08, 0xd7, 0x27, 0xd6, 0xb5, 0x8d, 0xa2, 0x89,
+	0x78, 0xdf, 0x09, 0xeb, 0x3a, 0x71, 0x3f, 0xc2, 0xe8, 0x3d, 0xb8, 0x2e, 0x46, 0x04, 0xd8, 0xe9,
+	0x79, 0x8e, 0xed, 0x35, 0xcf, 0xad, 0xb0, 0xf9, 0x00, 0x77, 0x70, 0xbd, 0x44, 0x57, 0xd1, 0xd8,
+	0x94, 0x26, 0x31, 0x63, 0x9e, 0x13, 0xca, 0x62, 0x2e, 0xf1, 0xd1, 0xe9, 0x0e, 0xe4, 0xc0, 0x8a,
+	0x10, 0x2c, 0xb5, 0xb7, 0xba, 0x76, 0x60, 0x77, 0x70, 0x84, 0x83, 0xb0, 0x3e, 0x49, 0x85, 0xaf,
+	0xab, 0xb6, 0xd9, 0x8f, 0x3f, 0x8f, 0x63, 0x3e, 0xb3, 0xc1, 0xc5, 0x64, 0x75, 0xa2, 0x55, 0x80,
+	0x47, 0x38, 0x08, 0x5d, 0xdf, 0x73, 0xbd, 0x56, 0xfd, 0xea, 0xba, 0xb6, 0x51, 0x36, 0x15, 0x0a,
+	0x5a, 0x86, 0x4a, 0xb7, 0x6d, 0x37, 0x31, 0xd1, 0xb7, 0x5e, 0x5e, 0xd7, 0x36, 0x2a, 0xa6, 0x24,
+	0x18, 0x2e, 0x4c, 0x33, 0x5f, 0xde, 0x75, 0xc3, 0xe8, 0x28, 0xc2, 0x9d, 0x4c, 0x9f, 0x26, 0x3d,
+	0x53, 0xb8, 0x94, 0x67, 0x8c, 0xbf, 0x17, 0x60, 0x9e, 0xcd, 0xb5, 0x4b, 0x69, 0x26, 0xfe, 0x5e,
+	0x0f, 0x87, 0xe3, 0x06, 0x51, 0x9e, 0xff, 0x8b, 0x97, 0xf3, 0xff, 0xc4, 0xd3, 0xf4, 0x7f, 0x69,
+	0x1c, 0xfe, 0x4f, 0xf8, 0x77, 0x32, 0xed, 0xdf, 0x37, 0x60, 0x21, 0x69, 0xf3, 0xb0, 0xeb, 0x7b,
+	0x21, 0x46, 0x1b, 0x30, 0x79, 0x4a, 0xe9, 0xd4, 0xec, 0xd5, 0xad, 0xd9, 0xcd, 0x38, 0xb2, 0x30,
+	0x7e, 0x93, 0xf7, 0x1b, 0xcf, 0xc0, 0x2c, 0xa3, 0x1c, 0xe0, 0x68, 0x80, 0xcb, 0x8c, 0xd7, 0x60,
+	0x4e, 0xe1, 0xbb, 0xf0, 0x34, 0xcf, 0x0a, 0x70, 0xec, 0xe1, 0x36, 0x1e, 0x08, 0x0e, 0x63, 0x11,
+	0x16, 0x92, 0xac, 0x6c, 0x32, 0xc3, 0x82, 0x39, 0x89, 0x65, 0x21, 0x60, 0x11, 0x26, 0x9b, 0xbd,
+	0x20, 0xf4, 0x03, 0x2e, 0x82, 0xb7, 0xd0, 0x02, 0x94, 0xda, 0x6e, 0xc7, 0x65, 0x68, 0x2e, 0x99,
+	0xac, 0x41, 0x8c, 0xe9, 0xb8, 0x01, 0x6e, 0x12, 0x1b, 0x53, 0xc8, 0x94, 0x4c, 0x49, 0x30, 0xde,
+	0x07, 0xa4, 0x4e, 0xc0, 0x75, 0xdc, 0x84, 0x92, 0x1b, 0xe1, 0x4e, 0x58, 0xd7, 0xd6, 0x8b, 0x1b,
+	0xd5, 0xad, 0x7a, 0x5a, 0x45, 0xb1, 0xb3, 0x4c, 0xc6, 0x46, 0x54, 0xea, 0xf8, 0x01, 0xa6, 0x13,
+	0x97, 0x4d, 0xfa, 0x6d, 0xbc, 0x0f, 0x0d, 0xc6, 0x7c, 0x82, 0xa3, 0xed, 0x28, 0x0a, 0xdc, 0xd3,
+	0x1e, 0x99, 0x71, 0xd0, 0x16, 0xb9, 0x0d, 0xd3, 0xb6, 0xe4, 0xb4, 0x5c, 0x87, 0x0a, 0xac, 0x99,
+	0x53, 0x0a, 0xf5, 0xc8, 0x31, 0x56, 0x61, 0x39, 0x5b, 0x32, 0x37, 0xda, 0x31, 0xe8, 0x71, 0xff,
+	0xbb, 0x71, 0xd4, 0x18, 0x34, 0x71, 0x32, 0xe0, 0x14, 0xd2, 0x01, 0xc7, 0x38, 0x80, 0x46, 0xa6,
+	0xc4, 0x0b, 0x43, 0xe2, 0x17, 0x1a, 0x4c, 0xdd, 0x75, 0xcf, 0x70, 0xf3, 0xbc, 0xd9, 0xc6, 0x66,
+	0xaf, 0x8d, 0xd1, 0x34, 0x14, 0x5c, 0x87, 0x8e, 0xab, 0x98, 0x05, 0xd7, 0x41, 0xcf, 0x82, 0x48,
+	0x7b, 0xd8, 0xb1, 0xba, 0x01, 0x3e, 0x73, 0x9f, 0x70, 0x2b, 0xcc, 0xc4, 0xf4, 0x63, 0x4a, 0x46,
+	0x5f, 0x82, 0x19, 0xfc, 0xa4, 0xeb, 0x06, 0x36, 0xb5, 0x96, 0x63, 0x9f, 0x87, 0xdc, 0xbf, 0xd3,
+	0x92, 0xbc, 0x67, 0x9f, 0x87, 0xe8, 0x75, 0x58, 0xb6, 0x4f, 0xfd, 0x20, 0xb2, 0x5c, 0xaf, 0xe9,
+	0x77, 0xba, 0x04, 0x61, 0x56, 0xaf, 0xdb, 0xf6, 0x6d, 0x87, 0x8d, 0x9a, 0xa0, 0xa3, 0xae, 0x53,
+	0x9e, 0xa3, 0x98, 0xe5, 0x9b, 0x94, 0x83, 0x08, 0x30, 0xde, 0x80, 0x19, 0xe1, 0x78, 0xbe, 0x76,
+	0xf4, 0x02, 0x94, 0x82, 0x5e, 0x1b, 0x0b, 0x88, 0x2c, 0x49, 0x95, 0x13, 0xfa, 0x99, 0x8c, 0xcb,
+	0xf8, 0x0e, 0x5c, 0x8f, 0x2d, 0x28, 0x19, 0x06, 0xb8, 0x24, 0x96, 0x5f, 0x18, 0x49, 0xfe, 0xb2,
+	0xe2, 0x73, 0x45, 0x3e, 0x47, 0xc4, 0x8b, 0x62, 0xf6, 0x83, 0xd1, 0x66, 0x37, 0xde, 0x06, 0x3d,
+	0x6b, 0x00, 0xf7, 0xf7, 0x05, 0x75, 0xff, 0x91, 0x06, 0xf3, 0xdb, 0x8e, 0x13, 0xe0, 0x30, 0xc4,
+	0xce, 0x7d, 0x52, 0x77, 0xdc, 0xa5, 0x3b, 0x73, 0x43, 0xec, 0x57, 0x86, 0x1a, 0xb4, 0xc9, 0x6b,
+	0x12, 0xc9, 0x22, 0xf6, 0xf0, 0x2e, 0x2c, 0x84, 0x91, 0x1f, 0xd8, 0x2d, 0x6c, 0x79, 0xbe, 0x83,
+	0x2d, 0x9b, 0x49, 0xe3, 0x69, 0x6b, 0x6e, 0x93, 0x10, 0x37, 0xdf, 0xf1, 0x1d, 0xcc, 0xa7, 0x31,
+	0x11, 0x67, 0x57, 0x68, 0xc6, 0xa7, 0x05, 0x58, 0xe4, 0x49, 0xe2, 0xbd, 0xc0, 0x8d, 0xe3, 0xd1,
+	0xfd, 0xb6, 0x43, 0x22, 0x8a, 0x02, 0xe0, 0x9a, 0x80, 0x2b, 0x31, 0x0d, 0xc9, 0x43, 0x1c, 0x80,
+	0xf4, 0x1b, 0xd5, 0xe1, 0x2a, 0xcf, 0x42, 0x3c, 0x01, 0x89, 0x26, 0xba, 0x03, 0x20, 0xb3, 0xcd,
+	0x28, 0x69, 0x46, 0x61, 0x47, 0x77, 0x40, 0xef, 0xd8, 0x4f, 0x2c, 0x89, 0xfd, 0x44, 0xaa, 0x2b,
+	0xd1, 0x99, 0x96, 0x3a, 0xf6, 0x93, 0x7d, 0xc1, 0xa0, 0xe6, 0xbb, 0x3d, 0x00, 0x09, 0xf9, 0xfa,
+	0xe4, 0x05, 0x92, 0xb9, 0x32, 0xce, 0xf8, 0x9d, 0x06, 0x4b, 0x49, 0x03, 0x31, 0x7f, 0x13, 0x0b,
+	0x1d, 0xc2, 0xac, 0x2d, 0x5c, 0x68, 0x51, 0xa7, 0x08, 0xef, 0xaf, 0x48, 0xef, 0x67, 0x38, 0xd9,
+	0x9c, 0x89, 0x87, 0xd1, 0x76, 0x88, 0x5e, 0x86, 0xa9, 0xc0, 0xf7, 0x23, 0xab, 0xeb, 0xe2, 0x26,
+	0x8e, 0x63, 0xdc, 0xce, 0x0c, 0x59, 0xd2, 0x1f, 0x3e, 0x5f, 0xbb, 0x7a, 0x4c, 0xe8, 0x47, 0x7b,
+	0x66, 0x95, 0x70, 0xb1, 0x86, 0x43, 0x8b, 0x87, 0xc0, 0x7d, 0x64, 0x47, 0xd8, 0x7a, 0x88, 0xcf,
+	0xa9, 0xe1, 0x6b, 0x3b, 0x4b, 0x7c, 0xc8, 0x0c, 0xe5, 0x3a, 0x66, 0xfd, 0x6f, 0xe3, 0x73, 0x13,
+	0xba, 0xf1, 0xb7, 0xf1, 0x99, 0x54, 0x6a, 0xd7, 0xef, 0x90, 0x15, 0x8d, 0xdb, 0xed, 0xcf, 0xc3,
+	0x55, 0xee, 0x63, 0xee, 0x73, 0xa4, 0xf8, 0xfc, 0x98, 0x7d, 0x99, 0x82, 0x05, 0xdd, 0x81, 0x19,
+	0x3f, 0x70, 0x5b, 0xae, 0x67, 0xb7, 0x85, 0x1d, 0x4b, 0xeb, 0xc5, 0x1c, 0xf8, 0x4f, 0x0b, 0x56,
+	0xda, 0x0c, 0x8d, 0x43, 0xa8, 0xa7, 0x74, 0x91, 0x1e, 0x52, 0x96, 0xa1, 0x0d, 0x5d, 0x86, 0xf1,
+	0x03, 0x0d, 0xae, 0x73, 0x51, 0x7b, 0xfe, 0x63, 0x8f, 0x44, 0xba, 0xb1, 0x1b, 0x66, 0x25, 0xce,
+	0x2a, 0xc4, 0xcd, 0x13, 0xac, 0x8e, 0xe1, 0x94, 0x23, 0xc7, 0xf8, 0xb5, 0x06, 0x7a, 0xdf, 0x12,
+	0x9e, 0x06, 0xe2, 0x14, 0xcb, 0x14, 0x86, 0x3b, 0xe8, 0xf2, 0x50, 0xfb, 0x3e, 0x5c, 0xe3, 0xfa,
+	0x1c, 0x79, 0x67, 0xfe, 0x7f, 0xda, 0x9c, 0x6f, 0xc2, 0x62, 0x62, 0xf6, 0x4c, 0x64, 0x0c, 0xd7,
+	0xdf, 0xb0, 0xe2, 0xfd, 0x92, 0x28, 0xdb, 0xc6, 0xa6, 0x87, 0xf1, 0xa9, 0x06, 0xf5, 0xd4, 0x0c,
+	0x4f, 0xc3, 0xeb, 0x29, 0x3f, 0x16, 0x46, 0xf7, 0xe3, 0x1f, 0x35, 0x58, 0x24, 0x15, 0x1e, 0x5f,
+	0x64, 0x38, 0x82, 0x05, 0x16, 0x61, 0x32, 0x51, 0xab, 0xf0, 0x16, 0x5a, 0x83, 0x6a, 0x18, 0xd9,
+	0x41, 0x64, 0xd9, 0x67, 0xc4, 0xfc, 0x14, 0x4c, 0x26, 0x50, 0xd2, 0x36, 0xa1, 0x10, 0xa7, 0x62,
+	0xcf, 0xb1, 0x4e, 0xf1, 0x19, 0xa9, 0x1f, 0x27, 0x68, 0x7f, 0x05, 0x7b, 0xce, 0x0e, 0x25, 0x90,
+	0xe2, 0x35, 0xc0, 0xa4, 0xbc, 0x75, 0x1f, 0xb1, 0x24, 0x50, 0x36, 0x25, 0x41, 0x16, 0xbc, 0x93,
+	0x6a, 0xc1, 0xbb, 0x02, 0x40, 0x2c, 0x65, 0x9d, 0xb5, 0xed, 0x56, 0x48, 0x4f, 0x8f, 0x57, 0xcd,
+	0x0a, 0xa1, 0xbc, 0x49, 0x08, 0x34, 0xca, 0x27, 0xb5, 0x93, 0xd6, 0x7f, 0x35, 0x59, 0xf7, 0x3e,
+	0xa3, 0x26, 0xf6, 0xcc, 0x11, 0x9b, 0x43, 0xaa, 0x60, 0x1d, 0xc3, 0x84, 0x38, 0x82, 0x52, 0x88,
+	0x68, 0x0a, 0x44, 0x2e, 0xb6, 0x2f, 0x1b, 0x50, 0x71, 0x43, 0x51, 0x11, 0x16, 0xe9, 0x14, 0x65,
+	0x37, 0x64, 0xa5, 0xa0, 0xf1, 0x01, 0xd4, 0xd3, 0xc5, 0x70, 0xec, 0xb3, 0x35, 0xa8, 0x32, 0x2f,
+	0x59, 0x4a, 0x99, 0x03, 0x8c, 0xf4, 0x0e, 0x29, 0xb5, 0x56, 0x00, 0xba, 0x76, 0x10, 0x79, 0x38,
+	0x90, 0x25, 0x77, 0x85, 0x53, 0x8e, 0x1c, 0xa3, 0x01, 0xd7, 0xd3, 0xb2, 0x63, 0xfd, 0x8d, 0x05,
+	0x40, 0xc7, 0x81, 0xff, 0x5d, 0xdc, 0x54, 0xf7, 0xbc, 0xf1, 0x0a, 0xcc, 0x27, 0xa8, 0x8c, 0x1f,
+	0xdd, 0x80, 0x5a, 0x97, 0x91, 0xad, 0xd0, 0x6e, 0x0b, 0x0c, 0x55, 0x39, 0xed, 0xc4, 0x6e, 0x47,
+	0xc6, 0x87, 0x70, 0xed, 0xfe, 0x29, 0x6d, 0x31, 0x63, 0xdf, 0xc3, 0x91, 0xed, 0xd8, 0x91, 0xad,
+	0xee, 0x27, 0x2d, 0x19, 0x17, 0x5e, 0x00, 0x24, 0xab, 0x86, 0x0e, 0xe7, 0xe7, 0x6a, 0xcc, 0xc5,
+	0x3d, 0x42, 0x90, 0xf1, 0x4f, 0x0d, 0xe6, 0xd8, 0x14, 0xbb, 0x7e, 0xf7, 0x5c, 0x39, 0x53, 0x65,
+	0x02, 0xfb, 0x36, 0x4c, 0x4b, 0xe1, 0xca, 0x26, 0x9f, 0x92, 0xc5, 0x38, 0x71, 0xe5, 0x0a, 0x80,
+	0x87, 0x1f, 0x5b, 0x5c, 0x04, 0x83, 0x79, 0xc5, 0xc3, 0x8f, 0xf9, 0xa5, 0xd2, 0xf3, 0x80, 0x48,
+	0x77, 0x4a, 0x12, 0x43, 0xfb, 0xac, 0x87, 0x1f, 0xef, 0x27, 0x84, 0xdd, 0x81, 0x32, 0xd7, 0x4d,
+	0xe4, 0xc6, 0x35, 0x09, 0xc4, 0x4c, 0xeb, 0x98, 0xf1, 0x00, 0x62, 0xa7, 0x00, 0xd3, 0xc3, 0x32,
+	0xdd, 0x15, 0x65, 0x53, 0x34, 0x8d, 0x26, 0x20, 0x55, 0x6f, 0xee, 0x93, 0x7b, 0x30, 0xeb, 0xd0,
+	0x28, 0x14, 0x57, 0x5c, 0x02, 0xfd, 0x86, 0x9c, 0x34, 0x2f, 0x5c, 0x99, 0x33, 0x7c, 0xac, 0xd8,
+	0x1a, 0xc6, 0x9f, 0x62, 0xeb, 0xde, 0xf3, 0x1f, 0xe1, 0xff, 0x37, 0xeb, 0x12, 0xb8, 0xab, 0xda,
+	0xf1, 0xe3, 0xc5, 0x07, 0x70, 0x9d, 0x51, 0x49, 0x94, 0xe0, 0xe7, 0xc3, 0x70, 0x3c, 0xba, 0x1b,
+	0xff, 0xd0, 0x40, 0xcf, 0x12, 0xce, 0xdd, 0xf7, 0x7a, 0x32, 0x62, 0x3d, 0x9b, 0x56, 0x25, 0x6b,
+	0x90, 0x1a, 0xb4, 0xf4, 0x9f, 0x6a, 0x3c, 0x42, 0x25, 0xd3, 0xab, 0x96, 0x4a, 0xaf, 0x3c, 0xfc,
+	0xb4, 0xed, 0x08, 0x87, 0x11, 0x8f, 0x70, 0x65, 0x37, 0xbc, 0x4b, 0xdb, 0xe8, 0x26, 0x4c, 0x31,
+	0x20, 0x58, 0x1d, 0x3b, 0x78, 0xc8, 0x03, 0x7d, 0xd9, 0xac, 0x31, 0xe2, 0x3d, 0x4a, 0xbb, 0x58,
+	0x9d, 0x68, 0x7c, 0x24, 0xd4, 0x66, 0xa0, 0xe3, 0x3a, 0x8c, 0x0f, 0x50, 0x8a, 0xae, 0xc5, 0x74,
+	0x29, 0xd1, 0x86, 0x46, 0xe6, 0xdc, 0x4f, 0x67, 0xcb, 0x7c, 0x08, 0x6b, 0x7c, 0x5f, 0xd2, 0xfb,
+	0xac, 0x3d, 0xc5, 0x66, 0x63, 0xc2, 0xd0, 0x36, 0xac, 0xe7, 0xcf, 0xc0, 0x95, 0x1a, 0xec, 0x7e,
+	0xe3, 0xe7, 0x05, 0x98, 0x64, 0x32, 0x9e, 0xae, 0xed, 0x73, 0xa2, 0xf9, 0x44, 0x4e, 0x34, 0x4f,
+	0x5d, 0xe3, 0x96, 0x2e, 0x77, 0xc1, 0xbe, 0xcb, 0x8f, 0x8f, 0x38, 0x24, 0x42, 0x2e, 0x72, 0x7c,
+	0xac, 0xf0, 0x71, 0xdb, 0x91, 0xf1, 0x17, 0x4d, 0xc4, 0x86, 0x1d, 0xdc, 0x72, 0xc7, 0x85, 0xd4,
+	0x43, 0x98, 0xeb, 0xbf, 0xc1, 0x2d, 0x0e, 0x3f, 0x5a, 0xcf, 0x06, 0x29, 0x4a, 0x4a, 0xc9, 0x89,
+	0xcb, 0x29, 0xb9, 0x07, 0xf3, 0x09, 0x1d, 0xe3, 0x0b, 0x91, 0x4a, 0x18, 0x05, 0xd8, 0xee, 0x08,
+	0xec, 0xd4, 0x76, 0x66, 0x79, 0xa5, 0x59, 0x3e, 0xa1, 0x1d, 0x47, 0x7b, 0x66, 0x99, 0xb1, 0x1c,
+	0x39, 0xc6, 0xef, 0x35, 0x21, 0x26, 0x71, 0x24, 0xfd, 0xa2, 0xb6, 0x4a, 0xac, 0xa2, 0x38, 0x6c,
+	0x15, 0x24, 0x68, 0x89, 0x3b, 0x86, 0xa6, 0xdf, 0xf3, 0x22, 0xfe, 0x9e, 0x52, 0x0b, 0xc5, 0x09,
+	0xb3, 0x97, 0x5b, 0x5c, 0x94, 0xf2, 0x8a, 0x8b, 0x8f, 0x35, 0x58, 0x48, 0x6a, 0x26, 0xaf, 0x08,
+	0x7d, 0x4a, 0xef, 0xbf, 0x22, 0x64, 0xfc, 0x26, 0xef, 0xcf, 0x8c, 0x2e, 0x85, 0xcb, 0x47, 0x97,
+	0x2e, 0xcc, 0xb2, 0x09, 0x0e, 0xf0, 0xb8, 0xec, 0x3c, 0x24, 0x7a, 0xbe, 0x06, 0x73, 0xca, 0x8c,
+	0x17, 0xd5, 0xdf, 0xf8, 0xb8, 0x00, 0x73, 0x32, 0x77, 0x0d, 0x5b, 0xf2, 0x05, 0xae, 0x4b, 0xb7,
+	0xe0, 0x9a, 0x64, 0xed, 0x3f, 0x95, 0xcc, 0xc7, 0x9d, 0x27, 0xf2, 0x78, 0xf2, 0x12, 0x2c, 0xc8,
+	0x31, 0x7d, 0x07, 0x15, 0x09, 0x8d, 0xfd, 0xa7, 0x79, 0x62, 0xf9, 0x5b, 0x01, 0x90, 0x6a, 0x11,
+	0x6e, 0xd2, 0xaf, 0x24, 0x53, 0xff, 0x8d, 0xac, 0xd4, 0x9f, 0x95, 0xf2, 0x33, 0xcf, 0x29, 0x3f,
+	0x2c, 0xf0, 0x32, 0xa0, 0x1f, 0x03, 0x5a, 0x16, 0x06, 0x12, 0xa7, 0x91, 0x42, 0xf2, 0x34, 0x92,
+	0xb3, 0x69, 0x8a, 0xa3, 0xc5, 0xf0, 0x89, 0x71, 0xc4, 0xf0, 0xd2, 0xe5, 0xc2, 0xdb, 0x5f, 0x35,
+	0x98, 0xe7, 0x3b, 0x67, 0x9c, 0x41, 0xfc, 0xf2, 0x81, 0xc9, 0xf5, 0x1c, 0xfc, 0x24, 0x15, 0x98,
+	0x8e, 0x08, 0xed, 0x0b, 0xdd, 0x97, 0x1a, 0xbf, 0xd1, 0x60, 0x21, 0xa9, 0x27, 0xc7, 0xd4, 0xff,
+	0xec, 0x35, 0xe7, 0x27, 0x85, 0x58, 0xa3, 0xff, 0x92, 0x9c, 0x92, 0xef, 0x3a, 0xa5, 0x10, 0x2e,
+	0x5d, 0xea, 0xc2, 0x74, 0x72, 0xe4, 0x0b, 0xd3, 0x25, 0xb8, 0x96, 0xb2, 0x0a, 0x3f, 0xb2, 0xbc,
+	0x09, 0xb5, 0x1d, 0x3b, 0x6a, 0x3e, 0x10, 0x66, 0xfa, 0x32, 0x94, 0x03, 0xf6, 0x29, 0x1c, 0xae,
+	0x2b, 0x8f, 0x58, 0x0a, 0x27, 0x0d, 0x24, 0x31, 0xaf, 0xf1, 0xaf, 0x22, 0xcc, 0xa6, 0xbb, 0xd1,
+	0x36, 0xd4, 0x58, 0x30, 0xb7, 0x4e, 0x09, 0xba, 0x78, 0xc8, 0x5f, 0x4e, 0x07, 0x28, 0x75, 0x8b,
+	0x1d, 0x5e, 0x31, 0xab, 0xbe, 0xa4, 0xa2, 0x3d, 0x98, 0xe2, 0x22, 0x9a, 0x74, 0xe1, 0xfc, 0x86,
+	0x64, 0x25, 0x2d, 0x23, 0xe1, 0xec, 0xc3, 0x2b, 0x66, 0xcd, 0x57, 0xc8, 0xe4, 0x45, 0x82, 0x4b,
+	0x69, 0x61, 0xf9, 0xfb, 0x45, 0x4a, 0x84, 0x4c, 0x8c, 0x87, 0x57, 0xcc, 0x8a, 0x2f, 0x68, 0xe8,
+	0xeb, 0xc0, 0x57, 0x64, 0xb5, 0xdd, 0x30, 0x8a, 0xdf, 0x33, 0x32, 0xa3, 0xac, 0x18, 0x0e, 0x7e,
+	0x4c, 0x24, 0x2a, 0x08, 0x2c, 0x30, 0x33, 0x94, 0xd2, 0x2a, 0x64, 0x84, 0x1a, 0xa2, 0x42, 0xa8,
+	0x90, 0xd1, 0x01, 0x4c, 0xcb, 0x2a, 0xa5, 0x23, 0x52, 0x47, 0x75, 0x6b, 0xb5, 0x4f, 0x4c, 0xda,
+	0x14, 0x53, 0xa1, 0x4a, 0x47, 0x6f, 0x49, 0x41, 0xac, 0x46, 0xa0, 0x89, 0x26, 0x91, 0x37, 0x72,
+	0xee, 0x3d, 0x15, 0x59, 0xac, 0x6b, 0xa7, 0x02, 0x57, 0x79, 0xb7, 0xf1, 0x08, 0xa6, 0xb8, 0xff,
+	0x79, 0x08, 0xf9, 0x2a, 0x49, 0x80, 0xec, 0x5b, 0x40, 0xa9, 0xd1, 0x07, 0x25, 0xd6, 0x4f, 0xb1,
+	0x24, 0xb9, 0xd1, 0x73, 0x50, 0xc2, 0x41, 0xe0, 0x8b, 0xeb, 0xb0, 0x85, 0xd4, 0xb0, 0x7d, 0xd2,
+	0x67, 0x32, 0x16, 0xe3, 0x6b, 0x00, 0x92, 0x48, 0x52, 0x5a, 0xd3, 0x77, 0xd8, 0xe5, 0x56, 0xc9,
+	0xa4, 0xdf, 0xe4, 0x26, 0xa4, 0x83, 0xc3, 0xd0, 0x6e, 0xb1, 0x4c, 0x57, 0x31, 0x45, 0xd3, 0xf8,
+	0xf1, 0x04, 0xcc, 0xf5, 0x2d, 0x04, 0xed, 0x64, 0xa2, 0x76, 0x25, 0x07, 0xb5, 0x6c, 0x60, 0x1a,
+	0xb6, 0xfb, 0xd9, 0xb0, 0x5d, 0xcd, 0x83, 0x6d, 0x2c, 0x25, 0x89, 0xdb, 0x57, 0x33, 0x70, 0xdb,
+	0xc8, 0xc4, 0x6d, 0x2c, 0x40, 0x01, 0xee, 0xeb, 0x59, 0xc0, 0x5d, 0x1e, 0x54, 0x1e, 0xa4, 0x90,
+	0xbb, 0x9f, 0x8d, 0xdc, 0xd5, 0x3c, 0xe4, 0x4a, 0x2d, 0x12, 0xd0, 0x3d, 0xcc, 0x81, 0xee, 0x5a,
+	0x2e, 0x74, 0x63, 0x41, 0x29, 0xec, 0xbe, 0x9d, 0x83, 0xdd, 0x11, 0x2a, 0xe2, 0x7e, 0xf0, 0x02,
+	0x94, 0xe3, 0x30, 0xf8, 0x5b, 0x0d, 0xf4, 0x63, 0xbb, 0xf9, 0x10, 0x3b, 0xcc, 0x28, 0xe1, 0x68,
+	0xc9, 0xe3, 0x62, 0x37, 0xb7, 0x19, 0x11, 0xbc, 0x38, 0x6a, 0x04, 0x47, 0x2f, 0xc1, 0x55, 0xe6,
+	0x19, 0xf2, 0x4c, 0x4f, 0x06, 0x2d, 0x4a, 0x9d, 0xd5, 0x95, 0x9b, 0x82, 0xcd, 0xf8, 0x44, 0x83,
+	0x9a, 0xda, 0x33, 0x6a, 0x49, 0xb7, 0x08, 0x93, 0xfe, 0xd9, 0x59, 0x88, 0x19, 0x68, 0x8b, 0x26,
+	0x6f, 0x11, 0x7a, 0x1b, 0x7b, 0xad, 0xe8, 0x01, 0x7f, 0xc8, 0xe0, 0xad, 0x0b, 0x9e, 0xd4, 0x8d,
+	0x9f, 0x69, 0xd0, 0xc8, 0x34, 0x35, 0x8f, 0x1b, 0xcf, 0x49, 0x45, 0x59, 0xd4, 0xe8, 0x3f, 0x22,
+	0x08, 0x86, 0x31, 0x9f, 0x91, 0xb6, 0x7e, 0x39, 0x0f, 0xe5, 0x7b, 0x7c, 0x18, 0xba, 0x07, 0x35,
+	0x76, 0x4d, 0xc2, 0x6f, 0x17, 0x57, 0xd2, 0x3f, 0x73, 0x24, 0x7e, 0xf5, 0xd2, 0x57, 0xf3, 0xba,
+	0xb9, 0x5a, 0x7b, 0x50, 0x39, 0xc0, 0x11, 0x97, 0xa5, 0xa7, 0x99, 0x65, 0xee, 0xd1, 0x1b, 0x99,
+	0x7d, 0xf1, 0x95, 0x53, 0x8d, 0xa3, 0x37, 0x67, 0x51, 0x89, 0x98, 0xad, 0xaf, 0xe6, 0x75, 0xc7,
+	0x65, 0x5e, 0x95, 0x6c, 0x74, 0xd6, 0x17, 0xa2, 0x46, 0xd6, 0xff, 0x3d, 0x42, 0xd6, 0x72, 0x76,
+	0x27, 0x97, 0x84, 0x49, 0xd9, 0xc5, 0x05, 0x29, 0x4f, 0x04, 0xe8, 0x76, 0x7a, 0x54, 0xe6, 0xf3,
+	0x84, 0xfe, 0xcc, 0x30, 0x36, 0x3e, 0xcd, 0x29, 0xcc, 0xc7, 0xd3, 0xc8, 0x1f, 0x70, 0xd0, 0xad,
+	0x8c, 0xe1, 0x7d, 0x7f, 0xfc, 0xe8, 0xb7, 0x87, 0x70, 0xf1, 0x39, 0x2c, 0x40, 0xf1, 0x1c, 0xf2,
+	0x3f, 0x97, 0x9b, 0x19, 0x83, 0xd3, 0xbf, 0x90, 0xe8, 0xb7, 0x06, 0x33, 0xc9, 0x09, 0x0e, 0x46,
+	0x98, 0xe0, 0x60, 0x94, 0x09, 0x32, 0xff, 0x4b, 0x79, 0x0f, 0x66, 0x19, 0xfa, 0x38, 0xb2, 0xc9,
+	0xeb, 0xcf, 0x7a, 0xdf, 0x86, 0x48, 0xfd, 0xfc, 0xa1, 0xdf, 0xc8, 0xe3, 0x90, 0xef, 0x62, 0xdf,
+	0x82, 0x59, 0xb6, 0x5b, 0x15, 0xc1, 0x37, 0x06, 0x17, 0x20, 0x44, 0xb2, 0x31, 0x24, 0xd0, 0x13,
+	0x31, 0x27, 0x30, 0xad, 0x3c, 0xdb, 0x12, 0x4a, 0x7f, 0x7a, 0x48, 0x3e, 0x27, 0xeb, 0xeb, 0x39,
+	0x0c, 0x52, 0xa8, 0x05, 0x48, 0x3c, 0xa9, 0x2b, 0x2b, 0xbe, 0xd9, 0x1f, 0x1b, 0xfa, 0x9e, 0xfe,
+	0xf5, 0x5b, 0x03, 0x98, 0x12, 0x06, 0x61, 0x5b, 0x6a, 0xa0, 0x41, 0xd2, 0x85, 0x94, 0x3e, 0x42,
+	0x74, 0x42, 0xef, 0xc2, 0x8c, 0xfa, 0xd8, 0x98, 0xf2, 0x61, 0xf6, 0xbb, 0xac, 0x7e, 0x23, 0x8f,
+	0x43, 0xca, 0xfd, 0x36, 0xcc, 0x25, 0x37, 0x17, 0x21, 0x26, 0x16, 0x94, 0xfd, 0x7e, 0xa8, 0xdf,
+	0xcc, 0xe7, 0x91, 0xd2, 0xdf, 0x82, 0xaa, 0xf2, 0xe2, 0x87, 0x94, 0xa0, 0xd1, 0xff, 0x3c, 0xa8,
+	0xaf, 0xe4, 0xf4, 0x72, 0x18, 0x1f, 0x00, 0x90, 0x27, 0x2a, 0x9e, 0xbd, 0x1a, 0xfd, 0xb5, 0x53,
+	0xf7, 0x3c, 0x23, 0x38, 0x65, 0xbc, 0x6d, 0x1d, 0x00, 0x90, 0x77, 0x9a, 0x3c, 0x41, 0xca, 0x0b,
+	0x95, 0xbe, 0x9c, 0xdd, 0x29, 0x77, 0x2e, 0x31, 0x2b, 0xeb, 0x11, 0xcf, 0x29, 0x2a, 0x9e, 0x72,
+	0x9f, 0x7f, 0xf4, 0x5b, 0x83, 0x99, 0x64, 0x7c, 0x63, 0x48, 0x48, 0x4c, 0x81, 0xfa, 0x06, 0x67,
+	0x3d, 0x86, 0xe8, 0xb7, 0x87, 0x70, 0xf1, 0x39, 0x1e, 0x02, 0xea, 0xbf, 0xff, 0x47, 0x7d, 0x2f,
+	0x46, 0xb9, 0xaf, 0x10, 0xfa, 0x73, 0xa3, 0xb0, 0xf2, 0xc9, 0xde, 0x82, 0x2a, 0x2d, 0x02, 0xb9,
+	0xed, 0x07, 0x9e, 0xfd, 0xf4, 0xc1, 0x35, 0x36, 0xcd, 0xc8, 0x34, 0x6e, 0x70, 0x61, 0x83, 0x0f,
+	0x81, 0xfa, 0x90, 0x62, 0x9b, 0x67, 0x64, 0x2e, 0x6b, 0xc0, 0x69, 0x50, 0x1f, 0x54, 0x71, 0x8b,
+	0x14, 0x7a, 0x9f, 0x57, 0x24, 0x83, 0xce, 0x85, 0xfa, 0xc0, 0xda, 0x9b, 0xa8, 0x47, 0xf5, 0x3d,
+	0x11, 0xff, 0xb9, 0x0c, 0x3c, 0x20, 0xea, 0x43, 0xaa, 0x70, 0x74, 0x0c, 0x53, 0x89, 0x58, 0x8d,
+	0x86, 0x9c, 0x14, 0xf5, 0x61, 0xe5, 0x38, 0x7a, 0x05, 0x4a, 0xf4, 0xb4, 0x84, 0x16, 0xb3, 0xaf,
+	0x04, 0xf4, 0xa5, 0x9c, 0xf3, 0x1d, 0x81, 0x35, 0x93, 0x95, 0x28, 0xfc, 0x54, 0x58, 0xe7, 0x17,
+	0xdf, 0xfa, 0xed, 0x21, 0x5c, 0x6c, 0x8e, 0x9d, 0x89, 0x0f, 0x0a, 0xdd, 0xd3, 0xd3, 0x49, 0x7a,
+	0xc5, 0xf7, 0xf2, 0xbf, 0x07, 0x00, 0x23, 0xde, 0x71, 0x55, 0x4e, 0x32, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
@@ -1613,6 +4406,9 @@ type MetainfoClient interface {
 	DeleteBucket(ctx context.Context, in *BucketDeleteRequest, opts ...grpc.CallOption) (*BucketDeleteResponse, error)
 	ListBuckets(ctx context.Context, in *BucketListRequest, opts ...grpc.CallOption) (*BucketListResponse, error)
 	SetBucketAttribution(ctx context.Context, in *BucketSetAttributionRequest, opts ...grpc.CallOption) (*BucketSetAttributionResponse, error)
+	SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest, opts ...grpc.CallOption) (*BucketSetVersioningResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *BucketSetLifecycleRequest, opts ...grpc.CallOption) (*BucketSetLifecycleResponse, error)
+	GetBucketLifecycle(ctx context.Context, in *BucketGetLifecycleRequest, opts ...grpc.CallOption) (*BucketGetLifecycleResponse, error)
 	CreateSegmentOld(ctx context.Context, in *SegmentWriteRequestOld, opts ...grpc.CallOption) (*SegmentWriteResponseOld, error)
 	CommitSegmentOld(ctx context.Context, in *SegmentCommitRequestOld, opts ...grpc.CallOption) (*SegmentCommitResponseOld, error)
 	SegmentInfoOld(ctx context.Context, in *SegmentInfoRequestOld, opts ...grpc.CallOption) (*SegmentInfoResponseOld, error)
@@ -1621,6 +4417,19 @@ type MetainfoClient interface {
 	ListSegmentsOld(ctx context.Context, in *ListSegmentsRequestOld, opts ...grpc.CallOption) (*ListSegmentsResponseOld, error)
 	SetAttributionOld(ctx context.Context, in *SetAttributionRequestOld, opts ...grpc.CallOption) (*SetAttributionResponseOld, error)
 	ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error)
+	CopyObject(ctx context.Context, in *ObjectCopyRequest, opts ...grpc.CallOption) (*ObjectCopyResponse, error)
+	MoveObject(ctx context.Context, in *ObjectMoveRequest, opts ...grpc.CallOption) (*ObjectMoveResponse, error)
+	ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest, opts ...grpc.CallOption) (*ObjectListVersionsResponse, error)
+	DeleteObjectVersion(ctx context.Context, in *ObjectDeleteVersionRequest, opts ...grpc.CallOption) (*ObjectDeleteVersionResponse, error)
+	CreateDeleteMarker(ctx context.Context, in *ObjectCreateDeleteMarkerRequest, opts ...grpc.CallOption) (*ObjectCreateDeleteMarkerResponse, error)
+	BeginObject(ctx context.Context, in *ObjectBeginRequest, opts ...grpc.CallOption) (*ObjectBeginResponse, error)
+	CommitObject(ctx context.Context, in *ObjectCommitRequest, opts ...grpc.CallOption) (*ObjectCommitResponse, error)
+	GetObject(ctx context.Context, in *ObjectGetRequest, opts ...grpc.CallOption) (*ObjectGetResponse, error)
+	ListObjects(ctx context.Context, in *ObjectListRequest, opts ...grpc.CallOption) (*ObjectListResponse, error)
+	BeginSegment(ctx context.Context, in *SegmentBeginRequest, opts ...grpc.CallOption) (*SegmentBeginResponse, error)
+	CommitSegment(ctx context.Context, in *SegmentCommitRequest, opts ...grpc.CallOption) (*SegmentCommitResponse, error)
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	CommitPackedObjects(ctx context.Context, in *PackedObjectsCommitRequest, opts ...grpc.CallOption) (*PackedObjectsCommitResponse, error)
 }
 
 type metainfoClient struct {
@@ -1676,6 +4485,33 @@ func (c *metainfoClient) SetBucketAttribution(ctx context.Context, in *BucketSet
 	return out, nil
 }
 
+func (c *metainfoClient) SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest, opts ...grpc.CallOption) (*BucketSetVersioningResponse, error) {
+	out := new(BucketSetVersioningResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketVersioning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetBucketLifecycle(ctx context.Context, in *BucketSetLifecycleRequest, opts ...grpc.CallOption) (*BucketSetLifecycleResponse, error) {
+	out := new(BucketSetLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) GetBucketLifecycle(ctx context.Context, in *BucketGetLifecycleRequest, opts ...grpc.CallOption) (*BucketGetLifecycleResponse, error) {
+	out := new(BucketGetLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/GetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metainfoClient) CreateSegmentOld(ctx context.Context, in *SegmentWriteRequestOld, opts ...grpc.CallOption) (*SegmentWriteResponseOld, error) {
 	out := new(SegmentWriteResponseOld)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CreateSegmentOld", in, out, opts...)
@@ -1703,45 +4539,162 @@ func (c *metainfoClient) SegmentInfoOld(ctx context.Context, in *SegmentInfoRequ
 	return out, nil
 }
 
-func (c *metainfoClient) DownloadSegmentOld(ctx context.Context, in *SegmentDownloadRequestOld, opts ...grpc.CallOption) (*SegmentDownloadResponseOld, error) {
-	out := new(SegmentDownloadResponseOld)
-	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DownloadSegmentOld", in, out, opts...)
+func (c *metainfoClient) DownloadSegmentOld(ctx context.Context, in *SegmentDownloadRequestOld, opts ...grpc.CallOption) (*SegmentDownloadResponseOld, error) {
+	out := new(SegmentDownloadResponseOld)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DownloadSegmentOld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) DeleteSegmentOld(ctx context.Context, in *SegmentDeleteRequestOld, opts ...grpc.CallOption) (*SegmentDeleteResponseOld, error) {
+	out := new(SegmentDeleteResponseOld)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DeleteSegmentOld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) ListSegmentsOld(ctx context.Context, in *ListSegmentsRequestOld, opts ...grpc.CallOption) (*ListSegmentsResponseOld, error) {
+	out := new(ListSegmentsResponseOld)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ListSegmentsOld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetAttributionOld(ctx context.Context, in *SetAttributionRequestOld, opts ...grpc.CallOption) (*SetAttributionResponseOld, error) {
+	out := new(SetAttributionResponseOld)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetAttributionOld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error) {
+	out := new(ProjectInfoResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ProjectInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) CopyObject(ctx context.Context, in *ObjectCopyRequest, opts ...grpc.CallOption) (*ObjectCopyResponse, error) {
+	out := new(ObjectCopyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CopyObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) MoveObject(ctx context.Context, in *ObjectMoveRequest, opts ...grpc.CallOption) (*ObjectMoveResponse, error) {
+	out := new(ObjectMoveResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/MoveObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest, opts ...grpc.CallOption) (*ObjectListVersionsResponse, error) {
+	out := new(ObjectListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ListObjectVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) DeleteObjectVersion(ctx context.Context, in *ObjectDeleteVersionRequest, opts ...grpc.CallOption) (*ObjectDeleteVersionResponse, error) {
+	out := new(ObjectDeleteVersionResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DeleteObjectVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) CreateDeleteMarker(ctx context.Context, in *ObjectCreateDeleteMarkerRequest, opts ...grpc.CallOption) (*ObjectCreateDeleteMarkerResponse, error) {
+	out := new(ObjectCreateDeleteMarkerResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CreateDeleteMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) BeginObject(ctx context.Context, in *ObjectBeginRequest, opts ...grpc.CallOption) (*ObjectBeginResponse, error) {
+	out := new(ObjectBeginResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/BeginObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) CommitObject(ctx context.Context, in *ObjectCommitRequest, opts ...grpc.CallOption) (*ObjectCommitResponse, error) {
+	out := new(ObjectCommitResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CommitObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) GetObject(ctx context.Context, in *ObjectGetRequest, opts ...grpc.CallOption) (*ObjectGetResponse, error) {
+	out := new(ObjectGetResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/GetObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) ListObjects(ctx context.Context, in *ObjectListRequest, opts ...grpc.CallOption) (*ObjectListResponse, error) {
+	out := new(ObjectListResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ListObjects", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *metainfoClient) DeleteSegmentOld(ctx context.Context, in *SegmentDeleteRequestOld, opts ...grpc.CallOption) (*SegmentDeleteResponseOld, error) {
-	out := new(SegmentDeleteResponseOld)
-	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DeleteSegmentOld", in, out, opts...)
+func (c *metainfoClient) BeginSegment(ctx context.Context, in *SegmentBeginRequest, opts ...grpc.CallOption) (*SegmentBeginResponse, error) {
+	out := new(SegmentBeginResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/BeginSegment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *metainfoClient) ListSegmentsOld(ctx context.Context, in *ListSegmentsRequestOld, opts ...grpc.CallOption) (*ListSegmentsResponseOld, error) {
-	out := new(ListSegmentsResponseOld)
-	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ListSegmentsOld", in, out, opts...)
+func (c *metainfoClient) CommitSegment(ctx context.Context, in *SegmentCommitRequest, opts ...grpc.CallOption) (*SegmentCommitResponse, error) {
+	out := new(SegmentCommitResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CommitSegment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *metainfoClient) SetAttributionOld(ctx context.Context, in *SetAttributionRequestOld, opts ...grpc.CallOption) (*SetAttributionResponseOld, error) {
-	out := new(SetAttributionResponseOld)
-	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetAttributionOld", in, out, opts...)
+func (c *metainfoClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/Batch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *metainfoClient) ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error) {
-	out := new(ProjectInfoResponse)
-	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/ProjectInfo", in, out, opts...)
+func (c *metainfoClient) CommitPackedObjects(ctx context.Context, in *PackedObjectsCommitRequest, opts ...grpc.CallOption) (*PackedObjectsCommitResponse, error) {
+	out := new(PackedObjectsCommitResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CommitPackedObjects", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1755,6 +4708,9 @@ type MetainfoServer interface {
 	DeleteBucket(context.Context, *BucketDeleteRequest) (*BucketDeleteResponse, error)
 	ListBuckets(context.Context, *BucketListRequest) (*BucketListResponse, error)
 	SetBucketAttribution(context.Context, *BucketSetAttributionRequest) (*BucketSetAttributionResponse, error)
+	SetBucketVersioning(context.Context, *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error)
+	SetBucketLifecycle(context.Context, *BucketSetLifecycleRequest) (*BucketSetLifecycleResponse, error)
+	GetBucketLifecycle(context.Context, *BucketGetLifecycleRequest) (*BucketGetLifecycleResponse, error)
 	CreateSegmentOld(context.Context, *SegmentWriteRequestOld) (*SegmentWriteResponseOld, error)
 	CommitSegmentOld(context.Context, *SegmentCommitRequestOld) (*SegmentCommitResponseOld, error)
 	SegmentInfoOld(context.Context, *SegmentInfoRequestOld) (*SegmentInfoResponseOld, error)
@@ -1763,6 +4719,19 @@ type MetainfoServer interface {
 	ListSegmentsOld(context.Context, *ListSegmentsRequestOld) (*ListSegmentsResponseOld, error)
 	SetAttributionOld(context.Context, *SetAttributionRequestOld) (*SetAttributionResponseOld, error)
 	ProjectInfo(context.Context, *ProjectInfoRequest) (*ProjectInfoResponse, error)
+	CopyObject(context.Context, *ObjectCopyRequest) (*ObjectCopyResponse, error)
+	MoveObject(context.Context, *ObjectMoveRequest) (*ObjectMoveResponse, error)
+	ListObjectVersions(context.Context, *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error)
+	DeleteObjectVersion(context.Context, *ObjectDeleteVersionRequest) (*ObjectDeleteVersionResponse, error)
+	CreateDeleteMarker(context.Context, *ObjectCreateDeleteMarkerRequest) (*ObjectCreateDeleteMarkerResponse, error)
+	BeginObject(context.Context, *ObjectBeginRequest) (*ObjectBeginResponse, error)
+	CommitObject(context.Context, *ObjectCommitRequest) (*ObjectCommitResponse, error)
+	GetObject(context.Context, *ObjectGetRequest) (*ObjectGetResponse, error)
+	ListObjects(context.Context, *ObjectListRequest) (*ObjectListResponse, error)
+	BeginSegment(context.Context, *SegmentBeginRequest) (*SegmentBeginResponse, error)
+	CommitSegment(context.Context, *SegmentCommitRequest) (*SegmentCommitResponse, error)
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	CommitPackedObjects(context.Context, *PackedObjectsCommitRequest) (*PackedObjectsCommitResponse, error)
 }
 
 func RegisterMetainfoServer(s *grpc.Server, srv MetainfoServer) {
@@ -1859,6 +4828,60 @@ func _Metainfo_SetBucketAttribution_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_SetBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketSetVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketVersioning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketVersioning(ctx, req.(*BucketSetVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketSetLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, req.(*BucketSetLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_GetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketGetLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).GetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/GetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).GetBucketLifecycle(ctx, req.(*BucketGetLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metainfo_CreateSegmentOld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SegmentWriteRequestOld)
 	if err := dec(in); err != nil {
@@ -2003,6 +5026,240 @@ func _Metainfo_ProjectInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/CopyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).CopyObject(ctx, req.(*ObjectCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_MoveObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).MoveObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/MoveObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).MoveObject(ctx, req.(*ObjectMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_ListObjectVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).ListObjectVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/ListObjectVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).ListObjectVersions(ctx, req.(*ObjectListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_DeleteObjectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectDeleteVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).DeleteObjectVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/DeleteObjectVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).DeleteObjectVersion(ctx, req.(*ObjectDeleteVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_CreateDeleteMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectCreateDeleteMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).CreateDeleteMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/CreateDeleteMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).CreateDeleteMarker(ctx, req.(*ObjectCreateDeleteMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_BeginObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectBeginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).BeginObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/BeginObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).BeginObject(ctx, req.(*ObjectBeginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_CommitObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).CommitObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/CommitObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).CommitObject(ctx, req.(*ObjectCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).GetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/GetObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).GetObject(ctx, req.(*ObjectGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/ListObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).ListObjects(ctx, req.(*ObjectListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_BeginSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentBeginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).BeginSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/BeginSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).BeginSegment(ctx, req.(*SegmentBeginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_CommitSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).CommitSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/CommitSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).CommitSegment(ctx, req.(*SegmentCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_CommitPackedObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PackedObjectsCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).CommitPackedObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/CommitPackedObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).CommitPackedObjects(ctx, req.(*PackedObjectsCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Metainfo_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metainfo.Metainfo",
 	HandlerType: (*MetainfoServer)(nil),
@@ -2027,6 +5284,18 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBucketAttribution",
 			Handler:    _Metainfo_SetBucketAttribution_Handler,
 		},
+		{
+			MethodName: "SetBucketVersioning",
+			Handler:    _Metainfo_SetBucketVersioning_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _Metainfo_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "GetBucketLifecycle",
+			Handler:    _Metainfo_GetBucketLifecycle_Handler,
+		},
 		{
 			MethodName: "CreateSegmentOld",
 			Handler:    _Metainfo_CreateSegmentOld_Handler,
@@ -2059,6 +5328,58 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProjectInfo",
 			Handler:    _Metainfo_ProjectInfo_Handler,
 		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _Metainfo_CopyObject_Handler,
+		},
+		{
+			MethodName: "MoveObject",
+			Handler:    _Metainfo_MoveObject_Handler,
+		},
+		{
+			MethodName: "ListObjectVersions",
+			Handler:    _Metainfo_ListObjectVersions_Handler,
+		},
+		{
+			MethodName: "DeleteObjectVersion",
+			Handler:    _Metainfo_DeleteObjectVersion_Handler,
+		},
+		{
+			MethodName: "CreateDeleteMarker",
+			Handler:    _Metainfo_CreateDeleteMarker_Handler,
+		},
+		{
+			MethodName: "BeginObject",
+			Handler:    _Metainfo_BeginObject_Handler,
+		},
+		{
+			MethodName: "CommitObject",
+			Handler:    _Metainfo_CommitObject_Handler,
+		},
+		{
+			MethodName: "GetObject",
+			Handler:    _Metainfo_GetObject_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _Metainfo_ListObjects_Handler,
+		},
+		{
+			MethodName: "BeginSegment",
+			Handler:    _Metainfo_BeginSegment_Handler,
+		},
+		{
+			MethodName: "CommitSegment",
+			Handler:    _Metainfo_CommitSegment_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _Metainfo_Batch_Handler,
+		},
+		{
+			MethodName: "CommitPackedObjects",
+			Handler:    _Metainfo_CommitPackedObjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metainfo.proto",
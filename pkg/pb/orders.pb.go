@@ -30,13 +30,14 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type PieceAction int32
 
 const (
-	PieceAction_INVALID    PieceAction = 0
-	PieceAction_PUT        PieceAction = 1
-	PieceAction_GET        PieceAction = 2
-	PieceAction_GET_AUDIT  PieceAction = 3
-	PieceAction_GET_REPAIR PieceAction = 4
-	PieceAction_PUT_REPAIR PieceAction = 5
-	PieceAction_DELETE     PieceAction = 6
+	PieceAction_INVALID           PieceAction = 0
+	PieceAction_PUT               PieceAction = 1
+	PieceAction_GET               PieceAction = 2
+	PieceAction_GET_AUDIT         PieceAction = 3
+	PieceAction_GET_REPAIR        PieceAction = 4
+	PieceAction_PUT_REPAIR        PieceAction = 5
+	PieceAction_DELETE            PieceAction = 6
+	PieceAction_PUT_GRACEFUL_EXIT PieceAction = 7
 )
 
 var PieceAction_name = map[int32]string{
@@ -47,16 +48,18 @@ var PieceAction_name = map[int32]string{
 	4: "GET_REPAIR",
 	5: "PUT_REPAIR",
 	6: "DELETE",
+	7: "PUT_GRACEFUL_EXIT",
 }
 
 var PieceAction_value = map[string]int32{
-	"INVALID":    0,
-	"PUT":        1,
-	"GET":        2,
-	"GET_AUDIT":  3,
-	"GET_REPAIR": 4,
-	"PUT_REPAIR": 5,
-	"DELETE":     6,
+	"INVALID":           0,
+	"PUT":               1,
+	"GET":               2,
+	"GET_AUDIT":         3,
+	"GET_REPAIR":        4,
+	"PUT_REPAIR":        5,
+	"DELETE":            6,
+	"PUT_GRACEFUL_EXIT": 7,
 }
 
 func (x PieceAction) String() string {
@@ -654,61 +657,62 @@ func init() {
 func init() { proto.RegisterFile("orders.proto", fileDescriptor_e0f5d4cf0fc9e41b) }
 
 var fileDescriptor_e0f5d4cf0fc9e41b = []byte{
-	// 859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xee, 0x34, 0x89, 0xd3, 0xbc, 0x38, 0xa9, 0x33, 0x5b, 0xad, 0x42, 0x04, 0x6a, 0x09, 0x97,
-	0xb0, 0x48, 0x29, 0x1b, 0x24, 0xa4, 0x95, 0x50, 0xa5, 0xa4, 0xb1, 0x8a, 0x69, 0xd5, 0x8d, 0x26,
-	0x29, 0x07, 0x2e, 0x91, 0x13, 0x0f, 0xae, 0xb5, 0x8e, 0x6d, 0x3c, 0x63, 0x89, 0xdd, 0x2b, 0xe2,
-	0xc6, 0x81, 0x7f, 0x88, 0x3b, 0x07, 0x24, 0xee, 0x1c, 0x96, 0xff, 0x83, 0x13, 0x9a, 0xe7, 0x5f,
-	0x29, 0x74, 0x05, 0xed, 0x2e, 0x12, 0x70, 0xf3, 0x9b, 0xf7, 0xbe, 0xf7, 0xe6, 0xcd, 0xfb, 0xbe,
-	0x67, 0xd0, 0xc3, 0xd8, 0xe1, 0xb1, 0x18, 0x46, 0x71, 0x28, 0x43, 0xaa, 0xa5, 0x56, 0x0f, 0xdc,
-	0xd0, 0x0d, 0xd3, 0xb3, 0xde, 0xa1, 0x1b, 0x86, 0xae, 0xcf, 0x8f, 0xd1, 0x5a, 0x25, 0x5f, 0x1e,
-	0x4b, 0x6f, 0xc3, 0x85, 0xb4, 0x37, 0x51, 0x16, 0x00, 0x41, 0xe8, 0xf0, 0xf4, 0xbb, 0xff, 0x8d,
-	0x06, 0xf0, 0x54, 0xe5, 0xb8, 0xf0, 0x36, 0x9e, 0xa4, 0x4f, 0xa0, 0x25, 0x78, 0xec, 0xd9, 0xfe,
-	0x32, 0x48, 0x36, 0x2b, 0x1e, 0x77, 0xc9, 0x11, 0x19, 0xe8, 0x93, 0x83, 0x1f, 0x5f, 0x1e, 0xee,
-	0xfc, 0xf2, 0xf2, 0x50, 0x9f, 0xa3, 0xf3, 0x12, 0x7d, 0x4c, 0x17, 0x5b, 0x16, 0x7d, 0x0c, 0xba,
-	0xb0, 0x25, 0xf7, 0x7d, 0x4f, 0xf2, 0xa5, 0xe7, 0x74, 0x77, 0x11, 0xd9, 0xce, 0x90, 0xda, 0x65,
-	0xe8, 0x70, 0x6b, 0xca, 0x9a, 0x45, 0x8c, 0xe5, 0xd0, 0x4f, 0xe0, 0xc0, 0xe1, 0x51, 0xcc, 0xd7,
-	0xb6, 0xe4, 0xce, 0x32, 0x89, 0x7c, 0x2f, 0x78, 0xa6, 0xa0, 0x15, 0x84, 0xc2, 0x16, 0x8c, 0x96,
-	0x71, 0x57, 0x18, 0x66, 0x39, 0x74, 0x02, 0x9d, 0x0c, 0x12, 0x25, 0x2b, 0xdf, 0x5b, 0x2f, 0x9f,
-	0xf1, 0xe7, 0xdd, 0x16, 0x42, 0x1f, 0x66, 0x55, 0xdb, 0x33, 0x8f, 0xaf, 0xf9, 0x0c, 0xdd, 0xe7,
-	0xfc, 0x39, 0xdb, 0x4f, 0x01, 0xc5, 0x01, 0xfd, 0x18, 0xf6, 0x85, 0x0c, 0x63, 0xdb, 0xe5, 0x4b,
-	0xf5, 0x28, 0xaa, 0x78, 0xf5, 0xd6, 0x7b, 0xb7, 0xb2, 0x30, 0x34, 0x1d, 0xfa, 0x08, 0xf6, 0x22,
-	0x95, 0x5a, 0x01, 0x6a, 0x08, 0xd8, 0xcf, 0x00, 0x75, 0x2c, 0x69, 0x4d, 0x59, 0x1d, 0x03, 0x2c,
-	0x87, 0x1e, 0x40, 0xcd, 0x57, 0x8f, 0xdb, 0xd5, 0x8e, 0xc8, 0xa0, 0xc2, 0x52, 0x83, 0x7e, 0x00,
-	0x9a, 0xbd, 0x96, 0x5e, 0x18, 0x74, 0xeb, 0x47, 0x64, 0xd0, 0x1e, 0x3d, 0x18, 0x66, 0x83, 0x45,
-	0xfc, 0x18, 0x5d, 0x2c, 0x0b, 0xa1, 0x4f, 0xc1, 0x48, 0xcb, 0xf1, 0xaf, 0x23, 0x2f, 0xb6, 0x11,
-	0xb6, 0x77, 0x44, 0x06, 0xcd, 0x51, 0x6f, 0x98, 0x4e, 0x7b, 0x98, 0x4f, 0x7b, 0xb8, 0xc8, 0xa7,
-	0x3d, 0xd9, 0x53, 0x57, 0xfa, 0xfe, 0xd7, 0x43, 0xc2, 0xf6, 0x11, 0x6d, 0x16, 0x60, 0x95, 0x10,
-	0xcb, 0x6d, 0x27, 0x6c, 0xdc, 0x25, 0x21, 0xa2, 0xb7, 0x12, 0x9e, 0x43, 0x3b, 0x4d, 0xb8, 0x8e,
-	0x79, 0x9a, 0x4e, 0xbf, 0x43, 0xba, 0x16, 0x62, 0x4f, 0x33, 0x28, 0x3d, 0x86, 0x07, 0x25, 0x95,
-	0x84, 0xe7, 0x06, 0xb6, 0x4c, 0x62, 0xde, 0x05, 0xf5, 0xd0, 0x8c, 0x16, 0xae, 0x79, 0xee, 0xa1,
-	0x27, 0xd0, 0x29, 0x01, 0xb6, 0xe3, 0xc4, 0x5c, 0x88, 0x6e, 0x13, 0x2f, 0xd0, 0x19, 0x22, 0xdb,
-	0xd5, 0xdc, 0xc6, 0xa9, 0x83, 0x19, 0x45, 0x6c, 0x76, 0xd2, 0xff, 0xad, 0x06, 0x9d, 0x52, 0x05,
-	0x2a, 0xaf, 0x17, 0xb8, 0xff, 0x29, 0x31, 0x9c, 0xbc, 0x5a, 0x0c, 0xf4, 0x7f, 0x24, 0x84, 0xf3,
-	0x7b, 0x09, 0xa1, 0x7a, 0xbb, 0x08, 0xce, 0xef, 0x25, 0x82, 0xea, 0xed, 0x02, 0x38, 0xbb, 0x87,
-	0x00, 0xaa, 0xff, 0x0a, 0xf2, 0x7f, 0x4b, 0xa0, 0x86, 0xe4, 0x7f, 0x1d, 0xc2, 0x3f, 0x04, 0xcd,
-	0xde, 0x84, 0x49, 0x20, 0x91, 0xea, 0x15, 0x96, 0x59, 0xf4, 0x7d, 0x30, 0x32, 0x5e, 0x96, 0xad,
-	0x20, 0xa3, 0x73, 0x0a, 0x16, 0x7d, 0xf4, 0xbf, 0x23, 0xa0, 0xe3, 0x3d, 0xde, 0x80, 0xfe, 0xde,
-	0xc0, 0x75, 0x7e, 0x22, 0xd0, 0x40, 0x0a, 0x7e, 0x6a, 0x8b, 0xeb, 0x1b, 0x3c, 0x27, 0x7f, 0xc1,
-	0x73, 0x0a, 0xd5, 0x6b, 0x5b, 0x5c, 0xa7, 0xa2, 0x67, 0xf8, 0x4d, 0xdf, 0x01, 0x48, 0xf1, 0xc2,
-	0x7b, 0xc1, 0x51, 0x5a, 0x15, 0xd6, 0xc0, 0x93, 0xb9, 0xf7, 0x82, 0xd3, 0x09, 0x34, 0x8a, 0xbf,
-	0x34, 0xea, 0xe8, 0xef, 0x6e, 0xce, 0x12, 0x46, 0xdf, 0x86, 0xc6, 0x1f, 0x9b, 0x2a, 0x0f, 0xfa,
-	0x3f, 0x13, 0x30, 0x8a, 0x76, 0xf2, 0x17, 0xfe, 0x87, 0xbb, 0x3a, 0xb9, 0x5b, 0x57, 0xd5, 0xbb,
-	0x75, 0xb4, 0x82, 0xce, 0x9c, 0x4b, 0xe9, 0xf3, 0x0d, 0x0f, 0x24, 0xe3, 0x5f, 0x25, 0x5c, 0x48,
-	0x3a, 0xc8, 0x77, 0x0c, 0xc1, 0x72, 0x34, 0x5f, 0x26, 0xe5, 0x76, 0xcf, 0xf7, 0xce, 0x7b, 0x50,
-	0x43, 0x1f, 0x36, 0xd4, 0x1c, 0xb5, 0x6e, 0x44, 0xb2, 0xd4, 0xd7, 0xff, 0x81, 0x00, 0xdd, 0x2e,
-	0x22, 0xa2, 0x30, 0x10, 0xfc, 0x75, 0x98, 0xf9, 0x04, 0x34, 0x21, 0x6d, 0x99, 0x08, 0xac, 0xdb,
-	0x1e, 0xbd, 0x9b, 0xd7, 0xfd, 0x73, 0x99, 0xe1, 0x1c, 0x03, 0x59, 0x06, 0xe8, 0x3f, 0x06, 0x2d,
-	0x3d, 0xa1, 0x4d, 0xa8, 0x5b, 0x97, 0x9f, 0x8f, 0x2f, 0xac, 0xa9, 0xb1, 0x43, 0x75, 0xd8, 0x1b,
-	0x9f, 0x9e, 0x9a, 0xb3, 0x85, 0x39, 0x35, 0x88, 0xb2, 0x98, 0xf9, 0x99, 0x79, 0xaa, 0xac, 0xdd,
-	0x47, 0x2e, 0x34, 0xb7, 0xd6, 0xe8, 0x4d, 0x5c, 0x1d, 0x2a, 0xb3, 0xab, 0x85, 0x41, 0xd4, 0xc7,
-	0x99, 0xb9, 0x30, 0x76, 0x69, 0x0b, 0x1a, 0x67, 0xe6, 0x62, 0x39, 0xbe, 0x9a, 0x5a, 0x0b, 0xa3,
-	0x42, 0xdb, 0x00, 0xca, 0x64, 0xe6, 0x6c, 0x6c, 0x31, 0xa3, 0xaa, 0xec, 0xd9, 0x55, 0x61, 0xd7,
-	0x28, 0x80, 0x36, 0x35, 0x2f, 0xcc, 0x85, 0x69, 0x68, 0xa3, 0x39, 0x68, 0xf8, 0x70, 0x82, 0x5a,
-	0x00, 0x65, 0x2b, 0xf4, 0xad, 0xdb, 0xda, 0xc3, 0x51, 0xf5, 0x7a, 0xaf, 0xee, 0xbc, 0xbf, 0x33,
-	0x20, 0x1f, 0x92, 0x49, 0xf5, 0x8b, 0xdd, 0x68, 0xb5, 0xd2, 0x90, 0x2a, 0x1f, 0xfd, 0x1e, 0x00,
-	0x00, 0xff, 0xff, 0x87, 0x49, 0x03, 0x47, 0xfa, 0x0a, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0x4e, 0xc7, 0xf6, 0x38, 0x2e, 0x3f, 0x32, 0xee, 0x0d, 0x2b, 0x63, 0x81, 0x12, 0xcc, 0xc5,
+	0x2c, 0x92, 0xc3, 0x1a, 0x09, 0x69, 0x25, 0x14, 0xc9, 0x8f, 0x21, 0x0c, 0x89, 0xb2, 0x56, 0xdb,
+	0x46, 0x88, 0x8b, 0x35, 0xf6, 0x34, 0xce, 0x68, 0xc7, 0x33, 0xc3, 0x74, 0x8f, 0xc4, 0xee, 0x81,
+	0x0b, 0xe2, 0xc6, 0x81, 0x3f, 0xc4, 0x9d, 0x03, 0x12, 0x77, 0x0e, 0xcb, 0xff, 0xe0, 0x84, 0xba,
+	0xe6, 0xe5, 0x40, 0x56, 0x2b, 0x67, 0x17, 0x09, 0xb8, 0x4d, 0x75, 0xd5, 0x57, 0xd5, 0xd5, 0xf5,
+	0x7d, 0x35, 0x50, 0xf3, 0x43, 0x9b, 0x87, 0xa2, 0x17, 0x84, 0xbe, 0xf4, 0xa9, 0x16, 0x5b, 0x6d,
+	0x58, 0xfb, 0x6b, 0x3f, 0x3e, 0x6b, 0x1f, 0xaf, 0x7d, 0x7f, 0xed, 0xf2, 0x53, 0xb4, 0x96, 0xd1,
+	0x57, 0xa7, 0xd2, 0xd9, 0x70, 0x21, 0xad, 0x4d, 0x90, 0x04, 0x80, 0xe7, 0xdb, 0x3c, 0xfe, 0xee,
+	0x7c, 0xa7, 0x01, 0x3c, 0x56, 0x39, 0x2e, 0x9d, 0x8d, 0x23, 0xe9, 0x23, 0xa8, 0x0b, 0x1e, 0x3a,
+	0x96, 0xbb, 0xf0, 0xa2, 0xcd, 0x92, 0x87, 0x2d, 0x72, 0x42, 0xba, 0xb5, 0xe1, 0xd1, 0xcf, 0xcf,
+	0x8f, 0xf7, 0x7e, 0x7b, 0x7e, 0x5c, 0x9b, 0xa2, 0xf3, 0x0a, 0x7d, 0xac, 0x26, 0xb6, 0x2c, 0xfa,
+	0x10, 0x6a, 0xc2, 0x92, 0xdc, 0x75, 0x1d, 0xc9, 0x17, 0x8e, 0xdd, 0xda, 0x47, 0x64, 0x23, 0x41,
+	0x6a, 0x57, 0xbe, 0xcd, 0xcd, 0x31, 0xab, 0x66, 0x31, 0xa6, 0x4d, 0x3f, 0x86, 0x23, 0x9b, 0x07,
+	0x21, 0x5f, 0x59, 0x92, 0xdb, 0x8b, 0x28, 0x70, 0x1d, 0xef, 0x89, 0x82, 0x16, 0x10, 0x0a, 0x5b,
+	0x30, 0x9a, 0xc7, 0xcd, 0x31, 0xcc, 0xb4, 0xe9, 0x10, 0x9a, 0x09, 0x24, 0x88, 0x96, 0xae, 0xb3,
+	0x5a, 0x3c, 0xe1, 0x4f, 0x5b, 0x75, 0x84, 0xde, 0x4f, 0xaa, 0x36, 0x26, 0x0e, 0x5f, 0xf1, 0x09,
+	0xba, 0x2f, 0xf8, 0x53, 0x76, 0x18, 0x03, 0xb2, 0x03, 0xfa, 0x11, 0x1c, 0x0a, 0xe9, 0x87, 0xd6,
+	0x9a, 0x2f, 0xd4, 0xa3, 0xa8, 0xe2, 0xc5, 0x5b, 0xef, 0x5d, 0x4f, 0xc2, 0xd0, 0xb4, 0xe9, 0x03,
+	0x38, 0x08, 0x54, 0x6a, 0x05, 0x28, 0x21, 0xe0, 0x30, 0x01, 0x94, 0xb1, 0xa4, 0x39, 0x66, 0x65,
+	0x0c, 0x30, 0x6d, 0x7a, 0x04, 0x25, 0x57, 0x3d, 0x6e, 0x4b, 0x3b, 0x21, 0xdd, 0x02, 0x8b, 0x0d,
+	0xfa, 0x3e, 0x68, 0xd6, 0x4a, 0x3a, 0xbe, 0xd7, 0x2a, 0x9f, 0x90, 0x6e, 0xa3, 0x7f, 0xaf, 0x97,
+	0x0c, 0x16, 0xf1, 0x03, 0x74, 0xb1, 0x24, 0x84, 0x3e, 0x06, 0x3d, 0x2e, 0xc7, 0xbf, 0x09, 0x9c,
+	0xd0, 0x42, 0xd8, 0xc1, 0x09, 0xe9, 0x56, 0xfb, 0xed, 0x5e, 0x3c, 0xed, 0x5e, 0x3a, 0xed, 0xde,
+	0x2c, 0x9d, 0xf6, 0xf0, 0x40, 0x5d, 0xe9, 0xc7, 0xdf, 0x8f, 0x09, 0x3b, 0x44, 0xb4, 0x91, 0x81,
+	0x55, 0x42, 0x2c, 0xb7, 0x9d, 0xb0, 0xb2, 0x4b, 0x42, 0x44, 0x6f, 0x25, 0xbc, 0x80, 0x46, 0x9c,
+	0x70, 0x15, 0xf2, 0x38, 0x5d, 0x6d, 0x87, 0x74, 0x75, 0xc4, 0x8e, 0x12, 0x28, 0x3d, 0x85, 0x7b,
+	0x39, 0x95, 0x84, 0xb3, 0xf6, 0x2c, 0x19, 0x85, 0xbc, 0x05, 0xea, 0xa1, 0x19, 0xcd, 0x5c, 0xd3,
+	0xd4, 0x43, 0xcf, 0xa0, 0x99, 0x03, 0x2c, 0xdb, 0x0e, 0xb9, 0x10, 0xad, 0x2a, 0x5e, 0xa0, 0xd9,
+	0x43, 0xb6, 0xab, 0xb9, 0x0d, 0x62, 0x07, 0xd3, 0xb3, 0xd8, 0xe4, 0xa4, 0xf3, 0x47, 0x09, 0x9a,
+	0xb9, 0x0a, 0x54, 0x5e, 0xc7, 0x5b, 0xff, 0xa7, 0xc4, 0x70, 0xf6, 0x62, 0x31, 0xd0, 0xff, 0x91,
+	0x10, 0x2e, 0xee, 0x24, 0x84, 0xe2, 0xed, 0x22, 0xb8, 0xb8, 0x93, 0x08, 0x8a, 0xb7, 0x0b, 0xe0,
+	0xfc, 0x0e, 0x02, 0x28, 0xfe, 0x2b, 0xc8, 0xff, 0x3d, 0x81, 0x12, 0x92, 0xff, 0x55, 0x08, 0x7f,
+	0x1f, 0x34, 0x6b, 0xe3, 0x47, 0x9e, 0x44, 0xaa, 0x17, 0x58, 0x62, 0xd1, 0xf7, 0x40, 0x4f, 0x78,
+	0x99, 0xb7, 0x82, 0x8c, 0x4e, 0x29, 0x98, 0xf5, 0xd1, 0xf9, 0x81, 0x40, 0x0d, 0xef, 0xf1, 0x1a,
+	0xf4, 0xf7, 0x1a, 0xae, 0xf3, 0x0b, 0x81, 0x0a, 0x52, 0xf0, 0x53, 0x4b, 0x5c, 0xdf, 0xe0, 0x39,
+	0x79, 0x09, 0xcf, 0x29, 0x14, 0xaf, 0x2d, 0x71, 0x1d, 0x8b, 0x9e, 0xe1, 0x37, 0x7d, 0x1b, 0x20,
+	0xc6, 0x0b, 0xe7, 0x19, 0x47, 0x69, 0x15, 0x58, 0x05, 0x4f, 0xa6, 0xce, 0x33, 0x4e, 0x87, 0x50,
+	0xc9, 0xfe, 0xd2, 0xad, 0xd2, 0x4b, 0x89, 0x93, 0x6f, 0xce, 0x1c, 0x46, 0xdf, 0x82, 0xca, 0x5f,
+	0x9b, 0xca, 0x0f, 0x3a, 0xbf, 0x12, 0xd0, 0xb3, 0x76, 0xd2, 0x17, 0xfe, 0x87, 0xbb, 0x3a, 0xdb,
+	0xad, 0xab, 0xe2, 0x6e, 0x1d, 0x2d, 0xa1, 0x39, 0xe5, 0x52, 0xba, 0x7c, 0xc3, 0x3d, 0xc9, 0xf8,
+	0xd7, 0x11, 0x17, 0x92, 0x76, 0xd3, 0x1d, 0x43, 0xb0, 0x1c, 0x4d, 0x97, 0x49, 0xbe, 0xdd, 0xd3,
+	0xbd, 0xf3, 0x2e, 0x94, 0xd0, 0x87, 0x0d, 0x55, 0xfb, 0xf5, 0x1b, 0x91, 0x2c, 0xf6, 0x75, 0x7e,
+	0x22, 0x40, 0xb7, 0x8b, 0x88, 0xc0, 0xf7, 0x04, 0x7f, 0x15, 0x66, 0x3e, 0x02, 0x4d, 0x48, 0x4b,
+	0x46, 0x02, 0xeb, 0x36, 0xfa, 0xef, 0xa4, 0x75, 0xff, 0x5e, 0xa6, 0x37, 0xc5, 0x40, 0x96, 0x00,
+	0x3a, 0x0f, 0x41, 0x8b, 0x4f, 0x68, 0x15, 0xca, 0xe6, 0xd5, 0xe7, 0x83, 0x4b, 0x73, 0xac, 0xef,
+	0xd1, 0x1a, 0x1c, 0x0c, 0x46, 0x23, 0x63, 0x32, 0x33, 0xc6, 0x3a, 0x51, 0x16, 0x33, 0x3e, 0x33,
+	0x46, 0xca, 0xda, 0x7f, 0xf0, 0x2d, 0x54, 0xb7, 0xd6, 0xe8, 0x4d, 0x5c, 0x19, 0x0a, 0x93, 0xf9,
+	0x4c, 0x27, 0xea, 0xe3, 0xdc, 0x98, 0xe9, 0xfb, 0xb4, 0x0e, 0x95, 0x73, 0x63, 0xb6, 0x18, 0xcc,
+	0xc7, 0xe6, 0x4c, 0x2f, 0xd0, 0x06, 0x80, 0x32, 0x99, 0x31, 0x19, 0x98, 0x4c, 0x2f, 0x2a, 0x7b,
+	0x32, 0xcf, 0xec, 0x12, 0x05, 0xd0, 0xc6, 0xc6, 0xa5, 0x31, 0x33, 0x74, 0x8d, 0xbe, 0x01, 0x4d,
+	0xe5, 0x3b, 0x67, 0x83, 0x91, 0xf1, 0xc9, 0xfc, 0x72, 0x61, 0x7c, 0x61, 0xce, 0xf4, 0x72, 0x7f,
+	0x0a, 0x1a, 0xbe, 0xa7, 0xa0, 0x26, 0x40, 0xde, 0x21, 0x7d, 0xf3, 0xb6, 0xae, 0x71, 0x82, 0xed,
+	0xf6, 0x8b, 0x1f, 0xa4, 0xb3, 0xd7, 0x25, 0x1f, 0x90, 0x61, 0xf1, 0xcb, 0xfd, 0x60, 0xb9, 0xd4,
+	0x90, 0x41, 0x1f, 0xfe, 0x39, 0x00, 0xc5, 0x1d, 0x6c, 0x8e, 0x11, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -27,13 +27,13 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // Expected order of messages from uplink:
-//   OrderLimit ->
-//   repeated
-//      Order ->
-//      Chunk ->
-//   PieceHash signed by uplink ->
-//      <- PieceHash signed by storage node
 //
+//	OrderLimit ->
+//	repeated
+//	   Order ->
+//	   Chunk ->
+//	PieceHash signed by uplink ->
+//	   <- PieceHash signed by storage node
 type PieceUploadRequest struct {
 	// first message to show that we are allowed to upload
 	Limit *OrderLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -185,11 +185,12 @@ func (m *PieceUploadResponse) GetDone() *PieceHash {
 }
 
 // Expected order of messages from uplink:
-//   {OrderLimit, Chunk} ->
-//   go repeated
-//      Order -> (async)
-//   go repeated
-//      <- PieceDownloadResponse.Chunk
+//
+//	{OrderLimit, Chunk} ->
+//	go repeated
+//	   Order -> (async)
+//	go repeated
+//	   <- PieceDownloadResponse.Chunk
 type PieceDownloadRequest struct {
 	// first message to show that we are allowed to upload
 	Limit *OrderLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -523,6 +524,66 @@ func (m *RetainResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetainResponse proto.InternalMessageInfo
 
+type RestoreTrashRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashRequest) Reset()         { *m = RestoreTrashRequest{} }
+func (m *RestoreTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashRequest) ProtoMessage()    {}
+func (*RestoreTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{8}
+}
+func (m *RestoreTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashRequest.Unmarshal(m, b)
+}
+func (m *RestoreTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashRequest.Merge(m, src)
+}
+func (m *RestoreTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashRequest.Size(m)
+}
+func (m *RestoreTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashRequest proto.InternalMessageInfo
+
+type RestoreTrashResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashResponse) Reset()         { *m = RestoreTrashResponse{} }
+func (m *RestoreTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashResponse) ProtoMessage()    {}
+func (*RestoreTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{9}
+}
+func (m *RestoreTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashResponse.Unmarshal(m, b)
+}
+func (m *RestoreTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashResponse.Merge(m, src)
+}
+func (m *RestoreTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashResponse.Size(m)
+}
+func (m *RestoreTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PieceUploadRequest)(nil), "piecestore.PieceUploadRequest")
 	proto.RegisterType((*PieceUploadRequest_Chunk)(nil), "piecestore.PieceUploadRequest.Chunk")
@@ -535,45 +596,48 @@ func init() {
 	proto.RegisterType((*PieceDeleteResponse)(nil), "piecestore.PieceDeleteResponse")
 	proto.RegisterType((*RetainRequest)(nil), "piecestore.RetainRequest")
 	proto.RegisterType((*RetainResponse)(nil), "piecestore.RetainResponse")
+	proto.RegisterType((*RestoreTrashRequest)(nil), "piecestore.RestoreTrashRequest")
+	proto.RegisterType((*RestoreTrashResponse)(nil), "piecestore.RestoreTrashResponse")
 }
 
 func init() { proto.RegisterFile("piecestore2.proto", fileDescriptor_23ff32dd550c2439) }
 
 var fileDescriptor_23ff32dd550c2439 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6e, 0x13, 0x3d,
-	0x14, 0xcd, 0xe4, 0x67, 0xd4, 0xef, 0x7e, 0x09, 0xa2, 0x86, 0xa2, 0x60, 0x09, 0x52, 0x86, 0xbf,
-	0xac, 0xa6, 0x28, 0xdd, 0xa1, 0x52, 0xa4, 0x92, 0x05, 0x08, 0x10, 0x95, 0xa1, 0x1b, 0x36, 0x95,
-	0x93, 0xb9, 0x49, 0x2d, 0x26, 0xe3, 0x61, 0xec, 0x08, 0xa9, 0xaf, 0xc0, 0x86, 0x47, 0x62, 0xc9,
-	0x53, 0xc0, 0x82, 0xc7, 0x60, 0x83, 0xc6, 0x3f, 0x2d, 0x93, 0x86, 0x44, 0x20, 0xb1, 0x9a, 0xb1,
-	0xef, 0x39, 0xf7, 0x1e, 0x9f, 0x63, 0xc3, 0x66, 0x2e, 0x70, 0x8c, 0x4a, 0xcb, 0x02, 0x07, 0x71,
-	0x5e, 0x48, 0x2d, 0x09, 0x9c, 0x6f, 0x51, 0x98, 0xca, 0xa9, 0xb4, 0xfb, 0xb4, 0x37, 0x95, 0x72,
-	0x9a, 0xe2, 0x8e, 0x59, 0x8d, 0xe6, 0x93, 0x1d, 0x2d, 0x66, 0xa8, 0x34, 0x9f, 0xe5, 0x0e, 0xd0,
-	0x96, 0x45, 0x82, 0x85, 0xb2, 0xab, 0xe8, 0x47, 0x00, 0xe4, 0xb0, 0xec, 0x74, 0x94, 0xa7, 0x92,
-	0x27, 0x0c, 0xdf, 0xcf, 0x51, 0x69, 0xd2, 0x87, 0x56, 0x2a, 0x66, 0x42, 0x77, 0x83, 0xed, 0xa0,
-	0xff, 0xff, 0x80, 0xc4, 0x8e, 0xf4, 0xaa, 0xfc, 0xbc, 0x28, 0x2b, 0xcc, 0x02, 0xc8, 0x6d, 0x68,
-	0x99, 0x5a, 0xb7, 0x6e, 0x90, 0x9d, 0x0a, 0x92, 0xd9, 0x1a, 0x79, 0x08, 0xad, 0xf1, 0xc9, 0x3c,
-	0x7b, 0xd7, 0x6d, 0x18, 0xd0, 0x9d, 0xf8, 0x5c, 0x7c, 0x7c, 0x71, 0x7a, 0xfc, 0xa4, 0xc4, 0x32,
-	0x4b, 0x21, 0x77, 0xa1, 0x99, 0xc8, 0x0c, 0xbb, 0x4d, 0x43, 0xdd, 0xf4, 0xfd, 0x0d, 0xed, 0x29,
-	0x57, 0x27, 0xcc, 0x94, 0xe9, 0x2e, 0xb4, 0x0c, 0x8d, 0x5c, 0x83, 0x50, 0x4e, 0x26, 0x0a, 0xad,
-	0xf6, 0x06, 0x73, 0x2b, 0x42, 0xa0, 0x99, 0x70, 0xcd, 0x8d, 0xce, 0x36, 0x33, 0xff, 0xd1, 0x1e,
-	0x5c, 0xa9, 0x8c, 0x57, 0xb9, 0xcc, 0x14, 0x9e, 0x8d, 0x0c, 0x56, 0x8e, 0x8c, 0xbe, 0x07, 0x70,
-	0xd5, 0xec, 0x0d, 0xe5, 0x87, 0xec, 0x1f, 0xba, 0xb7, 0x57, 0x75, 0xef, 0xde, 0x05, 0xf7, 0x16,
-	0xe6, 0x57, 0xfc, 0xa3, 0xfb, 0xeb, 0x8c, 0xb9, 0x01, 0x60, 0x90, 0xc7, 0x4a, 0x9c, 0xa2, 0x11,
-	0xd2, 0x60, 0xff, 0x99, 0x9d, 0xd7, 0xe2, 0x14, 0xa3, 0x8f, 0x01, 0x6c, 0x2d, 0x4c, 0x71, 0x36,
-	0x3d, 0xf2, 0xba, 0xec, 0x31, 0xef, 0xaf, 0xd0, 0x65, 0x19, 0x55, 0x61, 0x7f, 0x95, 0xd8, 0xbe,
-	0xbb, 0xae, 0x43, 0x4c, 0x51, 0xe3, 0x1f, 0x1b, 0x1e, 0x6d, 0xb9, 0xc4, 0x3d, 0xdf, 0x0a, 0x8b,
-	0x0a, 0xe8, 0x30, 0xd4, 0x5c, 0x64, 0xbe, 0xe3, 0x33, 0xe8, 0x8c, 0x0b, 0xe4, 0x5a, 0xc8, 0xec,
-	0x38, 0xe1, 0xda, 0xdf, 0x05, 0x1a, 0xdb, 0xe7, 0x15, 0xfb, 0xe7, 0x15, 0xbf, 0xf1, 0xcf, 0xeb,
-	0x60, 0xe3, 0xcb, 0xd7, 0x5e, 0xed, 0xd3, 0xb7, 0x5e, 0xc0, 0xda, 0x9e, 0x3a, 0xe4, 0x1a, 0xcb,
-	0xe3, 0x4d, 0x44, 0xaa, 0x5d, 0xc8, 0x6d, 0xe6, 0x56, 0xd1, 0x65, 0xb8, 0xe4, 0x67, 0x5a, 0x15,
-	0x83, 0xcf, 0x75, 0x80, 0xc3, 0x33, 0x0f, 0xc9, 0x4b, 0x08, 0xed, 0xc5, 0x24, 0x37, 0x57, 0x3f,
-	0x18, 0xda, 0xfb, 0x6d, 0xdd, 0x9d, 0xaf, 0xd6, 0x0f, 0xc8, 0x11, 0x6c, 0xf8, 0x40, 0xc8, 0xf6,
-	0xba, 0x3b, 0x44, 0x6f, 0xad, 0x4d, 0xb3, 0x6c, 0xfa, 0x20, 0x20, 0xcf, 0x21, 0xb4, 0x66, 0x2e,
-	0x51, 0x59, 0x49, 0x69, 0x89, 0xca, 0x85, 0x14, 0x6a, 0xe4, 0x31, 0x84, 0xd6, 0x13, 0x72, 0xfd,
-	0x57, 0x70, 0x25, 0x1b, 0x4a, 0x97, 0x95, 0x6c, 0x8b, 0x83, 0xe6, 0xdb, 0x7a, 0x3e, 0x1a, 0x85,
-	0x26, 0x9e, 0xdd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xd5, 0x7e, 0x72, 0x38, 0x05, 0x00,
-	0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0x9b, 0xc4, 0x2a, 0x83, 0x8b, 0xe8, 0xa6, 0xa9, 0xc2, 0x4a, 0xe0, 0x60, 0xfe, 0x72,
+	0x72, 0x51, 0x7a, 0x43, 0xa5, 0x48, 0x25, 0x07, 0x10, 0x20, 0xaa, 0x6d, 0x7b, 0xe1, 0x52, 0x39,
+	0xf1, 0x24, 0xb5, 0x70, 0xbc, 0xc6, 0xde, 0x08, 0xa9, 0xaf, 0xc0, 0x85, 0x0b, 0xef, 0xc4, 0x53,
+	0xc0, 0x81, 0xc7, 0xe0, 0x82, 0xbc, 0x3f, 0x6d, 0xb7, 0x69, 0x12, 0x81, 0xc4, 0x29, 0xd9, 0x99,
+	0xef, 0x9b, 0xf9, 0xfc, 0xcd, 0x0c, 0x6c, 0xe4, 0x09, 0x0e, 0xb1, 0x14, 0xbc, 0xc0, 0x5e, 0x98,
+	0x17, 0x5c, 0x70, 0x02, 0x17, 0x21, 0x0a, 0x63, 0x3e, 0xe6, 0x2a, 0x4e, 0xfd, 0x31, 0xe7, 0xe3,
+	0x14, 0xb7, 0xe5, 0x6b, 0x30, 0x1d, 0x6d, 0x8b, 0x64, 0x82, 0xa5, 0x88, 0x26, 0xb9, 0x06, 0x78,
+	0xbc, 0x88, 0xb1, 0x28, 0xd5, 0x2b, 0xf8, 0xed, 0x00, 0x39, 0xa8, 0x2a, 0x1d, 0xe7, 0x29, 0x8f,
+	0x62, 0x86, 0x9f, 0xa6, 0x58, 0x0a, 0xd2, 0x85, 0x46, 0x9a, 0x4c, 0x12, 0xd1, 0x76, 0x3a, 0x4e,
+	0xf7, 0x66, 0x8f, 0x84, 0x9a, 0xf4, 0xbe, 0xfa, 0x79, 0x5b, 0x65, 0x98, 0x02, 0x90, 0x07, 0xd0,
+	0x90, 0xb9, 0xf6, 0xaa, 0x44, 0xae, 0x5b, 0x48, 0xa6, 0x72, 0xe4, 0x19, 0x34, 0x86, 0xa7, 0xd3,
+	0xec, 0x63, 0xbb, 0x26, 0x41, 0x0f, 0xc3, 0x0b, 0xf1, 0xe1, 0x6c, 0xf7, 0xf0, 0x65, 0x85, 0x65,
+	0x8a, 0x42, 0x1e, 0x41, 0x3d, 0xe6, 0x19, 0xb6, 0xeb, 0x92, 0xba, 0x61, 0xea, 0x4b, 0xda, 0xab,
+	0xa8, 0x3c, 0x65, 0x32, 0x4d, 0x77, 0xa0, 0x21, 0x69, 0x64, 0x0b, 0x5c, 0x3e, 0x1a, 0x95, 0xa8,
+	0xb4, 0xd7, 0x98, 0x7e, 0x11, 0x02, 0xf5, 0x38, 0x12, 0x91, 0xd4, 0xe9, 0x31, 0xf9, 0x3f, 0xd8,
+	0x85, 0xa6, 0xd5, 0xbe, 0xcc, 0x79, 0x56, 0xe2, 0x79, 0x4b, 0x67, 0x61, 0xcb, 0xe0, 0x97, 0x03,
+	0x9b, 0x32, 0xd6, 0xe7, 0x9f, 0xb3, 0xff, 0xe8, 0xde, 0xae, 0xed, 0xde, 0xe3, 0x19, 0xf7, 0xae,
+	0xf4, 0xb7, 0xfc, 0xa3, 0x7b, 0xcb, 0x8c, 0xb9, 0x0b, 0x20, 0x91, 0x27, 0x65, 0x72, 0x86, 0x52,
+	0x48, 0x8d, 0xdd, 0x90, 0x91, 0xc3, 0xe4, 0x0c, 0x83, 0x2f, 0x0e, 0xb4, 0xae, 0x74, 0xd1, 0x36,
+	0x3d, 0x37, 0xba, 0xd4, 0x67, 0x3e, 0x59, 0xa0, 0x4b, 0x31, 0x6c, 0x61, 0xff, 0x34, 0xb1, 0x3d,
+	0xbd, 0xae, 0x7d, 0x4c, 0x51, 0xe0, 0x5f, 0x1b, 0x1e, 0xb4, 0xa0, 0x69, 0xf1, 0x95, 0xb0, 0xa0,
+	0x80, 0x75, 0x86, 0x22, 0x4a, 0x32, 0x53, 0xf1, 0x35, 0xac, 0x0f, 0x0b, 0x8c, 0x44, 0xc2, 0xb3,
+	0x93, 0x38, 0x12, 0x66, 0x17, 0x68, 0xa8, 0xce, 0x2b, 0x34, 0xe7, 0x15, 0x1e, 0x99, 0xf3, 0xda,
+	0x5f, 0xfb, 0xfe, 0xc3, 0x5f, 0xf9, 0xfa, 0xd3, 0x77, 0x98, 0x67, 0xa8, 0xfd, 0x48, 0x60, 0xf5,
+	0x79, 0xa3, 0x24, 0x15, 0x7a, 0xc8, 0x1e, 0xd3, 0xaf, 0xe0, 0x36, 0xdc, 0x32, 0x3d, 0xb5, 0x8a,
+	0x16, 0x34, 0x99, 0xf2, 0xef, 0xa8, 0xa8, 0xd6, 0x4c, 0x69, 0x09, 0xb6, 0x60, 0xd3, 0x0e, 0x2b,
+	0x78, 0xef, 0x5b, 0x0d, 0xe0, 0xe0, 0xdc, 0x72, 0xf2, 0x0e, 0x5c, 0xb5, 0xc7, 0xe4, 0xde, 0xe2,
+	0xfb, 0xa2, 0xfe, 0xdc, 0xbc, 0x16, 0xb2, 0xd2, 0x75, 0xc8, 0x31, 0xac, 0x99, 0xf9, 0x91, 0xce,
+	0xb2, 0x95, 0xa3, 0xf7, 0x97, 0x0e, 0xbf, 0x2a, 0xfa, 0xd4, 0x21, 0x6f, 0xc0, 0x55, 0xde, 0x5f,
+	0xa3, 0xd2, 0x1a, 0x2a, 0xf5, 0xe7, 0xe6, 0x4d, 0x41, 0xf2, 0x02, 0x5c, 0x65, 0x21, 0xb9, 0x73,
+	0x19, 0x6c, 0x8d, 0x92, 0xd2, 0xeb, 0x52, 0x7a, 0x85, 0x0f, 0xc1, 0xbb, 0x6c, 0x2d, 0xf1, 0x6d,
+	0xec, 0xcc, 0x2c, 0x68, 0x67, 0x3e, 0xc0, 0xa8, 0xda, 0xaf, 0x7f, 0x58, 0xcd, 0x07, 0x03, 0x57,
+	0xae, 0xc8, 0xce, 0x9f, 0x01, 0x00, 0x06, 0x0d, 0xa9, 0xfb, 0xbc, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -592,6 +656,7 @@ type PiecestoreClient interface {
 	Download(ctx context.Context, opts ...grpc.CallOption) (Piecestore_DownloadClient, error)
 	Delete(ctx context.Context, in *PieceDeleteRequest, opts ...grpc.CallOption) (*PieceDeleteResponse, error)
 	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error)
+	RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error)
 }
 
 type piecestoreClient struct {
@@ -685,12 +750,22 @@ func (c *piecestoreClient) Retain(ctx context.Context, in *RetainRequest, opts .
 	return out, nil
 }
 
+func (c *piecestoreClient) RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error) {
+	out := new(RestoreTrashResponse)
+	err := c.cc.Invoke(ctx, "/piecestore.Piecestore/RestoreTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PiecestoreServer is the server API for Piecestore service.
 type PiecestoreServer interface {
 	Upload(Piecestore_UploadServer) error
 	Download(Piecestore_DownloadServer) error
 	Delete(context.Context, *PieceDeleteRequest) (*PieceDeleteResponse, error)
 	Retain(context.Context, *RetainRequest) (*RetainResponse, error)
+	RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error)
 }
 
 func RegisterPiecestoreServer(s *grpc.Server, srv PiecestoreServer) {
@@ -785,6 +860,24 @@ func _Piecestore_Retain_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Piecestore_RestoreTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PiecestoreServer).RestoreTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestore.Piecestore/RestoreTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PiecestoreServer).RestoreTrash(ctx, req.(*RestoreTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Piecestore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestore.Piecestore",
 	HandlerType: (*PiecestoreServer)(nil),
@@ -797,6 +890,10 @@ var _Piecestore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Retain",
 			Handler:    _Piecestore_Retain_Handler,
 		},
+		{
+			MethodName: "RestoreTrash",
+			Handler:    _Piecestore_RestoreTrash_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
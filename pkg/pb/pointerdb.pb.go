@@ -256,16 +256,26 @@ func (m *RemoteSegment) GetMerkleRoot() []byte {
 }
 
 type Pointer struct {
-	Type                 Pointer_DataType `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
-	InlineSegment        []byte           `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
-	Remote               *RemoteSegment   `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
-	SegmentSize          int64            `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
-	CreationDate         time.Time        `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3,stdtime" json:"creation_date"`
-	ExpirationDate       time.Time        `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date"`
-	Metadata             []byte           `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Type           Pointer_DataType `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
+	InlineSegment  []byte           `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
+	Remote         *RemoteSegment   `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
+	SegmentSize    int64            `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	CreationDate   time.Time        `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3,stdtime" json:"creation_date"`
+	ExpirationDate time.Time        `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date"`
+	Metadata       []byte           `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	VersionId      string           `protobuf:"bytes,9,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	DeleteMarker   bool             `protobuf:"varint,10,opt,name=delete_marker,json=deleteMarker,proto3" json:"delete_marker,omitempty"`
+	// set when the remote segment holds the data of several small objects
+	Packed *PackedRange `protobuf:"bytes,11,opt,name=packed,proto3" json:"packed,omitempty"`
+	// number of pointers referring to a packed or copied segment, the
+	// segment is deleted along with the last of them
+	References int64 `protobuf:"varint,12,opt,name=references,proto3" json:"references,omitempty"`
+	// placement policy of a packed or copied segment, it doesn't belong to
+	// a single bucket
+	Placement            string   `protobuf:"bytes,13,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pointer) Reset()         { *m = Pointer{} }
@@ -341,6 +351,99 @@ func (m *Pointer) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Pointer) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *Pointer) GetDeleteMarker() bool {
+	if m != nil {
+		return m.DeleteMarker
+	}
+	return false
+}
+
+func (m *Pointer) GetPacked() *PackedRange {
+	if m != nil {
+		return m.Packed
+	}
+	return nil
+}
+
+func (m *Pointer) GetReferences() int64 {
+	if m != nil {
+		return m.References
+	}
+	return 0
+}
+
+func (m *Pointer) GetPlacement() string {
+	if m != nil {
+		return m.Placement
+	}
+	return ""
+}
+
+// PackedRange locates the data of an object within a packed or copied remote
+// segment
+type PackedRange struct {
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length int64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	// path of the packed segment, the pointer of a packed object has no pieces
+	// of its own
+	Segment              string   `protobuf:"bytes,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PackedRange) Reset()         { *m = PackedRange{} }
+func (m *PackedRange) String() string { return proto.CompactTextString(m) }
+func (*PackedRange) ProtoMessage()    {}
+func (*PackedRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{4}
+}
+func (m *PackedRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PackedRange.Unmarshal(m, b)
+}
+func (m *PackedRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PackedRange.Marshal(b, m, deterministic)
+}
+func (m *PackedRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackedRange.Merge(m, src)
+}
+func (m *PackedRange) XXX_Size() int {
+	return xxx_messageInfo_PackedRange.Size(m)
+}
+func (m *PackedRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackedRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackedRange proto.InternalMessageInfo
+
+func (m *PackedRange) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PackedRange) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *PackedRange) GetSegment() string {
+	if m != nil {
+		return m.Segment
+	}
+	return ""
+}
+
 // ListResponse is a response message for the List rpc call
 type ListResponse struct {
 	Items                []*ListResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -354,7 +457,7 @@ func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75fef806d28fc810, []int{4}
+	return fileDescriptor_75fef806d28fc810, []int{5}
 }
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
@@ -401,7 +504,7 @@ func (m *ListResponse_Item) Reset()         { *m = ListResponse_Item{} }
 func (m *ListResponse_Item) String() string { return proto.CompactTextString(m) }
 func (*ListResponse_Item) ProtoMessage()    {}
 func (*ListResponse_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75fef806d28fc810, []int{4, 0}
+	return fileDescriptor_75fef806d28fc810, []int{5, 0}
 }
 func (m *ListResponse_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse_Item.Unmarshal(m, b)
@@ -449,6 +552,7 @@ func init() {
 	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
 	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
 	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
+	proto.RegisterType((*PackedRange)(nil), "pointerdb.PackedRange")
 	proto.RegisterType((*ListResponse)(nil), "pointerdb.ListResponse")
 	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
 }
@@ -456,51 +560,60 @@ func init() {
 func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_75fef806d28fc810) }
 
 var fileDescriptor_75fef806d28fc810 = []byte{
-	// 735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0x2d, 0x89, 0xa2, 0x87, 0x94, 0xad, 0x2c, 0x8a, 0x96, 0x50, 0x0a, 0xc8, 0x21, 0x90,
-	0xd6, 0x45, 0x03, 0xba, 0x60, 0x6e, 0xcd, 0xa9, 0x86, 0x0c, 0x94, 0x80, 0xa3, 0x1a, 0x2b, 0xa1,
-	0x87, 0x5e, 0x88, 0x95, 0x38, 0x11, 0x17, 0x15, 0xb9, 0xcc, 0xee, 0x0a, 0x88, 0xfd, 0x14, 0x79,
-	0x8a, 0xbe, 0x45, 0xef, 0x7d, 0x86, 0x1e, 0x92, 0x57, 0x29, 0xb8, 0x4b, 0x4a, 0x4a, 0x03, 0x14,
-	0xe8, 0x85, 0x9c, 0x9f, 0x6f, 0x7e, 0xf6, 0x9b, 0x19, 0xb8, 0xa8, 0x05, 0xaf, 0x34, 0xca, 0x7c,
-	0x15, 0xd7, 0x52, 0x68, 0x41, 0xce, 0xf6, 0x86, 0xc9, 0x74, 0x23, 0xc4, 0x66, 0x8b, 0xd7, 0xc6,
-	0xb1, 0xda, 0xbd, 0xb9, 0xd6, 0xbc, 0x44, 0xa5, 0x59, 0x59, 0x5b, 0xec, 0x04, 0x36, 0x62, 0x23,
-	0x3a, 0xb9, 0x12, 0x39, 0xb6, 0x72, 0x20, 0x64, 0x8e, 0x52, 0x59, 0x2d, 0xfa, 0xe3, 0x14, 0xc6,
-	0x14, 0xf3, 0x5d, 0x95, 0xb3, 0x6a, 0xfd, 0xb0, 0x58, 0x17, 0x58, 0x22, 0xf9, 0x11, 0xfa, 0xfa,
-	0xa1, 0xc6, 0xd0, 0xb9, 0x74, 0xae, 0xce, 0x93, 0x6f, 0xe2, 0x43, 0x1b, 0xff, 0x86, 0xc6, 0xf6,
-	0xb7, 0x7c, 0xa8, 0x91, 0x9a, 0x18, 0xf2, 0x15, 0x0c, 0x4b, 0x5e, 0x65, 0x12, 0xdf, 0x86, 0xa7,
-	0x97, 0xce, 0xd5, 0x80, 0xba, 0x25, 0xaf, 0x28, 0xbe, 0x25, 0x5f, 0xc0, 0x40, 0x0b, 0xcd, 0xb6,
-	0x61, 0xcf, 0x98, 0xad, 0x42, 0xbe, 0x83, 0xb1, 0xc4, 0x9a, 0x71, 0x99, 0xe9, 0x42, 0xa2, 0x2a,
-	0xc4, 0x36, 0x0f, 0xfb, 0x06, 0x70, 0x61, 0xed, 0xcb, 0xce, 0x4c, 0xbe, 0x87, 0x27, 0x6a, 0xb7,
-	0x5e, 0xa3, 0x52, 0x47, 0xd8, 0x81, 0xc1, 0x8e, 0x5b, 0xc7, 0x01, 0xfc, 0x02, 0x08, 0x4a, 0xa6,
-	0x76, 0x12, 0x33, 0x55, 0xb0, 0xe6, 0xcb, 0x1f, 0x31, 0x74, 0x2d, 0xba, 0xf5, 0x2c, 0x1a, 0xc7,
-	0x82, 0x3f, 0x62, 0xf4, 0x0c, 0xe0, 0xf0, 0x10, 0xe2, 0xc3, 0x30, 0x9d, 0xff, 0xfa, 0xd3, 0x5d,
-	0x3a, 0x1b, 0x9f, 0x10, 0x17, 0x4e, 0xe9, 0x62, 0xec, 0x44, 0x8f, 0xe0, 0x53, 0x2c, 0x85, 0xc6,
-	0x7b, 0x8e, 0x6b, 0x24, 0x4f, 0xe1, 0xac, 0x6e, 0x84, 0xac, 0xda, 0x95, 0x86, 0xa7, 0x01, 0xf5,
-	0x8c, 0x61, 0xbe, 0x2b, 0xc9, 0xb7, 0x30, 0x6c, 0x08, 0xcf, 0x78, 0x6e, 0x38, 0x08, 0x6e, 0xce,
-	0xff, 0xfa, 0x30, 0x3d, 0xf9, 0xfb, 0xc3, 0xd4, 0x9d, 0x8b, 0x1c, 0xd3, 0x19, 0x75, 0x1b, 0x77,
-	0x9a, 0x93, 0xe7, 0xd0, 0x2f, 0x98, 0x2a, 0x0c, 0x25, 0x7e, 0xf2, 0x24, 0x6e, 0x47, 0x63, 0x4a,
-	0xfc, 0xcc, 0x54, 0x41, 0x8d, 0x3b, 0xfa, 0xe8, 0xc0, 0xc8, 0x16, 0x5f, 0xe0, 0xa6, 0xc4, 0x4a,
-	0x93, 0x57, 0x00, 0x72, 0x3f, 0x0a, 0x53, 0xdf, 0x4f, 0x9e, 0xfe, 0xc7, 0x9c, 0xe8, 0x11, 0x9c,
-	0xbc, 0x84, 0x91, 0x14, 0x42, 0x67, 0xf6, 0x01, 0xfb, 0x26, 0x2f, 0xda, 0x26, 0x87, 0xa6, 0x7c,
-	0x3a, 0xa3, 0x7e, 0x83, 0xb2, 0x4a, 0x4e, 0x5e, 0xc1, 0x48, 0x9a, 0x16, 0x6c, 0x98, 0x0a, 0x7b,
-	0x97, 0xbd, 0x2b, 0x3f, 0xf9, 0xf2, 0x93, 0xa2, 0x7b, 0x7e, 0x68, 0x20, 0x0f, 0x8a, 0x22, 0x53,
-	0xf0, 0x4b, 0x94, 0xbf, 0x6f, 0x31, 0x6b, 0x52, 0x9a, 0x01, 0x07, 0x14, 0xac, 0x89, 0x0a, 0xa1,
-	0xa3, 0xf7, 0x3d, 0x18, 0xde, 0xdb, 0x44, 0xe4, 0xfa, 0x93, 0xed, 0x3b, 0x7e, 0x55, 0x8b, 0x88,
-	0x67, 0x4c, 0xb3, 0xa3, 0x95, 0x7b, 0x0e, 0xe7, 0xbc, 0xda, 0xf2, 0x0a, 0x33, 0x65, 0xe9, 0x31,
-	0x7c, 0x06, 0x74, 0x64, 0xad, 0x1d, 0x67, 0x3f, 0x80, 0x6b, 0x9b, 0x32, 0xf5, 0xfd, 0x24, 0xfc,
-	0xac, 0xf5, 0x16, 0x49, 0x5b, 0x1c, 0x79, 0x06, 0x41, 0x9b, 0xd1, 0xae, 0x4f, 0xb3, 0x6c, 0x3d,
-	0xea, 0xb7, 0xb6, 0x66, 0x73, 0x48, 0x0a, 0xa3, 0xb5, 0x44, 0xa6, 0xb9, 0xa8, 0xb2, 0x9c, 0x69,
-	0xbb, 0x62, 0x7e, 0x32, 0x89, 0xed, 0x79, 0xc6, 0xdd, 0x79, 0xc6, 0xcb, 0xee, 0x3c, 0x6f, 0xbc,
-	0x86, 0xe7, 0xf7, 0x1f, 0xa7, 0x0e, 0x0d, 0xba, 0xd0, 0x19, 0xd3, 0x48, 0x5e, 0xc3, 0x05, 0xbe,
-	0xab, 0xb9, 0x3c, 0x4a, 0x36, 0xfc, 0x1f, 0xc9, 0xce, 0x0f, 0xc1, 0x26, 0xdd, 0x04, 0xbc, 0x12,
-	0x35, 0xcb, 0x99, 0x66, 0xa1, 0x67, 0xf8, 0xd8, 0xeb, 0x51, 0x04, 0x5e, 0xc7, 0x21, 0x01, 0x70,
-	0xd3, 0xf9, 0x5d, 0x3a, 0xbf, 0x1d, 0x9f, 0x34, 0x32, 0xbd, 0x7d, 0xfd, 0xcb, 0xf2, 0x76, 0xec,
-	0x44, 0x7f, 0x3a, 0x10, 0xdc, 0x71, 0xa5, 0x29, 0xaa, 0x5a, 0x54, 0x0a, 0x49, 0x02, 0x03, 0xae,
-	0xb1, 0x54, 0xa1, 0x63, 0x26, 0xff, 0xf5, 0x11, 0x7d, 0xc7, 0xb8, 0x38, 0xd5, 0x58, 0x52, 0x0b,
-	0x25, 0x04, 0xfa, 0xa5, 0x90, 0x68, 0x36, 0xcc, 0xa3, 0x46, 0x9e, 0x20, 0xf4, 0x1b, 0x48, 0xe3,
-	0xab, 0x99, 0x2e, 0xcc, 0x9c, 0xcf, 0xa8, 0x91, 0xc9, 0x0b, 0x18, 0xb6, 0x59, 0x4d, 0x88, 0x9f,
-	0x90, 0xcf, 0xc7, 0x4f, 0x3b, 0x48, 0x73, 0x84, 0x5c, 0x65, 0xb5, 0xc4, 0x37, 0xfc, 0x9d, 0x99,
-	0xb9, 0x47, 0x3d, 0xae, 0xee, 0x8d, 0x7e, 0xd3, 0xff, 0xed, 0xb4, 0x5e, 0xad, 0x5c, 0xc3, 0xd9,
-	0xcb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x73, 0x81, 0x70, 0xa2, 0x4b, 0x05, 0x00, 0x00,
+	// 875 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x8e, 0xe3, 0x44,
+	0x10, 0x1d, 0x4f, 0x12, 0xc7, 0x29, 0x3b, 0x33, 0xd9, 0x16, 0x5a, 0xac, 0xec, 0x42, 0xb2, 0x41,
+	0x0b, 0x41, 0xac, 0x3c, 0x28, 0x7b, 0x63, 0x4f, 0x8c, 0x32, 0x12, 0x46, 0x33, 0x61, 0xd4, 0x19,
+	0x81, 0xc4, 0xc5, 0xea, 0x89, 0x2b, 0x49, 0x6b, 0x62, 0xb7, 0xb7, 0xbb, 0x83, 0x76, 0xe6, 0x2b,
+	0xf8, 0x0a, 0xfe, 0x82, 0x0b, 0x27, 0xbe, 0x81, 0xc3, 0xf2, 0x05, 0xfc, 0x03, 0x72, 0xb7, 0x93,
+	0x78, 0x77, 0x25, 0x24, 0x2e, 0x49, 0xd7, 0xab, 0xd7, 0x55, 0xd5, 0x55, 0xaf, 0x0c, 0xa7, 0x85,
+	0xe0, 0xb9, 0x46, 0x99, 0xde, 0x46, 0x85, 0x14, 0x5a, 0x90, 0xce, 0x1e, 0xe8, 0x0f, 0x56, 0x42,
+	0xac, 0x36, 0x78, 0x66, 0x1c, 0xb7, 0xdb, 0xe5, 0x99, 0xe6, 0x19, 0x2a, 0xcd, 0xb2, 0xc2, 0x72,
+	0xfb, 0xb0, 0x12, 0x2b, 0xb1, 0x3b, 0xe7, 0x22, 0xc5, 0xea, 0x1c, 0x08, 0x99, 0xa2, 0x54, 0xd6,
+	0x1a, 0xfd, 0x76, 0x0c, 0x3d, 0x8a, 0xe9, 0x36, 0x4f, 0x59, 0xbe, 0xb8, 0x9f, 0x2f, 0xd6, 0x98,
+	0x21, 0xf9, 0x06, 0x9a, 0xfa, 0xbe, 0xc0, 0xd0, 0x19, 0x3a, 0xe3, 0x93, 0xc9, 0xe7, 0xd1, 0xa1,
+	0x8c, 0xf7, 0xa9, 0x91, 0xfd, 0xbb, 0xb9, 0x2f, 0x90, 0x9a, 0x3b, 0xe4, 0x63, 0x68, 0x67, 0x3c,
+	0x4f, 0x24, 0xbe, 0x0e, 0x8f, 0x87, 0xce, 0xb8, 0x45, 0xdd, 0x8c, 0xe7, 0x14, 0x5f, 0x93, 0x8f,
+	0xa0, 0xa5, 0x85, 0x66, 0x9b, 0xb0, 0x61, 0x60, 0x6b, 0x90, 0x2f, 0xa1, 0x27, 0xb1, 0x60, 0x5c,
+	0x26, 0x7a, 0x2d, 0x51, 0xad, 0xc5, 0x26, 0x0d, 0x9b, 0x86, 0x70, 0x6a, 0xf1, 0x9b, 0x1d, 0x4c,
+	0xbe, 0x82, 0x47, 0x6a, 0xbb, 0x58, 0xa0, 0x52, 0x35, 0x6e, 0xcb, 0x70, 0x7b, 0x95, 0xe3, 0x40,
+	0x7e, 0x01, 0x04, 0x25, 0x53, 0x5b, 0x89, 0x89, 0x5a, 0xb3, 0xf2, 0x97, 0x3f, 0x60, 0xe8, 0x5a,
+	0x76, 0xe5, 0x99, 0x97, 0x8e, 0x39, 0x7f, 0xc0, 0xd1, 0x33, 0x80, 0xc3, 0x43, 0x88, 0x0f, 0xed,
+	0x78, 0xf6, 0xe3, 0xb7, 0x97, 0xf1, 0xb4, 0x77, 0x44, 0x5c, 0x38, 0xa6, 0xf3, 0x9e, 0x33, 0x7a,
+	0x00, 0x9f, 0x62, 0x26, 0x34, 0x5e, 0x73, 0x5c, 0x20, 0x79, 0x02, 0x9d, 0xa2, 0x3c, 0x24, 0xf9,
+	0x36, 0x33, 0x7d, 0x6a, 0x51, 0xcf, 0x00, 0xb3, 0x6d, 0x46, 0xbe, 0x80, 0x76, 0xd9, 0xf0, 0x84,
+	0xa7, 0xa6, 0x07, 0xc1, 0xf9, 0xc9, 0x9f, 0x6f, 0x07, 0x47, 0x7f, 0xbd, 0x1d, 0xb8, 0x33, 0x91,
+	0x62, 0x3c, 0xa5, 0x6e, 0xe9, 0x8e, 0x53, 0xf2, 0x1c, 0x9a, 0x6b, 0xa6, 0xd6, 0xa6, 0x25, 0xfe,
+	0xe4, 0x51, 0x54, 0x8d, 0xc6, 0xa4, 0xf8, 0x8e, 0xa9, 0x35, 0x35, 0xee, 0xd1, 0x3f, 0x0e, 0x74,
+	0x6d, 0xf2, 0x39, 0xae, 0x32, 0xcc, 0x35, 0x79, 0x05, 0x20, 0xf7, 0xa3, 0x30, 0xf9, 0xfd, 0xc9,
+	0x93, 0xff, 0x98, 0x13, 0xad, 0xd1, 0xc9, 0x4b, 0xe8, 0x4a, 0x21, 0x74, 0x62, 0x1f, 0xb0, 0x2f,
+	0xf2, 0xb4, 0x2a, 0xb2, 0x6d, 0xd2, 0xc7, 0x53, 0xea, 0x97, 0x2c, 0x6b, 0xa4, 0xe4, 0x15, 0x74,
+	0xa5, 0x29, 0xc1, 0x5e, 0x53, 0x61, 0x63, 0xd8, 0x18, 0xfb, 0x93, 0xc7, 0xef, 0x24, 0xdd, 0xf7,
+	0x87, 0x06, 0xf2, 0x60, 0x28, 0x32, 0x00, 0x3f, 0x43, 0x79, 0xb7, 0xc1, 0xa4, 0x0c, 0x69, 0x06,
+	0x1c, 0x50, 0xb0, 0x10, 0x15, 0x42, 0x7f, 0xdf, 0xf4, 0x5a, 0x3d, 0x97, 0xba, 0x66, 0x54, 0xe9,
+	0xe8, 0x8f, 0x26, 0xb4, 0xaf, 0x6d, 0x58, 0x72, 0xf6, 0x8e, 0x16, 0xeb, 0x6f, 0xac, 0x18, 0xd1,
+	0x94, 0x69, 0x56, 0x13, 0xe0, 0x73, 0x38, 0xe1, 0xf9, 0x86, 0xe7, 0x98, 0x28, 0xdb, 0x2c, 0xd3,
+	0xdd, 0x80, 0x76, 0x2d, 0xba, 0xeb, 0xe0, 0xd7, 0xe0, 0xda, 0x12, 0x4d, 0x35, 0xfe, 0x24, 0xfc,
+	0xe0, 0x21, 0x15, 0x93, 0x56, 0x3c, 0xf2, 0x0c, 0x82, 0x2a, 0xa2, 0x15, 0x53, 0x29, 0xbd, 0x06,
+	0xf5, 0x2b, 0xac, 0xd4, 0x11, 0x89, 0xa1, 0xbb, 0x90, 0xc8, 0x34, 0x17, 0x79, 0x92, 0x32, 0x6d,
+	0x05, 0xe7, 0x4f, 0xfa, 0x91, 0x5d, 0xd6, 0x68, 0xb7, 0xac, 0xd1, 0xcd, 0x6e, 0x59, 0xcf, 0xbd,
+	0xb2, 0xeb, 0xbf, 0xfe, 0x3d, 0x70, 0x68, 0xb0, 0xbb, 0x3a, 0x65, 0x1a, 0xc9, 0x15, 0x9c, 0xe2,
+	0x9b, 0x82, 0xcb, 0x5a, 0xb0, 0xf6, 0xff, 0x08, 0x76, 0x72, 0xb8, 0x6c, 0xc2, 0xf5, 0xc1, 0xcb,
+	0x50, 0xb3, 0x94, 0x69, 0x16, 0x7a, 0xa6, 0x1f, 0x7b, 0x9b, 0x7c, 0x02, 0xf0, 0x0b, 0x4a, 0x55,
+	0xe6, 0xe1, 0x69, 0xd8, 0x19, 0x3a, 0xe3, 0x0e, 0xed, 0x54, 0x48, 0x9c, 0x92, 0xcf, 0xa0, 0x9b,
+	0xe2, 0x06, 0x35, 0x26, 0x19, 0x93, 0x77, 0x28, 0x43, 0x18, 0x3a, 0x63, 0x8f, 0x06, 0x16, 0xbc,
+	0x32, 0x18, 0x89, 0xc0, 0x2d, 0xd8, 0xe2, 0x0e, 0xd3, 0xd0, 0x1f, 0x3a, 0xef, 0xe9, 0xe2, 0xda,
+	0x38, 0x28, 0xcb, 0x57, 0x48, 0x2b, 0x16, 0xf9, 0xb4, 0x14, 0xf0, 0x12, 0x25, 0xe6, 0xa5, 0x96,
+	0x02, 0xd3, 0xca, 0x1a, 0x42, 0x9e, 0x42, 0xa7, 0xd8, 0xb0, 0x05, 0x9a, 0x01, 0x76, 0x6d, 0x49,
+	0x7b, 0x60, 0x34, 0x02, 0x6f, 0x37, 0x75, 0x02, 0xe0, 0xc6, 0xb3, 0xcb, 0x78, 0x76, 0xd1, 0x3b,
+	0x2a, 0xcf, 0xf4, 0xe2, 0xea, 0x87, 0x9b, 0x8b, 0x9e, 0x33, 0xfa, 0x09, 0xfc, 0x5a, 0x62, 0xf2,
+	0x18, 0x5c, 0xb1, 0x5c, 0x2a, 0xd4, 0x46, 0x49, 0x0d, 0x5a, 0x59, 0x25, 0xbe, 0xc1, 0x7c, 0xa5,
+	0xd7, 0x66, 0x0b, 0x1a, 0xb4, 0xb2, 0x48, 0x08, 0xed, 0xba, 0x7e, 0x3a, 0x74, 0x67, 0x8e, 0x7e,
+	0x77, 0x20, 0xb8, 0xe4, 0x4a, 0x53, 0x54, 0x85, 0xc8, 0x15, 0x92, 0x09, 0xb4, 0xb8, 0xc6, 0x4c,
+	0x85, 0x8e, 0x59, 0x89, 0xa7, 0xb5, 0xa7, 0xd7, 0x79, 0x51, 0xac, 0x31, 0xa3, 0x96, 0x4a, 0x08,
+	0x34, 0x33, 0x21, 0xd1, 0x24, 0xf5, 0xa8, 0x39, 0xf7, 0x11, 0x9a, 0x25, 0xa5, 0xf4, 0x15, 0x4c,
+	0xaf, 0x4d, 0xa1, 0x1d, 0x6a, 0xce, 0xe4, 0x05, 0xb4, 0xab, 0xa8, 0xe6, 0x8a, 0x3f, 0x21, 0x1f,
+	0x6e, 0x02, 0xdd, 0x51, 0xca, 0xaf, 0x13, 0x57, 0x49, 0x21, 0x71, 0xc9, 0xdf, 0x98, 0xf2, 0x3d,
+	0xea, 0x71, 0x75, 0x6d, 0xec, 0xf3, 0xe6, 0xcf, 0xc7, 0xc5, 0xed, 0xad, 0x6b, 0xe4, 0xf3, 0xf2,
+	0xdf, 0x01, 0x00, 0x0e, 0xa9, 0x33, 0x53, 0x64, 0x06, 0x00, 0x00,
 }
@@ -20,6 +20,7 @@ type Store interface {
 	Get(ctx context.Context, bucketName string) (_ storj.Bucket, err error)
 	Delete(ctx context.Context, bucketName string) (err error)
 	List(ctx context.Context, listOpts storj.BucketListOptions) (_ storj.BucketList, err error)
+	SetVersioning(ctx context.Context, bucketName string, versioning bool) (_ storj.Bucket, err error)
 }
 
 // BucketStore is an object to interact with buckets
@@ -58,3 +59,9 @@ func (store *BucketStore) List(ctx context.Context, listOpts storj.BucketListOpt
 	defer mon.Task()(&ctx)(&err)
 	return store.metainfoClient.ListBuckets(ctx, listOpts)
 }
+
+// SetVersioning enables or disables versioning for a bucket
+func (store *BucketStore) SetVersioning(ctx context.Context, bucketName string, versioning bool) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.metainfoClient.SetBucketVersioning(ctx, bucketName, versioning)
+}
@@ -33,6 +33,7 @@ type Client interface {
 	Get(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, es eestream.ErasureScheme, size int64) (ranger.Ranger, error)
 	Delete(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey) error
 	WithForceErrorDetection(force bool) Client
+	WithHedging(hedging Hedging) Client
 }
 
 type dialPiecestoreFunc func(context.Context, *pb.Node) (*piecestore.Client, error)
@@ -42,6 +43,7 @@ type ecClient struct {
 	transport           transport.Client
 	memoryLimit         int
 	forceErrorDetection bool
+	hedging             Hedging
 }
 
 // NewClient from the given identity and max buffer memory
@@ -50,6 +52,7 @@ func NewClient(log *zap.Logger, tc transport.Client, memoryLimit int) Client {
 		log:         log,
 		transport:   tc,
 		memoryLimit: memoryLimit,
+		hedging:     DefaultHedging,
 	}
 }
 
@@ -58,6 +61,19 @@ func (ec *ecClient) WithForceErrorDetection(force bool) Client {
 	return ec
 }
 
+// WithHedging sets how many piece downloads Get starts at once. The zero
+// values of the stall timeout and the scoreboard are replaced by the defaults.
+func (ec *ecClient) WithHedging(hedging Hedging) Client {
+	if hedging.StallTimeout <= 0 {
+		hedging.StallTimeout = DefaultHedging.StallTimeout
+	}
+	if hedging.Scoreboard == nil {
+		hedging.Scoreboard = DefaultHedging.Scoreboard
+	}
+	ec.hedging = hedging
+	return ec
+}
+
 func (ec *ecClient) dialPiecestore(ctx context.Context, n *pb.Node) (*piecestore.Client, error) {
 	logger := ec.log.Named(n.Id.String())
 	return piecestore.Dial(ctx, ec.transport, n, logger, piecestore.DefaultConfig)
@@ -328,6 +344,7 @@ func (ec *ecClient) Get(ctx context.Context, limits []*pb.AddressedOrderLimit, p
 	pieceSize := paddedSize / int64(es.RequiredCount())
 
 	rrs := map[int]ranger.Ranger{}
+	nodeIDs := map[int]storj.NodeID{}
 	for i, addressedLimit := range limits {
 		if addressedLimit == nil {
 			continue
@@ -339,11 +356,25 @@ func (ec *ecClient) Get(ctx context.Context, limits []*pb.AddressedOrderLimit, p
 			privateKey:     privateKey,
 			size:           pieceSize,
 		}
+		nodeIDs[i] = addressedLimit.GetLimit().StorageNodeId
 	}
 
-	rr, err = eestream.Decode(rrs, es, ec.memoryLimit, ec.forceErrorDetection)
-	if err != nil {
-		return nil, err
+	if ec.hedging.Extra < 0 {
+		rr, err = eestream.Decode(rrs, es, ec.memoryLimit, ec.forceErrorDetection)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rr = &hedgedRanger{
+			log:                 ec.log,
+			rangers:             rrs,
+			nodeIDs:             nodeIDs,
+			es:                  es,
+			pieceSize:           pieceSize,
+			memoryLimit:         ec.memoryLimit,
+			forceErrorDetection: ec.forceErrorDetection,
+			hedging:             ec.hedging,
+		}
 	}
 
 	return eestream.Unpad(rr, int(paddedSize-size))
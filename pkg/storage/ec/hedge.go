@@ -0,0 +1,491 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+// Hedging configures how many piece downloads Get starts at once.
+type Hedging struct {
+	// Extra is the number of pieces that are downloaded in addition to the
+	// required ones, at least one. A negative value downloads all pieces.
+	Extra int
+	// StallTimeout is how long a read may wait for the decoder before
+	// another piece is downloaded.
+	StallTimeout time.Duration
+	// Scoreboard orders the pieces by the latency of their nodes.
+	Scoreboard *Scoreboard
+}
+
+// DefaultScoreboard is the scoreboard shared by the clients of the process.
+var DefaultScoreboard = NewScoreboard(10000)
+
+// DefaultHedging is the hedging of new clients.
+var DefaultHedging = Hedging{
+	Extra:        2,
+	StallTimeout: time.Second,
+	Scoreboard:   DefaultScoreboard,
+}
+
+var errPieceClosed = Error.New("piece download closed")
+
+// hedgedRanger decodes a segment from the pieces of the fastest nodes and
+// keeps the pieces of the other nodes in reserve, they are downloaded when a
+// download fails or the decoding stalls.
+type hedgedRanger struct {
+	log                 *zap.Logger
+	rangers             map[int]ranger.Ranger
+	nodeIDs             map[int]storj.NodeID
+	es                  eestream.ErasureScheme
+	pieceSize           int64
+	memoryLimit         int
+	forceErrorDetection bool
+	hedging             Hedging
+}
+
+// Size implements Ranger.Size
+func (hr *hedgedRanger) Size() int64 {
+	return hr.pieceSize / int64(hr.es.ErasureShareSize()) * int64(hr.es.StripeSize())
+}
+
+// Range implements Ranger.Range
+func (hr *hedgedRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	download := newHedgedDownload(ctx, hr, offset)
+
+	rrs := make(map[int]ranger.Ranger, len(download.pieces))
+	for i, piece := range download.pieces {
+		rrs[i] = piece
+	}
+
+	rr, err := eestream.Decode(rrs, hr.es, hr.memoryLimit, hr.forceErrorDetection)
+	if err != nil {
+		return nil, err
+	}
+
+	go download.watch()
+
+	// the decoder skips to the offset before it returns
+	download.startRead()
+	reader, err := rr.Range(ctx, offset, length)
+	download.finishRead(0)
+	if err != nil {
+		download.stop()
+		for _, piece := range download.pieces {
+			err = errs.Combine(err, piece.Close())
+		}
+		return nil, err
+	}
+
+	return &hedgedReader{ReadCloser: reader, download: download}, nil
+}
+
+// hedgedReader returns the decoded data of a hedged download.
+type hedgedReader struct {
+	io.ReadCloser
+	download *hedgedDownload
+}
+
+// Read implements io.Reader
+func (reader *hedgedReader) Read(p []byte) (n int, err error) {
+	reader.download.startRead()
+	n, err = reader.ReadCloser.Read(p)
+	reader.download.finishRead(n)
+	return n, err
+}
+
+// Close implements io.Closer
+func (reader *hedgedReader) Close() error {
+	reader.download.stop()
+	return reader.ReadCloser.Close()
+}
+
+// hedgedDownload keeps track of the piece downloads of a range.
+type hedgedDownload struct {
+	ctx          context.Context
+	log          *zap.Logger
+	es           eestream.ErasureScheme
+	board        *Scoreboard
+	stallTimeout time.Duration
+
+	// required is the number of pieces that decode a stripe without waiting
+	// for the other downloads
+	required int
+	// lagShares is the number of shares a download may fall behind the
+	// decoded data before it is canceled
+	lagShares int64
+	// skipped is the number of bytes of the first stripe before the offset
+	skipped int64
+
+	pieces map[int]*hedgedPiece
+
+	mu             sync.Mutex
+	reserve        []*hedgedPiece
+	lastActivation time.Time
+
+	decoded   int64 // atomic, bytes returned to the reader
+	readStart int64 // atomic, unix nanoseconds of the pending read
+
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+func newHedgedDownload(ctx context.Context, hr *hedgedRanger, offset int64) *hedgedDownload {
+	download := &hedgedDownload{
+		ctx:          ctx,
+		log:          hr.log,
+		es:           hr.es,
+		board:        hr.hedging.Scoreboard,
+		stallTimeout: hr.hedging.StallTimeout,
+
+		required: hr.es.RequiredCount() + 1,
+		skipped:  offset % int64(hr.es.StripeSize()),
+
+		pieces:         make(map[int]*hedgedPiece, len(hr.rangers)),
+		lastActivation: time.Now(),
+
+		stopped: make(chan struct{}),
+	}
+
+	// the decoder splits the memory between all pieces
+	download.lagShares = int64(hr.memoryLimit / len(hr.rangers) / hr.es.ErasureShareSize())
+	if download.lagShares < 1 {
+		download.lagShares = 1
+	}
+
+	indexes := make([]int, 0, len(hr.rangers))
+	ids := make([]storj.NodeID, 0, len(hr.rangers))
+	for i := range hr.rangers {
+		indexes = append(indexes, i)
+		ids = append(ids, hr.nodeIDs[i])
+	}
+
+	extra := hr.hedging.Extra
+	if extra < 1 {
+		extra = 1
+	}
+	active := hr.es.RequiredCount() + extra
+
+	for rank, k := range download.board.Order(ids) {
+		i := indexes[k]
+		piece := newHedgedPiece(download, hr.nodeIDs[i], hr.rangers[i], hr.pieceSize)
+		download.pieces[i] = piece
+		if rank < active {
+			piece.activate()
+		} else {
+			download.reserve = append(download.reserve, piece)
+		}
+	}
+
+	return download
+}
+
+// startRead marks that a read waits for the decoder
+func (download *hedgedDownload) startRead() {
+	atomic.StoreInt64(&download.readStart, time.Now().UnixNano())
+}
+
+// finishRead marks that a read returned n bytes
+func (download *hedgedDownload) finishRead(n int) {
+	atomic.StoreInt64(&download.readStart, 0)
+	atomic.AddInt64(&download.decoded, int64(n))
+}
+
+// activateNext starts downloading the next piece in reserve
+func (download *hedgedDownload) activateNext() bool {
+	download.mu.Lock()
+	if len(download.reserve) == 0 {
+		download.mu.Unlock()
+		return false
+	}
+	piece := download.reserve[0]
+	download.reserve = download.reserve[1:]
+	download.lastActivation = time.Now()
+	download.mu.Unlock()
+
+	piece.activate()
+	return true
+}
+
+// watch checks the progress of the download until it is stopped
+func (download *hedgedDownload) watch() {
+	interval := download.stallTimeout / 4
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-download.stopped:
+			return
+		case <-download.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		download.checkStall()
+		download.cancelSlowest()
+	}
+}
+
+// checkStall starts another piece download when a read has waited too long
+// for the decoder
+func (download *hedgedDownload) checkStall() {
+	readStart := atomic.LoadInt64(&download.readStart)
+	if readStart == 0 || time.Since(time.Unix(0, readStart)) < download.stallTimeout {
+		return
+	}
+
+	download.mu.Lock()
+	stalled := time.Since(download.lastActivation) >= download.stallTimeout
+	download.mu.Unlock()
+
+	if stalled && download.activateNext() {
+		download.log.Debug("decoding stalled, downloading another piece")
+	}
+}
+
+// cancelSlowest cancels the download that is furthest behind the decoded
+// data, if enough other downloads are running
+func (download *hedgedDownload) cancelSlowest() {
+	decodedStripes := (download.skipped + atomic.LoadInt64(&download.decoded)) / int64(download.es.StripeSize())
+
+	var running int
+	var slowest *hedgedPiece
+	var slowestShares int64
+	for _, piece := range download.pieces {
+		if !piece.running() {
+			continue
+		}
+		running++
+
+		shares := atomic.LoadInt64(&piece.read) / int64(download.es.ErasureShareSize())
+		if decodedStripes-shares <= download.lagShares {
+			continue
+		}
+		if slowest == nil || shares < slowestShares {
+			slowest, slowestShares = piece, shares
+		}
+	}
+
+	if slowest != nil && running > download.required {
+		download.log.Sugar().Debugf("Canceling the download from node %s, which is %d shares behind", slowest.nodeID, decodedStripes-slowestShares)
+		slowest.cancelSlow()
+	}
+}
+
+// stop stops watching the download
+func (download *hedgedDownload) stop() {
+	download.stopOnce.Do(func() { close(download.stopped) })
+}
+
+type pieceState int
+
+const (
+	piecePending pieceState = iota
+	pieceRunning
+	pieceFinished
+	pieceFailed
+	pieceCanceled
+)
+
+// hedgedPiece is the download of a piece, which only dials the node once it
+// is activated. It is the ranger and the reader of the piece for the decoder.
+type hedgedPiece struct {
+	download *hedgedDownload
+	nodeID   storj.NodeID
+	ranger   ranger.Ranger
+	size     int64
+
+	ctx    context.Context
+	cancel func()
+
+	activated    chan struct{}
+	activateOnce sync.Once
+	closed       chan struct{}
+	closeOnce    sync.Once
+
+	offset  int64
+	length  int64
+	started time.Time
+	read    int64 // atomic
+
+	mu      sync.Mutex
+	state   pieceState
+	reader  io.ReadCloser
+	closing bool
+}
+
+func newHedgedPiece(download *hedgedDownload, nodeID storj.NodeID, ranger ranger.Ranger, size int64) *hedgedPiece {
+	piece := &hedgedPiece{
+		download:  download,
+		nodeID:    nodeID,
+		ranger:    ranger,
+		size:      size,
+		activated: make(chan struct{}),
+		closed:    make(chan struct{}),
+	}
+	piece.ctx, piece.cancel = context.WithCancel(download.ctx)
+	return piece
+}
+
+// Size implements Ranger.Size
+func (piece *hedgedPiece) Size() int64 {
+	return piece.size
+}
+
+// Range implements Ranger.Range, the piece is downloaded once it is activated
+func (piece *hedgedPiece) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+	piece.offset, piece.length = offset, length
+	return piece, nil
+}
+
+// activate allows the piece to be downloaded
+func (piece *hedgedPiece) activate() {
+	piece.mu.Lock()
+	if piece.state == piecePending {
+		piece.state = pieceRunning
+	}
+	piece.mu.Unlock()
+
+	piece.activateOnce.Do(func() { close(piece.activated) })
+}
+
+// running returns whether the piece is being downloaded
+func (piece *hedgedPiece) running() bool {
+	piece.mu.Lock()
+	defer piece.mu.Unlock()
+	return piece.state == pieceRunning
+}
+
+// Read implements io.Reader
+func (piece *hedgedPiece) Read(p []byte) (n int, err error) {
+	reader, err := piece.open()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err = reader.Read(p)
+	if n > 0 && atomic.AddInt64(&piece.read, int64(n)) == int64(n) {
+		piece.download.board.Success(piece.nodeID, time.Since(piece.started))
+	}
+
+	switch {
+	case err == io.EOF:
+		piece.mu.Lock()
+		if piece.state == pieceRunning {
+			piece.state = pieceFinished
+		}
+		piece.mu.Unlock()
+	case err != nil:
+		piece.fail(err)
+	}
+	return n, err
+}
+
+// open waits until the piece is activated and starts its download
+func (piece *hedgedPiece) open() (io.ReadCloser, error) {
+	piece.mu.Lock()
+	reader, closing := piece.reader, piece.closing
+	piece.mu.Unlock()
+	if closing {
+		return nil, errPieceClosed
+	}
+	if reader != nil {
+		return reader, nil
+	}
+
+	select {
+	case <-piece.activated:
+	case <-piece.closed:
+		return nil, errPieceClosed
+	case <-piece.ctx.Done():
+		return nil, piece.ctx.Err()
+	}
+
+	piece.started = time.Now()
+	reader, err := piece.ranger.Range(piece.ctx, piece.offset, piece.length)
+	if err != nil {
+		piece.fail(err)
+		return nil, err
+	}
+
+	piece.mu.Lock()
+	defer piece.mu.Unlock()
+	if piece.closing {
+		return nil, errs.Combine(errPieceClosed, reader.Close())
+	}
+	piece.reader = reader
+	return reader, nil
+}
+
+// fail records a failed download and replaces it with a piece in reserve
+func (piece *hedgedPiece) fail(err error) {
+	piece.mu.Lock()
+	expected := piece.closing || piece.state == pieceCanceled
+	if !expected {
+		piece.state = pieceFailed
+	}
+	piece.mu.Unlock()
+
+	if expected || piece.download.ctx.Err() != nil {
+		return
+	}
+
+	piece.download.log.Sugar().Debugf("Download from node %s failed: %v", piece.nodeID, err)
+	piece.download.board.Failure(piece.nodeID)
+	piece.download.activateNext()
+}
+
+// cancelSlow cancels the download because it is too slow
+func (piece *hedgedPiece) cancelSlow() {
+	piece.mu.Lock()
+	if piece.state != pieceRunning {
+		piece.mu.Unlock()
+		return
+	}
+	piece.state = pieceCanceled
+	piece.mu.Unlock()
+
+	piece.download.board.Failure(piece.nodeID)
+	piece.cancel()
+}
+
+// Close implements io.Closer
+func (piece *hedgedPiece) Close() error {
+	defer piece.cancel()
+	piece.closeOnce.Do(func() { close(piece.closed) })
+
+	piece.mu.Lock()
+	piece.closing = true
+	reader, canceled := piece.reader, piece.state == pieceCanceled
+	piece.reader = nil
+	piece.mu.Unlock()
+
+	if reader == nil {
+		return nil
+	}
+	err := reader.Close()
+	if canceled {
+		// the error of the canceled download doesn't matter
+		return nil
+	}
+	return err
+}
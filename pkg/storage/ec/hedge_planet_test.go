@@ -0,0 +1,191 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/pb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+const hedgedNodes = 6
+
+func TestECClient_GetHedged(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, hedgedNodes, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	ec := ecclient.NewClient(planet.Uplinks[0].Log.Named("ecclient"), planet.Uplinks[0].Transport, 0)
+	es, hashes, data := putHedged(ctx, t, planet, ec)
+
+	// the first pieces are downloaded first, as none of the nodes is known yet
+	require.NoError(t, planet.StopPeer(planet.StorageNodes[0]))
+	require.NoError(t, planet.StopPeer(planet.StorageNodes[1]))
+
+	board := ecclient.NewScoreboard(hedgedNodes)
+	ec = ec.WithHedging(ecclient.Hedging{
+		Extra:        1,
+		StallTimeout: 100 * time.Millisecond,
+		Scoreboard:   board,
+	})
+	getHedged(ctx, t, planet, ec, es, hashes, data)
+
+	// the download may finish before the dial of a stopped node fails, so
+	// only the pieces which have been downloaded are sure to be scored
+	var downloaded int
+	for i, node := range planet.StorageNodes {
+		latency, ok := board.Latency(node.ID())
+		if !ok {
+			continue
+		}
+		if i < 2 {
+			assert.True(t, latency >= 5*time.Second, i)
+		} else {
+			assert.True(t, latency < 5*time.Second, i)
+			downloaded++
+		}
+	}
+	assert.True(t, downloaded >= es.RequiredCount(), downloaded)
+}
+
+// BenchmarkECClient_GetHedged downloads a segment while two of the nodes are
+// behind a slow network connection.
+func BenchmarkECClient_GetHedged(b *testing.B) {
+	ctx := testcontext.New(b)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(b, 1, hedgedNodes, 1)
+	require.NoError(b, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	uplink := planet.Uplinks[0]
+	ec := ecclient.NewClient(uplink.Log.Named("ecclient"), uplink.Transport, 0)
+	es, hashes, data := putHedged(ctx, b, planet, ec)
+
+	network := &transport.SimulatedNetwork{
+		DialLatency:    50 * time.Millisecond,
+		BytesPerSecond: 32 * memory.KiB,
+	}
+	slow := &slowNodes{
+		Client: uplink.Transport,
+		slow:   network.NewClient(uplink.Transport),
+		nodes: map[storj.NodeID]bool{
+			planet.StorageNodes[0].ID(): true,
+			planet.StorageNodes[1].ID(): true,
+		},
+	}
+
+	warm := ecclient.NewScoreboard(hedgedNodes)
+	for _, bench := range []struct {
+		name  string
+		extra int
+		board func() *ecclient.Scoreboard
+	}{
+		{"All", -1, nil},
+		{"Extra1/Cold", 1, func() *ecclient.Scoreboard { return ecclient.NewScoreboard(hedgedNodes) }},
+		{"Extra1/Warm", 1, func() *ecclient.Scoreboard { return warm }},
+		{"Extra3/Cold", 3, func() *ecclient.Scoreboard { return ecclient.NewScoreboard(hedgedNodes) }},
+	} {
+		bench := bench
+		b.Run(bench.name, func(b *testing.B) {
+			ec := ecclient.NewClient(uplink.Log.Named("ecclient"), slow, 0)
+			for i := 0; i < b.N; i++ {
+				hedging := ecclient.Hedging{Extra: bench.extra, StallTimeout: 100 * time.Millisecond}
+				if bench.board != nil {
+					hedging.Scoreboard = bench.board()
+				}
+				getHedged(ctx, b, planet, ec.WithHedging(hedging), es, hashes, data)
+			}
+		})
+	}
+}
+
+// slowNodes dials some of the nodes through a slow network
+type slowNodes struct {
+	transport.Client
+	slow  transport.Client
+	nodes map[storj.NodeID]bool
+}
+
+// DialNode dials the node through the slow network if it is a slow node
+func (client *slowNodes) DialNode(ctx context.Context, node *pb.Node, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if client.nodes[node.Id] {
+		return client.slow.DialNode(ctx, node, opts...)
+	}
+	return client.Client.DialNode(ctx, node, opts...)
+}
+
+// putHedged uploads random data to all storage nodes
+func putHedged(ctx context.Context, tb testing.TB, planet *testplanet.Planet, ec ecclient.Client) (eestream.ErasureScheme, []*pb.PieceHash, []byte) {
+	fc, err := infectious.NewFEC(2, hedgedNodes)
+	require.NoError(tb, err)
+
+	es := eestream.NewRSScheme(fc, dataSize.Int()/hedgedNodes)
+	rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+	require.NoError(tb, err)
+
+	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+	require.NoError(tb, err)
+
+	limits := make([]*pb.AddressedOrderLimit, hedgedNodes)
+	for i := range limits {
+		limits[i], err = newAddressedOrderLimit(ctx, pb.PieceAction_PUT, planet.Satellites[0], piecePublicKey, planet.StorageNodes[i], storj.NewPieceID())
+		require.NoError(tb, err)
+	}
+
+	data := testrand.BytesInt(dataSize.Int())
+	_, hashes, err := ec.Put(ctx, limits, piecePrivateKey, rs, bytes.NewReader(data), time.Time{})
+	require.NoError(tb, err)
+	for _, hash := range hashes {
+		require.NotNil(tb, hash)
+	}
+
+	return es, hashes, data
+}
+
+// getHedged downloads the pieces from all storage nodes
+func getHedged(ctx context.Context, tb testing.TB, planet *testplanet.Planet, ec ecclient.Client, es eestream.ErasureScheme, hashes []*pb.PieceHash, expected []byte) {
+	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+	require.NoError(tb, err)
+
+	limits := make([]*pb.AddressedOrderLimit, len(hashes))
+	for i := range limits {
+		limits[i], err = newAddressedOrderLimit(ctx, pb.PieceAction_GET, planet.Satellites[0], piecePublicKey, planet.StorageNodes[i], hashes[i].PieceId)
+		require.NoError(tb, err)
+	}
+
+	rr, err := ec.Get(ctx, limits, piecePrivateKey, es, dataSize.Int64())
+	require.NoError(tb, err)
+
+	r, err := rr.Range(ctx, 0, rr.Size())
+	require.NoError(tb, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(tb, err)
+	assert.Equal(tb, expected, data)
+	assert.NoError(tb, r.Close())
+}
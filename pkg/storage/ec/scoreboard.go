@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	// latencyWeight is the weight of the previous latency of a node when a
+	// new latency is recorded
+	latencyWeight = 4
+	// failurePenalty is the minimum latency of a node after a failed download
+	failurePenalty = 5 * time.Second
+)
+
+// Scoreboard keeps track of the latency of piece downloads from storage
+// nodes, so that later downloads can prefer the fast nodes. It is safe for
+// concurrent use.
+type Scoreboard struct {
+	mu      sync.Mutex
+	limit   int
+	latency map[storj.NodeID]time.Duration
+}
+
+// NewScoreboard creates a scoreboard that keeps the scores of up to limit nodes.
+func NewScoreboard(limit int) *Scoreboard {
+	return &Scoreboard{
+		limit:   limit,
+		latency: make(map[storj.NodeID]time.Duration),
+	}
+}
+
+// Success records the time it took a node to return the first bytes of a piece.
+func (board *Scoreboard) Success(id storj.NodeID, latency time.Duration) {
+	board.mu.Lock()
+	defer board.mu.Unlock()
+
+	previous, ok := board.latency[id]
+	if ok {
+		latency = previous + (latency-previous)/latencyWeight
+	}
+	board.set(id, latency)
+}
+
+// Failure records a download from a node that failed or was too slow.
+func (board *Scoreboard) Failure(id storj.NodeID) {
+	board.mu.Lock()
+	defer board.mu.Unlock()
+
+	latency := 2 * board.latency[id]
+	if latency < failurePenalty {
+		latency = failurePenalty
+	}
+	board.set(id, latency)
+}
+
+// Latency returns the recorded latency of a node.
+func (board *Scoreboard) Latency(id storj.NodeID) (_ time.Duration, ok bool) {
+	board.mu.Lock()
+	defer board.mu.Unlock()
+
+	latency, ok := board.latency[id]
+	return latency, ok
+}
+
+// Order returns the indexes of ids sorted from the fastest to the slowest
+// node. Nodes without a recorded latency are ranked with the average latency,
+// so they are tried before the nodes that are known to be slow.
+func (board *Scoreboard) Order(ids []storj.NodeID) []int {
+	board.mu.Lock()
+	defer board.mu.Unlock()
+
+	var total time.Duration
+	var known int
+	latencies := make([]time.Duration, len(ids))
+	for i, id := range ids {
+		latency, ok := board.latency[id]
+		if !ok {
+			latencies[i] = -1
+			continue
+		}
+		latencies[i] = latency
+		total += latency
+		known++
+	}
+
+	var average time.Duration
+	if known > 0 {
+		average = total / time.Duration(known)
+	}
+
+	order := make([]int, len(ids))
+	for i := range order {
+		order[i] = i
+		if latencies[i] < 0 {
+			latencies[i] = average
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return latencies[order[a]] < latencies[order[b]]
+	})
+	return order
+}
+
+// set stores the latency of a node and makes room for it if necessary
+func (board *Scoreboard) set(id storj.NodeID, latency time.Duration) {
+	if _, ok := board.latency[id]; !ok && len(board.latency) >= board.limit {
+		// forget an arbitrary node
+		for evict := range board.latency {
+			delete(board.latency, evict)
+			break
+		}
+	}
+	board.latency[id] = latency
+}
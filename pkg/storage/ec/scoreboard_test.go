@@ -0,0 +1,53 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestScoreboard(t *testing.T) {
+	fast := teststorj.NodeIDFromString("fast")
+	slow := teststorj.NodeIDFromString("slow")
+	failed := teststorj.NodeIDFromString("failed")
+	unknown := teststorj.NodeIDFromString("unknown")
+
+	board := NewScoreboard(10)
+	board.Success(fast, 10*time.Millisecond)
+	board.Success(slow, 10*time.Millisecond)
+	board.Success(slow, 410*time.Millisecond)
+	board.Failure(failed)
+
+	latency, ok := board.Latency(slow)
+	assert.True(t, ok)
+	assert.Equal(t, 110*time.Millisecond, latency)
+
+	latency, ok = board.Latency(failed)
+	assert.True(t, ok)
+	assert.Equal(t, failurePenalty, latency)
+
+	_, ok = board.Latency(unknown)
+	assert.False(t, ok)
+
+	// the unknown node is ranked with the average latency
+	order := board.Order([]storj.NodeID{failed, unknown, slow, fast})
+	assert.Equal(t, []int{3, 2, 1, 0}, order)
+}
+
+func TestScoreboard_Limit(t *testing.T) {
+	board := NewScoreboard(2)
+	for _, name := range []string{"a", "b", "c"} {
+		board.Success(teststorj.NodeIDFromString(name), time.Millisecond)
+	}
+
+	_, ok := board.Latency(teststorj.NodeIDFromString("c"))
+	assert.True(t, ok)
+	assert.Len(t, board.latency, 2)
+}
@@ -14,6 +14,7 @@ import (
 
 	ranger "storj.io/storj/pkg/ranger"
 	storj "storj.io/storj/pkg/storj"
+	metainfo "storj.io/storj/uplink/metainfo"
 )
 
 // MockStore is a mock of Store interface
@@ -66,6 +67,20 @@ func (mr *MockStoreMockRecorder) Get(ctx, path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), ctx, path)
 }
 
+// GetVersion mocks base method
+func (m *MockStore) GetVersion(ctx context.Context, path storj.Path, versionID string) (ranger.Ranger, Meta, error) {
+	ret := m.ctrl.Call(m, "GetVersion", ctx, path, versionID)
+	ret0, _ := ret[0].(ranger.Ranger)
+	ret1, _ := ret[1].(Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockStoreMockRecorder) GetVersion(ctx, path, versionID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockStore)(nil).GetVersion), ctx, path, versionID)
+}
+
 // Repair mocks base method
 func (m *MockStore) Repair(ctx context.Context, path storj.Path, lostPieces []int32) error {
 	ret := m.ctrl.Call(m, "Repair", ctx, path, lostPieces)
@@ -116,3 +131,117 @@ func (m *MockStore) List(ctx context.Context, prefix, startAfter, endBefore stor
 func (mr *MockStoreMockRecorder) List(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStore)(nil).List), ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags)
 }
+
+// BeginObject mocks base method
+func (m *MockStore) BeginObject(ctx context.Context, bucket string, objectPath storj.Path, expiration time.Time) (storj.StreamID, error) {
+	ret := m.ctrl.Call(m, "BeginObject", ctx, bucket, objectPath, expiration)
+	ret0, _ := ret[0].(storj.StreamID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginObject indicates an expected call of BeginObject
+func (mr *MockStoreMockRecorder) BeginObject(ctx, bucket, objectPath, expiration interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginObject", reflect.TypeOf((*MockStore)(nil).BeginObject), ctx, bucket, objectPath, expiration)
+}
+
+// PutPending mocks base method
+func (m *MockStore) PutPending(ctx context.Context, data io.Reader, bucket string, objectPath storj.Path, streamID storj.StreamID, segmentIndex int64, expiration time.Time, metadata []byte) (Meta, error) {
+	ret := m.ctrl.Call(m, "PutPending", ctx, data, bucket, objectPath, streamID, segmentIndex, expiration, metadata)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutPending indicates an expected call of PutPending
+func (mr *MockStoreMockRecorder) PutPending(ctx, data, bucket, objectPath, streamID, segmentIndex, expiration, metadata interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPending", reflect.TypeOf((*MockStore)(nil).PutPending), ctx, data, bucket, objectPath, streamID, segmentIndex, expiration, metadata)
+}
+
+// CommitObject mocks base method
+func (m *MockStore) CommitObject(ctx context.Context, bucket string, objectPath storj.Path, streamID storj.StreamID, segmentCount int64, metadata []byte) (Meta, error) {
+	ret := m.ctrl.Call(m, "CommitObject", ctx, bucket, objectPath, streamID, segmentCount, metadata)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitObject indicates an expected call of CommitObject
+func (mr *MockStoreMockRecorder) CommitObject(ctx, bucket, objectPath, streamID, segmentCount, metadata interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitObject", reflect.TypeOf((*MockStore)(nil).CommitObject), ctx, bucket, objectPath, streamID, segmentCount, metadata)
+}
+
+// ObjectMeta mocks base method
+func (m *MockStore) ObjectMeta(ctx context.Context, bucket string, objectPath storj.Path) (Meta, error) {
+	ret := m.ctrl.Call(m, "ObjectMeta", ctx, bucket, objectPath)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectMeta indicates an expected call of ObjectMeta
+func (mr *MockStoreMockRecorder) ObjectMeta(ctx, bucket, objectPath interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectMeta", reflect.TypeOf((*MockStore)(nil).ObjectMeta), ctx, bucket, objectPath)
+}
+
+// ListObjects mocks base method
+func (m *MockStore) ListObjects(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) ([]ListItem, bool, error) {
+	ret := m.ctrl.Call(m, "ListObjects", ctx, bucket, prefix, startAfter, endBefore, recursive, limit, metaFlags)
+	ret0, _ := ret[0].([]ListItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListObjects indicates an expected call of ListObjects
+func (mr *MockStoreMockRecorder) ListObjects(ctx, bucket, prefix, startAfter, endBefore, recursive, limit, metaFlags interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockStore)(nil).ListObjects), ctx, bucket, prefix, startAfter, endBefore, recursive, limit, metaFlags)
+}
+
+// PutInlineObject mocks base method
+func (m *MockStore) PutInlineObject(ctx context.Context, data []byte, bucket string, objectPath storj.Path, expiration time.Time, metadata []byte) (Meta, error) {
+	ret := m.ctrl.Call(m, "PutInlineObject", ctx, data, bucket, objectPath, expiration, metadata)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutInlineObject indicates an expected call of PutInlineObject
+func (mr *MockStoreMockRecorder) PutInlineObject(ctx, data, bucket, objectPath, expiration, metadata interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutInlineObject", reflect.TypeOf((*MockStore)(nil).PutInlineObject), ctx, data, bucket, objectPath, expiration, metadata)
+}
+
+// DeleteObject mocks base method
+func (m *MockStore) DeleteObject(ctx context.Context, bucket string, objectPath storj.Path, segmentCount int64) error {
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, bucket, objectPath, segmentCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject
+func (mr *MockStoreMockRecorder) DeleteObject(ctx, bucket, objectPath, segmentCount interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockStore)(nil).DeleteObject), ctx, bucket, objectPath, segmentCount)
+}
+
+// PutPacked mocks base method
+func (m *MockStore) PutPacked(ctx context.Context, data io.Reader, bucket string, expiration time.Time, objects []PackedObject) ([]Meta, error) {
+	ret := m.ctrl.Call(m, "PutPacked", ctx, data, bucket, expiration, objects)
+	ret0, _ := ret[0].([]Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutPacked indicates an expected call of PutPacked
+func (mr *MockStoreMockRecorder) PutPacked(ctx, data, bucket, expiration, objects interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPacked", reflect.TypeOf((*MockStore)(nil).PutPacked), ctx, data, bucket, expiration, objects)
+}
+
+// DeletePieces mocks base method
+func (m *MockStore) DeletePieces(ctx context.Context, deleted []metainfo.DeletedSegment) {
+	m.ctrl.Call(m, "DeletePieces", ctx, deleted)
+}
+
+// DeletePieces indicates an expected call of DeletePieces
+func (mr *MockStoreMockRecorder) DeletePieces(ctx, deleted interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePieces", reflect.TypeOf((*MockStore)(nil).DeletePieces), ctx, deleted)
+}
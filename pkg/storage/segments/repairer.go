@@ -77,6 +77,11 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	if pointer.GetType() != pb.Pointer_REMOTE {
 		return Error.New("cannot repair inline segment %s", path)
 	}
+	if pointer.GetRemote() == nil {
+		// the pieces of a packed or copied segment are repaired with the segment
+		repairer.log.Sugar().Debugf("segment %v has no pieces of its own", path)
+		return nil
+	}
 
 	mon.Meter("repair_attempts").Mark(1)
 	mon.IntVal("repair_segment_size").Observe(pointer.GetSegmentSize())
@@ -89,7 +94,7 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
 	expiration := pointer.GetExpirationDate()
 
-	var excludeNodeIDs storj.NodeIDList
+	var excludeNodeIDs, placedNodeIDs storj.NodeIDList
 	var healthyPieces, unhealthyPieces []*pb.RemotePiece
 	healthyMap := make(map[int32]bool)
 	pieces := pointer.GetRemote().GetRemotePieces()
@@ -105,8 +110,38 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 		return Error.New("segment %v cannot be repaired: only %d healthy pieces, %d required", path, numHealthy, pointer.Remote.Redundancy.MinReq+1)
 	}
 
+	// pieces which violate the placement policy are replaced, as long as
+	// enough pieces remain to download the segment
+	var healthy []*pb.RemotePiece
+	missingSet := sliceToSet(missingPieces)
+	for _, piece := range pieces {
+		if _, ok := missingSet[piece.GetPieceNum()]; !ok {
+			healthy = append(healthy, piece)
+		}
+	}
+	placement, err := repairer.metainfo.SegmentPlacement(ctx, path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	misplacedPieces, err := repairer.cache.MisplacedPieces(ctx, placement, healthy)
+	if err != nil {
+		if !overlay.ErrInvalidPlacement.Has(err) {
+			return Error.Wrap(err)
+		}
+		// the placement is only ignored to keep the segment from being lost
+		if int32(numHealthy) > pointer.Remote.Redundancy.RepairThreshold {
+			return Error.Wrap(err)
+		}
+		mon.Meter("repair_placement_ignored").Mark(1)
+		repairer.log.Error("repairing segment below the repair threshold without its placement policy", zap.String("Path", path), zap.Error(err))
+		placement = ""
+	}
+	if maxMisplaced := numHealthy - int(pointer.Remote.Redundancy.MinReq+1); len(misplacedPieces) > maxMisplaced {
+		misplacedPieces = misplacedPieces[:maxMisplaced]
+	}
+
 	// repair not needed
-	if int32(numHealthy) > pointer.Remote.Redundancy.RepairThreshold {
+	if int32(numHealthy) > pointer.Remote.Redundancy.RepairThreshold && len(misplacedPieces) == 0 {
 		mon.Meter("repair_unnecessary").Mark(1)
 		repairer.log.Sugar().Debugf("segment %v with %d pieces above repair threshold %d", path, numHealthy, pointer.Remote.Redundancy.RepairThreshold)
 		return nil
@@ -118,7 +153,7 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	}
 	mon.FloatVal("healthy_ratio_before_repair").Observe(healthyRatioBeforeRepair)
 
-	lostPiecesSet := sliceToSet(missingPieces)
+	lostPiecesSet := sliceToSet(append(missingPieces, misplacedPieces...))
 
 	// Populate healthyPieces with all pieces from the pointer except those correlating to indices in lostPieces
 	for _, piece := range pieces {
@@ -126,6 +161,7 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 		if _, ok := lostPiecesSet[piece.GetPieceNum()]; !ok {
 			healthyPieces = append(healthyPieces, piece)
 			healthyMap[piece.GetPieceNum()] = true
+			placedNodeIDs = append(placedNodeIDs, piece.NodeId)
 		} else {
 			unhealthyPieces = append(unhealthyPieces, piece)
 		}
@@ -156,6 +192,8 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 		FreeBandwidth:  pieceSize,
 		FreeDisk:       pieceSize,
 		ExcludedNodes:  excludeNodeIDs,
+		PlacedNodes:    placedNodeIDs,
+		Placement:      placement,
 	}
 	newNodes, err := repairer.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -187,17 +225,19 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	}
 
 	// Add the successfully uploaded pieces to the healthyPieces
+	var repairedPieces []*pb.RemotePiece
 	for i, node := range successfulNodes {
 		if node == nil {
 			continue
 		}
-		healthyPieces = append(healthyPieces, &pb.RemotePiece{
+		repairedPieces = append(repairedPieces, &pb.RemotePiece{
 			PieceNum: int32(i),
 			NodeId:   node.Id,
 			Hash:     hashes[i],
 		})
 		healthyMap[int32(i)] = true
 	}
+	healthyPieces = append(healthyPieces, repairedPieces...)
 
 	healthyLength := int32(len(healthyPieces))
 	switch {
@@ -215,20 +255,18 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path) (err erro
 	}
 	mon.FloatVal("healthy_ratio_after_repair").Observe(healthyRatioAfterRepair)
 
-	// if partial repair, include "unhealthy" pieces that are not duplicates
-	if healthyLength < pointer.Remote.Redundancy.SuccessThreshold {
-		for _, p := range unhealthyPieces {
-			if _, ok := healthyMap[p.GetPieceNum()]; !ok {
-				healthyPieces = append(healthyPieces, p)
-			}
+	// if partial repair, keep the "unhealthy" pieces that are not replaced
+	var removedPieces []*pb.RemotePiece
+	for _, p := range unhealthyPieces {
+		if healthyLength >= pointer.Remote.Redundancy.SuccessThreshold || healthyMap[p.GetPieceNum()] {
+			removedPieces = append(removedPieces, p)
 		}
 	}
 
-	// Update the remote pieces in the pointer
-	pointer.GetRemote().RemotePieces = healthyPieces
-
-	// Update the segment pointer in the metainfo
-	return repairer.metainfo.Put(ctx, path, pointer)
+	// Update the remote pieces of the current segment pointer, so changes made
+	// during the repair, e.g. by graceful exit or by copying the segment, are kept
+	_, err = repairer.metainfo.UpdatePieces(ctx, path, repairedPieces, removedPieces)
+	return Error.Wrap(err)
 }
 
 // sliceToSet converts the given slice to a set
@@ -245,5 +283,9 @@ func createBucketID(path storj.Path) ([]byte, error) {
 	if len(comps) < 3 {
 		return nil, Error.New("no bucket component in path: %s", path)
 	}
+	// packed and copied segments don't belong to a bucket
+	if comps[1] == "c" {
+		return []byte(storj.JoinPaths(comps[0], "")), nil
+	}
 	return []byte(storj.JoinPaths(comps[0], comps[2])), nil
 }
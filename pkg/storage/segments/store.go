@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/zeebo/errs"
 	"gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/pkg/eestream"
@@ -40,13 +41,34 @@ type ListItem struct {
 	IsPrefix bool
 }
 
+// PackedObject is an object stored in a range of a remote segment that is
+// shared with other small objects
+type PackedObject struct {
+	Path     storj.Path
+	Offset   int64
+	Length   int64
+	Metadata []byte
+}
+
 // Store for segments
 type Store interface {
 	Meta(ctx context.Context, path storj.Path) (meta Meta, err error)
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
+	GetVersion(ctx context.Context, path storj.Path, versionID string) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+
+	BeginObject(ctx context.Context, bucket string, objectPath storj.Path, expiration time.Time) (streamID storj.StreamID, err error)
+	PutPending(ctx context.Context, data io.Reader, bucket string, objectPath storj.Path, streamID storj.StreamID, segmentIndex int64, expiration time.Time, metadata []byte) (meta Meta, err error)
+	CommitObject(ctx context.Context, bucket string, objectPath storj.Path, streamID storj.StreamID, segmentCount int64, metadata []byte) (meta Meta, err error)
+	ObjectMeta(ctx context.Context, bucket string, objectPath storj.Path) (meta Meta, err error)
+	ListObjects(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+
+	PutInlineObject(ctx context.Context, data []byte, bucket string, objectPath storj.Path, expiration time.Time, metadata []byte) (meta Meta, err error)
+	DeleteObject(ctx context.Context, bucket string, objectPath storj.Path, segmentCount int64) (err error)
+	PutPacked(ctx context.Context, data io.Reader, bucket string, expiration time.Time, objects []PackedObject) (metas []Meta, err error)
+	DeletePieces(ctx context.Context, deleted []metainfo.DeletedSegment)
 }
 
 type segmentStore struct {
@@ -89,14 +111,7 @@ func (s *segmentStore) Meta(ctx context.Context, path storj.Path) (meta Meta, er
 func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	redundancy := &pb.RedundancyScheme{
-		Type:             pb.RedundancyScheme_RS,
-		MinReq:           int32(s.rs.RequiredCount()),
-		Total:            int32(s.rs.TotalCount()),
-		RepairThreshold:  int32(s.rs.RepairThreshold()),
-		SuccessThreshold: int32(s.rs.OptimalThreshold()),
-		ErasureShareSize: int32(s.rs.ErasureShareSize()),
-	}
+	redundancy := s.redundancyScheme()
 
 	peekReader := NewPeekThresholdReader(data)
 	remoteSized, err := peekReader.IsLargerThan(s.thresholdSize)
@@ -176,16 +191,237 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 	return convertMeta(savedPointer), nil
 }
 
+// BeginObject starts the upload of an object, its segments stay invisible
+// until the object is committed
+func (s *segmentStore) BeginObject(ctx context.Context, bucket string, objectPath storj.Path, expiration time.Time) (streamID storj.StreamID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamID, err = s.metainfo.BeginObject(ctx, bucket, objectPath, s.redundancyScheme(), expiration)
+	if err != nil {
+		return storj.StreamID{}, Error.Wrap(err)
+	}
+	return streamID, nil
+}
+
+// PutPending uploads a segment of an object begun with BeginObject
+func (s *segmentStore) PutPending(ctx context.Context, data io.Reader, bucket string, objectPath storj.Path, streamID storj.StreamID, segmentIndex int64, expiration time.Time, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peekReader := NewPeekThresholdReader(data)
+	remoteSized, err := peekReader.IsLargerThan(s.thresholdSize)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	var pointer *pb.Pointer
+	var originalLimits []*pb.OrderLimit
+	if !remoteSized {
+		pointer = &pb.Pointer{
+			CreationDate:   time.Now(),
+			Type:           pb.Pointer_INLINE,
+			InlineSegment:  peekReader.thresholdBuf,
+			SegmentSize:    int64(len(peekReader.thresholdBuf)),
+			ExpirationDate: expiration,
+			Metadata:       metadata,
+		}
+	} else {
+		limits, rootPieceID, piecePrivateKey, err := s.metainfo.BeginSegment(ctx, bucket, objectPath, streamID, segmentIndex, s.maxEncryptedSegmentSize)
+		if err != nil {
+			return Meta{}, Error.Wrap(err)
+		}
+
+		sizedReader := SizeReader(peekReader)
+
+		successfulNodes, successfulHashes, err := s.ec.Put(ctx, limits, piecePrivateKey, s.rs, sizedReader, expiration)
+		if err != nil {
+			return Meta{}, Error.Wrap(err)
+		}
+
+		pointer, err = makeRemotePointer(successfulNodes, successfulHashes, s.rs, rootPieceID, sizedReader.Size(), expiration, metadata)
+		if err != nil {
+			return Meta{}, Error.Wrap(err)
+		}
+
+		originalLimits = make([]*pb.OrderLimit, len(limits))
+		for i, addressedLimit := range limits {
+			originalLimits[i] = addressedLimit.GetLimit()
+		}
+	}
+
+	err = s.metainfo.CommitSegmentNew(ctx, bucket, objectPath, streamID, segmentIndex, pointer, originalLimits)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return convertMeta(pointer), nil
+}
+
+// CommitObject makes the segments uploaded with PutPending visible as the
+// object, the metadata is stored with the last segment
+func (s *segmentStore) CommitObject(ctx context.Context, bucket string, objectPath storj.Path, streamID storj.StreamID, segmentCount int64, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, deleted, err := s.metainfo.CommitObject(ctx, bucket, objectPath, streamID, segmentCount, metadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+	s.DeletePieces(ctx, deleted)
+
+	return convertObjectMeta(object), nil
+}
+
+// PutInlineObject uploads an object that consists of a single inline segment
+// with a single call to the satellite, the metadata is stored with the segment
+func (s *segmentStore) PutInlineObject(ctx context.Context, data []byte, bucket string, objectPath storj.Path, expiration time.Time, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, deleted, err := s.metainfo.PutInlineObject(ctx, bucket, objectPath, s.redundancyScheme(), expiration, data, metadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+	s.DeletePieces(ctx, deleted)
+
+	return convertObjectMeta(object), nil
+}
+
+// PutPacked uploads data holding several small objects as a single remote
+// segment, every object refers to its own range of the segment
+func (s *segmentStore) PutPacked(ctx context.Context, data io.Reader, bucket string, expiration time.Time, objects []PackedObject) (metas []Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 {
+		return nil, Error.New("no objects to pack")
+	}
+
+	// the order limits are requested for the first object, the segment
+	// belongs to all of them once it is committed
+	limits, rootPieceID, piecePrivateKey, err := s.metainfo.CreateSegment(ctx, bucket, objects[0].Path, -1, s.redundancyScheme(), s.maxEncryptedSegmentSize, expiration)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sizedReader := SizeReader(data)
+
+	successfulNodes, successfulHashes, err := s.ec.Put(ctx, limits, piecePrivateKey, s.rs, sizedReader, expiration)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	pointer, err := makeRemotePointer(successfulNodes, successfulHashes, s.rs, rootPieceID, sizedReader.Size(), expiration, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	originalLimits := make([]*pb.OrderLimit, len(limits))
+	for i, addressedLimit := range limits {
+		originalLimits[i] = addressedLimit.GetLimit()
+	}
+
+	packed := make([]metainfo.PackedObject, len(objects))
+	for i, object := range objects {
+		packed[i] = metainfo.PackedObject{
+			EncryptedPath:     object.Path,
+			Offset:            object.Offset,
+			Length:            object.Length,
+			EncryptedMetadata: object.Metadata,
+		}
+	}
+
+	committed, deleted, err := s.metainfo.CommitPackedObjects(ctx, bucket, pointer, originalLimits, packed)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	s.DeletePieces(ctx, deleted)
+
+	metas = make([]Meta, len(committed))
+	for i, object := range committed {
+		metas[i] = convertObjectMeta(object)
+		metas[i].Size = objects[i].Length
+	}
+	return metas, nil
+}
+
+// DeletePieces removes the pieces of segments which have been deleted from the
+// metainfo, e.g. those of replaced objects, from the storage nodes. Pieces
+// which can't be deleted are left to garbage collection.
+func (s *segmentStore) DeletePieces(ctx context.Context, deleted []metainfo.DeletedSegment) {
+	defer mon.Task()(&ctx)(nil)
+
+	for _, segment := range deleted {
+		if len(segment.Limits) == 0 {
+			continue
+		}
+		// the failures are logged by the ec client
+		_ = s.ec.Delete(ctx, segment.Limits, segment.PiecePrivateKey)
+	}
+}
+
+// ObjectMeta retrieves the metadata of a committed object
+func (s *segmentStore) ObjectMeta(ctx context.Context, bucket string, objectPath storj.Path) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := s.metainfo.GetObject(ctx, bucket, objectPath)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return convertObjectMeta(object), nil
+}
+
+// ListObjects retrieves the paths of committed objects and their metadata
+func (s *segmentStore) ListObjects(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, more, err := s.metainfo.ListObjects(ctx, bucket, prefix, startAfter, endBefore, recursive, int32(limit), metaFlags)
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	items = make([]ListItem, len(list))
+	for i, item := range list {
+		items[i] = ListItem{
+			Path: item.EncryptedPath,
+			Meta: Meta{
+				Modified:   item.CreatedAt,
+				Expiration: item.ExpiresAt,
+				Data:       item.EncryptedMetadata,
+			},
+			IsPrefix: item.IsPrefix,
+		}
+	}
+
+	return items, more, nil
+}
+
+// redundancyScheme returns the redundancy scheme of the uploaded segments
+func (s *segmentStore) redundancyScheme() *pb.RedundancyScheme {
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_RS,
+		MinReq:           int32(s.rs.RequiredCount()),
+		Total:            int32(s.rs.TotalCount()),
+		RepairThreshold:  int32(s.rs.RepairThreshold()),
+		SuccessThreshold: int32(s.rs.OptimalThreshold()),
+		ErasureShareSize: int32(s.rs.ErasureShareSize()),
+	}
+}
+
 // Get requests the satellite to read a segment and downloaded the pieces from the storage nodes
 func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.GetVersion(ctx, path, "")
+}
+
+// GetVersion reads a segment of the given object version, an empty version id
+// refers to the current version
+func (s *segmentStore) GetVersion(ctx context.Context, path storj.Path, versionID string) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
-	pointer, limits, piecePrivateKey, err := s.metainfo.ReadSegment(ctx, bucket, objectPath, segmentIndex)
+	pointer, limits, piecePrivateKey, err := s.metainfo.ReadSegmentVersion(ctx, bucket, objectPath, segmentIndex, versionID)
 	if err != nil {
 		return nil, Meta{}, Error.Wrap(err)
 	}
@@ -221,6 +457,14 @@ func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Rang
 			return nil, Meta{}, Error.Wrap(err)
 		}
 
+		// only the range of the object is read from a packed segment
+		if packed := pointer.GetPacked(); packed != nil {
+			rr, err = ranger.Subrange(rr, packed.Offset, packed.Length)
+			if err != nil {
+				return nil, Meta{}, Error.Wrap(err)
+			}
+		}
+
 		return rr, convertMeta(pointer), nil
 	default:
 		return nil, Meta{}, Error.New("unsupported pointer type: %d", pointer.GetType())
@@ -296,6 +540,36 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	return nil
 }
 
+// DeleteObject deletes the segments of an object with a single call to the
+// satellite and then removes the pieces of its remote segments from the
+// storage nodes
+func (s *segmentStore) DeleteObject(ctx context.Context, bucket string, objectPath storj.Path, segmentCount int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the last segment is deleted last, so a failure leaves the object visible
+	var segmentIndexes []int64
+	for i := int64(0); i < segmentCount-1; i++ {
+		segmentIndexes = append(segmentIndexes, i)
+	}
+	segmentIndexes = append(segmentIndexes, -1)
+
+	// the segments deleted before a failure still have their pieces removed
+	deleted, err := s.metainfo.DeleteSegments(ctx, bucket, objectPath, segmentIndexes)
+
+	var group errs.Group
+	group.Add(err)
+	for _, segment := range deleted {
+		if len(segment.Limits) == 0 {
+			// inline segment - nothing else to do
+			continue
+		}
+
+		group.Add(s.ec.Delete(ctx, segment.Limits, segment.PiecePrivateKey))
+	}
+
+	return Error.Wrap(group.Err())
+}
+
 // List retrieves paths to segments and their metadata stored in the metainfo
 func (s *segmentStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -346,14 +620,27 @@ func CalcNeededNodes(rs *pb.RedundancyScheme) int32 {
 
 // convertMeta converts pointer to segment metadata
 func convertMeta(pr *pb.Pointer) Meta {
+	size := pr.GetSegmentSize()
+	if packed := pr.GetPacked(); packed != nil {
+		size = packed.Length
+	}
 	return Meta{
 		Modified:   pr.GetCreationDate(),
 		Expiration: pr.GetExpirationDate(),
-		Size:       pr.GetSegmentSize(),
+		Size:       size,
 		Data:       pr.GetMetadata(),
 	}
 }
 
+// convertObjectMeta converts a committed object to the metadata of its last segment
+func convertObjectMeta(object metainfo.Object) Meta {
+	return Meta{
+		Modified:   object.CreatedAt,
+		Expiration: object.ExpiresAt,
+		Data:       object.EncryptedMetadata,
+	}
+}
+
 func splitPathFragments(path storj.Path) (bucket string, objectPath storj.Path, segmentIndex int64, err error) {
 	components := storj.SplitPath(path)
 	if len(components) < 1 {
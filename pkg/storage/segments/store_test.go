@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strconv"
 	"testing"
 	time "time"
@@ -147,6 +148,43 @@ func TestSegmentStoreDelete(t *testing.T) {
 	}
 }
 
+func TestSegmentStoreDeleteObjectPartialFailure(t *testing.T) {
+	runTest(t, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store) {
+		satellite := planet.Satellites[0]
+
+		_, err := segmentStore.Put(ctx, bytes.NewReader(testrand.Bytes(100*memory.KiB)), time.Time{}, func() (storj.Path, []byte, error) {
+			return "s0/test-bucket/mypath/1", []byte("metadata"), nil
+		})
+		require.NoError(t, err)
+
+		keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		pointer, err := satellite.Metainfo.Service.Get(ctx, keys[0].String())
+		require.NoError(t, err)
+		remote := pointer.GetRemote()
+		require.NotNil(t, remote)
+
+		// the last segment doesn't exist, so the batch fails after deleting
+		// the first segment
+		err = segmentStore.DeleteObject(ctx, "test-bucket", "mypath/1", 2)
+		require.True(t, storage.ErrKeyNotFound.Has(err), err)
+
+		_, err = satellite.Metainfo.Service.Get(ctx, keys[0].String())
+		require.True(t, storage.ErrKeyNotFound.Has(err), err)
+
+		for _, piece := range remote.GetRemotePieces() {
+			for _, node := range planet.StorageNodes {
+				if node.ID() != piece.NodeId {
+					continue
+				}
+				_, err := node.Storage2.Store.Reader(ctx, satellite.ID(), remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+				require.True(t, os.IsNotExist(err), "piece of a deleted segment should have been deleted")
+			}
+		}
+	})
+}
+
 func TestSegmentStoreList(t *testing.T) {
 	runTest(t, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, segmentStore segments.Store) {
 		expiration := time.Now().Add(24 * time.Hour * 10)
@@ -0,0 +1,229 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/ranger"
+)
+
+// consumedReader signals when the upload of a segment has read all of its
+// data, so that the data of the next segment can be read.
+type consumedReader struct {
+	reader   io.Reader
+	consumed chan struct{}
+	once     sync.Once
+}
+
+func newConsumedReader(reader io.Reader) *consumedReader {
+	return &consumedReader{
+		reader:   reader,
+		consumed: make(chan struct{}),
+	}
+}
+
+func (r *consumedReader) Read(p []byte) (n int, err error) {
+	select {
+	case <-r.consumed:
+		return 0, io.EOF
+	default:
+	}
+
+	n, err = r.reader.Read(p)
+	if err == io.EOF {
+		r.markConsumed()
+	}
+	return n, err
+}
+
+func (r *consumedReader) markConsumed() {
+	r.once.Do(func() { close(r.consumed) })
+}
+
+// segmentRange is the part of a segment that is read by a range request
+type segmentRange struct {
+	ranger ranger.Ranger
+	offset int64
+	length int64
+}
+
+// parallelRanger concatenates the rangers of the segments of a stream and
+// downloads up to parallelism segments at once. The data of the segments that
+// are downloaded ahead is buffered in temporary files, or in memory if the
+// context asks for it.
+type parallelRanger struct {
+	rangers     []ranger.Ranger
+	parallelism int
+}
+
+// Size implements Ranger.Size
+func (pr *parallelRanger) Size() (size int64) {
+	for _, rr := range pr.rangers {
+		size += rr.Size()
+	}
+	return size
+}
+
+// Range implements Ranger.Range
+func (pr *parallelRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if offset < 0 {
+		return nil, errs.New("negative offset")
+	}
+	if length < 0 {
+		return nil, errs.New("negative length")
+	}
+	if offset+length > pr.Size() {
+		return nil, errs.New("range beyond end of stream")
+	}
+
+	var parts []segmentRange
+	for _, rr := range pr.rangers {
+		if length <= 0 {
+			break
+		}
+
+		size := rr.Size()
+		if offset >= size {
+			offset -= size
+			continue
+		}
+
+		partLength := size - offset
+		if partLength > length {
+			partLength = length
+		}
+		parts = append(parts, segmentRange{ranger: rr, offset: offset, length: partLength})
+		offset, length = 0, length-partLength
+	}
+
+	switch len(parts) {
+	case 0:
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	case 1:
+		return parts[0].ranger.Range(ctx, parts[0].offset, parts[0].length)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &parallelReader{
+		ctx:         ctx,
+		cancel:      cancel,
+		parts:       parts,
+		parallelism: pr.parallelism,
+	}, nil
+}
+
+// parallelReader reads the parts of a range in order while the following
+// parts are downloaded in the background.
+type parallelReader struct {
+	ctx         context.Context
+	cancel      func()
+	parts       []segmentRange
+	parallelism int
+
+	pending []sync2.PipeReader
+	fetches sync.WaitGroup
+}
+
+// Read implements io.Reader
+func (pr *parallelReader) Read(data []byte) (n int, err error) {
+	for {
+		err = pr.startFetches()
+		if err != nil {
+			return 0, err
+		}
+		if len(pr.pending) == 0 {
+			return 0, io.EOF
+		}
+
+		n, err = pr.pending[0].Read(data)
+		if err != io.EOF {
+			return n, err
+		}
+
+		err = pr.pending[0].Close()
+		pr.pending = pr.pending[1:]
+		if err != nil || n > 0 {
+			return n, err
+		}
+	}
+}
+
+// startFetches starts downloading the next parts until parallelism parts
+// are pending.
+func (pr *parallelReader) startFetches() error {
+	for len(pr.pending) < pr.parallelism && len(pr.parts) > 0 {
+		part := pr.parts[0]
+
+		reader, writer, err := newSegmentPipe(pr.ctx, part.length)
+		if err != nil {
+			return err
+		}
+
+		pr.parts = pr.parts[1:]
+		pr.pending = append(pr.pending, reader)
+
+		pr.fetches.Add(1)
+		go func() {
+			defer pr.fetches.Done()
+			_ = writer.CloseWithError(fetchSegment(pr.ctx, part, writer))
+		}()
+	}
+	return nil
+}
+
+// Close implements io.Closer
+func (pr *parallelReader) Close() (err error) {
+	pr.cancel()
+	for _, reader := range pr.pending {
+		err = errs.Combine(err, reader.Close())
+	}
+	pr.pending = nil
+	pr.fetches.Wait()
+	return err
+}
+
+// fetchSegment copies the data of a segment range to the writer
+func fetchSegment(ctx context.Context, part segmentRange, writer io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := part.ranger.Range(ctx, part.offset, part.length)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	written, err := sync2.Copy(ctx, writer, reader)
+	// an in-memory pipe reports a closed pipe once its buffer is full
+	if written == part.length {
+		return nil
+	}
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// newSegmentPipe creates the buffer for the data of a segment range
+func newSegmentPipe(ctx context.Context, size int64) (sync2.PipeReader, sync2.PipeWriter, error) {
+	tempDir, inmemory, _ := fpath.GetTempData(ctx)
+	if inmemory {
+		return sync2.NewPipeMemory(size)
+	}
+	if tempDir == "" {
+		tempDir = os.TempDir()
+	}
+	return sync2.NewPipeFile(tempDir)
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/ranger"
+)
+
+func TestParallelRanger(t *testing.T) {
+	data := testrand.BytesInt(1000)
+
+	var rangers []ranger.Ranger
+	for offset := 0; offset < len(data); offset += 300 {
+		end := offset + 300
+		if end > len(data) {
+			end = len(data)
+		}
+		rangers = append(rangers, ranger.ByteRanger(data[offset:end]))
+	}
+
+	for _, inmemory := range []bool{false, true} {
+		ctx := fpath.WithTempData(context.Background(), "", inmemory)
+
+		for _, parallelism := range []int{2, 3, 10} {
+			rr := &parallelRanger{rangers: rangers, parallelism: parallelism}
+			require.Equal(t, int64(len(data)), rr.Size())
+
+			for _, tt := range []struct{ offset, length int64 }{
+				{0, 1000}, {0, 0}, {0, 300}, {299, 2}, {100, 800}, {600, 400}, {999, 1},
+			} {
+				name := fmt.Sprintf("inmemory=%t parallelism=%d range=%d+%d", inmemory, parallelism, tt.offset, tt.length)
+
+				reader, err := rr.Range(ctx, tt.offset, tt.length)
+				require.NoError(t, err, name)
+
+				read, err := ioutil.ReadAll(reader)
+				require.NoError(t, err, name)
+				require.NoError(t, reader.Close(), name)
+
+				assert.Equal(t, data[tt.offset:tt.offset+tt.length], read, name)
+			}
+		}
+	}
+
+	rr := &parallelRanger{rangers: rangers, parallelism: 2}
+	_, err := rr.Range(context.Background(), 500, 501)
+	assert.Error(t, err)
+	_, err = rr.Range(context.Background(), -1, 10)
+	assert.Error(t, err)
+}
+
+func TestParallelRangerClose(t *testing.T) {
+	data := testrand.BytesInt(1000)
+	rr := &parallelRanger{
+		rangers: []ranger.Ranger{
+			ranger.ByteRanger(data[:400]),
+			ranger.ByteRanger(data[400:800]),
+			ranger.ByteRanger(data[800:]),
+		},
+		parallelism: 3,
+	}
+
+	reader, err := rr.Range(context.Background(), 0, 1000)
+	require.NoError(t, err)
+
+	// closing in the middle of a range stops the downloads ahead
+	buf := make([]byte, 10)
+	_, err = reader.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, data[:10], buf)
+	require.NoError(t, reader.Close())
+}
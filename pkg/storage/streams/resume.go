@@ -0,0 +1,100 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// UploadJournal records the progress of an upload, so that the upload can be
+// resumed after it failed.
+type UploadJournal interface {
+	// Load returns the recorded progress, which is empty for a new upload.
+	Load(ctx context.Context) (UploadState, error)
+	// Save records the progress.
+	Save(ctx context.Context, state UploadState) error
+}
+
+// UploadState is the progress of an upload. The satellite keeps the segments
+// of the pending object until the upload is abandoned.
+type UploadState struct {
+	StreamID    storj.StreamID    `json:"stream_id"`
+	SegmentSize int64             `json:"segment_size"`
+	Segments    []UploadedSegment `json:"segments"`
+}
+
+// UploadedSegment is a segment of a pending object that has been committed.
+type UploadedSegment struct {
+	Index        int64                     `json:"index"`
+	Size         int64                     `json:"size"`
+	EncryptedKey storj.EncryptedPrivateKey `json:"encrypted_key"`
+	KeyNonce     storj.Nonce               `json:"key_nonce"`
+}
+
+// Completed returns the segments that were uploaded before the first segment
+// that is missing, the upload is resumed after them.
+func (state UploadState) Completed() []UploadedSegment {
+	segments := append([]UploadedSegment(nil), state.Segments...)
+	sort.Slice(segments, func(i, k int) bool {
+		return segments[i].Index < segments[k].Index
+	})
+
+	for i, segment := range segments {
+		if segment.Index != int64(i) {
+			return segments[:i]
+		}
+	}
+	return segments
+}
+
+// Offset returns where the data of the upload continues.
+func (state UploadState) Offset() (offset int64) {
+	for _, segment := range state.Completed() {
+		offset += segment.Size
+	}
+	return offset
+}
+
+// uploadProgress records the progress of an upload in a journal, the segments
+// may be uploaded concurrently.
+type uploadProgress struct {
+	mu      sync.Mutex
+	journal UploadJournal
+	state   UploadState
+}
+
+// begin records the pending object of the upload.
+func (progress *uploadProgress) begin(ctx context.Context, streamID storj.StreamID, segmentSize int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if progress.journal == nil {
+		return nil
+	}
+
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	progress.state = UploadState{
+		StreamID:    streamID,
+		SegmentSize: segmentSize,
+	}
+	return progress.journal.Save(ctx, progress.state)
+}
+
+// uploaded records a segment that has been committed.
+func (progress *uploadProgress) uploaded(ctx context.Context, segment UploadedSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if progress.journal == nil {
+		return nil
+	}
+
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	progress.state.Segments = append(progress.state.Segments, segment)
+	return progress.journal.Save(ctx, progress.state)
+}
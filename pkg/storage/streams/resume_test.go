@@ -0,0 +1,30 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadState(t *testing.T) {
+	state := UploadState{
+		SegmentSize: 10,
+		Segments: []UploadedSegment{
+			{Index: 1, Size: 10},
+			{Index: 0, Size: 10},
+			{Index: 3, Size: 10},
+		},
+	}
+
+	completed := state.Completed()
+	assert.Len(t, completed, 2)
+	assert.Equal(t, int64(0), completed[0].Index)
+	assert.Equal(t, int64(1), completed[1].Index)
+	assert.Equal(t, int64(20), state.Offset())
+
+	assert.Empty(t, UploadState{}.Completed())
+	assert.Equal(t, int64(0), UploadState{}.Offset())
+}
@@ -18,9 +18,13 @@ import (
 type Store interface {
 	Meta(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
+	GetVersion(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, versionID string) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	PutResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.ReadSeeker, metadata []byte, expiration time.Time, journal UploadJournal) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	PutPacked(ctx context.Context, bucket string, pathCipher storj.CipherSuite, objects []PackedObject, expiration time.Time) ([]Meta, error)
+	WithParallelism(parallelism int) Store
 }
 
 type shimStore struct {
@@ -50,6 +54,13 @@ func (s *shimStore) Get(ctx context.Context, path storj.Path, pathCipher storj.C
 	return s.store.Get(ctx, ParsePath(path), pathCipher)
 }
 
+// GetVersion parses the passed in path and dispatches to the typed store.
+func (s *shimStore) GetVersion(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, versionID string) (_ ranger.Ranger, _ Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.GetVersion(ctx, ParsePath(path), pathCipher, versionID)
+}
+
 // Put parses the passed in path and dispatches to the typed store.
 func (s *shimStore) Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (_ Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -57,6 +68,13 @@ func (s *shimStore) Put(ctx context.Context, path storj.Path, pathCipher storj.C
 	return s.store.Put(ctx, ParsePath(path), pathCipher, data, metadata, expiration)
 }
 
+// PutResumable parses the passed in path and dispatches to the typed store.
+func (s *shimStore) PutResumable(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.ReadSeeker, metadata []byte, expiration time.Time, journal UploadJournal) (_ Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.PutResumable(ctx, ParsePath(path), pathCipher, data, metadata, expiration, journal)
+}
+
 // Delete parses the passed in path and dispatches to the typed store.
 func (s *shimStore) Delete(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -70,3 +88,16 @@ func (s *shimStore) List(ctx context.Context, prefix storj.Path, startAfter stor
 
 	return s.store.List(ctx, ParsePath(prefix), startAfter, endBefore, pathCipher, recursive, limit, metaFlags)
 }
+
+// PutPacked dispatches to the typed store.
+func (s *shimStore) PutPacked(ctx context.Context, bucket string, pathCipher storj.CipherSuite, objects []PackedObject, expiration time.Time) (_ []Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.PutPacked(ctx, bucket, pathCipher, objects, expiration)
+}
+
+// WithParallelism returns a store that transfers up to parallelism segments
+// of a stream at once.
+func (s *shimStore) WithParallelism(parallelism int) Store {
+	return &shimStore{store: s.store.WithParallelism(parallelism)}
+}
@@ -14,7 +14,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
-	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/pkg/eestream"
@@ -25,7 +25,6 @@ import (
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/storage"
 )
 
 var mon = monkit.Package()
@@ -52,9 +51,21 @@ func convertMeta(lastSegmentMeta segments.Meta, stream pb.StreamInfo, streamMeta
 type typedStore interface {
 	Meta(ctx context.Context, path Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
+	GetVersion(ctx context.Context, path Path, pathCipher storj.CipherSuite, versionID string) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	PutResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.ReadSeeker, metadata []byte, expiration time.Time, journal UploadJournal) (Meta, error)
 	Delete(ctx context.Context, path Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix Path, startAfter, endBefore string, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	PutPacked(ctx context.Context, bucket string, pathCipher storj.CipherSuite, objects []PackedObject, expiration time.Time) ([]Meta, error)
+	WithParallelism(parallelism int) typedStore
+}
+
+// PackedObject is a small object that is uploaded together with other objects
+// of the same bucket, its path is relative to the bucket
+type PackedObject struct {
+	Path     storj.Path
+	Data     []byte
+	Metadata []byte
 }
 
 // streamStore is a store for streams. It implements typedStore as part of an ongoing migration
@@ -66,6 +77,7 @@ type streamStore struct {
 	encBlockSize    int
 	cipher          storj.CipherSuite
 	inlineThreshold int
+	parallelism     int
 }
 
 // newTypedStreamStore constructs a typedStore backed by a streamStore.
@@ -84,64 +96,126 @@ func newTypedStreamStore(segments segments.Store, segmentSize int64, encStore *e
 		encBlockSize:    encBlockSize,
 		cipher:          cipher,
 		inlineThreshold: inlineThreshold,
+		parallelism:     1,
 	}, nil
 }
 
+// WithParallelism returns a copy of the store that uploads and downloads up to
+// parallelism segments of a stream at once.
+func (s *streamStore) WithParallelism(parallelism int) typedStore {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	store := *s
+	store.parallelism = parallelism
+	return &store
+}
+
 // Put breaks up data as it comes in into s.segmentSize length pieces, then
 // store the first piece at s0/<path>, second piece at s1/<path>, and the
 // *last* piece at l/<path>. Store the given metadata, along with the number
 // of segments, in a new protobuf, in the metadata of l/<path>.
+//
+// The segments are uploaded as a pending object, which replaces the previous
+// object only once all of them have been uploaded. The segments of an upload
+// that fails are deleted by the satellite. An object that fits into a single
+// inline segment is uploaded with a single call to the satellite.
+//
+// Up to s.parallelism segments are uploaded at once. The data of a segment is
+// read once the previous segment has been handed to the erasure coding, which
+// buffers it until the pieces are uploaded.
 func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.put(ctx, path, pathCipher, data, metadata, expiration, &uploadProgress{})
+}
 
-	// previously file uploaded?
-	err = s.Delete(ctx, path, pathCipher)
-	if err != nil && !storage.ErrKeyNotFound.Has(err) {
-		// something wrong happened checking for an existing
-		// file with the same name
+// PutResumable uploads the stream like Put and records the progress of the
+// upload in the journal. When the journal contains the progress of an earlier
+// upload, which failed, the upload continues with the first segment that is
+// missing. The data has to be the same as the data of the earlier upload.
+func (s *streamStore) PutResumable(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.ReadSeeker, metadata []byte, expiration time.Time, journal UploadJournal) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := journal.Load(ctx)
+	if err != nil {
 		return Meta{}, err
 	}
 
-	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
-	if err != nil {
-		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
+	progress := &uploadProgress{journal: journal}
+	if !state.StreamID.IsZero() {
+		if state.SegmentSize != s.segmentSize {
+			return Meta{}, errs.New("segment size changed from %d to %d", state.SegmentSize, s.segmentSize)
+		}
+		progress.state = UploadState{
+			StreamID:    state.StreamID,
+			SegmentSize: state.SegmentSize,
+			Segments:    state.Completed(),
+		}
+
+		_, err = data.Seek(progress.state.Offset(), io.SeekStart)
+		if err != nil {
+			return Meta{}, err
+		}
 	}
 
-	return m, err
+	return s.put(ctx, path, pathCipher, data, metadata, expiration, progress)
 }
 
-func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, lastSegment int64, err error) {
+// put uploads the stream, continuing the upload of progress
+func (s *streamStore) put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, progress *uploadProgress) (m Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var currentSegment int64
-	var streamSize int64
-	var putMeta segments.Meta
-
-	defer func() {
-		select {
-		case <-ctx.Done():
-			s.cancelHandler(context.Background(), currentSegment, path, pathCipher)
-		default:
-		}
-	}()
-
 	derivedKey, err := encryption.DeriveContentKey(path.Bucket(), path.UnencryptedPath(), s.encStore)
 	if err != nil {
-		return Meta{}, currentSegment, err
+		return Meta{}, err
 	}
 	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
 	if err != nil {
-		return Meta{}, currentSegment, err
+		return Meta{}, err
 	}
 
+	var streamID storj.StreamID
+	var currentSegment int64
+	var streamSize int64
+	var lastSegmentSize int64
+	var contentKey storj.Key
+	var encryptedKey storj.EncryptedPrivateKey
+	var keyNonce storj.Nonce
+
+	// the stream is complete when the last segment of a resumed upload is
+	// smaller than the segment size
+	var complete bool
+	if !progress.state.StreamID.IsZero() {
+		streamID = progress.state.StreamID
+		for _, segment := range progress.state.Segments {
+			currentSegment++
+			lastSegmentSize = segment.Size
+			streamSize += segment.Size
+			encryptedKey, keyNonce = segment.EncryptedKey, segment.KeyNonce
+		}
+		if currentSegment > 0 {
+			key, err := encryption.DecryptKey(encryptedKey, s.cipher, derivedKey, &keyNonce)
+			if err != nil {
+				return Meta{}, err
+			}
+			contentKey = *key
+			complete = lastSegmentSize < s.segmentSize
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	limit := make(chan struct{}, s.parallelism)
+
 	eofReader := NewEOFReader(data)
 
-	for !eofReader.isEOF() && !eofReader.hasError() {
+	for !complete && !eofReader.isEOF() && !eofReader.hasError() {
 		// generate random key for encrypting the segment's content
-		var contentKey storj.Key
 		_, err = rand.Read(contentKey[:])
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, err
 		}
 
 		// Initialize the content nonce with the segment's index incremented by 1.
@@ -150,24 +224,23 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		var contentNonce storj.Nonce
 		_, err := encryption.Increment(&contentNonce, currentSegment+1)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, err
 		}
 
 		encrypter, err := encryption.NewEncrypter(s.cipher, &contentKey, &contentNonce, s.encBlockSize)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, err
 		}
 
 		// generate random nonce for encrypting the content key
-		var keyNonce storj.Nonce
 		_, err = rand.Read(keyNonce[:])
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, err
 		}
 
-		encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
+		encryptedKey, err = encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, err
 		}
 
 		sizeReader := NewSizeReader(eofReader)
@@ -176,7 +249,7 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		// If the data is larger than the inline threshold size, then it will be a remote segment
 		isRemote, err := peekReader.IsLargerThan(s.inlineThreshold)
 		if err != nil {
-			return Meta{}, currentSegment, err
+			return Meta{}, err
 		}
 		var transformedReader io.Reader
 		if isRemote {
@@ -185,88 +258,113 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		} else {
 			data, err := ioutil.ReadAll(peekReader)
 			if err != nil {
-				return Meta{}, currentSegment, err
+				return Meta{}, err
 			}
 			cipherData, err := encryption.Encrypt(data, s.cipher, &contentKey, &contentNonce)
 			if err != nil {
-				return Meta{}, currentSegment, err
+				return Meta{}, err
 			}
-			transformedReader = bytes.NewReader(cipherData)
-		}
 
-		putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
-			if !eofReader.isEOF() {
-				segmentPath, err := createSegmentPath(ctx, currentSegment, path.Bucket(), encPath)
+			// a segment shorter than the segment size is the only one
+			if streamID.IsZero() && int64(len(data)) < s.segmentSize {
+				lastSegmentMeta, err := s.lastSegmentMeta(1, int64(len(data)), metadata, &contentKey, encryptedKey, keyNonce)
 				if err != nil {
-					return "", nil, err
+					return Meta{}, err
 				}
 
-				if s.cipher == storj.EncNull {
-					return segmentPath, nil, nil
-				}
-
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
+				putMeta, err := s.segments.PutInlineObject(ctx, cipherData, path.Bucket(), encPath.Raw(), expiration, lastSegmentMeta)
 				if err != nil {
-					return "", nil, err
+					return Meta{}, err
 				}
 
-				return segmentPath, segmentMeta, nil
+				return Meta{
+					Modified:   putMeta.Modified,
+					Expiration: expiration,
+					Size:       int64(len(data)),
+					Data:       metadata,
+				}, nil
 			}
 
-			lastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
+			transformedReader = bytes.NewReader(cipherData)
+		}
+
+		if streamID.IsZero() {
+			streamID, err = s.segments.BeginObject(ctx, path.Bucket(), encPath.Raw(), expiration)
 			if err != nil {
-				return "", nil, err
+				return Meta{}, err
 			}
-
-			streamInfo, err := proto.Marshal(&pb.StreamInfo{
-				NumberOfSegments: currentSegment + 1,
-				SegmentsSize:     s.segmentSize,
-				LastSegmentSize:  sizeReader.Size(),
-				Metadata:         metadata,
-			})
+			err = progress.begin(ctx, streamID, s.segmentSize)
 			if err != nil {
-				return "", nil, err
+				return Meta{}, err
 			}
+		}
 
-			// encrypt metadata with the content encryption key and zero nonce
-			encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, &contentKey, &storj.Nonce{})
+		// the metadata of the last segment is replaced by the stream metadata
+		// when the object is committed
+		var segmentMeta []byte
+		if s.cipher != storj.EncNull {
+			segmentMeta, err = proto.Marshal(&pb.SegmentMeta{
+				EncryptedKey: encryptedKey,
+				KeyNonce:     keyNonce[:],
+			})
 			if err != nil {
-				return "", nil, err
+				return Meta{}, err
 			}
+		}
 
-			streamMeta := pb.StreamMeta{
-				EncryptedStreamInfo: encryptedStreamInfo,
-				EncryptionType:      int32(s.cipher),
-				EncryptionBlockSize: int32(s.encBlockSize),
-			}
+		select {
+		case limit <- struct{}{}:
+		case <-groupCtx.Done():
+			return Meta{}, group.Wait()
+		}
 
-			if s.cipher != storj.EncNull {
-				streamMeta.LastSegmentMeta = &pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				}
-			}
+		uploadReader := newConsumedReader(transformedReader)
+		segment := UploadedSegment{
+			Index:        currentSegment,
+			EncryptedKey: encryptedKey,
+			KeyNonce:     keyNonce,
+		}
+		group.Go(func() error {
+			defer func() { <-limit }()
 
-			lastSegmentMeta, err := proto.Marshal(&streamMeta)
+			_, err := s.segments.PutPending(groupCtx, uploadReader, path.Bucket(), encPath.Raw(), streamID, segment.Index, expiration, segmentMeta)
 			if err != nil {
-				return "", nil, err
+				return err
 			}
+			uploadReader.markConsumed()
 
-			return lastSegmentPath, lastSegmentMeta, nil
+			// the whole segment has been read once it has been uploaded
+			segment.Size = sizeReader.Size()
+			return progress.uploaded(groupCtx, segment)
 		})
-		if err != nil {
-			return Meta{}, currentSegment, err
+
+		select {
+		case <-uploadReader.consumed:
+		case <-groupCtx.Done():
+			return Meta{}, group.Wait()
 		}
 
 		currentSegment++
-		streamSize += sizeReader.Size()
+		lastSegmentSize = sizeReader.Size()
+		streamSize += lastSegmentSize
 	}
 
+	err = group.Wait()
 	if eofReader.hasError() {
-		return Meta{}, currentSegment, eofReader.err
+		return Meta{}, eofReader.err
+	}
+	if err != nil {
+		return Meta{}, err
+	}
+
+	lastSegmentMeta, err := s.lastSegmentMeta(currentSegment, lastSegmentSize, metadata, &contentKey, encryptedKey, keyNonce)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	putMeta, err := s.segments.CommitObject(ctx, path.Bucket(), encPath.Raw(), streamID, currentSegment, lastSegmentMeta)
+	if err != nil {
+		return Meta{}, err
 	}
 
 	resultMeta := Meta{
@@ -276,7 +374,129 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		Data:       metadata,
 	}
 
-	return resultMeta, currentSegment, nil
+	return resultMeta, nil
+}
+
+// PutPacked encrypts small objects of a bucket, each of them with its own key,
+// and uploads them together as a single remote segment. Every object consists
+// of a single segment, which refers to its range of the packed segment.
+func (s *streamStore) PutPacked(ctx context.Context, bucket string, pathCipher storj.CipherSuite, objects []PackedObject, expiration time.Time) (metas []Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var packed bytes.Buffer
+	segmentObjects := make([]segments.PackedObject, len(objects))
+	for i, object := range objects {
+		path := CreatePath(bucket, paths.NewUnencrypted(object.Path))
+
+		derivedKey, err := encryption.DeriveContentKey(path.Bucket(), path.UnencryptedPath(), s.encStore)
+		if err != nil {
+			return nil, err
+		}
+		encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
+		if err != nil {
+			return nil, err
+		}
+
+		var contentKey storj.Key
+		_, err = rand.Read(contentKey[:])
+		if err != nil {
+			return nil, err
+		}
+		var keyNonce storj.Nonce
+		_, err = rand.Read(keyNonce[:])
+		if err != nil {
+			return nil, err
+		}
+		encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
+		if err != nil {
+			return nil, err
+		}
+
+		// the object is the first and only segment of its stream
+		var contentNonce storj.Nonce
+		_, err = encryption.Increment(&contentNonce, 1)
+		if err != nil {
+			return nil, err
+		}
+		encrypter, err := encryption.NewEncrypter(s.cipher, &contentKey, &contentNonce, s.encBlockSize)
+		if err != nil {
+			return nil, err
+		}
+
+		// the data is padded like a remote segment, so it's decrypted the same way
+		paddedReader := eestream.PadReader(ioutil.NopCloser(bytes.NewReader(object.Data)), encrypter.InBlockSize())
+		offset := int64(packed.Len())
+		length, err := packed.ReadFrom(encryption.TransformReader(paddedReader, encrypter, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		lastSegmentMeta, err := s.lastSegmentMeta(1, int64(len(object.Data)), object.Metadata, &contentKey, encryptedKey, keyNonce)
+		if err != nil {
+			return nil, err
+		}
+
+		segmentObjects[i] = segments.PackedObject{
+			Path:     encPath.Raw(),
+			Offset:   offset,
+			Length:   length,
+			Metadata: lastSegmentMeta,
+		}
+	}
+
+	segmentMetas, err := s.segments.PutPacked(ctx, &packed, bucket, expiration, segmentObjects)
+	if err != nil {
+		return nil, err
+	}
+	if len(segmentMetas) != len(objects) {
+		return nil, errs.New("expected %d committed objects, got %d", len(objects), len(segmentMetas))
+	}
+
+	metas = make([]Meta, len(objects))
+	for i, object := range objects {
+		metas[i] = Meta{
+			Modified:   segmentMetas[i].Modified,
+			Expiration: expiration,
+			Size:       int64(len(object.Data)),
+			Data:       object.Metadata,
+		}
+	}
+	return metas, nil
+}
+
+// lastSegmentMeta returns the metadata stored with the last segment of a
+// stream, the stream info is encrypted with the content key of the last segment.
+func (s *streamStore) lastSegmentMeta(numberOfSegments, lastSegmentSize int64, metadata []byte, contentKey *storj.Key, encryptedKey storj.EncryptedPrivateKey, keyNonce storj.Nonce) ([]byte, error) {
+	streamInfo, err := proto.Marshal(&pb.StreamInfo{
+		NumberOfSegments: numberOfSegments,
+		SegmentsSize:     s.segmentSize,
+		LastSegmentSize:  lastSegmentSize,
+		Metadata:         metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// encrypt metadata with the content encryption key and zero nonce
+	encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, contentKey, &storj.Nonce{})
+	if err != nil {
+		return nil, err
+	}
+
+	streamMeta := pb.StreamMeta{
+		EncryptedStreamInfo: encryptedStreamInfo,
+		EncryptionType:      int32(s.cipher),
+		EncryptionBlockSize: int32(s.encBlockSize),
+	}
+
+	if s.cipher != storj.EncNull {
+		streamMeta.LastSegmentMeta = &pb.SegmentMeta{
+			EncryptedKey: encryptedKey,
+			KeyNonce:     keyNonce[:],
+		}
+	}
+
+	return proto.Marshal(&streamMeta)
 }
 
 // Get returns a ranger that knows what the overall size is (from l/<path>)
@@ -284,6 +504,13 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 // ..., l/<path>.
 func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.CipherSuite) (rr ranger.Ranger, meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.GetVersion(ctx, path, pathCipher, "")
+}
+
+// GetVersion returns a ranger for the given version of the stream, an empty
+// version id refers to the current version.
+func (s *streamStore) GetVersion(ctx context.Context, path Path, pathCipher storj.CipherSuite, versionID string) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
 	if err != nil {
@@ -295,7 +522,7 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return nil, Meta{}, err
 	}
 
-	lastSegmentRanger, lastSegmentMeta, err := s.segments.Get(ctx, segmentPath)
+	lastSegmentRanger, lastSegmentMeta, err := s.segments.GetVersion(ctx, segmentPath, versionID)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -332,6 +559,7 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		rangers = append(rangers, &lazySegmentRanger{
 			segments:      s.segments,
 			path:          currentPath,
+			versionID:     versionID,
 			size:          stream.SegmentsSize,
 			derivedKey:    derivedKey,
 			startingNonce: &contentNonce,
@@ -363,9 +591,11 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 	}
 
 	rangers = append(rangers, decryptedLastSegmentRanger)
-	catRangers := ranger.Concat(rangers...)
 	meta = convertMeta(lastSegmentMeta, stream, streamMeta)
-	return catRangers, meta, nil
+	if s.parallelism > 1 && len(rangers) > 1 {
+		return &parallelRanger{rangers: rangers, parallelism: s.parallelism}, meta, nil
+	}
+	return ranger.Concat(rangers...), meta, nil
 }
 
 // Meta implements Store.Meta
@@ -377,17 +607,12 @@ func (s *streamStore) Meta(ctx context.Context, path Path, pathCipher storj.Ciph
 		return Meta{}, err
 	}
 
-	segmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
+	objectMeta, err := s.segments.ObjectMeta(ctx, path.Bucket(), encPath.Raw())
 	if err != nil {
 		return Meta{}, err
 	}
 
-	lastSegmentMeta, err := s.segments.Meta(ctx, segmentPath)
-	if err != nil {
-		return Meta{}, err
-	}
-
-	streamInfo, streamMeta, err := TypedDecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.encStore)
+	streamInfo, streamMeta, err := TypedDecryptStreamInfo(ctx, objectMeta.Data, path, s.encStore)
 	if err != nil {
 		return Meta{}, err
 	}
@@ -397,7 +622,7 @@ func (s *streamStore) Meta(ctx context.Context, path Path, pathCipher storj.Ciph
 		return Meta{}, err
 	}
 
-	return convertMeta(lastSegmentMeta, stream, streamMeta), nil
+	return convertMeta(objectMeta, stream, streamMeta), nil
 }
 
 // Delete all the segments, with the last one last
@@ -428,19 +653,7 @@ func (s *streamStore) Delete(ctx context.Context, path Path, pathCipher storj.Ci
 		return err
 	}
 
-	for i := 0; i < int(stream.NumberOfSegments-1); i++ {
-		currentPath, err := createSegmentPath(ctx, int64(i), path.Bucket(), encPath)
-		if err != nil {
-			return err
-		}
-
-		err = s.segments.Delete(ctx, currentPath)
-		if err != nil {
-			return err
-		}
-	}
-
-	return s.segments.Delete(ctx, lastSegmentPath)
+	return s.segments.DeleteObject(ctx, path.Bucket(), encPath.Raw(), stream.NumberOfSegments)
 }
 
 // ListItem is a single item in a listing
@@ -450,7 +663,7 @@ type ListItem struct {
 	IsPrefix bool
 }
 
-// List all the paths inside l/, stripping off the l/ prefix
+// List all the committed objects below prefix, stripping off the prefix
 func (s *streamStore) List(ctx context.Context, prefix Path, startAfter, endBefore string, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -486,18 +699,13 @@ func (s *streamStore) List(ctx context.Context, prefix Path, startAfter, endBefo
 		}
 	}
 
-	segmentPrefix, err := createSegmentPath(ctx, -1, prefix.Bucket(), encPrefix)
+	objects, more, err := s.segments.ListObjects(ctx, prefix.Bucket(), encPrefix.Raw(), startAfter, endBefore, recursive, limit, metaFlags)
 	if err != nil {
 		return nil, false, err
 	}
 
-	segments, more, err := s.segments.List(ctx, segmentPrefix, startAfter, endBefore, recursive, limit, metaFlags)
-	if err != nil {
-		return nil, false, err
-	}
-
-	items = make([]ListItem, len(segments))
-	for i, item := range segments {
+	items = make([]ListItem, len(objects))
+	for i, item := range objects {
 		var path Path
 		var itemPath string
 
@@ -546,6 +754,7 @@ type lazySegmentRanger struct {
 	ranger        ranger.Ranger
 	segments      segments.Store
 	path          storj.Path
+	versionID     string
 	size          int64
 	derivedKey    *storj.Key
 	startingNonce *storj.Nonce
@@ -562,7 +771,7 @@ func (lr *lazySegmentRanger) Size() int64 {
 func (lr *lazySegmentRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if lr.ranger == nil {
-		rr, m, err := lr.segments.Get(ctx, lr.path)
+		rr, m, err := lr.segments.GetVersion(ctx, lr.path, lr.versionID)
 		if err != nil {
 			return nil, err
 		}
@@ -618,31 +827,6 @@ func decryptRanger(ctx context.Context, rr ranger.Ranger, decryptedSize int64, c
 	return eestream.Unpad(rd, int(rd.Size()-decryptedSize))
 }
 
-// CancelHandler handles clean up of segments on receiving CTRL+C
-func (s *streamStore) cancelHandler(ctx context.Context, totalSegments int64, path Path, pathCipher storj.CipherSuite) {
-	defer mon.Task()(&ctx)(nil)
-
-	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
-	if err != nil {
-		zap.S().Warnf("Failed deleting segments: %v", err)
-		return
-	}
-
-	for i := int64(0); i < totalSegments; i++ {
-		currentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
-		if err != nil {
-			zap.S().Warnf("Failed deleting segment %d: %v", i, err)
-			continue
-		}
-
-		err = s.segments.Delete(ctx, currentPath)
-		if err != nil {
-			zap.S().Warnf("Failed deleting segment %v: %v", currentPath, err)
-			continue
-		}
-	}
-}
-
 func getEncryptedKeyAndNonce(m *pb.SegmentMeta) (storj.EncryptedPrivateKey, *storj.Nonce) {
 	if m == nil {
 		return nil, nil
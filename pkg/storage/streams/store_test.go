@@ -93,7 +93,7 @@ func TestStreamStoreMeta(t *testing.T) {
 		errTag := fmt.Sprintf("Test case #%d", i)
 
 		mockSegmentStore.EXPECT().
-			Meta(gomock.Any(), gomock.Any()).
+			ObjectMeta(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(test.segmentMeta, test.segmentError)
 
 		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, storj.EncAESGCM, 4)
@@ -156,25 +156,28 @@ func TestStreamStorePut(t *testing.T) {
 	} {
 		errTag := fmt.Sprintf("Test case #%d", i)
 
-		mockSegmentStore.EXPECT().
-			Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(test.segmentMeta, test.segmentError).
-			Do(func(ctx context.Context, data io.Reader, expiration time.Time, info func() (storj.Path, []byte, error)) {
-				for {
-					buf := make([]byte, 4)
-					_, err := data.Read(buf)
-					if err == io.EOF {
-						break
-					}
-				}
-			})
+		streamID := storj.StreamID{1}
 
-		mockSegmentStore.EXPECT().
-			Meta(gomock.Any(), gomock.Any()).
-			Return(test.segmentMeta, test.segmentError)
-		mockSegmentStore.EXPECT().
-			Delete(gomock.Any(), gomock.Any()).
-			Return(test.segmentError)
+		gomock.InOrder(
+			mockSegmentStore.EXPECT().
+				BeginObject(gomock.Any(), "bucket", gomock.Any(), test.expiration).
+				Return(streamID, test.segmentError),
+			mockSegmentStore.EXPECT().
+				PutPending(gomock.Any(), gomock.Any(), "bucket", gomock.Any(), streamID, int64(0), test.expiration, gomock.Any()).
+				Return(test.segmentMeta, test.segmentError).
+				Do(func(ctx context.Context, data io.Reader, bucket string, objectPath storj.Path, streamID storj.StreamID, segmentIndex int64, expiration time.Time, metadata []byte) {
+					for {
+						buf := make([]byte, 4)
+						_, err := data.Read(buf)
+						if err == io.EOF {
+							break
+						}
+					}
+				}),
+			mockSegmentStore.EXPECT().
+				CommitObject(gomock.Any(), "bucket", gomock.Any(), streamID, int64(1), gomock.Any()).
+				Return(test.segmentMeta, test.segmentError),
+		)
 
 		streamStore, err := NewStreamStore(mockSegmentStore, segSize, newStore(), encBlockSize, dataCipher, inlineSize)
 		if err != nil {
@@ -280,7 +283,7 @@ func TestStreamStoreGet(t *testing.T) {
 
 		calls := []*gomock.Call{
 			mockSegmentStore.EXPECT().
-				Get(gomock.Any(), gomock.Any()).
+				GetVersion(gomock.Any(), gomock.Any(), "").
 				Return(test.segmentRanger, test.segmentMeta, test.segmentError),
 		}
 
@@ -301,6 +304,41 @@ func TestStreamStoreGet(t *testing.T) {
 	}
 }
 
+func TestStreamStorePutInline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSegmentStore := segments.NewMockStore(ctrl)
+
+	staticTime := time.Now()
+	segmentMeta := segments.Meta{
+		Modified:   staticTime,
+		Expiration: staticTime,
+	}
+
+	// an object that fits into a single inline segment is uploaded at once
+	mockSegmentStore.EXPECT().
+		PutInlineObject(gomock.Any(), []byte("data"), "bucket", gomock.Any(), staticTime, gomock.Any()).
+		Return(segmentMeta, nil)
+
+	streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, storj.EncNull, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := streamStore.Put(ctx, "bucket", storj.EncAESGCM, strings.NewReader("data"), []byte("metadata"), staticTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, Meta{
+		Modified:   staticTime,
+		Expiration: staticTime,
+		Size:       4,
+		Data:       []byte("metadata"),
+	}, meta)
+}
+
 func TestStreamStoreDelete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -332,7 +370,7 @@ func TestStreamStoreDelete(t *testing.T) {
 			Meta(gomock.Any(), gomock.Any()).
 			Return(test.segmentMeta, test.segmentError)
 		mockSegmentStore.EXPECT().
-			Delete(gomock.Any(), gomock.Any()).
+			DeleteObject(gomock.Any(), "bucket", gomock.Any(), int64(0)).
 			Return(test.segmentError)
 
 		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, 0, 0)
@@ -376,7 +414,7 @@ func TestStreamStoreList(t *testing.T) {
 		errTag := fmt.Sprintf("Test case #%d", i)
 
 		mockSegmentStore.EXPECT().
-			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			ListObjects(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(test.segments, test.segmentMore, test.segmentError)
 
 		streamStore, err := NewStreamStore(mockSegmentStore, 10, newStore(), 10, 0, 0)
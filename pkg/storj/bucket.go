@@ -32,4 +32,24 @@ type Bucket struct {
 	DefaultSegmentsSize         int64
 	DefaultRedundancyScheme     RedundancyScheme
 	DefaultEncryptionParameters EncryptionParameters
+	Versioning                  bool
+	LifecycleRules              []LifecycleRule
+	// Placement is the placement policy restricting the nodes which store
+	// the data of the bucket, e.g. "EU". Empty allows any node.
+	Placement string
+}
+
+// LifecycleRule describes when the objects of a bucket are removed
+// automatically. A zero number of days disables the respective action.
+type LifecycleRule struct {
+	// ID identifies the rule within the bucket
+	ID string
+	// Prefix limits the rule to the objects below the path, on the satellite
+	// it is the encrypted path
+	Prefix Path
+	// ExpirationDays is the number of days after their creation when objects expire
+	ExpirationDays int
+	// AbortIncompleteUploadDays is the number of days after which the
+	// segments of uploads that were never committed are removed
+	AbortIncompleteUploadDays int
 }
@@ -22,6 +22,12 @@ type Metainfo interface {
 	GetBucket(ctx context.Context, bucket string) (Bucket, error)
 	// ListBuckets lists buckets starting from first
 	ListBuckets(ctx context.Context, options BucketListOptions) (BucketList, error)
+	// SetBucketVersioning enables or disables keeping the previous versions of objects
+	SetBucketVersioning(ctx context.Context, bucket string, versioning bool) (Bucket, error)
+	// SetBucketLifecycle replaces the lifecycle rules of a bucket
+	SetBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error
+	// GetBucketLifecycle returns the lifecycle rules of a bucket
+	GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error)
 
 	// GetObject returns information about an object
 	GetObject(ctx context.Context, bucket string, path Path) (Object, error)
@@ -34,9 +40,23 @@ type Metainfo interface {
 	ModifyObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// DeleteObject deletes an object from database
 	DeleteObject(ctx context.Context, bucket string, path Path) error
+	// CopyObject copies an object to a new bucket and path, an existing object
+	// at the new path is replaced when replace is set
+	CopyObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path, replace bool) error
+	// MoveObject moves an object to a new bucket and path
+	MoveObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path) error
 	// ListObjects lists objects in bucket based on the ListOptions
 	ListObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 
+	// GetObjectVersion returns information about a version of an object
+	GetObjectVersion(ctx context.Context, bucket string, path Path, versionID string) (Object, error)
+	// GetObjectVersionStream returns interface for reading the stream of a version of an object
+	GetObjectVersionStream(ctx context.Context, bucket string, path Path, versionID string) (ReadOnlyStream, error)
+	// DeleteObjectVersion permanently deletes a version of an object
+	DeleteObjectVersion(ctx context.Context, bucket string, path Path, versionID string) error
+	// ListObjectVersions lists all versions of an object, starting with the newest one
+	ListObjectVersions(ctx context.Context, bucket string, path Path) ([]ObjectVersion, error)
+
 	// ModifyPendingObject creates a mutable object for updating a partially uploaded object
 	ModifyPendingObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// ListPendingObjects lists pending objects in bucket based on the ListOptions
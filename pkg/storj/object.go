@@ -15,14 +15,18 @@ var (
 
 	// ErrObjectNotFound is an error class for non-existing object
 	ErrObjectNotFound = errs.Class("object not found")
+
+	// ErrObjectAlreadyExists is an error class for an object which already exists
+	ErrObjectAlreadyExists = errs.Class("object already exists")
 )
 
 // Object contains information about a specific object
 type Object struct {
-	Version  uint32
-	Bucket   Bucket
-	Path     Path
-	IsPrefix bool
+	Version   uint32
+	VersionID string
+	Bucket    Bucket
+	Path      Path
+	IsPrefix  bool
 
 	Metadata map[string]string
 
@@ -34,6 +38,16 @@ type Object struct {
 	Stream
 }
 
+// ObjectVersion contains information about a version of an object
+type ObjectVersion struct {
+	Object
+
+	// IsLatest is true for the current version of the object
+	IsLatest bool
+	// IsDeleteMarker is true for versions which mark the deletion of the object
+	IsDeleteMarker bool
+}
+
 // Stream is information about an object stream
 type Stream struct {
 	// Size is the total size of the stream in bytes
@@ -6,6 +6,7 @@ package storj
 import (
 	"database/sql/driver"
 	"encoding/base32"
+	"encoding/json"
 
 	"github.com/zeebo/errs"
 )
@@ -80,8 +81,13 @@ func (id StreamID) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON deserializes a json string (as bytes) to a stream ID
 func (id *StreamID) UnmarshalJSON(data []byte) error {
-	var err error
-	*id, err = StreamIDFromString(string(data))
+	var unquoted string
+	err := json.Unmarshal(data, &unquoted)
+	if err != nil {
+		return err
+	}
+
+	*id, err = StreamIDFromString(unquoted)
 	if err != nil {
 		return err
 	}
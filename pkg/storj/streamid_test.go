@@ -4,6 +4,7 @@
 package storj_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,5 +30,11 @@ func TestStreamID_Encode(t *testing.T) {
 
 		assert.Equal(t, streamID, fromString)
 		assert.Equal(t, streamID, fromBytes)
+
+		data, err := json.Marshal(streamID)
+		assert.NoError(t, err)
+		var fromJSON storj.StreamID
+		assert.NoError(t, json.Unmarshal(data, &fromJSON))
+		assert.Equal(t, streamID, fromJSON)
 	}
 }
@@ -40,11 +40,7 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 	upload.errgroup.Go(func() error {
 		obj := stream.Info()
 
-		serMetaInfo := pb.SerializableMeta{
-			ContentType: obj.ContentType,
-			UserDefined: obj.Metadata,
-		}
-		metadata, err := proto.Marshal(&serMetaInfo)
+		metadata, err := serializeMeta(obj)
 		if err != nil {
 			return errs.Combine(err, reader.CloseWithError(err))
 		}
@@ -60,6 +56,29 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 	return &upload
 }
 
+// Resume uploads data to the stream and records the progress of the upload in
+// the journal. When the journal contains the progress of an earlier upload of
+// the stream, which failed, the upload continues where the earlier one ended.
+func Resume(ctx context.Context, stream storj.MutableStream, streams streams.Store, data io.ReadSeeker, journal streams.UploadJournal) error {
+	obj := stream.Info()
+
+	metadata, err := serializeMeta(obj)
+	if err != nil {
+		return err
+	}
+
+	_, err = streams.PutResumable(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, data, metadata, obj.Expires, journal)
+	return err
+}
+
+// serializeMeta returns the metadata of the object, which is stored with the stream
+func serializeMeta(obj storj.Object) ([]byte, error) {
+	return proto.Marshal(&pb.SerializableMeta{
+		ContentType: obj.ContentType,
+		UserDefined: obj.Metadata,
+	})
+}
+
 // Write writes len(data) bytes from data to the underlying data stream.
 //
 // See io.Writer for more details.
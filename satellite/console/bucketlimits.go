@@ -0,0 +1,28 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// BucketLimits exposes methods to manage the storage and egress limits of buckets.
+type BucketLimits interface {
+	// Get is a method for querying the limits of a bucket from the database.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (*BucketLimit, error)
+	// Update is a method for updating the limits of a bucket.
+	Update(ctx context.Context, limit BucketLimit) error
+}
+
+// BucketLimit describes the storage and egress limits of a bucket in bytes,
+// a limit of 0 means the bucket is only limited by its project.
+type BucketLimit struct {
+	ProjectID  uuid.UUID `json:"projectId"`
+	BucketName string    `json:"bucketName"`
+
+	StorageLimit int64 `json:"storageLimit"`
+	EgressLimit  int64 `json:"egressLimit"`
+}
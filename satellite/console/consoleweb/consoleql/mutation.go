@@ -31,6 +31,10 @@ const (
 	DeleteProjectMutation = "deleteProject"
 	// UpdateProjectDescriptionMutation is a mutation name for project updating
 	UpdateProjectDescriptionMutation = "updateProjectDescription"
+	// UpdateProjectBandwidthLimitMutation is a mutation name for updating the egress limit of a project
+	UpdateProjectBandwidthLimitMutation = "updateProjectBandwidthLimit"
+	// UpdateBucketLimitsMutation is a mutation name for updating the storage and egress limits of a bucket
+	UpdateBucketLimitsMutation = "updateBucketLimits"
 
 	// AddProjectMembersMutation is a mutation name for adding new project members
 	AddProjectMembersMutation = "addProjectMembers"
@@ -267,6 +271,59 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return service.UpdateProject(p.Context, *projectID, description)
 				},
 			},
+			// updates project egress limit, 0 removes the limit
+			UpdateProjectBandwidthLimitMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldBandwidthLimit: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Float),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					bandwidthLimit := p.Args[FieldBandwidthLimit].(float64)
+
+					inputID := p.Args[FieldID].(string)
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.UpdateProjectBandwidthLimit(p.Context, *projectID, int64(bandwidthLimit))
+				},
+			},
+			// updates storage and egress limits of a bucket, 0 removes a limit
+			UpdateBucketLimitsMutation: &graphql.Field{
+				Type: types.bucketLimit,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldBucketName: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldStorageLimit: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Float),
+					},
+					FieldEgressLimit: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Float),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					bucketName := p.Args[FieldBucketName].(string)
+					storageLimit := p.Args[FieldStorageLimit].(float64)
+					egressLimit := p.Args[FieldEgressLimit].(float64)
+
+					projectID, err := uuid.Parse(p.Args[FieldProjectID].(string))
+					if err != nil {
+						return nil, err
+					}
+
+					return service.UpdateBucketLimits(p.Context, *projectID, bucketName, int64(storageLimit), int64(egressLimit))
+				},
+			},
 			// add user as member of given project
 			AddProjectMembersMutation: &graphql.Field{
 				Type: types.project,
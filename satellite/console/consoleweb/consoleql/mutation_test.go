@@ -18,7 +18,9 @@ import (
 
 	"storj.io/storj/internal/post"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -336,6 +338,51 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, "", proj[consoleql.FieldDescription])
 		})
 
+		t.Run("Update project bandwidth limit mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {updateProjectBandwidthLimit(id:\"%s\",bandwidthLimit:%d){id,bandwidthLimit}}",
+				project.ID.String(),
+				1000,
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			proj := data[consoleql.UpdateProjectBandwidthLimitMutation].(map[string]interface{})
+
+			assert.Equal(t, project.ID.String(), proj[consoleql.FieldID])
+			assert.Equal(t, float64(1000), proj[consoleql.FieldBandwidthLimit])
+		})
+
+		t.Run("Update bucket limits mutation", func(t *testing.T) {
+			_, err := db.Buckets().CreateBucket(ctx, storj.Bucket{
+				ID:        testrand.UUID(),
+				Name:      "limitedbucket",
+				ProjectID: project.ID,
+			})
+			require.NoError(t, err)
+
+			query := fmt.Sprintf(
+				"mutation {updateBucketLimits(projectID:\"%s\",bucketName:\"%s\",storageLimit:%d,egressLimit:%d){bucketName,storageLimit,egressLimit}}",
+				project.ID.String(),
+				"limitedbucket",
+				2000,
+				0,
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			limit := data[consoleql.UpdateBucketLimitsMutation].(map[string]interface{})
+
+			assert.Equal(t, "limitedbucket", limit[consoleql.FieldBucketName])
+			assert.Equal(t, float64(2000), limit[consoleql.FieldStorageLimit])
+			assert.Equal(t, float64(0), limit[consoleql.FieldEgressLimit])
+
+			err = db.Buckets().DeleteBucket(ctx, []byte("limitedbucket"), project.ID)
+			require.NoError(t, err)
+		})
+
 		regTokenUser1, err := service.CreateRegToken(ctx, 1)
 		require.NoError(t, err)
 
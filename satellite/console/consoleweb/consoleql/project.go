@@ -25,6 +25,8 @@ const (
 	BucketUsageType = "bucketUsage"
 	// BucketUsagePageType is a field name for bucket usage page
 	BucketUsagePageType = "bucketUsagePage"
+	// BucketLimitType is a graphql type name for bucket limits
+	BucketLimitType = "bucketLimit"
 	// PaymentMethodType is a field name for payment method
 	PaymentMethodType = "paymentMethod"
 	// FieldName is a field name for "name"
@@ -47,6 +49,14 @@ const (
 	FieldStorage = "storage"
 	// FieldEgress is a field name for egress total
 	FieldEgress = "egress"
+	// FieldBandwidthLimit is a field name for the egress limit of a project
+	FieldBandwidthLimit = "bandwidthLimit"
+	// FieldBucketLimits is a field name for the limits of a bucket
+	FieldBucketLimits = "bucketLimits"
+	// FieldStorageLimit is a field name for storage limit
+	FieldStorageLimit = "storageLimit"
+	// FieldEgressLimit is a field name for egress limit
+	FieldEgressLimit = "egressLimit"
 	// FieldObjectCount is a field name for objects count
 	FieldObjectCount = "objectCount"
 	// FieldPageCount is a field name for total page count
@@ -95,6 +105,9 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 			FieldDescription: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldBandwidthLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
 			FieldCreatedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
@@ -182,6 +195,21 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 					return service.GetProjectUsage(p.Context, project.ID, since, before)
 				},
 			},
+			FieldBucketLimits: &graphql.Field{
+				Type: types.bucketLimit,
+				Args: graphql.FieldConfigArgument{
+					FieldBucketName: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					bucketName, _ := p.Args[FieldBucketName].(string)
+
+					return service.GetBucketLimits(p.Context, project.ID, bucketName)
+				},
+			},
 			FieldBucketUsages: &graphql.Field{
 				Type: types.bucketUsagePage,
 				Args: graphql.FieldConfigArgument{
@@ -324,6 +352,24 @@ func graphqlBucketUsagePage(types *TypeCreator) *graphql.Object {
 	})
 }
 
+// graphqlBucketLimit creates bucket limit graphql type
+func graphqlBucketLimit() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: BucketLimitType,
+		Fields: graphql.Fields{
+			FieldBucketName: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldStorageLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldEgressLimit: &graphql.Field{
+				Type: graphql.Float,
+			},
+		},
+	})
+}
+
 // graphqlProjectUsage creates project usage graphql type
 func graphqlProjectUsage() *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
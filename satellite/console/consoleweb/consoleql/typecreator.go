@@ -25,6 +25,7 @@ type TypeCreator struct {
 	projectUsage    *graphql.Object
 	bucketUsage     *graphql.Object
 	bucketUsagePage *graphql.Object
+	bucketLimit     *graphql.Object
 	paymentMethod   *graphql.Object
 	projectMember   *graphql.Object
 	apiKeyInfo      *graphql.Object
@@ -79,6 +80,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.bucketLimit = graphqlBucketLimit()
+	if err := c.bucketLimit.Error(); err != nil {
+		return err
+	}
+
 	c.paymentMethod = graphqlPaymentMethod()
 	if err := c.paymentMethod.Error(); err != nil {
 		return err
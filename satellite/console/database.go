@@ -21,6 +21,8 @@ type DB interface {
 	APIKeys() APIKeys
 	// BucketUsage is a getter for accounting.BucketUsage repository
 	BucketUsage() accounting.BucketUsage
+	// BucketLimits is a getter for BucketLimits repository
+	BucketLimits() BucketLimits
 	// RegistrationTokens is a getter for RegistrationTokens repository
 	RegistrationTokens() RegistrationTokens
 	// ResetPasswordTokens is a getter for ResetPasswordTokens repository
@@ -32,10 +32,11 @@ type Projects interface {
 type Project struct {
 	ID uuid.UUID `json:"id"`
 
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	UsageLimit  int64     `json:"usageLimit"`
-	PartnerID   uuid.UUID `json:"partnerId"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	UsageLimit     int64     `json:"usageLimit"`
+	BandwidthLimit int64     `json:"bandwidthLimit"`
+	PartnerID      uuid.UUID `json:"partnerId"`
 
 	CreatedAt time.Time `json:"createdAt"`
 }
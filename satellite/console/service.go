@@ -47,6 +47,8 @@ const (
 	credentialsErrMsg                    = "Your email or password was incorrect, please try again"
 	oldPassIncorrectErrMsg               = "Old password is incorrect, please try again"
 	passwordIncorrectErrMsg              = "Your password needs at least %d characters long"
+	negativeLimitErrMsg                  = "Limits can't be negative"
+	bucketDoesNotExistErrMsg             = "There is no bucket with this name in the project"
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
 
@@ -734,6 +736,88 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 	return project, nil
 }
 
+// UpdateProjectBandwidthLimit is a method for updating the egress limit of the project by id,
+// a limit of 0 removes it. The limit can't raise the usage limit of the project.
+func (s *Service) UpdateProjectBandwidthLimit(ctx context.Context, projectID uuid.UUID, bandwidthLimit int64) (p *Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if bandwidthLimit < 0 {
+		return nil, errs.New(negativeLimitErrMsg)
+	}
+
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	project := isMember.project
+	project.BandwidthLimit = bandwidthLimit
+
+	err = s.store.Projects().Update(ctx, project)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return project, nil
+}
+
+// GetBucketLimits returns the storage and egress limits of a bucket of the project.
+func (s *Service) GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	limit, err := s.store.BucketLimits().Get(ctx, projectID, bucketName)
+	if err != nil {
+		return nil, errs.New(bucketDoesNotExistErrMsg)
+	}
+
+	return limit, nil
+}
+
+// UpdateBucketLimits is a method for updating the storage and egress limits of a bucket
+// of the project, a limit of 0 removes it. The bucket stays limited by its project.
+func (s *Service) UpdateBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string, storageLimit, egressLimit int64) (_ *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if storageLimit < 0 || egressLimit < 0 {
+		return nil, errs.New(negativeLimitErrMsg)
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	limit, err := s.store.BucketLimits().Get(ctx, projectID, bucketName)
+	if err != nil {
+		return nil, errs.New(bucketDoesNotExistErrMsg)
+	}
+
+	limit.StorageLimit = storageLimit
+	limit.EgressLimit = egressLimit
+
+	err = s.store.BucketLimits().Update(ctx, *limit)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return limit, nil
+}
+
 // AddProjectMembers adds users by email to given project
 func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
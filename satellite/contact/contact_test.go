@@ -0,0 +1,166 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+)
+
+func TestCheckIn(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+
+		node, err := satellite.Overlay.Service.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+		assert.Equal(t, storageNode.Addr(), node.Address.Address)
+		assert.Equal(t, storageNode.Local().Capacity, node.Capacity)
+		assert.Equal(t, storageNode.Local().Version.Version, node.Version.Version)
+		assert.True(t, satellite.Overlay.Service.IsOnline(node))
+	})
+}
+
+func TestCheckIn_Offline(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+		overlay := satellite.Overlay.Service
+
+		// mark node as offline in overlay cache
+		_, err := overlay.UpdateUptime(ctx, storageNode.ID(), false)
+		require.NoError(t, err)
+
+		node, err := overlay.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+		assert.False(t, overlay.IsOnline(node))
+
+		storageNode.Contact.Chore.Loop.TriggerWait()
+
+		node, err = overlay.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+		assert.True(t, overlay.IsOnline(node))
+	})
+}
+
+func TestCheckIn_UpdatesNodeInfo(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+		overlay := satellite.Overlay.Service
+
+		before, err := overlay.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+
+		satelliteNode := satellite.Local().Node
+		conn, err := storageNode.Transport.DialNode(ctx, &satelliteNode)
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		capacity := pb.NodeCapacity{FreeBandwidth: 1000, FreeDisk: 2000}
+		operator := pb.NodeOperator{Email: "operator@example.com", Wallet: "0x1234"}
+		version := pb.NodeVersion{Version: "v1.2.3", CommitHash: "abc"}
+		resp, err := pb.NewContactClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+			Address:  storageNode.Addr(),
+			Capacity: &capacity,
+			Operator: &operator,
+			Version:  &version,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.PingNodeSuccess)
+
+		// the node info and the uptime are updated by the check-in
+		after, err := overlay.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+		assert.Equal(t, capacity.FreeBandwidth, after.Capacity.FreeBandwidth)
+		assert.Equal(t, capacity.FreeDisk, after.Capacity.FreeDisk)
+		assert.Equal(t, operator.Email, after.Operator.Email)
+		assert.Equal(t, operator.Wallet, after.Operator.Wallet)
+		assert.Equal(t, version.Version, after.Version.Version)
+		assert.True(t, after.Reputation.UptimeCount > before.Reputation.UptimeCount)
+		assert.True(t, after.Reputation.LastContactSuccess.After(before.Reputation.LastContactSuccess))
+		assert.True(t, overlay.IsOnline(after))
+	})
+}
+
+func TestCheckIn_Unreachable(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+
+		satelliteNode := satellite.Local().Node
+		conn, err := storageNode.Transport.DialNode(ctx, &satelliteNode)
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		before, err := satellite.Overlay.Service.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+
+		// nobody listens on this address, so the ping back fails
+		resp, err := pb.NewContactClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+			Address: "127.0.0.1:1",
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.PingNodeSuccess)
+		assert.NotEmpty(t, resp.PingErrorMessage)
+
+		// the node keeps its previous address
+		node, err := satellite.Overlay.Service.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+		assert.Equal(t, storageNode.Addr(), node.Address.Address)
+		assert.False(t, satellite.Overlay.Service.IsOnline(node))
+		// the failed ping back is counted against the uptime
+		assert.True(t, node.Reputation.UptimeCount > before.Reputation.UptimeCount)
+		assert.True(t, node.Reputation.LastContactFailure.After(before.Reputation.LastContactFailure))
+	})
+}
+
+func TestCheckIn_TrustAll(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage.SatelliteIDRestriction = false
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storageNode := planet.StorageNodes[0]
+		overlay := satellite.Overlay.Service
+
+		// the configured satellites are checked in with when all satellites are trusted
+		assert.Equal(t, []storj.NodeID{satellite.ID()}, storageNode.Storage2.Trust.GetSatellites(ctx))
+
+		node, err := overlay.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+		assert.Equal(t, storageNode.Addr(), node.Address.Address)
+		assert.True(t, overlay.IsOnline(node))
+
+		// mark node as offline in overlay cache
+		_, err = overlay.UpdateUptime(ctx, storageNode.ID(), false)
+		require.NoError(t, err)
+
+		storageNode.Contact.Chore.Loop.TriggerWait()
+
+		node, err = overlay.Get(ctx, storageNode.ID())
+		require.NoError(t, err)
+		assert.True(t, overlay.IsOnline(node))
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/transport"
+)
+
+var (
+	// Error is the default error class for contact package
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Endpoint implements the contact service that storage nodes check in with
+type Endpoint struct {
+	log       *zap.Logger
+	transport transport.Client
+	cache     *overlay.Cache
+}
+
+// NewEndpoint returns a new contact service endpoint
+func NewEndpoint(log *zap.Logger, transport transport.Client, cache *overlay.Cache) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		transport: transport,
+		cache:     cache,
+	}
+}
+
+// CheckIn is periodically called by storage nodes to keep the satellite informed
+// about their address, capacity and version. The node is identified by the
+// identity of its connection, the satellite pings it back at the given
+// address before updating the overlay.
+func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (_ *pb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	nodeID := peerID.ID
+
+	if req.Address == "" {
+		return nil, Error.New("node %s checked in without an address", nodeID)
+	}
+
+	node := pb.Node{
+		Id: nodeID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   req.Address,
+		},
+	}
+
+	pingErr := endpoint.pingBack(ctx, &node)
+	if pingErr != nil {
+		endpoint.log.Debug("failed to ping back node", zap.Stringer("Node ID", nodeID), zap.String("Address", req.Address), zap.Error(pingErr))
+
+		// nodes that are not known yet don't have any uptime to update
+		_, err = endpoint.cache.UpdateUptime(ctx, nodeID, false)
+		if err != nil {
+			endpoint.log.Debug("failed to update uptime", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		}
+
+		return &pb.CheckInResponse{
+			PingNodeSuccess:  false,
+			PingErrorMessage: pingErr.Error(),
+		}, nil
+	}
+
+	err = endpoint.cache.Put(ctx, nodeID, node)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = endpoint.cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+		Type:     pb.NodeType_STORAGE,
+		Operator: req.Operator,
+		Capacity: req.Capacity,
+		Version:  req.Version,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = endpoint.cache.UpdateUptime(ctx, nodeID, true)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.CheckInResponse{
+		PingNodeSuccess: true,
+	}, nil
+}
+
+// pingBack dials the node at the address it checked in with, which only
+// succeeds when the node at that address holds the identity of the node
+func (endpoint *Endpoint) pingBack(ctx context.Context, node *pb.Node) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := endpoint.transport.DialNode(ctx, node)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = pb.NewNodeClient(conn).PingNode(ctx, &pb.ContactPingRequest{})
+	return err
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"context"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/uplink"
+)
+
+// TestGarbageCollection does the following:
+// * Set up a network with one storagenode
+// * Upload two objects
+// * Delete one object from the metainfodb (i.e. make this data garbage)
+// * Trigger the checker, which sends a bloom filter to the storagenode
+// * Check that pieces of the deleted object are deleted on the storagenode
+// * Check that pieces of the kept object are not deleted on the storagenode
+func TestGarbageCollection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				// leave no room for clock differences, pieces are created right before the filter
+				config.Storage2.RetainTimeBuffer = 0
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		targetNode := planet.StorageNodes[0]
+
+		// stop the checker loop, so we can run it manually
+		satellite.Repair.Checker.Loop.Pause()
+
+		// store every piece on the single storage node
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     1,
+			RepairThreshold:  1,
+			SuccessThreshold: 1,
+			MaxThreshold:     1,
+		}
+
+		// upload the object that should be kept
+		err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path/1", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+		pointerToKeep, _ := getRemoteSegments(t, ctx, satellite, nil)
+
+		// upload the object that will become garbage
+		err = upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path/2", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+		pointerToDelete, pathsToDelete := getRemoteSegments(t, ctx, satellite, pointerToKeep)
+
+		// delete the pointer directly from metainfo, so the storage node is never notified
+		for _, path := range pathsToDelete {
+			err = satellite.Metainfo.Service.Delete(ctx, path)
+			require.NoError(t, err)
+		}
+
+		keptPieceIDs := pieceIDsOnNode(pointerToKeep, targetNode.ID())
+		deletedPieceIDs := pieceIDsOnNode(pointerToDelete, targetNode.ID())
+		require.NotEmpty(t, keptPieceIDs)
+		require.NotEmpty(t, deletedPieceIDs)
+
+		for _, pieceID := range append(keptPieceIDs, deletedPieceIDs...) {
+			reader, err := targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+
+		// piece creation times are compared with second precision on the storage node
+		time.Sleep(time.Second)
+
+		// the checker builds the bloom filters and sends them at the end of a full pass
+		err = satellite.Repair.Checker.IdentifyInjuredSegments(ctx)
+		require.NoError(t, err)
+
+		for _, pieceID := range deletedPieceIDs {
+			_, err := targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+			require.True(t, os.IsNotExist(err), "garbage piece should have been deleted")
+		}
+
+		for _, pieceID := range keptPieceIDs {
+			reader, err := targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err, "live piece should have been kept")
+			require.NoError(t, reader.Close())
+		}
+	})
+}
+
+// TestGarbageCollectionResumedPass checks that a checker pass, which resumes
+// after a failed pass, doesn't send filters missing the pieces of the beginning.
+func TestGarbageCollectionResumedPass(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage2.RetainTimeBuffer = 0
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		targetNode := planet.StorageNodes[0]
+
+		satellite.Repair.Checker.Loop.Pause()
+
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     1,
+			RepairThreshold:  1,
+			SuccessThreshold: 1,
+			MaxThreshold:     1,
+		}
+
+		for _, path := range []string{"test/path/1", "test/path/2"} {
+			err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", path, testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+		pointers, paths := getRemoteSegments(t, ctx, satellite, nil)
+		require.True(t, len(paths) >= 2)
+		sort.Strings(paths)
+
+		// an invalid pointer right after the first segment fails the pass there
+		invalidKey := storage.Key(paths[0] + "\x00")
+		err := satellite.Metainfo.Service.DB.Put(ctx, invalidKey, storage.Value("invalid pointer"))
+		require.NoError(t, err)
+
+		time.Sleep(time.Second)
+
+		err = satellite.Repair.Checker.IdentifyInjuredSegments(ctx)
+		require.Error(t, err)
+
+		err = satellite.Metainfo.Service.DB.Delete(ctx, invalidKey)
+		require.NoError(t, err)
+
+		// the resumed pass completes, but it hasn't seen the first segment
+		err = satellite.Repair.Checker.IdentifyInjuredSegments(ctx)
+		require.NoError(t, err)
+
+		pieceIDs := pieceIDsOnNode(pointers, targetNode.ID())
+		require.NotEmpty(t, pieceIDs)
+		for _, pieceID := range pieceIDs {
+			reader, err := targetNode.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err, "live piece should have been kept")
+			require.NoError(t, reader.Close())
+		}
+	})
+}
+
+// getRemoteSegments returns all remote pointers and their paths from the satellite, skipping those in exclude.
+// nolint:golint
+func getRemoteSegments(t *testing.T, ctx context.Context, satellite *satellite.Peer, exclude []*pb.Pointer) (pointers []*pb.Pointer, paths []string) {
+	t.Helper()
+
+	excluded := map[storj.PieceID]bool{}
+	for _, pointer := range exclude {
+		excluded[pointer.GetRemote().RootPieceId] = true
+	}
+
+	metainfo := satellite.Metainfo.Service
+	listResponse, _, err := metainfo.List(ctx, "", "", "", true, 0, 0)
+	require.NoError(t, err)
+
+	for _, v := range listResponse {
+		path := v.GetPath()
+		pointer, err := metainfo.Get(ctx, path)
+		require.NoError(t, err)
+		if pointer.GetType() != pb.Pointer_REMOTE || excluded[pointer.GetRemote().RootPieceId] {
+			continue
+		}
+		pointers = append(pointers, pointer)
+		paths = append(paths, path)
+	}
+
+	require.NotEmpty(t, pointers, "satellite doesn't have any new remote segment")
+	return pointers, paths
+}
+
+// pieceIDsOnNode returns the derived piece ids of the pointers that are stored on the node.
+func pieceIDsOnNode(pointers []*pb.Pointer, nodeID storj.NodeID) (pieceIDs []storj.PieceID) {
+	for _, pointer := range pointers {
+		remote := pointer.GetRemote()
+		for _, piece := range remote.GetRemotePieces() {
+			if piece.NodeId == nodeID {
+				pieceIDs = append(pieceIDs, remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+			}
+		}
+	}
+	return pieceIDs
+}
@@ -0,0 +1,230 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error defines the gc service errors class
+	Error = errs.Class("gc service error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Interval time.Duration `help:"the time between each send of garbage collection filters to storage nodes" releaseDefault:"168h" devDefault:"10m"`
+	Active   bool          `help:"set if garbage collection is actively running or not" releaseDefault:"false" devDefault:"true"`
+	// value for InitialPieces currently based on average pieces per node
+	InitialPieces     int     `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" releaseDefault:"400000" devDefault:"10"`
+	FalsePositiveRate float64 `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
+	ConcurrentSends   int     `help:"the number of nodes to concurrently send garbage collection bloom filters to" releaseDefault:"1" devDefault:"1"`
+}
+
+// Service implements the garbage collection service
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	transport transport.Client
+	overlay   *overlay.Cache
+
+	mu              sync.Mutex
+	lastSendTime    time.Time
+	lastPieceCounts map[storj.NodeID]int
+}
+
+// RetainInfo contains info needed for a storage node to retain important data and delete garbage data
+type RetainInfo struct {
+	Filter       *bloomfilter.Filter
+	CreationDate time.Time
+	Count        int
+}
+
+// PieceTracker allows access to info about the good pieces that storage nodes need to retain
+type PieceTracker interface {
+	// Add adds a piece to the set of pieces the storage node should retain
+	Add(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) error
+	// GetRetainInfos gets all of the RetainInfos
+	GetRetainInfos() map[storj.NodeID]*RetainInfo
+}
+
+// NewService creates a new instance of the gc service
+func NewService(log *zap.Logger, config Config, transport transport.Client, overlay *overlay.Cache) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+
+		transport: transport,
+		overlay:   overlay,
+
+		lastPieceCounts: make(map[storj.NodeID]int),
+	}
+}
+
+// NewPieceTracker instantiates a piece tracker for a single pass over metainfo.
+// When garbage collection is inactive or the interval has not passed yet,
+// the returned tracker ignores all pieces.
+func (service *Service) NewPieceTracker() PieceTracker {
+	if service == nil || !service.config.Active {
+		return &noOpPieceTracker{}
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if time.Now().Before(service.lastSendTime.Add(service.config.Interval)) {
+		return &noOpPieceTracker{}
+	}
+
+	return &pieceTracker{
+		filterCreationDate: time.Now().UTC(),
+		initialPieces:      service.config.InitialPieces,
+		falsePositiveRate:  service.config.FalsePositiveRate,
+		pieceCounts:        service.lastPieceCounts,
+		retainInfos:        make(map[storj.NodeID]*RetainInfo),
+	}
+}
+
+// Send sends retain requests to all storage nodes tracked by pieceTracker
+func (service *Service) Send(ctx context.Context, pieceTracker PieceTracker) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service == nil || !service.config.Active {
+		return nil
+	}
+
+	retainInfos := pieceTracker.GetRetainInfos()
+	if len(retainInfos) == 0 {
+		return nil
+	}
+
+	concurrentSends := service.config.ConcurrentSends
+	if concurrentSends <= 0 {
+		concurrentSends = 1
+	}
+
+	var errlist errs.Group
+	var errmu sync.Mutex
+
+	limiter := sync2.NewLimiter(concurrentSends)
+	for nodeID, info := range retainInfos {
+		nodeID, info := nodeID, info
+		limiter.Go(ctx, func() {
+			err := service.sendRetainRequest(ctx, nodeID, info)
+			if err != nil {
+				service.log.Error("error sending retain info", zap.Stringer("node ID", nodeID), zap.Error(err))
+				errmu.Lock()
+				errlist.Add(err)
+				errmu.Unlock()
+			}
+		})
+	}
+	limiter.Wait()
+
+	pieceCounts := make(map[storj.NodeID]int, len(retainInfos))
+	for nodeID, info := range retainInfos {
+		pieceCounts[nodeID] = info.Count
+	}
+
+	service.mu.Lock()
+	service.lastSendTime = time.Now().UTC()
+	service.lastPieceCounts = pieceCounts
+	service.mu.Unlock()
+
+	return errlist.Err()
+}
+
+// sendRetainRequest sends a single retain request to the storage node
+func (service *Service) sendRetainRequest(ctx context.Context, nodeID storj.NodeID, info *RetainInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dossier, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	client, err := piecestore.Dial(ctx, service.transport, &dossier.Node, service.log.Named("piecestore"), piecestore.DefaultConfig)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, Error.Wrap(client.Close()))
+	}()
+
+	mon.IntVal("retain_filter_size_bytes").Observe(int64(len(info.Filter.Bytes())))
+
+	err = client.Retain(ctx, &pb.RetainRequest{
+		CreationDate: info.CreationDate,
+		Filter:       info.Filter.Bytes(),
+	})
+	return Error.Wrap(err)
+}
+
+// pieceTracker collects the pieces each storage node should be storing
+type pieceTracker struct {
+	filterCreationDate time.Time
+	initialPieces      int
+	falsePositiveRate  float64
+	pieceCounts        map[storj.NodeID]int
+
+	retainInfos map[storj.NodeID]*RetainInfo
+}
+
+// Add adds a pieceID to the relevant node's RetainInfo
+func (tracker *pieceTracker) Add(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (err error) {
+	info, ok := tracker.retainInfos[nodeID]
+	if !ok {
+		// if we know how many pieces a node should be storing, use that number, otherwise use the default
+		numPieces := tracker.initialPieces
+		if tracker.pieceCounts[nodeID] > 0 {
+			numPieces = tracker.pieceCounts[nodeID]
+		}
+		if numPieces <= 0 {
+			numPieces = 1
+		}
+		info = &RetainInfo{
+			Filter:       bloomfilter.NewOptimal(numPieces, tracker.falsePositiveRate),
+			CreationDate: tracker.filterCreationDate,
+		}
+		tracker.retainInfos[nodeID] = info
+	}
+
+	info.Filter.Add(pieceID)
+	info.Count++
+	return nil
+}
+
+// GetRetainInfos returns the retain infos collected by the tracker
+func (tracker *pieceTracker) GetRetainInfos() map[storj.NodeID]*RetainInfo {
+	return tracker.retainInfos
+}
+
+// noOpPieceTracker does nothing when PieceTracker methods are called, because it's not time for the next iteration.
+type noOpPieceTracker struct{}
+
+// Add implements PieceTracker
+func (*noOpPieceTracker) Add(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (err error) {
+	return nil
+}
+
+// GetRetainInfos implements PieceTracker
+func (*noOpPieceTracker) GetRetainInfos() map[storj.NodeID]*RetainInfo {
+	return nil
+}
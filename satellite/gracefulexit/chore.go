@@ -0,0 +1,141 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+)
+
+// Chore populates the graceful exit transfer queue.
+type Chore struct {
+	log      *zap.Logger
+	Loop     sync2.Cycle
+	db       DB
+	metainfo *metainfo.Service
+	config   Config
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, db DB, metainfo *metainfo.Service, config Config) *Chore {
+	return &Chore{
+		log:      log,
+		Loop:     *sync2.NewCycle(config.ChoreInterval),
+		db:       db,
+		metainfo: metainfo,
+		config:   config,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("error collecting pieces of exiting nodes", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce adds the pieces of all newly exiting nodes to the transfer queue.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exitingNodes, err := chore.db.GetExitingNodesLoopIncomplete(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(exitingNodes) == 0 {
+		return nil
+	}
+
+	exiting := make(map[storj.NodeID]bool, len(exitingNodes))
+	for _, nodeID := range exitingNodes {
+		exiting[nodeID] = true
+	}
+
+	batchSize := chore.config.ChoreBatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	batch := make([]TransferQueueItem, 0, batchSize)
+
+	err = chore.metainfo.Iterate(ctx, "", "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				pointer := &pb.Pointer{}
+				err := proto.Unmarshal(item.Value, pointer)
+				if err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
+				}
+
+				pieces := remote.GetRemotePieces()
+				durabilityRatio := float64(len(pieces)) / float64(remote.GetRedundancy().GetTotal())
+
+				for _, piece := range pieces {
+					if !exiting[piece.NodeId] {
+						continue
+					}
+
+					batch = append(batch, TransferQueueItem{
+						NodeID:          piece.NodeId,
+						PieceID:         remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum),
+						Path:            item.Key.String(),
+						PieceNum:        piece.PieceNum,
+						DurabilityRatio: durabilityRatio,
+					})
+
+					if len(batch) >= batchSize {
+						if err := chore.db.Enqueue(ctx, batch); err != nil {
+							return Error.Wrap(err)
+						}
+						batch = batch[:0]
+					}
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		if err := chore.db.Enqueue(ctx, batch); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, nodeID := range exitingNodes {
+		if err := chore.db.MarkLoopCompleted(ctx, nodeID, now); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes resources
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	// Error is the default error class for graceful exit package.
+	Error = errs.Class("gracefulexit")
+
+	// ErrNotFound is the error returned when a graceful exit record is not found.
+	ErrNotFound = errs.Class("graceful exit record not found")
+
+	mon = monkit.Package()
+)
+
+// Config for the graceful exit service
+type Config struct {
+	ChoreInterval  time.Duration `help:"how often to run the graceful exit chore, which collects the pieces of exiting nodes" releaseDefault:"15m" devDefault:"10s"`
+	ChoreBatchSize int           `help:"size of the buffer used to batch inserts into the transfer queue" default:"500"`
+
+	EndpointBatchSize            int `help:"maximum number of pieces handed out to an exiting node per request" default:"100"`
+	MaxFailuresPerPiece          int `help:"maximum number of transfer failures per piece" default:"3"`
+	OverallMaxFailuresPercentage int `help:"maximum percentage of failed transfers for an exit to still be considered successful" default:"10"`
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Progress represents the persisted graceful exit progress record.
+type Progress struct {
+	NodeID            storj.NodeID
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+
+	ExitInitiatedAt     time.Time
+	ExitLoopCompletedAt *time.Time
+	ExitFinishedAt      *time.Time
+	ExitSuccess         bool
+
+	UpdatedAt time.Time
+}
+
+// TransferQueueItem represents the persisted graceful exit queue record.
+type TransferQueueItem struct {
+	NodeID          storj.NodeID
+	PieceID         storj.PieceID
+	Path            storj.Path
+	PieceNum        int32
+	DurabilityRatio float64
+	QueuedAt        time.Time
+	LastFailedAt    *time.Time
+	LastFailedCode  *int
+	FailedCount     int
+}
+
+// DB implements CRUD operations for graceful exit service
+type DB interface {
+	// InitiateExit records that the node has started a graceful exit, it does nothing when the exit has already been initiated.
+	InitiateExit(ctx context.Context, nodeID storj.NodeID, initiatedAt time.Time) error
+	// GetProgress gets the graceful exit progress of a node.
+	GetProgress(ctx context.Context, nodeID storj.NodeID) (*Progress, error)
+	// GetExitingNodesLoopIncomplete returns the exiting nodes whose pieces have not been added to the transfer queue yet.
+	GetExitingNodesLoopIncomplete(ctx context.Context) (storj.NodeIDList, error)
+	// MarkLoopCompleted records that all pieces of the node have been added to the transfer queue.
+	MarkLoopCompleted(ctx context.Context, nodeID storj.NodeID, completedAt time.Time) error
+	// MarkFinished records the end of the graceful exit of the node.
+	MarkFinished(ctx context.Context, nodeID storj.NodeID, finishedAt time.Time, success bool) error
+	// IncrementProgress increments transfer stats for a node.
+	IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) error
+
+	// Enqueue batch inserts graceful exit transfer queue entries, it ignores entries that already exist.
+	Enqueue(ctx context.Context, items []TransferQueueItem) error
+	// GetTransferQueueItem gets a graceful exit transfer queue entry.
+	GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (*TransferQueueItem, error)
+	// GetIncomplete gets the transfer queue entries that have failed less than maxFailures times, ordered by durability ratio.
+	GetIncomplete(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int) ([]*TransferQueueItem, error)
+	// MarkTransferFailed increments the failure count of a transfer queue entry and returns the updated entry.
+	MarkTransferFailed(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID, failedAt time.Time, code int) (*TransferQueueItem, error)
+	// DeleteTransferQueueItem deletes a graceful exit transfer queue entry.
+	DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) error
+	// DeleteTransferQueueItems deletes all graceful exit transfer queue entries of a node.
+	DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) error
+}
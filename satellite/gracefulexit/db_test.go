@@ -0,0 +1,120 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProgress(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		gracefulexitDB := db.GracefulExit()
+		nodeID := testrand.NodeID()
+
+		_, err := gracefulexitDB.GetProgress(ctx, nodeID)
+		require.True(t, gracefulexit.ErrNotFound.Has(err))
+
+		initiatedAt := time.Now().UTC()
+		require.NoError(t, gracefulexitDB.InitiateExit(ctx, nodeID, initiatedAt))
+		// initiating the exit again doesn't reset it
+		require.NoError(t, gracefulexitDB.InitiateExit(ctx, nodeID, initiatedAt.Add(time.Hour)))
+
+		exiting, err := gracefulexitDB.GetExitingNodesLoopIncomplete(ctx)
+		require.NoError(t, err)
+		require.Len(t, exiting, 1)
+		require.Equal(t, nodeID, exiting[0])
+
+		require.NoError(t, gracefulexitDB.MarkLoopCompleted(ctx, nodeID, time.Now().UTC()))
+		require.NoError(t, gracefulexitDB.IncrementProgress(ctx, nodeID, 100, 2, 1))
+		require.NoError(t, gracefulexitDB.IncrementProgress(ctx, nodeID, 100, 1, 0))
+
+		exiting, err = gracefulexitDB.GetExitingNodesLoopIncomplete(ctx)
+		require.NoError(t, err)
+		require.Empty(t, exiting)
+
+		progress, err := gracefulexitDB.GetProgress(ctx, nodeID)
+		require.NoError(t, err)
+		require.True(t, initiatedAt.Equal(progress.ExitInitiatedAt))
+		require.NotNil(t, progress.ExitLoopCompletedAt)
+		require.Nil(t, progress.ExitFinishedAt)
+		require.EqualValues(t, 200, progress.BytesTransferred)
+		require.EqualValues(t, 3, progress.PiecesTransferred)
+		require.EqualValues(t, 1, progress.PiecesFailed)
+
+		require.NoError(t, gracefulexitDB.MarkFinished(ctx, nodeID, time.Now().UTC(), true))
+
+		progress, err = gracefulexitDB.GetProgress(ctx, nodeID)
+		require.NoError(t, err)
+		require.NotNil(t, progress.ExitFinishedAt)
+		require.True(t, progress.ExitSuccess)
+	})
+}
+
+func TestTransferQueue(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		gracefulexitDB := db.GracefulExit()
+		nodeID := testrand.NodeID()
+
+		items := []gracefulexit.TransferQueueItem{
+			{NodeID: nodeID, PieceID: testrand.PieceID(), Path: "project/l/bucket/a", PieceNum: 1, DurabilityRatio: 0.9},
+			{NodeID: nodeID, PieceID: testrand.PieceID(), Path: "project/l/bucket/b", PieceNum: 2, DurabilityRatio: 0.5},
+			{NodeID: nodeID, PieceID: testrand.PieceID(), Path: "project/l/bucket/c", PieceNum: 3, DurabilityRatio: 0.7},
+		}
+		require.NoError(t, gracefulexitDB.Enqueue(ctx, items))
+		// enqueueing the same items again is ignored
+		require.NoError(t, gracefulexitDB.Enqueue(ctx, items))
+
+		incomplete, err := gracefulexitDB.GetIncomplete(ctx, nodeID, 2, 10)
+		require.NoError(t, err)
+		require.Len(t, incomplete, 3)
+		// the least durable segments come first
+		require.Equal(t, items[1].PieceID, incomplete[0].PieceID)
+		require.Equal(t, items[2].PieceID, incomplete[1].PieceID)
+		require.Equal(t, items[0].PieceID, incomplete[2].PieceID)
+		require.Equal(t, items[1].Path, incomplete[0].Path)
+		require.Equal(t, items[1].PieceNum, incomplete[0].PieceNum)
+
+		for i := 1; i <= 2; i++ {
+			item, err := gracefulexitDB.MarkTransferFailed(ctx, nodeID, items[1].PieceID, time.Now().UTC(), int(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE))
+			require.NoError(t, err)
+			require.Equal(t, i, item.FailedCount)
+			require.NotNil(t, item.LastFailedAt)
+			require.NotNil(t, item.LastFailedCode)
+			require.Equal(t, int(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE), *item.LastFailedCode)
+		}
+
+		// pieces which failed too often aren't handed out anymore
+		incomplete, err = gracefulexitDB.GetIncomplete(ctx, nodeID, 2, 10)
+		require.NoError(t, err)
+		require.Len(t, incomplete, 2)
+
+		require.NoError(t, gracefulexitDB.DeleteTransferQueueItem(ctx, nodeID, items[2].PieceID))
+		_, err = gracefulexitDB.GetTransferQueueItem(ctx, nodeID, items[2].PieceID)
+		require.True(t, gracefulexit.ErrNotFound.Has(err))
+
+		incomplete, err = gracefulexitDB.GetIncomplete(ctx, nodeID, 2, 10)
+		require.NoError(t, err)
+		require.Len(t, incomplete, 1)
+
+		require.NoError(t, gracefulexitDB.DeleteTransferQueueItems(ctx, nodeID))
+		_, err = gracefulexitDB.GetTransferQueueItem(ctx, nodeID, items[1].PieceID)
+		require.True(t, gracefulexit.ErrNotFound.Has(err))
+	})
+}
@@ -0,0 +1,408 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+// errSkip is returned when a queued piece doesn't need to be transferred anymore.
+var errSkip = Error.New("piece no longer needs to be transferred")
+
+// errPostpone is returned when a queued piece can't be transferred yet, without
+// the exiting node being at fault.
+var errPostpone = Error.New("piece transfer postponed")
+
+// Endpoint for handling the transfer of pieces for Graceful Exit.
+type Endpoint struct {
+	log      *zap.Logger
+	db       DB
+	overlay  *overlay.Cache
+	metainfo *metainfo.Service
+	orders   *orders.Service
+	kademlia *kademlia.Kademlia
+	config   Config
+}
+
+// NewEndpoint creates a new graceful exit endpoint.
+func NewEndpoint(log *zap.Logger, db DB, overlay *overlay.Cache, metainfo *metainfo.Service, orders *orders.Service, kademlia *kademlia.Kademlia, config Config) *Endpoint {
+	return &Endpoint{
+		log:      log,
+		db:       db,
+		overlay:  overlay,
+		metainfo: metainfo,
+		orders:   orders,
+		kademlia: kademlia,
+		config:   config,
+	}
+}
+
+// InitiateExit marks the calling storage node as gracefully exiting.
+func (endpoint *Endpoint) InitiateExit(ctx context.Context, req *pb.InitiateExitRequest) (_ *pb.InitiateExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	nodeID := peer.ID
+
+	node, err := endpoint.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if node.Disqualified != nil {
+		return nil, overlay.ErrNodeDisqualified.New(nodeID.String())
+	}
+
+	err = endpoint.db.InitiateExit(ctx, nodeID, time.Now().UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	progress, err := endpoint.db.GetProgress(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("graceful exit initiated", zap.Stringer("node ID", nodeID))
+
+	return &pb.InitiateExitResponse{InitiatedAt: progress.ExitInitiatedAt}, nil
+}
+
+// GetTransfers returns the next batch of pieces the calling storage node should transfer.
+func (endpoint *Endpoint) GetTransfers(ctx context.Context, req *pb.GetTransfersRequest) (_ *pb.GetTransfersResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	nodeID := peer.ID
+
+	progress, err := endpoint.db.GetProgress(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if progress.ExitFinishedAt != nil {
+		return &pb.GetTransfersResponse{Finished: true, Successful: progress.ExitSuccess}, nil
+	}
+	if progress.ExitLoopCompletedAt == nil {
+		return &pb.GetTransfersResponse{Pending: true}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > endpoint.config.EndpointBatchSize {
+		limit = endpoint.config.EndpointBatchSize
+	}
+
+	items, err := endpoint.db.GetIncomplete(ctx, nodeID, endpoint.config.MaxFailuresPerPiece, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if len(items) == 0 {
+		return endpoint.finish(ctx, progress)
+	}
+
+	response := &pb.GetTransfersResponse{}
+	for _, item := range items {
+		piece, err := endpoint.createTransferPiece(ctx, nodeID, item)
+		if err == errSkip {
+			err = endpoint.db.DeleteTransferQueueItem(ctx, nodeID, item.PieceID)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			continue
+		}
+		if err == errPostpone {
+			continue
+		}
+		if err != nil {
+			// the piece is handed out again until it reaches the maximum failures
+			endpoint.log.Warn("unable to create transfer", zap.Stringer("node ID", nodeID), zap.Stringer("piece ID", item.PieceID), zap.Error(err))
+			err = endpoint.markFailed(ctx, item, int(pb.TransferFailed_UNKNOWN))
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			continue
+		}
+		response.Pieces = append(response.Pieces, piece)
+	}
+
+	return response, nil
+}
+
+// ReportTransfer verifies and records the result of transferring a single piece.
+func (endpoint *Endpoint) ReportTransfer(ctx context.Context, req *pb.ReportTransferRequest) (_ *pb.ReportTransferResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	nodeID := peer.ID
+
+	item, err := endpoint.db.GetTransferQueueItem(ctx, nodeID, req.OriginalPieceId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if req.Failed != nil {
+		endpoint.log.Debug("transfer failed", zap.Stringer("node ID", nodeID), zap.Stringer("piece ID", item.PieceID), zap.Stringer("error", req.Failed.Error))
+		err = endpoint.markFailed(ctx, item, int(req.Failed.Error))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return &pb.ReportTransferResponse{}, nil
+	}
+
+	pointer, err := endpoint.metainfo.Get(ctx, item.Path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			// the segment was deleted during the transfer
+			return &pb.ReportTransferResponse{}, Error.Wrap(endpoint.db.DeleteTransferQueueItem(ctx, nodeID, item.PieceID))
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	err = endpoint.verifyTransfer(ctx, item, pointer, req)
+	if err != nil {
+		endpoint.log.Warn("transfer verification failed", zap.Stringer("node ID", nodeID), zap.Stringer("piece ID", item.PieceID), zap.Error(err))
+		return nil, Error.Wrap(errs.Combine(err, endpoint.markFailed(ctx, item, int(pb.TransferFailed_HASH_VERIFICATION))))
+	}
+
+	_, err = endpoint.metainfo.UpdatePieces(ctx, item.Path,
+		[]*pb.RemotePiece{{
+			PieceNum: item.PieceNum,
+			NodeId:   req.ReplacementOrderLimit.StorageNodeId,
+			Hash:     req.ReplacementPieceHash,
+		}},
+		[]*pb.RemotePiece{{
+			PieceNum: item.PieceNum,
+			NodeId:   nodeID,
+		}},
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = endpoint.db.IncrementProgress(ctx, nodeID, req.ReplacementPieceHash.PieceSize, 1, 0)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = endpoint.db.DeleteTransferQueueItem(ctx, nodeID, item.PieceID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.ReportTransferResponse{}, nil
+}
+
+// createTransferPiece finds a new node for the queued piece and creates the order limit for uploading to it.
+func (endpoint *Endpoint) createTransferPiece(ctx context.Context, nodeID storj.NodeID, item *TransferQueueItem) (_ *pb.TransferPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := endpoint.metainfo.Get(ctx, item.Path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, errSkip
+		}
+		return nil, err
+	}
+
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return nil, errSkip
+	}
+
+	var stored bool
+	excludedNodes := make([]storj.NodeID, 0, len(remote.GetRemotePieces()))
+	placedNodes := make([]storj.NodeID, 0, len(remote.GetRemotePieces()))
+	for _, piece := range remote.GetRemotePieces() {
+		if piece.NodeId == nodeID && piece.PieceNum == item.PieceNum {
+			stored = true
+		} else {
+			placedNodes = append(placedNodes, piece.NodeId)
+		}
+		excludedNodes = append(excludedNodes, piece.NodeId)
+	}
+	if !stored {
+		// the piece has been repaired to another node in the meantime
+		return nil, errSkip
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(remote.GetRedundancy())
+	if err != nil {
+		return nil, err
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	placement, err := endpoint.metainfo.SegmentPlacement(ctx, item.Path)
+	if err != nil {
+		return nil, err
+	}
+	if err := endpoint.overlay.ValidatePlacement(placement); err != nil {
+		// the piece stays on the exiting node until the placement can be enforced
+		mon.Meter("transfer_placement_postponed").Mark(1)
+		endpoint.log.Error("placement policy of the segment can't be enforced", zap.String("Path", item.Path), zap.Error(err))
+		return nil, errPostpone
+	}
+
+	newNodes, err := endpoint.overlay.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: 1,
+		FreeBandwidth:  pieceSize,
+		FreeDisk:       pieceSize,
+		ExcludedNodes:  excludedNodes,
+		PlacedNodes:    placedNodes,
+		Placement:      placement,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bucketID, err := createBucketID(item.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, privateKey, err := endpoint.orders.CreateGracefulExitPutOrderLimit(ctx, bucketID, newNodes[0], item.PieceNum, remote.RootPieceId, pieceSize, pointer.GetExpirationDate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TransferPiece{
+		OriginalPieceId:     item.PieceID,
+		PrivateKey:          privateKey,
+		AddressedOrderLimit: limit,
+	}, nil
+}
+
+// verifyTransfer checks that the replacement node received the same data the uplink originally uploaded.
+func (endpoint *Endpoint) verifyTransfer(ctx context.Context, item *TransferQueueItem, pointer *pb.Pointer, req *pb.ReportTransferRequest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	originalLimit, originalHash := req.OriginalOrderLimit, req.OriginalPieceHash
+	replacementLimit, replacementHash := req.ReplacementOrderLimit, req.ReplacementPieceHash
+	if originalLimit == nil || originalHash == nil || replacementLimit == nil || replacementHash == nil {
+		return Error.New("incomplete transfer report")
+	}
+
+	// the original order limit and the uplink signed hash prove what the exiting node was storing
+	if err := endpoint.orders.VerifyOrderLimitSignature(ctx, originalLimit); err != nil {
+		return Error.New("invalid original order limit signature: %v", err)
+	}
+	if originalLimit.StorageNodeId != item.NodeID || originalLimit.PieceId != item.PieceID {
+		return Error.New("original order limit doesn't match the transferred piece")
+	}
+	if originalHash.PieceId != item.PieceID {
+		return Error.New("original piece hash doesn't match the transferred piece")
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, originalLimit.UplinkPublicKey, originalHash); err != nil {
+		return Error.New("invalid original piece hash signature: %v", err)
+	}
+
+	// the replacement node signed hash proves what it has received
+	if err := endpoint.orders.VerifyOrderLimitSignature(ctx, replacementLimit); err != nil {
+		return Error.New("invalid replacement order limit signature: %v", err)
+	}
+	if replacementLimit.Action != pb.PieceAction_PUT_GRACEFUL_EXIT {
+		return Error.New("invalid replacement order limit action %v", replacementLimit.Action)
+	}
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return Error.New("pointer is not remote")
+	}
+	expectedPieceID := remote.RootPieceId.Derive(replacementLimit.StorageNodeId, item.PieceNum)
+	if replacementLimit.PieceId != expectedPieceID || replacementHash.PieceId != expectedPieceID {
+		return Error.New("replacement doesn't match the transferred piece")
+	}
+
+	replacementNode, err := endpoint.kademlia.FetchPeerIdentity(ctx, replacementLimit.StorageNodeId)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := signing.VerifyPieceHashSignature(ctx, signing.SigneeFromPeerIdentity(replacementNode), replacementHash); err != nil {
+		return Error.New("invalid replacement piece hash signature: %v", err)
+	}
+
+	if !bytes.Equal(originalHash.Hash, replacementHash.Hash) {
+		return Error.New("piece hashes don't match")
+	}
+
+	return nil
+}
+
+// markFailed records a failed transfer, the piece counts as failed after reaching the maximum failures.
+func (endpoint *Endpoint) markFailed(ctx context.Context, item *TransferQueueItem, code int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := endpoint.db.MarkTransferFailed(ctx, item.NodeID, item.PieceID, time.Now().UTC(), code)
+	if err != nil {
+		return err
+	}
+
+	if updated.FailedCount >= endpoint.config.MaxFailuresPerPiece {
+		return endpoint.db.IncrementProgress(ctx, item.NodeID, 0, 0, 1)
+	}
+	return nil
+}
+
+// finish completes the graceful exit of the node once all its pieces have been processed.
+func (endpoint *Endpoint) finish(ctx context.Context, progress *Progress) (_ *pb.GetTransfersResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	success := true
+	processed := progress.PiecesTransferred + progress.PiecesFailed
+	if processed > 0 {
+		success = progress.PiecesFailed*100 <= int64(endpoint.config.OverallMaxFailuresPercentage)*processed
+	}
+
+	err = endpoint.db.MarkFinished(ctx, progress.NodeID, time.Now().UTC(), success)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// pieces which failed too often are not going to be transferred anymore
+	err = endpoint.db.DeleteTransferQueueItems(ctx, progress.NodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("graceful exit finished", zap.Stringer("node ID", progress.NodeID), zap.Bool("success", success))
+
+	return &pb.GetTransfersResponse{Finished: true, Successful: success}, nil
+}
+
+// createBucketID creates the bucket id of the segment path.
+func createBucketID(path storj.Path) ([]byte, error) {
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return nil, Error.New("no bucket component in path: %s", path)
+	}
+	// packed and copied segments don't belong to a bucket
+	if comps[1] == "c" {
+		return []byte(storj.JoinPaths(comps[0], "")), nil
+	}
+	// project_id/bucket_name
+	return []byte(storj.JoinPaths(comps[0], comps[2])), nil
+}
@@ -0,0 +1,275 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/uplink"
+)
+
+// TestGracefulExit does the following:
+// * Upload an object to four of five storage nodes
+// * Initiate the graceful exit of a node storing one of the pieces
+// * Check that the exiting node isn't selected for uploads anymore
+// * Collect the pieces of the exiting node and let the node transfer them
+// * Check that the piece moved to the remaining node and the exit succeeded
+func TestGracefulExit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		// stop the loops, so we can run them manually
+		satellite.GracefulExit.Chore.Loop.Pause()
+		for _, node := range planet.StorageNodes {
+			node.GracefulExit.Chore.Loop.Pause()
+		}
+
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+
+		err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		path, pointer := getRemoteSegment(t, ctx, planet)
+		remote := pointer.GetRemote()
+		require.Len(t, remote.GetRemotePieces(), 4)
+
+		exitingPiece := remote.GetRemotePieces()[0]
+		exitingNode := findStorageNode(planet, exitingPiece.NodeId)
+		require.NotNil(t, exitingNode)
+		originalPieceID := remote.RootPieceId.Derive(exitingPiece.NodeId, exitingPiece.PieceNum)
+
+		_, err = exitingNode.GracefulExit.Endpoint.InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{NodeId: satellite.ID()})
+		require.NoError(t, err)
+
+		nonExiting, err := exitingNode.GracefulExit.Endpoint.GetNonExitingSatellites(ctx, &pb.GetNonExitingSatellitesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, nonExiting.GetSatellites())
+
+		// the exiting node isn't selected for new pieces
+		nodes, err := satellite.Overlay.Service.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 4,
+		})
+		require.NoError(t, err)
+		for _, node := range nodes {
+			require.NotEqual(t, exitingNode.ID(), node.Id)
+		}
+
+		satellite.GracefulExit.Chore.Loop.TriggerWait()
+		exitingNode.GracefulExit.Chore.Loop.TriggerWait()
+
+		progress, err := exitingNode.GracefulExit.Endpoint.GetExitProgress(ctx, &pb.GetExitProgressRequest{})
+		require.NoError(t, err)
+		require.Len(t, progress.GetProgress(), 1)
+		require.True(t, progress.GetProgress()[0].Finished)
+		require.True(t, progress.GetProgress()[0].Successful)
+
+		satelliteProgress, err := satellite.DB.GracefulExit().GetProgress(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.NotNil(t, satelliteProgress.ExitFinishedAt)
+		require.True(t, satelliteProgress.ExitSuccess)
+		require.EqualValues(t, 1, satelliteProgress.PiecesTransferred)
+		require.EqualValues(t, 0, satelliteProgress.PiecesFailed)
+
+		// the piece has been moved from the exiting node to the remaining node
+		pointer, err = satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		pieces := pointer.GetRemote().GetRemotePieces()
+		require.Len(t, pieces, 4)
+
+		var newPiece *pb.RemotePiece
+		for _, piece := range pieces {
+			require.NotEqual(t, exitingNode.ID(), piece.NodeId)
+			if piece.PieceNum == exitingPiece.PieceNum {
+				newPiece = piece
+			}
+		}
+		require.NotNil(t, newPiece)
+
+		newNode := findStorageNode(planet, newPiece.NodeId)
+		require.NotNil(t, newNode)
+		reader, err := newNode.Storage2.Store.Reader(ctx, satellite.ID(), remote.RootPieceId.Derive(newPiece.NodeId, newPiece.PieceNum))
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		_, err = exitingNode.Storage2.Store.Reader(ctx, satellite.ID(), originalPieceID)
+		require.True(t, os.IsNotExist(err), "transferred piece should have been deleted")
+
+		// the object can still be downloaded
+		_, err = upl.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+	})
+}
+
+// TestGracefulExit_CopiedObject checks that the piece of a copied object is
+// transferred once and both the original and the copy keep it.
+func TestGracefulExit_CopiedObject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		satellite.GracefulExit.Chore.Loop.Pause()
+		for _, node := range planet.StorageNodes {
+			node.GracefulExit.Chore.Loop.Pause()
+		}
+
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+
+		data := testrand.Bytes(5 * memory.KiB)
+		err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path", data)
+		require.NoError(t, err)
+
+		db, _, cleanup, err := testplanet.DialMetainfo(ctx, upl.Log, upl.GetConfig(satellite), upl.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		err = db.CopyObject(ctx, "testbucket", "test/path", "testbucket", "test/copy", false)
+		require.NoError(t, err)
+
+		// the original and the copy refer to a single segment with the pieces
+		path, pointer := getRemoteSegment(t, ctx, planet)
+		require.EqualValues(t, 2, pointer.References)
+
+		exitingPiece := pointer.GetRemote().GetRemotePieces()[0]
+		exitingNode := findStorageNode(planet, exitingPiece.NodeId)
+		require.NotNil(t, exitingNode)
+
+		_, err = exitingNode.GracefulExit.Endpoint.InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{NodeId: satellite.ID()})
+		require.NoError(t, err)
+
+		satellite.GracefulExit.Chore.Loop.TriggerWait()
+		exitingNode.GracefulExit.Chore.Loop.TriggerWait()
+
+		satelliteProgress, err := satellite.DB.GracefulExit().GetProgress(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.NotNil(t, satelliteProgress.ExitFinishedAt)
+		require.True(t, satelliteProgress.ExitSuccess)
+		require.EqualValues(t, 1, satelliteProgress.PiecesTransferred)
+
+		pointer, err = satellite.Metainfo.Service.Get(ctx, path)
+		require.NoError(t, err)
+		require.Len(t, pointer.GetRemote().GetRemotePieces(), 4)
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			require.NotEqual(t, exitingNode.ID(), piece.NodeId)
+		}
+
+		for _, objectPath := range []string{"test/path", "test/copy"} {
+			downloaded, err := upl.Download(ctx, satellite, "testbucket", objectPath)
+			require.NoError(t, err)
+			require.Equal(t, data, downloaded)
+		}
+	})
+}
+
+// TestGracefulExit_NoReplacementNode checks that pieces, for which no
+// replacement node can be found, count as failed and the exit finishes.
+func TestGracefulExit_NoReplacementNode(t *testing.T) {
+	const maxFailures = 2
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.GracefulExit.MaxFailuresPerPiece = maxFailures
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		satellite.GracefulExit.Chore.Loop.Pause()
+		for _, node := range planet.StorageNodes {
+			node.GracefulExit.Chore.Loop.Pause()
+		}
+
+		// every node stores a piece, so none of them can take over the piece of the exiting node
+		redundancy := &uplink.RSConfig{
+			MinThreshold:     2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			MaxThreshold:     4,
+		}
+
+		err := upl.UploadWithConfig(ctx, satellite, redundancy, "testbucket", "test/path", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		_, pointer := getRemoteSegment(t, ctx, planet)
+		exitingNode := findStorageNode(planet, pointer.GetRemote().GetRemotePieces()[0].NodeId)
+		require.NotNil(t, exitingNode)
+
+		_, err = exitingNode.GracefulExit.Endpoint.InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{NodeId: satellite.ID()})
+		require.NoError(t, err)
+
+		satellite.GracefulExit.Chore.Loop.TriggerWait()
+
+		// every request counts as a failure of the piece, until the maximum is reached
+		for i := 0; i <= maxFailures; i++ {
+			exitingNode.GracefulExit.Chore.Loop.TriggerWait()
+		}
+
+		satelliteProgress, err := satellite.DB.GracefulExit().GetProgress(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.NotNil(t, satelliteProgress.ExitFinishedAt)
+		require.False(t, satelliteProgress.ExitSuccess)
+		require.EqualValues(t, 0, satelliteProgress.PiecesTransferred)
+		require.EqualValues(t, 1, satelliteProgress.PiecesFailed)
+	})
+}
+
+// getRemoteSegment returns the single remote segment stored on the satellite.
+func getRemoteSegment(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) (string, *pb.Pointer) {
+	t.Helper()
+
+	metainfo := planet.Satellites[0].Metainfo.Service
+	listResponse, _, err := metainfo.List(ctx, "", "", "", true, 0, 0)
+	require.NoError(t, err)
+
+	for _, v := range listResponse {
+		path := v.GetPath()
+		pointer, err := metainfo.Get(ctx, path)
+		require.NoError(t, err)
+		if pointer.GetRemote() != nil {
+			return path, pointer
+		}
+	}
+
+	t.Fatal("satellite doesn't have any remote segment")
+	return "", nil
+}
+
+// findStorageNode returns the storage node with the given id.
+func findStorageNode(planet *testplanet.Planet, nodeID storj.NodeID) *storagenode.Peer {
+	for _, node := range planet.StorageNodes {
+		if node.ID() == nodeID {
+			return node
+		}
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error is the default error class for the lifecycle package.
+	Error = errs.Class("lifecycle")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for applying bucket lifecycle rules
+type Config struct {
+	Interval                time.Duration `help:"how frequently the lifecycle rules of the buckets are applied" releaseDefault:"24h" devDefault:"1m"`
+	PendingObjectExpiration time.Duration `help:"how long an object may stay uncommitted before its upload is abandoned" default:"168h"`
+}
+
+// pendingObjectsBatchSize is the number of abandoned pending objects which
+// are deleted at once.
+const pendingObjectsBatchSize = 100
+
+// Chore applies the lifecycle rules of the buckets, it deletes expired
+// objects and the segments of uploads that were never committed. The pieces
+// of the deleted segments are deleted from the storage nodes like an uplink
+// deletes them.
+type Chore struct {
+	log         *zap.Logger
+	Loop        sync2.Cycle
+	metainfo    *metainfo.Service
+	orders      *orders.Service
+	containment metainfo.Containment
+	ec          ecclient.Client
+
+	pendingObjectExpiration time.Duration
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, metainfo *metainfo.Service, orders *orders.Service, containment metainfo.Containment, transport transport.Client, config Config) *Chore {
+	return &Chore{
+		log:         log,
+		Loop:        *sync2.NewCycle(config.Interval),
+		metainfo:    metainfo,
+		orders:      orders,
+		containment: containment,
+		ec:          ecclient.NewClient(log.Named("ecclient"), transport, 0),
+
+		pendingObjectExpiration: config.PendingObjectExpiration,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("error applying lifecycle rules", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce applies the lifecycle rules of all buckets once.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets, err := chore.metainfo.ListLifecycleBuckets(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	errlist.Add(chore.deletePendingObjects(ctx))
+	for _, bucket := range buckets {
+		for _, rule := range bucket.LifecycleRules {
+			if rule.ExpirationDays > 0 {
+				errlist.Add(chore.expireObjects(ctx, bucket, rule))
+			}
+			if rule.AbortIncompleteUploadDays > 0 {
+				errlist.Add(chore.abortIncompleteUploads(ctx, bucket, rule))
+			}
+		}
+	}
+	return Error.Wrap(errlist.Err())
+}
+
+// expireObjects deletes the objects matching the rule that are older than
+// the expiration.
+func (chore *Chore) expireObjects(ctx context.Context, bucket storj.Bucket, rule storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	before := time.Now().Add(-time.Duration(rule.ExpirationDays) * 24 * time.Hour)
+	expired, err := chore.collect(ctx, bucket, -1, rule.Prefix, before)
+	if err != nil {
+		return err
+	}
+
+	for _, encryptedPath := range expired {
+		unreferenced, err := chore.metainfo.DeleteObject(ctx, bucket.ProjectID, []byte(bucket.Name), []byte(encryptedPath), bucket.Versioning)
+		chore.deletePieces(ctx, bucket, unreferenced)
+		// an object which is being committed again isn't expired anymore
+		if metainfo.ErrConcurrentCommit.Has(err) {
+			continue
+		}
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+		mon.Meter("lifecycle_expired_objects").Mark(1)
+	}
+	return nil
+}
+
+// abortIncompleteUploads deletes the segments of the uploads matching the
+// rule which were started before the deadline but never committed.
+func (chore *Chore) abortIncompleteUploads(ctx context.Context, bucket storj.Bucket, rule storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	before := time.Now().Add(-time.Duration(rule.AbortIncompleteUploadDays) * 24 * time.Hour)
+	started, err := chore.collect(ctx, bucket, 0, rule.Prefix, before)
+	if err != nil {
+		return err
+	}
+
+	for _, encryptedPath := range started {
+		lastPath, err := metainfo.CreatePath(ctx, bucket.ProjectID, -1, []byte(bucket.Name), []byte(encryptedPath))
+		if err != nil {
+			return err
+		}
+		_, err = chore.metainfo.Get(ctx, lastPath)
+		if err == nil {
+			// the upload was committed
+			continue
+		}
+		if !storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+
+		for segmentIndex := int64(0); ; segmentIndex++ {
+			path, err := metainfo.CreatePath(ctx, bucket.ProjectID, segmentIndex, []byte(bucket.Name), []byte(encryptedPath))
+			if err != nil {
+				return err
+			}
+
+			// not all key value stores report deleting a missing key
+			_, err = chore.metainfo.Get(ctx, path)
+			if storage.ErrKeyNotFound.Has(err) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			unreferenced, err := chore.metainfo.Remove(ctx, path)
+			if err != nil {
+				return err
+			}
+			if unreferenced != nil {
+				chore.deletePieces(ctx, bucket, []*pb.Pointer{unreferenced})
+			}
+		}
+		mon.Meter("lifecycle_aborted_uploads").Mark(1)
+	}
+	return nil
+}
+
+// deletePendingObjects deletes the pending objects whose upload began longer
+// ago than the pending object expiration, along with their segments and
+// pieces.
+func (chore *Chore) deletePendingObjects(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if chore.pendingObjectExpiration <= 0 {
+		return nil
+	}
+
+	before := time.Now().Add(-chore.pendingObjectExpiration)
+	for {
+		objects, err := chore.metainfo.ListPendingObjectsBefore(ctx, before, pendingObjectsBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range objects {
+			deleted, err := chore.metainfo.DeletePendingObject(ctx, object)
+			chore.deletePieces(ctx, storj.Bucket{ProjectID: object.ProjectID, Name: string(object.BucketName)}, deleted)
+			if err != nil {
+				return err
+			}
+			mon.Meter("lifecycle_abandoned_uploads").Mark(1)
+		}
+
+		if len(objects) < pendingObjectsBatchSize {
+			return nil
+		}
+	}
+}
+
+// deletePieces deletes the pieces of the deleted segments from the storage
+// nodes. Pieces which can't be deleted are left to garbage collection.
+func (chore *Chore) deletePieces(ctx context.Context, bucket storj.Bucket, segments []*pb.Pointer) {
+	defer mon.Task()(&ctx)(nil)
+
+	bucketID := []byte(storj.JoinPaths(bucket.ProjectID.String(), bucket.Name))
+	for _, segment := range segments {
+		for _, piece := range segment.GetRemote().GetRemotePieces() {
+			_, err := chore.containment.Delete(ctx, piece.NodeId)
+			if err != nil {
+				chore.log.Warn("unable to release containment", zap.Stringer("node ID", piece.NodeId), zap.Error(err))
+			}
+		}
+
+		limits, privateKey, err := chore.orders.CreateDeleteOrderLimits(ctx, bucketID, segment)
+		if err != nil {
+			chore.log.Warn("unable to create delete order limits", zap.Stringer("root piece ID", segment.GetRemote().RootPieceId), zap.Error(err))
+			continue
+		}
+
+		err = chore.ec.Delete(ctx, limits, privateKey)
+		if err != nil {
+			chore.log.Warn("unable to delete pieces", zap.Stringer("root piece ID", segment.GetRemote().RootPieceId), zap.Error(err))
+		}
+	}
+}
+
+// collect returns the encrypted paths of the objects below prefix whose
+// segment with the given index was created before the deadline. The prefix
+// only matches whole path components.
+func (chore *Chore) collect(ctx context.Context, bucket storj.Bucket, segmentIndex int64, prefix storj.Path, before time.Time) (encryptedPaths []storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketPath, err := metainfo.CreatePath(ctx, bucket.ProjectID, segmentIndex, []byte(bucket.Name), nil)
+	if err != nil {
+		return nil, err
+	}
+	bucketPath += "/"
+
+	// the pointers are deleted after iterating, as not all key value stores
+	// support modifications during iteration
+	err = chore.metainfo.Iterate(ctx, bucketPath+prefix, "", true, false,
+		func(ctx context.Context, it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(ctx, &item) {
+				encryptedPath := strings.TrimPrefix(item.Key.String(), bucketPath)
+				if prefix != "" && encryptedPath != prefix && !strings.HasPrefix(encryptedPath, prefix+"/") {
+					continue
+				}
+
+				pointer := &pb.Pointer{}
+				err := proto.Unmarshal(item.Value, pointer)
+				if err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				if pointer.CreationDate.Before(before) {
+					encryptedPaths = append(encryptedPaths, encryptedPath)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return encryptedPaths, nil
+}
+
+// Close closes resources
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+)
+
+// TestLifecycle does the following:
+// * Upload objects below two prefixes and to a versioned bucket and age them
+// * Upload another object and add a dangling segment of an old upload
+// * Set lifecycle rules expiring the objects of one prefix and aborting old uploads
+// * Check that only the old objects of the prefix and the old upload are removed
+// * Check that the object in the versioned bucket is kept as a previous version
+// * Check that the pieces of the removed objects are deleted from the storage nodes
+func TestLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		// stop the loop, so we can run it manually
+		satellite.Lifecycle.Chore.Loop.Pause()
+
+		db, _, cleanup, err := testplanet.DialMetainfo(ctx, upl.Log.Named("metainfo"), upl.GetConfig(satellite), upl.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		_, err = db.CreateBucket(ctx, "versionedbucket", nil)
+		require.NoError(t, err)
+		_, err = db.SetBucketVersioning(ctx, "versionedbucket", true)
+		require.NoError(t, err)
+
+		for _, path := range []storj.Path{"logs/old", "other/old"} {
+			err := upl.Upload(ctx, satellite, "testbucket", path, testrand.Bytes(5*memory.KiB))
+			require.NoError(t, err)
+		}
+		err = upl.Upload(ctx, satellite, "versionedbucket", "logs/old", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		ageAllPointers(t, ctx, satellite, 31*24*time.Hour)
+
+		err = upl.Upload(ctx, satellite, "testbucket", "logs/new", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		// a segment without a last segment is an upload that was never committed
+		keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 1)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		projectID := storj.SplitPath(keys[0].String())[0]
+		incompletePath := storj.JoinPaths(projectID, "s0", "testbucket", "incomplete")
+		incomplete, err := proto.Marshal(&pb.Pointer{
+			Type:         pb.Pointer_INLINE,
+			CreationDate: time.Now().Add(-8 * 24 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.NoError(t, satellite.Metainfo.Database.Put(ctx, storage.Key(incompletePath), incomplete))
+
+		err = db.SetBucketLifecycle(ctx, "testbucket", []storj.LifecycleRule{
+			{ID: "logs", Prefix: "logs/", ExpirationDays: 30},
+			{ID: "uploads", AbortIncompleteUploadDays: 7},
+		})
+		require.NoError(t, err)
+
+		rules, err := db.GetBucketLifecycle(ctx, "testbucket")
+		require.NoError(t, err)
+		require.Equal(t, []storj.LifecycleRule{
+			{ID: "logs", Prefix: "logs", ExpirationDays: 30},
+			{ID: "uploads", AbortIncompleteUploadDays: 7},
+		}, rules)
+
+		err = db.SetBucketLifecycle(ctx, "versionedbucket", []storj.LifecycleRule{
+			{ID: "all", ExpirationDays: 30},
+		})
+		require.NoError(t, err)
+
+		// rules without an action are rejected
+		err = db.SetBucketLifecycle(ctx, "testbucket", []storj.LifecycleRule{{ID: "none"}})
+		require.Error(t, err)
+
+		remoteSegments := getRemoteSegments(t, ctx, satellite)
+
+		satellite.Lifecycle.Chore.Loop.TriggerWait()
+
+		list, err := db.ListObjects(ctx, "testbucket", storj.ListOptions{Direction: storj.After, Recursive: true})
+		require.NoError(t, err)
+		var paths []storj.Path
+		for _, item := range list.Items {
+			paths = append(paths, item.Path)
+		}
+		require.ElementsMatch(t, []storj.Path{"logs/new", "other/old"}, paths)
+
+		_, err = satellite.Metainfo.Service.Get(ctx, incompletePath)
+		require.True(t, storage.ErrKeyNotFound.Has(err), err)
+
+		// in a versioned bucket the expired object is kept as a previous version
+		_, err = db.GetObject(ctx, "versionedbucket", "logs/old")
+		require.True(t, storj.ErrObjectNotFound.Has(err), err)
+
+		versions, err := db.ListObjectVersions(ctx, "versionedbucket", "logs/old")
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		require.True(t, versions[0].IsDeleteMarker)
+		require.False(t, versions[1].IsDeleteMarker)
+
+		// archived versions are moved, so the pointers are matched by their pieces
+		keptSegments := map[storj.PieceID]bool{}
+		for _, pointer := range getRemoteSegments(t, ctx, satellite) {
+			keptSegments[pointer.GetRemote().RootPieceId] = true
+		}
+
+		var removed int
+		for _, pointer := range remoteSegments {
+			remote := pointer.GetRemote()
+			kept := keptSegments[remote.RootPieceId]
+			if !kept {
+				removed++
+			}
+
+			for _, piece := range remote.GetRemotePieces() {
+				node := findStorageNode(planet, piece.NodeId)
+				require.NotNil(t, node)
+				reader, err := node.Storage2.Store.Reader(ctx, satellite.ID(), remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+				if kept {
+					require.NoError(t, err)
+					require.NoError(t, reader.Close())
+				} else {
+					require.True(t, os.IsNotExist(err), "piece of a removed segment should have been deleted")
+				}
+			}
+		}
+		require.Equal(t, 1, removed)
+	})
+}
+
+// TestDeletePendingObjects checks that the segments of an abandoned upload
+// are removed together with their pieces.
+func TestDeletePendingObjects(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Lifecycle.PendingObjectExpiration = time.Nanosecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		// stop the loop, so we can run it manually
+		satellite.Lifecycle.Chore.Loop.Pause()
+
+		err := upl.Upload(ctx, satellite, "testbucket", "pending", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		// turn the committed object into the segment of an upload in progress
+		keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		pointer, err := satellite.Metainfo.Service.Get(ctx, keys[0].String())
+		require.NoError(t, err)
+		require.NotNil(t, pointer.GetRemote())
+
+		entries := storj.SplitPath(keys[0].String())
+		projectID, err := uuid.Parse(entries[0])
+		require.NoError(t, err)
+		object := metainfo.PendingObject{
+			StreamID:      testrand.StreamID(),
+			ProjectID:     *projectID,
+			BucketName:    []byte(entries[2]),
+			EncryptedPath: []byte(storj.JoinPaths(entries[3:]...)),
+			Redundancy:    &pb.RedundancyScheme{},
+			SegmentCount:  1,
+		}
+		pendingPath, err := metainfo.CreatePendingPath(ctx, object.ProjectID, 0, object.BucketName, object.EncryptedPath, object.StreamID)
+		require.NoError(t, err)
+
+		value, err := proto.Marshal(pointer)
+		require.NoError(t, err)
+		require.NoError(t, satellite.Metainfo.Database.Put(ctx, storage.Key(pendingPath), value))
+		require.NoError(t, satellite.Metainfo.Database.Delete(ctx, keys[0]))
+		require.NoError(t, satellite.DB.PendingObjects().CreatePendingObject(ctx, object))
+
+		satellite.Lifecycle.Chore.Loop.TriggerWait()
+
+		_, err = satellite.Metainfo.Service.Get(ctx, pendingPath)
+		require.True(t, storage.ErrKeyNotFound.Has(err), err)
+
+		_, err = satellite.DB.PendingObjects().GetPendingObject(ctx, object.StreamID)
+		require.True(t, metainfo.ErrPendingObjectNotFound.Has(err), err)
+
+		remote := pointer.GetRemote()
+		for _, piece := range remote.GetRemotePieces() {
+			node := findStorageNode(planet, piece.NodeId)
+			require.NotNil(t, node)
+			_, err := node.Storage2.Store.Reader(ctx, satellite.ID(), remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+			require.True(t, os.IsNotExist(err), "piece of an abandoned upload should have been deleted")
+		}
+	})
+}
+
+// getRemoteSegments returns the remote pointers of the satellite.
+func getRemoteSegments(t *testing.T, ctx *testcontext.Context, satellite *satellite.Peer) (pointers []*pb.Pointer) {
+	keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		pointer, err := satellite.Metainfo.Service.Get(ctx, key.String())
+		require.NoError(t, err)
+		if pointer.GetRemote() != nil {
+			pointers = append(pointers, pointer)
+		}
+	}
+	return pointers
+}
+
+// findStorageNode returns the storage node with the given id.
+func findStorageNode(planet *testplanet.Planet, nodeID storj.NodeID) *storagenode.Peer {
+	for _, node := range planet.StorageNodes {
+		if node.ID() == nodeID {
+			return node
+		}
+	}
+	return nil
+}
+
+// ageAllPointers moves the creation date of all pointers into the past.
+func ageAllPointers(t *testing.T, ctx *testcontext.Context, satellite *satellite.Peer, age time.Duration) {
+	keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, keys)
+
+	for _, key := range keys {
+		pointer, err := satellite.Metainfo.Service.Get(ctx, key.String())
+		require.NoError(t, err)
+
+		pointer.CreationDate = pointer.CreationDate.Add(-age)
+		value, err := proto.Marshal(pointer)
+		require.NoError(t, err)
+
+		// the pointer is stored directly, as the service resets the creation date
+		err = satellite.Metainfo.Database.Put(ctx, key, value)
+		require.NoError(t, err)
+	}
+}
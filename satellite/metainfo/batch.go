@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// maxBatchSize is the maximum number of requests in a single batch
+const maxBatchSize = 100
+
+// Batch handles the requests of a batch one after another and stops at the
+// first one that fails. The responses of the requests before it are returned
+// together with the error, so the client can finish what they started. A
+// segment or object commit without a stream id refers to the object begun last
+// in the batch.
+func (endpoint *Endpoint) Batch(ctx context.Context, req *pb.BatchRequest) (resp *pb.BatchResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(req.Requests) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch contains %d requests, the maximum is %d", len(req.Requests), maxBatchSize)
+	}
+
+	resp = &pb.BatchResponse{
+		Responses: make([]*pb.BatchResponseItem, 0, len(req.Requests)),
+	}
+
+	var lastStreamID storj.StreamID
+	for _, item := range req.Requests {
+		response := &pb.BatchResponseItem{}
+
+		switch request := item.Request.(type) {
+		case *pb.BatchRequestItem_ObjectBegin:
+			objectBegin, err := endpoint.BeginObject(ctx, request.ObjectBegin)
+			if err != nil {
+				return batchFailed(resp, err)
+			}
+			lastStreamID = objectBegin.StreamId
+			response.Response = &pb.BatchResponseItem_ObjectBegin{ObjectBegin: objectBegin}
+
+		case *pb.BatchRequestItem_ObjectCommit:
+			if request.ObjectCommit.StreamId.IsZero() {
+				request.ObjectCommit.StreamId = lastStreamID
+			}
+			objectCommit, err := endpoint.CommitObject(ctx, request.ObjectCommit)
+			if err != nil {
+				return batchFailed(resp, err)
+			}
+			response.Response = &pb.BatchResponseItem_ObjectCommit{ObjectCommit: objectCommit}
+
+		case *pb.BatchRequestItem_ObjectGet:
+			objectGet, err := endpoint.GetObject(ctx, request.ObjectGet)
+			if err != nil {
+				return batchFailed(resp, err)
+			}
+			response.Response = &pb.BatchResponseItem_ObjectGet{ObjectGet: objectGet}
+
+		case *pb.BatchRequestItem_ObjectList:
+			objectList, err := endpoint.ListObjects(ctx, request.ObjectList)
+			if err != nil {
+				return batchFailed(resp, err)
+			}
+			response.Response = &pb.BatchResponseItem_ObjectList{ObjectList: objectList}
+
+		case *pb.BatchRequestItem_SegmentBegin:
+			if request.SegmentBegin.StreamId.IsZero() {
+				request.SegmentBegin.StreamId = lastStreamID
+			}
+			segmentBegin, err := endpoint.BeginSegment(ctx, request.SegmentBegin)
+			if err != nil {
+				return batchFailed(resp, err)
+			}
+			response.Response = &pb.BatchResponseItem_SegmentBegin{SegmentBegin: segmentBegin}
+
+		case *pb.BatchRequestItem_SegmentCommit:
+			if request.SegmentCommit.StreamId.IsZero() {
+				request.SegmentCommit.StreamId = lastStreamID
+			}
+			segmentCommit, err := endpoint.CommitSegment(ctx, request.SegmentCommit)
+			if err != nil {
+				return batchFailed(resp, err)
+			}
+			response.Response = &pb.BatchResponseItem_SegmentCommit{SegmentCommit: segmentCommit}
+
+		case *pb.BatchRequestItem_SegmentDelete:
+			segmentDelete, err := endpoint.DeleteSegmentOld(ctx, request.SegmentDelete)
+			if err != nil {
+				return batchFailed(resp, err)
+			}
+			response.Response = &pb.BatchResponseItem_SegmentDelete{SegmentDelete: segmentDelete}
+
+		default:
+			return batchFailed(resp, status.Errorf(codes.InvalidArgument, "unsupported batch request %T", item.Request))
+		}
+
+		resp.Responses = append(resp.Responses, response)
+	}
+
+	return resp, nil
+}
+
+// batchFailed adds the error of a failed request to the response of a batch.
+func batchFailed(resp *pb.BatchResponse, err error) (*pb.BatchResponse, error) {
+	resp.Error = &pb.BatchError{
+		Code:    int32(status.Code(err)),
+		Message: status.Convert(err).Message(),
+	}
+	return resp, nil
+}
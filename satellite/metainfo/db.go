@@ -5,21 +5,58 @@ package metainfo
 
 import (
 	"context"
+	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 )
 
+// ErrPendingObjectNotFound is returned when a pending object doesn't exist
+var ErrPendingObjectNotFound = errs.Class("pending object not found")
+
 // BucketsDB is the interface for the database to interact with buckets
 type BucketsDB interface {
 	// Create creates a new bucket
 	CreateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error)
 	// Get returns an existing bucket
 	GetBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket storj.Bucket, err error)
+	// UpdateBucket updates the settings of an existing bucket
+	UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error)
 	// Delete deletes a bucket
 	DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
 	// List returns all buckets for a project
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
+	// ListLifecycleBuckets returns all buckets that have lifecycle rules
+	ListLifecycleBuckets(ctx context.Context) (buckets []storj.Bucket, err error)
+}
+
+// PendingObject is an object whose upload has begun but hasn't been committed
+// yet, its segments are kept apart until the object is committed.
+type PendingObject struct {
+	StreamID      storj.StreamID
+	ProjectID     uuid.UUID
+	BucketName    []byte
+	EncryptedPath []byte
+	Redundancy    *pb.RedundancyScheme
+	SegmentCount  int64
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+}
+
+// PendingObjectsDB is the interface for the database to keep track of pending objects
+type PendingObjectsDB interface {
+	// CreatePendingObject adds a new pending object
+	CreatePendingObject(ctx context.Context, object PendingObject) (err error)
+	// GetPendingObject returns a pending object
+	GetPendingObject(ctx context.Context, streamID storj.StreamID) (_ PendingObject, err error)
+	// UpdateSegmentCount raises the segment count of a pending object to at least segmentCount
+	UpdateSegmentCount(ctx context.Context, streamID storj.StreamID, segmentCount int64) (err error)
+	// DeletePendingObject deletes a pending object
+	DeletePendingObject(ctx context.Context, streamID storj.StreamID) (err error)
+	// ListPendingObjectsBefore returns up to limit pending objects created before the given time
+	ListPendingObjectsBefore(ctx context.Context, before time.Time, limit int) (_ []PendingObject, err error)
 }
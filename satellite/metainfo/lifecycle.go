@@ -0,0 +1,119 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *pb.BucketSetLifecycleRequest) (resp *pb.BucketSetLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	rules, err := convertProtoToLifecycleRules(req.Rules)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	bucket.LifecycleRules = rules
+	_, err = endpoint.metainfo.UpdateBucket(ctx, bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketSetLifecycleResponse{}, nil
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket
+func (endpoint *Endpoint) GetBucketLifecycle(ctx context.Context, req *pb.BucketGetLifecycleRequest) (resp *pb.BucketGetLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketGetLifecycleResponse{
+		Rules: convertLifecycleRulesToProto(bucket.LifecycleRules),
+	}, nil
+}
+
+// convertProtoToLifecycleRules converts and validates the lifecycle rules sent by an uplink.
+func convertProtoToLifecycleRules(pbRules []*pb.LifecycleRule) (rules []storj.LifecycleRule, err error) {
+	ids := make(map[string]bool, len(pbRules))
+	for _, pbRule := range pbRules {
+		if pbRule.ExpirationDays < 0 || pbRule.AbortIncompleteUploadDays < 0 {
+			return nil, Error.New("lifecycle rule %q: number of days must not be negative", pbRule.Id)
+		}
+		if pbRule.ExpirationDays == 0 && pbRule.AbortIncompleteUploadDays == 0 {
+			return nil, Error.New("lifecycle rule %q: no action specified", pbRule.Id)
+		}
+		if ids[pbRule.Id] {
+			return nil, Error.New("lifecycle rule %q: duplicate id", pbRule.Id)
+		}
+		ids[pbRule.Id] = true
+
+		rules = append(rules, storj.LifecycleRule{
+			ID:                        pbRule.Id,
+			Prefix:                    storj.Path(pbRule.EncryptedPrefix),
+			ExpirationDays:            int(pbRule.ExpirationDays),
+			AbortIncompleteUploadDays: int(pbRule.AbortIncompleteUploadDays),
+		})
+	}
+	return rules, nil
+}
+
+func convertLifecycleRulesToProto(rules []storj.LifecycleRule) (pbRules []*pb.LifecycleRule) {
+	for _, rule := range rules {
+		pbRules = append(pbRules, &pb.LifecycleRule{
+			Id:                        rule.ID,
+			EncryptedPrefix:           []byte(rule.Prefix),
+			ExpirationDays:            int32(rule.ExpirationDays),
+			AbortIncompleteUploadDays: int32(rule.AbortIncompleteUploadDays),
+		})
+	}
+	return pbRules
+}
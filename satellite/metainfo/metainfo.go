@@ -107,9 +107,9 @@ func (endpoint *Endpoint) SegmentInfoOld(ctx context.Context, req *pb.SegmentInf
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	path, err := endpoint.segmentPath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path, req.VersionId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	// TODO refactor to use []byte directly
@@ -120,6 +120,17 @@ func (endpoint *Endpoint) SegmentInfoOld(ctx context.Context, req *pb.SegmentInf
 		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	if pointer.DeleteMarker {
+		return nil, status.Errorf(codes.NotFound, "object version is a delete marker")
+	}
+
+	pointer, err = endpoint.metainfo.resolvePacked(ctx, pointer)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
 
 	return &pb.SegmentInfoResponseOld{Pointer: pointer}, nil
 }
@@ -152,48 +163,75 @@ func (endpoint *Endpoint) CreateSegmentOld(ctx context.Context, req *pb.SegmentW
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
+	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.createPutOrderLimits(ctx, keyInfo.ProjectID, req.Bucket, req.Redundancy, req.MaxEncryptedSegmentSize, req.Expiration)
 	if err != nil {
-		endpoint.log.Error("retrieving project storage totals", zap.Error(err))
-	}
-	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for storage for projectID %s",
-			limit, keyInfo.ProjectID,
-		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		return nil, err
 	}
 
-	redundancy, err := eestream.NewRedundancyStrategyFromProto(req.GetRedundancy())
+	return &pb.SegmentWriteResponseOld{AddressedLimits: addressedLimits, RootPieceId: rootPieceID, PrivateKey: piecePrivateKey}, nil
+}
+
+// createPutOrderLimits selects the nodes for a new remote segment and creates
+// the order limits to upload its pieces. The returned errors are grpc status
+// errors.
+func (endpoint *Endpoint) createPutOrderLimits(ctx context.Context, projectID uuid.UUID, bucket []byte, redundancyScheme *pb.RedundancyScheme, maxEncryptedSegmentSize int64, expiration time.Time) (_ storj.PieceID, _ []*pb.AddressedOrderLimit, _ storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(redundancyScheme)
 	if err != nil {
-		return nil, err
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	maxPieceSize := eestream.CalcPieceSize(req.GetMaxEncryptedSegmentSize(), redundancy)
+	maxPieceSize := eestream.CalcPieceSize(maxEncryptedSegmentSize, redundancy)
+
+	placement, err := endpoint.bucketPlacement(ctx, projectID, bucket)
+	if err != nil {
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, status.Errorf(codes.Internal, err.Error())
+	}
 
 	request := overlay.FindStorageNodesRequest{
-		RequestedCount: int(req.Redundancy.Total),
+		RequestedCount: int(redundancyScheme.Total),
 		FreeBandwidth:  maxPieceSize,
 		FreeDisk:       maxPieceSize,
+		Placement:      placement,
 	}
 	nodes, err := endpoint.cache.FindStorageNodes(ctx, request)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, status.Errorf(codes.Internal, err.Error())
 	}
 
-	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
-	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucketID, nodes, req.Expiration, maxPieceSize)
+	bucketID := createBucketID(projectID, bucket)
+	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucketID, nodes, expiration, maxPieceSize)
 	if err != nil {
-		return nil, Error.Wrap(err)
+		if orders.ErrUsageLimit.Has(err) {
+			return storj.PieceID{}, nil, storj.PiecePrivateKey{}, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		}
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
 	if len(addressedLimits) > 0 {
 		endpoint.createRequests.Put(addressedLimits[0].Limit.SerialNumber, &createRequest{
-			Expiration: req.Expiration,
-			Redundancy: req.Redundancy,
+			Expiration: expiration,
+			Redundancy: redundancyScheme,
 		})
 	}
 
-	return &pb.SegmentWriteResponseOld{AddressedLimits: addressedLimits, RootPieceId: rootPieceID, PrivateKey: piecePrivateKey}, nil
+	return rootPieceID, addressedLimits, piecePrivateKey, nil
+}
+
+// bucketPlacement returns the placement policy of the bucket, buckets which
+// don't exist have no placement policy.
+func (endpoint *Endpoint) bucketPlacement(ctx context.Context, projectID uuid.UUID, bucket []byte) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := endpoint.metainfo.GetBucket(ctx, bucket, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return bucketInfo.Placement, nil
 }
 
 func calculateSpaceUsed(ptr *pb.Pointer) (inlineSpace, remoteSpace int64) {
@@ -231,78 +269,123 @@ func (endpoint *Endpoint) CommitSegmentOld(ctx context.Context, req *pb.SegmentC
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = endpoint.validateCommitSegment(ctx, req)
+	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = endpoint.filterValidPieces(ctx, req.Pointer, req.OriginalLimits)
+	err = endpoint.prepareCommitSegment(ctx, keyInfo.ProjectID, req.Bucket, req.Pointer, req.OriginalLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Segment == -1 {
+		versioning, err := endpoint.bucketVersioning(ctx, keyInfo.ProjectID, req.Bucket)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		if versioning {
+			req.Pointer.VersionId, err = newVersionID(time.Now())
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	err = endpoint.metainfo.Put(ctx, path, req.Pointer)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	err = endpoint.finishCommitSegment(ctx, keyInfo.ProjectID, req.Bucket, req.Pointer, req.OriginalLimits)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
-	exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
+	pointer, err := endpoint.metainfo.Get(ctx, path)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+
+	return &pb.SegmentCommitResponseOld{Pointer: pointer}, nil
+}
+
+// prepareCommitSegment validates a segment that is about to be committed,
+// checks the storage limits and tracks the storage usage of the segment.
+// The returned errors are grpc status errors.
+func (endpoint *Endpoint) prepareCommitSegment(ctx context.Context, projectID uuid.UUID, bucket []byte, pointer *pb.Pointer, originalLimits []*pb.OrderLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.validateCommitSegment(ctx, bucket, pointer, originalLimits)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	err = endpoint.filterValidPieces(ctx, pointer, originalLimits)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	exceeded, limit, err := endpoint.projectUsage.ExceedsBucketStorageUsage(ctx, projectID, bucket)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
 	if exceeded {
 		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for storage for projectID %s.",
-			limit, keyInfo.ProjectID,
+			limit, projectID,
 		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		return status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
 	// clear hashes so we don't store them
-	for _, piece := range req.GetPointer().GetRemote().GetRemotePieces() {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
 		piece.Hash = nil
 	}
 
-	inlineUsed, remoteUsed := calculateSpaceUsed(req.Pointer)
+	// versions and packed ranges are only assigned by the satellite
+	pointer.VersionId = ""
+	pointer.DeleteMarker = false
+	pointer.Packed = nil
+
+	inlineUsed, remoteUsed := calculateSpaceUsed(pointer)
 
 	// ToDo: Replace with hash & signature validation
 	// Ensure neither uplink or storage nodes are cheating on us
-	if req.Pointer.Type == pb.Pointer_REMOTE {
+	if pointer.Type == pb.Pointer_REMOTE {
 		//We cannot have more redundancy than total/min
-		if float64(remoteUsed) > (float64(req.Pointer.SegmentSize)/float64(req.Pointer.Remote.Redundancy.MinReq))*float64(req.Pointer.Remote.Redundancy.Total) {
-			endpoint.log.Sugar().Debugf("data size mismatch, got segment: %d, pieces: %d, RS Min, Total: %d,%d", req.Pointer.SegmentSize, remoteUsed, req.Pointer.Remote.Redundancy.MinReq, req.Pointer.Remote.Redundancy.Total)
-			return nil, status.Errorf(codes.InvalidArgument, "mismatched segment size and piece usage")
+		if float64(remoteUsed) > (float64(pointer.SegmentSize)/float64(pointer.Remote.Redundancy.MinReq))*float64(pointer.Remote.Redundancy.Total) {
+			endpoint.log.Sugar().Debugf("data size mismatch, got segment: %d, pieces: %d, RS Min, Total: %d,%d", pointer.SegmentSize, remoteUsed, pointer.Remote.Redundancy.MinReq, pointer.Remote.Redundancy.Total)
+			return status.Errorf(codes.InvalidArgument, "mismatched segment size and piece usage")
 		}
 	}
 
-	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, inlineUsed, remoteUsed); err != nil {
-		endpoint.log.Sugar().Errorf("Could not track new storage usage by project %v: %v", keyInfo.ProjectID, err)
+	if err := endpoint.projectUsage.AddBucketStorageUsage(ctx, projectID, bucket, inlineUsed, remoteUsed); err != nil {
+		endpoint.log.Sugar().Errorf("Could not track new storage usage by project %v: %v", projectID, err)
 		// but continue. it's most likely our own fault that we couldn't track it, and the only thing
 		// that will be affected is our per-project bandwidth and storage limits.
 	}
 
-	err = endpoint.metainfo.Put(ctx, path, req.Pointer)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
-	}
+	return nil
+}
+
+// finishCommitSegment settles the orders of a segment that has been stored.
+// The returned errors are grpc status errors.
+func (endpoint *Endpoint) finishCommitSegment(ctx context.Context, projectID uuid.UUID, bucket []byte, pointer *pb.Pointer, originalLimits []*pb.OrderLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	if req.Pointer.Type == pb.Pointer_INLINE {
+	if pointer.Type == pb.Pointer_INLINE {
 		// TODO or maybe use pointer.SegmentSize ??
-		err = endpoint.orders.UpdatePutInlineOrder(ctx, keyInfo.ProjectID, req.Bucket, int64(len(req.Pointer.InlineSegment)))
+		err = endpoint.orders.UpdatePutInlineOrder(ctx, projectID, bucket, int64(len(pointer.InlineSegment)))
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, err.Error())
+			return status.Errorf(codes.Internal, err.Error())
 		}
 	}
 
-	pointer, err := endpoint.metainfo.Get(ctx, path)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
-	}
-
-	if len(req.OriginalLimits) > 0 {
-		endpoint.createRequests.Remove(req.OriginalLimits[0].SerialNumber)
+	if len(originalLimits) > 0 {
+		endpoint.createRequests.Remove(originalLimits[0].SerialNumber)
 	}
 
-	return &pb.SegmentCommitResponseOld{Pointer: pointer}, nil
+	return nil
 }
 
 // DownloadSegmentOld gets Pointer incase of INLINE data or list of OrderLimit necessary to download remote data
@@ -326,24 +409,24 @@ func (endpoint *Endpoint) DownloadSegmentOld(ctx context.Context, req *pb.Segmen
 
 	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
 
-	exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID, bucketID)
+	path, err := endpoint.segmentPath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path, req.VersionId)
 	if err != nil {
-		endpoint.log.Error("retrieving project bandwidth total", zap.Error(err))
-	}
-	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for bandwidth for projectID %s.",
-			limit, keyInfo.ProjectID,
-		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		return nil, err
 	}
 
-	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	// TODO refactor to use []byte directly
+	pointer, err := endpoint.metainfo.Get(ctx, path)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if pointer.DeleteMarker {
+		return nil, status.Errorf(codes.NotFound, "object version is a delete marker")
 	}
 
-	// TODO refactor to use []byte directly
-	pointer, err := endpoint.metainfo.Get(ctx, path)
+	pointer, err = endpoint.metainfo.resolvePacked(ctx, pointer)
 	if err != nil {
 		if storage.ErrKeyNotFound.Has(err) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
@@ -352,8 +435,20 @@ func (endpoint *Endpoint) DownloadSegmentOld(ctx context.Context, req *pb.Segmen
 	}
 
 	if pointer.Type == pb.Pointer_INLINE {
+		// inline segments don't need order limits, so the limits are checked here
+		exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID, bucketID)
+		if err != nil {
+			endpoint.log.Error("retrieving project bandwidth total", zap.Error(err))
+		}
+		if exceeded {
+			endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for bandwidth for projectID %s.",
+				limit, keyInfo.ProjectID,
+			)
+			return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		}
+
 		// TODO or maybe use pointer.SegmentSize ??
-		err := endpoint.orders.UpdateGetInlineOrder(ctx, keyInfo.ProjectID, req.Bucket, int64(len(pointer.InlineSegment)))
+		err = endpoint.orders.UpdateGetInlineOrder(ctx, keyInfo.ProjectID, req.Bucket, int64(len(pointer.InlineSegment)))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
@@ -361,6 +456,9 @@ func (endpoint *Endpoint) DownloadSegmentOld(ctx context.Context, req *pb.Segmen
 	} else if pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil {
 		limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, bucketID, pointer)
 		if err != nil {
+			if orders.ErrUsageLimit.Has(err) {
+				return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+			}
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 		return &pb.SegmentDownloadResponseOld{Pointer: pointer, AddressedLimits: limits, PrivateKey: privateKey}, nil
@@ -402,27 +500,50 @@ func (endpoint *Endpoint) DeleteSegmentOld(ctx context.Context, req *pb.SegmentD
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	err = endpoint.metainfo.Delete(ctx, path)
-
+	versioning, err := endpoint.bucketVersioning(ctx, keyInfo.ProjectID, req.Bucket)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	if versioning {
+		unlock, err := endpoint.lockObject(ctx, keyInfo.ProjectID, req.Bucket, req.Path)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
 
-	if pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil {
-		for _, piece := range pointer.GetRemote().GetRemotePieces() {
-			_, err := endpoint.containment.Delete(ctx, piece.NodeId)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, err.Error())
+		// the segment is kept as part of a previous version of the object
+		archived, unreferenced, err := endpoint.archiveSegment(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path, pointer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		if archived {
+			if unreferenced != nil {
+				deleted, err := endpoint.createDeleteLimits(ctx, keyInfo.ProjectID, req.Bucket, []*pb.Pointer{unreferenced})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, err.Error())
+				}
+				return deleted[0], nil
 			}
+			return &pb.SegmentDeleteResponseOld{}, nil
+		}
+	}
+
+	// the pieces of a packed or copied segment are only deleted along with
+	// the last pointer referring to them
+	unreferenced, err := endpoint.metainfo.Remove(ctx, path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
 		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
 
-		bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
-		limits, privateKey, err := endpoint.orders.CreateDeleteOrderLimits(ctx, bucketID, pointer)
+	if unreferenced != nil {
+		deleted, err := endpoint.createDeleteLimits(ctx, keyInfo.ProjectID, req.Bucket, []*pb.Pointer{unreferenced})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
-
-		return &pb.SegmentDeleteResponseOld{AddressedLimits: limits, PrivateKey: privateKey}, nil
+		return deleted[0], nil
 	}
 
 	return &pb.SegmentDeleteResponseOld{}, nil
@@ -645,6 +766,217 @@ func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRe
 	}, nil
 }
 
+// CopyObject copies an object to a new path by copying the pointers of its segments
+func (endpoint *Endpoint) CopyObject(ctx context.Context, req *pb.ObjectCopyRequest) (resp *pb.ObjectCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := endpoint.relocateObject(ctx, req.Bucket, req.EncryptedPath, req.NewBucket, req.NewEncryptedPath, req.Segments, false, req.Replace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ObjectCopyResponse{DeletedSegments: deleted}, nil
+}
+
+// MoveObject moves an object to a new path by moving the pointers of its segments
+func (endpoint *Endpoint) MoveObject(ctx context.Context, req *pb.ObjectMoveRequest) (resp *pb.ObjectMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = endpoint.relocateObject(ctx, req.Bucket, req.EncryptedPath, req.NewBucket, req.NewEncryptedPath, req.Segments, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ObjectMoveResponse{}, nil
+}
+
+// relocateObject copies or moves the segments of an object to a new path,
+// replacing the metadata of every segment with the one re-encrypted by the uplink.
+// A copy replaces an existing object at the new path when replace is set, the
+// order limits for deleting the pieces of the replaced object are returned.
+func (endpoint *Endpoint) relocateObject(ctx context.Context, bucket, encryptedPath, newBucket, newEncryptedPath []byte, segments []*pb.ObjectSegmentMetadata, move, replace bool) (deleted []*pb.SegmentDeleteResponseOld, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sourceOp := macaroon.ActionRead
+	if move {
+		sourceOp = macaroon.ActionDelete
+	}
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            sourceOp,
+		Bucket:        bucket,
+		EncryptedPath: encryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	_, err = endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        newBucket,
+		EncryptedPath: newEncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	for _, name := range [][]byte{bucket, newBucket} {
+		err = endpoint.validateBucket(ctx, name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(encryptedPath) == 0 || len(newEncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "object path is missing")
+	}
+
+	_, err = endpoint.metainfo.GetBucket(ctx, newBucket, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	metadata := make(map[int64][]byte, len(segments))
+	for _, segment := range segments {
+		if _, ok := metadata[segment.Segment]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate segment %d", segment.Segment)
+		}
+		metadata[segment.Segment] = segment.EncryptedMetadata
+	}
+
+	// all segments of the object have to be relocated,
+	// the last segment is relocated after the others
+	if _, ok := metadata[-1]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "last segment is missing")
+	}
+	segmentCount := int64(len(metadata) - 1)
+	indexes := make([]int64, 0, len(metadata))
+	for index := int64(0); index < segmentCount; index++ {
+		if _, ok := metadata[index]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "segment %d is missing", index)
+		}
+		indexes = append(indexes, index)
+	}
+	indexes = append(indexes, -1)
+
+	nextPath, err := CreatePath(ctx, keyInfo.ProjectID, segmentCount, bucket, encryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	_, err = endpoint.metainfo.Get(ctx, nextPath)
+	if err == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "segment %d is missing", segmentCount)
+	}
+	if !storage.ErrKeyNotFound.Has(err) {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	newLastPath, err := CreatePath(ctx, keyInfo.ProjectID, -1, newBucket, newEncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	// checked again while the object is locked
+	_, err = endpoint.metainfo.Get(ctx, newLastPath)
+	if err == nil && (move || !replace) {
+		return nil, status.Errorf(codes.AlreadyExists, "object already exists")
+	}
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	if !move {
+		exceeded, limit, err := endpoint.projectUsage.ExceedsBucketStorageUsage(ctx, keyInfo.ProjectID, newBucket)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		if exceeded {
+			endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for storage for projectID %s.",
+				limit, keyInfo.ProjectID,
+			)
+			return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
+		}
+	}
+
+	paths := make([]string, 0, len(indexes))
+	newPaths := make([]string, 0, len(indexes))
+	segmentMetadata := make([][]byte, 0, len(indexes))
+	for _, index := range indexes {
+		path, err := CreatePath(ctx, keyInfo.ProjectID, index, bucket, encryptedPath)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		newPath, err := CreatePath(ctx, keyInfo.ProjectID, index, newBucket, newEncryptedPath)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		paths = append(paths, path)
+		newPaths = append(newPaths, newPath)
+		segmentMetadata = append(segmentMetadata, metadata[index])
+	}
+
+	if move {
+		err = endpoint.metainfo.MoveObject(ctx, keyInfo.ProjectID, bucket, encryptedPath, paths, newBucket, newEncryptedPath, newPaths, segmentMetadata)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return nil, status.Errorf(codes.NotFound, err.Error())
+			}
+			if ErrObjectExists.Has(err) {
+				return nil, status.Errorf(codes.AlreadyExists, err.Error())
+			}
+			if ErrConcurrentCommit.Has(err) {
+				return nil, status.Errorf(codes.Aborted, err.Error())
+			}
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		return nil, nil
+	}
+
+	versioning, err := endpoint.bucketVersioning(ctx, keyInfo.ProjectID, newBucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	var versionID string
+	if versioning {
+		versionID, err = newVersionID(time.Now())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	// the existing object is only replaced once all segments are copied
+	pointers, replaced, err := endpoint.metainfo.CopyObject(ctx, keyInfo.ProjectID, paths, newBucket, newEncryptedPath, segmentMetadata, versioning, versionID, replace)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if ErrObjectExists.Has(err) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		if ErrConcurrentCommit.Has(err) {
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	for _, pointer := range pointers {
+		inlineUsed, remoteUsed := calculateSpaceUsed(pointer)
+		if err := endpoint.projectUsage.AddBucketStorageUsage(ctx, keyInfo.ProjectID, newBucket, inlineUsed, remoteUsed); err != nil {
+			endpoint.log.Sugar().Errorf("Could not track new storage usage by project %v: %v", keyInfo.ProjectID, err)
+		}
+	}
+
+	deleted, err = endpoint.createDeleteLimits(ctx, keyInfo.ProjectID, newBucket, replaced)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return deleted, nil
+}
+
 // GetBucket returns a bucket
 func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetRequest) (resp *pb.BucketGetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -694,6 +1026,11 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	err = endpoint.cache.ValidatePlacement(req.GetPlacement())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	bucket, err := convertProtoToBucket(req, keyInfo.ProjectID)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
@@ -823,6 +1160,7 @@ func convertProtoToBucket(req *pb.BucketCreateRequest, projectID uuid.UUID) (sto
 			CipherSuite: storj.CipherSuite(defaultEP.CipherSuite),
 			BlockSize:   int32(defaultEP.BlockSize),
 		},
+		Placement: req.GetPlacement(),
 	}, nil
 }
 
@@ -845,5 +1183,7 @@ func convertBucketToProto(ctx context.Context, bucket storj.Bucket) (pbBucket *p
 			CipherSuite: pb.CipherSuite(int(bucket.DefaultEncryptionParameters.CipherSuite)),
 			BlockSize:   int64(bucket.DefaultEncryptionParameters.BlockSize),
 		},
+		Versioning: bucket.Versioning,
+		Placement:  bucket.Placement,
 	}
 }
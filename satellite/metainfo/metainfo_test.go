@@ -5,7 +5,11 @@ package metainfo_test
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,9 +27,11 @@ import (
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink/metainfo"
 )
 
@@ -813,3 +819,266 @@ func TestBucketNameValidation(t *testing.T) {
 		}
 	})
 }
+
+func TestBucketPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				// all storage nodes of testplanet are in the US
+				regionDatabase := filepath.Join(config.Kademlia.DBPath, "regions.csv")
+				err := ioutil.WriteFile(regionDatabase, []byte("127.0.0.0,127.255.255.255,US\n"), 0644)
+				if err != nil {
+					log.Error("failed to write region database", zap.Error(err))
+				}
+				config.Overlay.Node.RegionDatabase = regionDatabase
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		uplink := planet.Uplinks[0]
+
+		config := uplink.GetConfig(planet.Satellites[0])
+		metainfo, _, cleanup, err := testplanet.DialMetainfo(ctx, uplink.Log.Named("metainfo"), config, uplink.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		pathCipher := config.GetEncryptionParameters().CipherSuite
+		_, err = metainfo.CreateBucket(ctx, "invalid", &storj.Bucket{PathCipher: pathCipher, Placement: "EUROPE"})
+		require.Error(t, err)
+
+		for _, placement := range []string{"US", "EU"} {
+			bucketName := strings.ToLower(placement) + "-bucket"
+			bucket, err := metainfo.CreateBucket(ctx, bucketName, &storj.Bucket{PathCipher: pathCipher, Placement: placement})
+			require.NoError(t, err)
+			assert.Equal(t, placement, bucket.Placement)
+
+			bucket, err = metainfo.GetBucket(ctx, bucketName)
+			require.NoError(t, err)
+			assert.Equal(t, placement, bucket.Placement)
+		}
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		rs := &pb.RedundancyScheme{
+			MinReq:           1,
+			RepairThreshold:  2,
+			SuccessThreshold: 3,
+			Total:            4,
+			ErasureShareSize: 256,
+		}
+		limits, _, _, err := metainfoClient.CreateSegment(ctx, "us-bucket", "path", -1, rs, 1000, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Len(t, limits, 4)
+
+		// there are no storage nodes in the EU
+		_, _, _, err = metainfoClient.CreateSegment(ctx, "eu-bucket", "path", -1, rs, 1000, time.Now().Add(time.Hour))
+		require.Error(t, err)
+	})
+}
+
+func TestBeginCommitObject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		bucket, path := "my-bucket-name", storj.Path("file/path")
+		rs := &pb.RedundancyScheme{
+			MinReq:           1,
+			RepairThreshold:  2,
+			SuccessThreshold: 3,
+			Total:            4,
+			ErasureShareSize: 256,
+		}
+
+		streamID, err := metainfo.BeginObject(ctx, bucket, path, rs, time.Time{})
+		require.NoError(t, err)
+
+		segments := [][]byte{[]byte("first segment"), []byte("last segment")}
+		for i, data := range segments {
+			pointer := &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: data,
+				SegmentSize:   int64(len(data)),
+			}
+			err = metainfo.CommitSegmentNew(ctx, bucket, path, streamID, int64(i), pointer, nil)
+			require.NoError(t, err)
+		}
+
+		// the object is not visible before it is committed
+		_, err = metainfo.GetObject(ctx, bucket, path)
+		require.True(t, storage.ErrKeyNotFound.Has(err))
+
+		items, _, err := metainfo.ListObjects(ctx, bucket, "", "", "", true, 0, meta.All)
+		require.NoError(t, err)
+		require.Empty(t, items)
+
+		_, _, err = metainfo.CommitObject(ctx, bucket, path, streamID, 3, nil)
+		require.Error(t, err)
+
+		object, deleted, err := metainfo.CommitObject(ctx, bucket, path, streamID, int64(len(segments)), []byte("metadata"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("metadata"), object.EncryptedMetadata)
+		assert.Empty(t, deleted)
+
+		object, err = metainfo.GetObject(ctx, bucket, path)
+		require.NoError(t, err)
+		assert.Equal(t, path, object.EncryptedPath)
+		assert.Equal(t, []byte("metadata"), object.EncryptedMetadata)
+
+		items, _, err = metainfo.ListObjects(ctx, bucket, "", "", "", true, 0, meta.All)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, path, items[0].EncryptedPath)
+
+		pointer, err := metainfo.SegmentInfo(ctx, bucket, path, 0)
+		require.NoError(t, err)
+		assert.Equal(t, segments[0], pointer.InlineSegment)
+
+		pointer, err = metainfo.SegmentInfo(ctx, bucket, path, -1)
+		require.NoError(t, err)
+		assert.Equal(t, segments[1], pointer.InlineSegment)
+
+		// the pending object is gone after the commit
+		_, _, err = metainfo.CommitObject(ctx, bucket, path, streamID, int64(len(segments)), nil)
+		require.Error(t, err)
+	})
+}
+
+func TestCommitObjectDeletesReplacedPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		err := uplink.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		replaced, err := satellite.Metainfo.Service.Get(ctx, keys[0].String())
+		require.NoError(t, err)
+		require.NotNil(t, replaced.GetRemote())
+
+		err = uplink.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		// the uplink deletes the pieces of the replaced object
+		remote := replaced.GetRemote()
+		for _, piece := range remote.GetRemotePieces() {
+			for _, node := range planet.StorageNodes {
+				if node.ID() != piece.NodeId {
+					continue
+				}
+				_, err := node.Storage2.Store.Reader(ctx, satellite.ID(), remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+				require.True(t, os.IsNotExist(err), "piece of the replaced object should have been deleted")
+			}
+		}
+	})
+}
+
+func TestDeleteObjectVersionDeletesPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		config := uplink.GetConfig(satellite)
+		metainfo, _, cleanup, err := testplanet.DialMetainfo(ctx, uplink.Log.Named("metainfo"), config, uplink.Identity)
+		require.NoError(t, err)
+		defer ctx.Check(cleanup)
+
+		_, err = metainfo.CreateBucket(ctx, "testbucket", &storj.Bucket{PathCipher: config.GetEncryptionParameters().CipherSuite})
+		require.NoError(t, err)
+		_, err = metainfo.SetBucketVersioning(ctx, "testbucket", true)
+		require.NoError(t, err)
+
+		err = uplink.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		deleted, err := satellite.Metainfo.Service.Get(ctx, keys[0].String())
+		require.NoError(t, err)
+		require.NotNil(t, deleted.GetRemote())
+
+		versions, err := metainfo.ListObjectVersions(ctx, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		require.NoError(t, metainfo.DeleteObjectVersion(ctx, "testbucket", "test/path", versions[0].VersionID))
+
+		// the uplink deletes the pieces of the deleted version
+		remote := deleted.GetRemote()
+		for _, piece := range remote.GetRemotePieces() {
+			for _, node := range planet.StorageNodes {
+				if node.ID() != piece.NodeId {
+					continue
+				}
+				_, err := node.Storage2.Store.Reader(ctx, satellite.ID(), remote.RootPieceId.Derive(piece.NodeId, piece.PieceNum))
+				require.True(t, os.IsNotExist(err), "piece of the deleted version should have been deleted")
+			}
+		}
+	})
+}
+
+func TestBatch(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+
+		metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfo.Close)
+
+		bucket, path := "my-bucket-name", storj.Path("file/path")
+		rs := &pb.RedundancyScheme{
+			MinReq:           1,
+			RepairThreshold:  2,
+			SuccessThreshold: 3,
+			Total:            4,
+			ErasureShareSize: 256,
+		}
+
+		object, _, err := metainfo.PutInlineObject(ctx, bucket, path, rs, time.Time{}, []byte("inline data"), []byte("metadata"))
+		require.NoError(t, err)
+		assert.Equal(t, path, object.EncryptedPath)
+		assert.Equal(t, []byte("metadata"), object.EncryptedMetadata)
+
+		pointer, err := metainfo.SegmentInfo(ctx, bucket, path, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("inline data"), pointer.InlineSegment)
+
+		deleted, err := metainfo.DeleteSegments(ctx, bucket, path, []int64{-1})
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.Empty(t, deleted[0].Limits)
+
+		_, err = metainfo.GetObject(ctx, bucket, path)
+		require.True(t, storage.ErrKeyNotFound.Has(err))
+
+		// a failing request stops the batch, the responses of the requests
+		// before it are returned with the error
+		_, _, err = metainfo.PutInlineObject(ctx, bucket, path, rs, time.Time{}, []byte("inline data"), []byte("metadata"))
+		require.NoError(t, err)
+
+		deleted, err = metainfo.DeleteSegments(ctx, bucket, path, []int64{-1, -1})
+		require.True(t, storage.ErrKeyNotFound.Has(err))
+		require.Len(t, deleted, 1)
+
+		responses, err := metainfo.Batch(ctx, &pb.BatchRequestItem{})
+		require.Error(t, err)
+		require.Empty(t, responses)
+	})
+}
@@ -0,0 +1,762 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// CreatePendingPath will create the path of a segment of a pending object
+func CreatePendingPath(ctx context.Context, projectID uuid.UUID, segmentIndex int64, bucket, path []byte, streamID storj.StreamID) (_ storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if segmentIndex < 0 {
+		return "", errors.New("invalid segment index")
+	}
+	if len(bucket) == 0 || len(path) == 0 {
+		return "", errors.New("bucket and path are required")
+	}
+
+	segmentPath, err := CreatePath(ctx, projectID, segmentIndex, bucket, path)
+	if err != nil {
+		return "", err
+	}
+
+	// the segments of pending objects are kept apart from the committed ones,
+	// so they don't show up before the object is committed
+	entries := storj.SplitPath(segmentPath)
+	entries[1] = "p" + entries[1]
+	entries = append(entries, streamID.String())
+	return storj.JoinPaths(entries...), nil
+}
+
+// ErrConcurrentCommit is returned when the object is being committed by
+// another upload
+var ErrConcurrentCommit = errs.Class("concurrent commit")
+
+// ErrObjectExists is returned when an object which mustn't be replaced exists
+var ErrObjectExists = errs.Class("object already exists")
+
+// commitMarkerTimeout is how long a commit marker keeps other commits of the
+// same object out, a marker left behind by a failed commit is taken over after
+// it.
+const commitMarkerTimeout = 10 * time.Minute
+
+// createCommitMarkerPath creates the path of the marker which is kept while the
+// object is committed.
+func createCommitMarkerPath(projectID uuid.UUID, bucket, encryptedPath []byte) (_ storj.Path, err error) {
+	if len(bucket) == 0 || len(encryptedPath) == 0 {
+		return "", errors.New("bucket and path are required")
+	}
+	return storj.JoinPaths(projectID.String(), "cm", string(bucket), string(encryptedPath)), nil
+}
+
+// lockObject stores the commit marker of the object, so that the current
+// version of the object is only replaced by one commit at a time. It fails
+// with ErrConcurrentCommit when another commit holds the marker. The returned
+// function removes the marker.
+func (s *Service) lockObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (unlock func() error, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path, err := createCommitMarkerPath(projectID, bucket, encryptedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// the marker is a pointer, like everything else in the pointer database
+	markerBytes, err := proto.Marshal(&pb.Pointer{
+		Type:         pb.Pointer_INLINE,
+		CreationDate: time.Now(),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var oldMarkerBytes []byte
+	for {
+		err = s.DB.CompareAndSwap(ctx, []byte(path), oldMarkerBytes, markerBytes)
+		if err == nil {
+			break
+		}
+		if !storage.ErrValueChanged.Has(err) {
+			return nil, Error.Wrap(err)
+		}
+
+		oldMarkerBytes, err = s.DB.Get(ctx, []byte(path))
+		if storage.ErrKeyNotFound.Has(err) {
+			// the other commit has finished in the meantime
+			oldMarkerBytes = nil
+			continue
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		oldMarker := &pb.Pointer{}
+		err = proto.Unmarshal(oldMarkerBytes, oldMarker)
+		if err != nil {
+			return nil, Error.New("error unmarshaling commit marker: %v", err)
+		}
+		if time.Since(oldMarker.CreationDate) < commitMarkerTimeout {
+			return nil, ErrConcurrentCommit.New("%s", encryptedPath)
+		}
+	}
+
+	return func() error {
+		// a marker which has been taken over is left to its new owner
+		err := s.DB.CompareAndSwap(ctx, []byte(path), markerBytes, nil)
+		if err != nil && !storage.ErrValueChanged.Has(err) {
+			return Error.Wrap(err)
+		}
+		return nil
+	}, nil
+}
+
+// BeginObject starts the upload of a new object, its segments are kept apart
+// until the object is committed.
+func (s *Service) BeginObject(ctx context.Context, object PendingObject) (_ PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := rand.Read(object.StreamID[:]); err != nil {
+		return PendingObject{}, Error.Wrap(err)
+	}
+	object.SegmentCount = 0
+
+	err = s.pendingObjectsDB.CreatePendingObject(ctx, object)
+	if err != nil {
+		return PendingObject{}, err
+	}
+	return object, nil
+}
+
+// GetPendingObject returns a pending object.
+func (s *Service) GetPendingObject(ctx context.Context, streamID storj.StreamID) (_ PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.pendingObjectsDB.GetPendingObject(ctx, streamID)
+}
+
+// PutPendingSegment stores a segment of a pending object.
+func (s *Service) PutPendingSegment(ctx context.Context, object PendingObject, segmentIndex int64, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path, err := CreatePendingPath(ctx, object.ProjectID, segmentIndex, object.BucketName, object.EncryptedPath, object.StreamID)
+	if err != nil {
+		return err
+	}
+
+	err = s.Put(ctx, path, pointer)
+	if err != nil {
+		return err
+	}
+
+	return s.pendingObjectsDB.UpdateSegmentCount(ctx, object.StreamID, segmentIndex+1)
+}
+
+// CommitPendingObject makes the segments of a pending object the current
+// version of the object. The metadata and the version id are set on the last
+// segment. The current version of the object is archived in a versioned
+// bucket, otherwise it is deleted and its segments are returned as replaced,
+// their pieces are left to garbage collection. The commits of an object are
+// serialised, a concurrent commit fails with ErrConcurrentCommit.
+func (s *Service) CommitPendingObject(ctx context.Context, object PendingObject, metadata []byte, versioning bool, versionID string) (_ *pb.Pointer, replaced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.commitPendingObject(ctx, object, metadata, versioning, versionID, true)
+}
+
+// commitPendingObject commits a pending object like CommitPendingObject. The
+// current version of the object is only replaced when replace is set,
+// otherwise it fails with ErrObjectExists.
+func (s *Service) commitPendingObject(ctx context.Context, object PendingObject, metadata []byte, versioning bool, versionID string, replace bool) (_ *pb.Pointer, replaced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if object.SegmentCount < 1 {
+		return nil, nil, Error.New("object has no segments")
+	}
+
+	unlock, err := s.lockObject(ctx, object.ProjectID, object.BucketName, object.EncryptedPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { err = errs.Combine(err, unlock()) }()
+
+	lastPath, err := CreatePath(ctx, object.ProjectID, -1, object.BucketName, object.EncryptedPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the current version is checked while the object is locked
+	if !replace {
+		_, err = s.Get(ctx, lastPath)
+		if err == nil {
+			return nil, nil, ErrObjectExists.New("%s", object.EncryptedPath)
+		}
+		if !storage.ErrKeyNotFound.Has(err) {
+			return nil, nil, err
+		}
+	}
+
+	// all the segments are checked before the current version is replaced
+	pendingPaths := make([]storj.Path, object.SegmentCount)
+	var last *pb.Pointer
+	for segmentIndex := range pendingPaths {
+		pendingPaths[segmentIndex], err = CreatePendingPath(ctx, object.ProjectID, int64(segmentIndex), object.BucketName, object.EncryptedPath, object.StreamID)
+		if err != nil {
+			return nil, nil, err
+		}
+		last, err = s.Get(ctx, pendingPaths[segmentIndex])
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return nil, nil, Error.New("segment %d of the object is missing", segmentIndex)
+			}
+			return nil, nil, err
+		}
+	}
+
+	replaced, err = s.replaceObject(ctx, object.ProjectID, object.BucketName, object.EncryptedPath, versioning)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lastIndex := len(pendingPaths) - 1
+	for segmentIndex, pendingPath := range pendingPaths[:lastIndex] {
+		path, err := CreatePath(ctx, object.ProjectID, int64(segmentIndex), object.BucketName, object.EncryptedPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = s.Rename(ctx, pendingPath, path)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// the last segment is written anew, so the object is created at commit
+	last.Metadata = metadata
+	last.VersionId = versionID
+	err = s.Put(ctx, lastPath, last)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the pending last segment has been moved, so the references of a
+	// copied segment are kept
+	err = s.DB.Delete(ctx, []byte(pendingPaths[lastIndex]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.pendingObjectsDB.DeletePendingObject(ctx, object.StreamID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return last, replaced, nil
+}
+
+// PutObject stores an object that consists of a single segment as the current
+// version of the object, the current version is replaced like in
+// CommitPendingObject.
+func (s *Service) PutObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, pointer *pb.Pointer, versioning bool) (replaced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := s.lockObject(ctx, projectID, bucket, encryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, unlock()) }()
+
+	replaced, err = s.replaceObject(ctx, projectID, bucket, encryptedPath, versioning)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Put(ctx, lastPath, pointer)
+	if err != nil {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+// CopyObject copies the segments under paths to the object at newBucket and
+// newEncryptedPath with their metadata replaced, the last of paths is the last
+// segment of the object. The copies are committed like an uploaded object, so
+// the current version of the object is only replaced once all of them are
+// stored. The copied pointers are returned along with the replaced segments.
+// An existing object is only replaced when replace is set, otherwise it fails
+// with ErrObjectExists.
+func (s *Service) CopyObject(ctx context.Context, projectID uuid.UUID, paths []string, newBucket, newEncryptedPath []byte, metadata [][]byte, versioning bool, versionID string, replace bool) (pointers, replaced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the segments are copied, so the pending object doesn't need a redundancy scheme
+	object, err := s.BeginObject(ctx, PendingObject{
+		ProjectID:     projectID,
+		BucketName:    newBucket,
+		EncryptedPath: newEncryptedPath,
+		Redundancy:    &pb.RedundancyScheme{},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pendingPaths := make([]string, len(paths))
+	for segmentIndex := range paths {
+		pendingPaths[segmentIndex], err = CreatePendingPath(ctx, projectID, int64(segmentIndex), newBucket, newEncryptedPath, object.StreamID)
+		if err != nil {
+			return nil, nil, errs.Combine(err, s.pendingObjectsDB.DeletePendingObject(ctx, object.StreamID))
+		}
+	}
+
+	// a failed copy deletes the copies stored so far
+	pointers, err = s.Copy(ctx, paths, pendingPaths, metadata)
+	if err != nil {
+		return nil, nil, errs.Combine(err, s.pendingObjectsDB.DeletePendingObject(ctx, object.StreamID))
+	}
+
+	object.SegmentCount = int64(len(paths))
+	err = s.pendingObjectsDB.UpdateSegmentCount(ctx, object.StreamID, object.SegmentCount)
+	if err != nil {
+		_, deleteErr := s.DeletePendingObject(ctx, object)
+		return nil, nil, errs.Combine(err, deleteErr)
+	}
+
+	_, replaced, err = s.commitPendingObject(ctx, object, metadata[len(metadata)-1], versioning, versionID, replace)
+	if err != nil {
+		// nothing has been committed when another commit is in progress
+		// or when the object exists
+		if ErrConcurrentCommit.Has(err) || ErrObjectExists.Has(err) {
+			_, deleteErr := s.DeletePendingObject(ctx, object)
+			return nil, nil, errs.Combine(err, deleteErr)
+		}
+		return nil, nil, err
+	}
+	return pointers, replaced, nil
+}
+
+// replaceObject archives the current version of an object in a versioned
+// bucket, otherwise it deletes the current version. The segments whose pieces
+// aren't referred to anymore are returned.
+func (s *Service) replaceObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, versioning bool) (replaced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versioning {
+		replaced, err = s.archiveObject(ctx, projectID, bucket, encryptedPath)
+	} else {
+		replaced, err = s.deleteSegments(ctx, func(segmentIndex int64) (storj.Path, error) {
+			return CreatePath(ctx, projectID, segmentIndex, bucket, encryptedPath)
+		})
+	}
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+// DeletePendingObject deletes a pending object along with its segments and
+// returns the segments, so their pieces can be deleted.
+func (s *Service) DeletePendingObject(ctx context.Context, object PendingObject) (deleted []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for segmentIndex := int64(0); segmentIndex < object.SegmentCount; segmentIndex++ {
+		path, err := CreatePendingPath(ctx, object.ProjectID, segmentIndex, object.BucketName, object.EncryptedPath, object.StreamID)
+		if err != nil {
+			return deleted, err
+		}
+		pointer, err := s.Remove(ctx, path)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return deleted, err
+		}
+		if pointer != nil {
+			deleted = append(deleted, pointer)
+		}
+	}
+
+	return deleted, s.pendingObjectsDB.DeletePendingObject(ctx, object.StreamID)
+}
+
+// ListPendingObjectsBefore returns up to limit pending objects whose upload
+// began before the given time.
+func (s *Service) ListPendingObjectsBefore(ctx context.Context, before time.Time, limit int) (_ []PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.pendingObjectsDB.ListPendingObjectsBefore(ctx, before, limit)
+}
+
+// BeginObject starts the upload of an object. The object stays invisible
+// until it is committed.
+func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRequest) (resp *pb.ObjectBeginResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !req.ExpiresAt.IsZero() && !req.ExpiresAt.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid expiration time")
+	}
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.EncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "encrypted path is required")
+	}
+
+	if req.RedundancyScheme == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "redundancy scheme is required")
+	}
+	err = endpoint.validateRedundancy(ctx, req.RedundancyScheme)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	object, err := endpoint.metainfo.BeginObject(ctx, PendingObject{
+		ProjectID:     keyInfo.ProjectID,
+		BucketName:    req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Redundancy:    req.RedundancyScheme,
+		ExpiresAt:     req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectBeginResponse{StreamId: object.StreamID}, nil
+}
+
+// BeginSegment creates the order limits to upload a remote segment of a
+// pending object.
+func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBeginRequest) (resp *pb.SegmentBeginResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if req.SegmentIndex < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid segment index")
+	}
+
+	object, err := endpoint.pendingObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.createPutOrderLimits(ctx, keyInfo.ProjectID, req.Bucket, object.Redundancy, req.MaxEncryptedSegmentSize, object.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SegmentBeginResponse{AddressedLimits: addressedLimits, RootPieceId: rootPieceID, PrivateKey: piecePrivateKey}, nil
+}
+
+// CommitSegment stores a segment of a pending object. Inline segments are
+// committed without beginning them.
+func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentCommitRequest) (resp *pb.SegmentCommitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if req.SegmentIndex < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid segment index")
+	}
+
+	object, err := endpoint.pendingObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Pointer != nil && !req.Pointer.ExpirationDate.Equal(object.ExpiresAt) {
+		return nil, status.Errorf(codes.InvalidArgument, "pointer expiration date does not match the object one")
+	}
+
+	err = endpoint.prepareCommitSegment(ctx, keyInfo.ProjectID, req.Bucket, req.Pointer, req.OriginalLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.metainfo.PutPendingSegment(ctx, object, req.SegmentIndex, req.Pointer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	err = endpoint.finishCommitSegment(ctx, keyInfo.ProjectID, req.Bucket, req.Pointer, req.OriginalLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SegmentCommitResponse{}, nil
+}
+
+// CommitObject makes the segments of a pending object visible as the current
+// version of the object.
+func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommitRequest) (resp *pb.ObjectCommitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	object, err := endpoint.pendingObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SegmentCount < 1 || req.SegmentCount != object.SegmentCount {
+		return nil, status.Errorf(codes.InvalidArgument, "object has %d segments, but %d were committed", req.SegmentCount, object.SegmentCount)
+	}
+
+	versioning, err := endpoint.bucketVersioning(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	var versionID string
+	if versioning {
+		versionID, err = newVersionID(time.Now())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	pointer, replaced, err := endpoint.metainfo.CommitPendingObject(ctx, object, req.EncryptedMetadata, versioning, versionID)
+	if err != nil {
+		if ErrConcurrentCommit.Has(err) {
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	deleted, err := endpoint.createDeleteLimits(ctx, keyInfo.ProjectID, req.Bucket, replaced)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectCommitResponse{
+		Object:          convertPointerToObject(req.Bucket, req.EncryptedPath, pointer),
+		DeletedSegments: deleted,
+	}, nil
+}
+
+// GetObject returns the given version of an object, an empty version id
+// refers to the current version.
+func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetRequest) (resp *pb.ObjectGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	path, err := endpoint.segmentPath(ctx, keyInfo.ProjectID, -1, req.Bucket, req.EncryptedPath, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	pointer, err := endpoint.metainfo.Get(ctx, path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if pointer.DeleteMarker {
+		return nil, status.Errorf(codes.NotFound, "object version is a delete marker")
+	}
+
+	return &pb.ObjectGetResponse{
+		Object: convertPointerToObject(req.Bucket, req.EncryptedPath, pointer),
+	}, nil
+}
+
+// ListObjects lists the committed objects of a bucket below the given prefix.
+func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListRequest) (resp *pb.ObjectListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionList,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPrefix,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	prefix, err := CreatePath(ctx, keyInfo.ProjectID, -1, req.Bucket, req.EncryptedPrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	items, more, err := endpoint.metainfo.List(ctx, prefix, string(req.EncryptedStartAfter), string(req.EncryptedEndBefore), req.Recursive, req.Limit, req.MetaFlags)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListObjects: %v", err)
+	}
+
+	objectItems := make([]*pb.ObjectListResponse_Item, len(items))
+	for i, item := range items {
+		objectItems[i] = &pb.ObjectListResponse_Item{
+			EncryptedPath: []byte(item.Path),
+			IsPrefix:      item.IsPrefix,
+		}
+		if item.Pointer != nil {
+			objectItems[i].EncryptedMetadata = item.Pointer.Metadata
+			objectItems[i].CreatedAt = item.Pointer.CreationDate
+			objectItems[i].ExpiresAt = item.Pointer.ExpirationDate
+		}
+	}
+
+	return &pb.ObjectListResponse{Items: objectItems, More: more}, nil
+}
+
+// pendingObject returns the pending object with the given stream id, when it
+// belongs to the given object. The returned errors are grpc status errors.
+func (endpoint *Endpoint) pendingObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, streamID storj.StreamID) (_ PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := endpoint.metainfo.GetPendingObject(ctx, streamID)
+	if err != nil {
+		if ErrPendingObjectNotFound.Has(err) {
+			return PendingObject{}, status.Errorf(codes.NotFound, err.Error())
+		}
+		return PendingObject{}, status.Errorf(codes.Internal, err.Error())
+	}
+
+	if object.ProjectID != projectID || string(object.BucketName) != string(bucket) || string(object.EncryptedPath) != string(encryptedPath) {
+		return PendingObject{}, status.Errorf(codes.NotFound, "pending object not found: %v", streamID)
+	}
+	return object, nil
+}
+
+// lockObject locks the object at encryptedPath like the commits of the object
+// do. The returned function unlocks it again, an error is only logged, since
+// the marker is taken over after commitMarkerTimeout anyway. The returned
+// errors are grpc status errors.
+func (endpoint *Endpoint) lockObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (unlock func(), err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	unlockObject, err := endpoint.metainfo.lockObject(ctx, projectID, bucket, encryptedPath)
+	if err != nil {
+		if ErrConcurrentCommit.Has(err) {
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return func() {
+		if err := unlockObject(); err != nil {
+			endpoint.log.Error("unable to unlock the object", zap.ByteString("Path", encryptedPath), zap.Error(err))
+		}
+	}, nil
+}
+
+// createDeleteLimits creates the order limits for deleting the pieces of
+// segments which aren't referred to anymore. The pending audits of the nodes
+// holding the pieces are deleted, they can't be verified anymore.
+func (endpoint *Endpoint) createDeleteLimits(ctx context.Context, projectID uuid.UUID, bucket []byte, segments []*pb.Pointer) (deleted []*pb.SegmentDeleteResponseOld, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketID := createBucketID(projectID, bucket)
+	for _, segment := range segments {
+		if segment.GetRemote() == nil {
+			continue
+		}
+		for _, piece := range segment.GetRemote().GetRemotePieces() {
+			_, err := endpoint.containment.Delete(ctx, piece.NodeId)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		limits, privateKey, err := endpoint.orders.CreateDeleteOrderLimits(ctx, bucketID, segment)
+		if err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, &pb.SegmentDeleteResponseOld{AddressedLimits: limits, PrivateKey: privateKey})
+	}
+	return deleted, nil
+}
+
+// convertPointerToObject converts the last segment of an object to the object.
+func convertPointerToObject(bucket, encryptedPath []byte, pointer *pb.Pointer) *pb.Object {
+	return &pb.Object{
+		Bucket:            bucket,
+		EncryptedPath:     encryptedPath,
+		VersionId:         pointer.VersionId,
+		EncryptedMetadata: pointer.Metadata,
+		CreatedAt:         pointer.CreationDate,
+		ExpiresAt:         pointer.ExpirationDate,
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+// maxPackedObjects is the maximum number of objects in a single packed segment
+const maxPackedObjects = 1000
+
+// CommitPackedObjects commits a remote segment that holds the data of several
+// small objects. Every object gets a pointer which refers to the segment with
+// the range of its own data, the segment is deleted along with the last of
+// them and its pieces are left to garbage collection.
+func (endpoint *Endpoint) CommitPackedObjects(ctx context.Context, req *pb.PackedObjectsCommitRequest) (resp *pb.PackedObjectsCommitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(req.Objects) == 0 || len(req.Objects) > maxPackedObjects {
+		return nil, status.Errorf(codes.InvalidArgument, "packed segment contains %d objects, expected between 1 and %d", len(req.Objects), maxPackedObjects)
+	}
+
+	var keyInfo *console.APIKeyInfo
+	for _, object := range req.Objects {
+		keyInfo, err = endpoint.validateAuth(ctx, macaroon.Action{
+			Op:            macaroon.ActionWrite,
+			Bucket:        req.Bucket,
+			EncryptedPath: object.EncryptedPath,
+			Time:          time.Now(),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	pointer := req.Pointer
+	if pointer.GetType() != pb.Pointer_REMOTE {
+		return nil, status.Errorf(codes.InvalidArgument, "packed objects require a remote segment")
+	}
+	for _, object := range req.Objects {
+		if len(object.EncryptedPath) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "packed object without path")
+		}
+		if object.Offset < 0 || object.Length < 0 || object.Offset+object.Length > pointer.SegmentSize {
+			return nil, status.Errorf(codes.InvalidArgument, "packed object range %d+%d is outside of the segment", object.Offset, object.Length)
+		}
+	}
+
+	err = endpoint.prepareCommitSegment(ctx, keyInfo.ProjectID, req.Bucket, pointer, req.OriginalLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	versioning, err := endpoint.bucketVersioning(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// the segment is stored only once, so it is checked and repaired only
+	// once, the objects refer to it with the range of their own data
+	segmentPath, err := CreatePackedPath(ctx, keyInfo.ProjectID, pointer.Remote.RootPieceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	// the objects may be copied or moved to other buckets, so the segment
+	// keeps the placement its pieces have been uploaded with
+	pointer.Placement, err = endpoint.bucketPlacement(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	pointer.References = int64(len(req.Objects))
+	err = endpoint.metainfo.putPackedSegment(ctx, segmentPath, pointer)
+	if err != nil {
+		if storage.ErrValueChanged.Has(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "packed segment is already committed")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	resp = &pb.PackedObjectsCommitResponse{
+		Objects: make([]*pb.Object, 0, len(req.Objects)),
+	}
+	for i, object := range req.Objects {
+		objectPointer := &pb.Pointer{
+			Type:           pb.Pointer_REMOTE,
+			SegmentSize:    object.Length,
+			ExpirationDate: pointer.ExpirationDate,
+			Metadata:       object.EncryptedMetadata,
+			Packed: &pb.PackedRange{
+				Offset:  object.Offset,
+				Length:  object.Length,
+				Segment: segmentPath,
+			},
+		}
+		if versioning {
+			objectPointer.VersionId, err = newVersionID(time.Now())
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+		}
+
+		replaced, err := endpoint.metainfo.PutObject(ctx, keyInfo.ProjectID, req.Bucket, object.EncryptedPath, objectPointer, versioning)
+		if err != nil {
+			// the objects which haven't been stored don't refer to the segment
+			unreferenced := int64(len(req.Objects) - i)
+			if _, refErr := endpoint.metainfo.updateReferences(ctx, segmentPath, -unreferenced); refErr != nil {
+				endpoint.log.Error("releasing packed segment", zap.String("Path", segmentPath), zap.Error(refErr))
+			}
+			if ErrConcurrentCommit.Has(err) {
+				return nil, status.Errorf(codes.Aborted, err.Error())
+			}
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		deleted, err := endpoint.createDeleteLimits(ctx, keyInfo.ProjectID, req.Bucket, replaced)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		resp.DeletedSegments = append(resp.DeletedSegments, deleted...)
+
+		resp.Objects = append(resp.Objects, convertPointerToObject(req.Bucket, object.EncryptedPath, objectPointer))
+	}
+
+	err = endpoint.finishCommitSegment(ctx, keyInfo.ProjectID, req.Bucket, pointer, req.OriginalLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CreatePackedPath creates the path of a packed segment of a project.
+func CreatePackedPath(ctx context.Context, projectID uuid.UUID, rootPieceID storj.PieceID) (_ storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// packed segments are kept apart from the objects, so they don't show up
+	// when listing the objects of a bucket, and apart from the buckets, as
+	// their objects can be copied or moved to other buckets
+	return storj.JoinPaths(projectID.String(), "c", rootPieceID.String()), nil
+}
+
+// createSharedPath creates the path a remote segment under path is moved to
+// when it is copied, copied segments are kept like packed segments.
+func createSharedPath(path storj.Path, rootPieceID storj.PieceID) (_ storj.Path, err error) {
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return "", errs.New("no bucket component in path: %s", path)
+	}
+	return storj.JoinPaths(comps[0], "c", rootPieceID.String()), nil
+}
+
+// isSharedPath returns whether path is the path of a packed or copied segment.
+func isSharedPath(path storj.Path) bool {
+	comps := storj.SplitPath(path)
+	return len(comps) == 3 && comps[1] == "c"
+}
+
+// putPackedSegment stores a new packed segment under path. It fails with
+// storage.ErrValueChanged when a pointer is already stored under path.
+func (s *Service) putPackedSegment(ctx context.Context, path string, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer.CreationDate = time.Now()
+
+	pointerBytes, err := proto.Marshal(pointer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return s.DB.CompareAndSwap(ctx, []byte(path), nil, pointerBytes)
+}
+
+// updateReferences adds delta to the number of pointers referring to the
+// packed or copied segment under path. The segment is deleted when no pointer
+// refers to it anymore, then it is returned, so its pieces can be deleted.
+func (s *Service) updateReferences(ctx context.Context, path string, delta int64) (deleted *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		oldPointerBytes, err := s.DB.Get(ctx, []byte(path))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		pointer := &pb.Pointer{}
+		err = proto.Unmarshal(oldPointerBytes, pointer)
+		if err != nil {
+			return nil, Error.New("error unmarshaling pointer: %v", err)
+		}
+
+		var newPointerBytes []byte
+		pointer.References += delta
+		if pointer.References > 0 {
+			newPointerBytes, err = proto.Marshal(pointer)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+
+		err = s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, newPointerBytes)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		if pointer.References > 0 {
+			return nil, nil
+		}
+		return pointer, nil
+	}
+}
+
+// resolvePacked returns the packed segment a packed object refers to, along
+// with the metadata and the range of the object. Other pointers are returned
+// unchanged.
+func (s *Service) resolvePacked(ctx context.Context, pointer *pb.Pointer) (_ *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	packed := pointer.GetPacked()
+	if packed.GetSegment() == "" {
+		return pointer, nil
+	}
+
+	segment, err := s.Get(ctx, packed.Segment)
+	if err != nil {
+		return nil, err
+	}
+	segment.CreationDate = pointer.CreationDate
+	segment.ExpirationDate = pointer.ExpirationDate
+	segment.Metadata = pointer.Metadata
+	segment.VersionId = pointer.VersionId
+	segment.References = 0
+	segment.Packed = &pb.PackedRange{
+		Offset: packed.Offset,
+		Length: packed.Length,
+	}
+	return segment, nil
+}
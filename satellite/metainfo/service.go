@@ -4,6 +4,7 @@
 package metainfo
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -21,14 +22,15 @@ import (
 
 // Service structure
 type Service struct {
-	logger    *zap.Logger
-	DB        storage.KeyValueStore
-	bucketsDB BucketsDB
+	logger           *zap.Logger
+	DB               storage.KeyValueStore
+	bucketsDB        BucketsDB
+	pendingObjectsDB PendingObjectsDB
 }
 
 // NewService creates new metainfo service
-func NewService(logger *zap.Logger, db storage.KeyValueStore, bucketsDB BucketsDB) *Service {
-	return &Service{logger: logger, DB: db, bucketsDB: bucketsDB}
+func NewService(logger *zap.Logger, db storage.KeyValueStore, bucketsDB BucketsDB, pendingObjectsDB PendingObjectsDB) *Service {
+	return &Service{logger: logger, DB: db, bucketsDB: bucketsDB, pendingObjectsDB: pendingObjectsDB}
 }
 
 // Put puts pointer to db under specific path
@@ -70,6 +72,363 @@ func (s *Service) Get(ctx context.Context, path string) (pointer *pb.Pointer, er
 	return pointer, nil
 }
 
+// UpdatePieces removes the toRemove pieces from the remote pointer under path,
+// adds the toAdd pieces to it and returns the updated pointer.
+//
+// A piece is only removed when both its number and node id match, so a piece
+// which has been replaced in the meantime (e.g. by repair) is kept. The pointer
+// is swapped atomically and the update is retried when the pointer has been
+// changed concurrently.
+func (s *Service) UpdatePieces(ctx context.Context, path string, toAdd, toRemove []*pb.RemotePiece) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		oldPointerBytes, err := s.DB.Get(ctx, []byte(path))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		pointer = &pb.Pointer{}
+		err = proto.Unmarshal(oldPointerBytes, pointer)
+		if err != nil {
+			return nil, Error.New("error unmarshaling pointer: %v", err)
+		}
+
+		remote := pointer.GetRemote()
+		if remote == nil {
+			return nil, Error.New("pointer is not remote: %s", path)
+		}
+
+		pieces := make(map[int32]*pb.RemotePiece, len(remote.RemotePieces))
+		for _, piece := range remote.RemotePieces {
+			pieces[piece.PieceNum] = piece
+		}
+
+		for _, piece := range toRemove {
+			existing, ok := pieces[piece.PieceNum]
+			if ok && existing.NodeId == piece.NodeId {
+				delete(pieces, piece.PieceNum)
+			}
+		}
+
+		for _, piece := range toAdd {
+			if _, ok := pieces[piece.PieceNum]; ok {
+				return nil, Error.New("piece to add already exists (piece no: %d)", piece.PieceNum)
+			}
+			pieces[piece.PieceNum] = piece
+		}
+
+		// keep the original order of the pieces, appending the new ones
+		var updated []*pb.RemotePiece
+		for _, piece := range remote.RemotePieces {
+			if pieces[piece.PieceNum] == piece {
+				updated = append(updated, piece)
+			}
+		}
+		updated = append(updated, toAdd...)
+		remote.RemotePieces = updated
+
+		// the pointer is written directly, so its creation date is kept
+		newPointerBytes, err := proto.Marshal(pointer)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		err = s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, newPointerBytes)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return pointer, nil
+	}
+}
+
+// Copy stores copies of the pointers under paths at newPaths with their
+// metadata replaced. A remote segment is moved to a shared path when it is
+// copied for the first time, afterwards the original and the copy refer to it
+// like packed objects do, so its pieces are still checked, repaired and
+// transferred only once. Either all of the copies are stored or none of them.
+func (s *Service) Copy(ctx context.Context, paths, newPaths []string, metadata [][]byte) (pointers []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i, path := range paths {
+		pointer, err := s.copy(ctx, path, newPaths[i], metadata[i])
+		if err != nil {
+			// the copies stored so far release their references again
+			for _, newPath := range newPaths[:i] {
+				err = errs.Combine(err, s.Delete(ctx, newPath))
+			}
+			return nil, err
+		}
+		pointers = append(pointers, pointer)
+	}
+
+	return pointers, nil
+}
+
+// copy stores a copy of the pointer under path at newPath with its metadata
+// replaced.
+func (s *Service) copy(ctx context.Context, path, newPath string, metadata []byte) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err = s.share(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	segment := pointer.GetPacked().GetSegment()
+	if segment != "" {
+		_, err = s.updateReferences(ctx, segment, 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pointer.Metadata = metadata
+	err = s.Put(ctx, newPath, pointer)
+	if err != nil {
+		if segment != "" {
+			_, refErr := s.updateReferences(ctx, segment, -1)
+			err = errs.Combine(err, refErr)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return pointer, nil
+}
+
+// share moves the remote segment under path to a shared path and replaces it
+// with a pointer referring to the shared segment. The updated pointer is
+// returned, pointers without pieces of their own are returned unchanged.
+func (s *Service) share(ctx context.Context, path string) (_ *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		oldPointerBytes, err := s.DB.Get(ctx, []byte(path))
+		if err != nil {
+			return nil, err
+		}
+
+		pointer := &pb.Pointer{}
+		err = proto.Unmarshal(oldPointerBytes, pointer)
+		if err != nil {
+			return nil, Error.New("error unmarshaling pointer: %v", err)
+		}
+
+		remote := pointer.GetRemote()
+		if remote == nil {
+			return pointer, nil
+		}
+
+		sharedPath, err := createSharedPath(path, remote.RootPieceId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		// the pieces stay where the bucket of path placed them
+		placement, err := s.SegmentPlacement(ctx, path)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		segment := &pb.Pointer{
+			Type:           pb.Pointer_REMOTE,
+			Remote:         remote,
+			SegmentSize:    pointer.SegmentSize,
+			ExpirationDate: pointer.ExpirationDate,
+			References:     1,
+			Placement:      placement,
+		}
+		err = s.putPackedSegment(ctx, sharedPath, segment)
+		if err != nil {
+			if storage.ErrValueChanged.Has(err) {
+				return nil, Error.New("segment %s is shared concurrently", path)
+			}
+			return nil, Error.Wrap(err)
+		}
+
+		reference := &pb.Pointer{
+			Type:           pb.Pointer_REMOTE,
+			SegmentSize:    pointer.SegmentSize,
+			CreationDate:   pointer.CreationDate,
+			ExpirationDate: pointer.ExpirationDate,
+			Metadata:       pointer.Metadata,
+			VersionId:      pointer.VersionId,
+			Packed: &pb.PackedRange{
+				Offset:  0,
+				Length:  pointer.SegmentSize,
+				Segment: sharedPath,
+			},
+		}
+		newPointerBytes, err := proto.Marshal(reference)
+		if err != nil {
+			return nil, Error.Wrap(errs.Combine(err, s.DB.Delete(ctx, []byte(sharedPath))))
+		}
+
+		err = s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, newPointerBytes)
+		if err != nil {
+			// the shared segment is created again from the updated pointer
+			deleteErr := s.DB.Delete(ctx, []byte(sharedPath))
+			if storage.ErrValueChanged.Has(err) && deleteErr == nil {
+				continue
+			}
+			return nil, Error.Wrap(errs.Combine(err, deleteErr))
+		}
+
+		return reference, nil
+	}
+}
+
+// MoveObject moves the segments under paths of the object at bucket and
+// encryptedPath to newPaths of the object at newBucket and newEncryptedPath
+// like Move. Both objects are locked while the segments are moved, it fails
+// with ErrConcurrentCommit while one of them is being committed and with
+// ErrObjectExists when the object at the new path exists.
+func (s *Service) MoveObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, paths []string, newBucket, newEncryptedPath []byte, newPaths []string, metadata [][]byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bytes.Equal(bucket, newBucket) && bytes.Equal(encryptedPath, newEncryptedPath) {
+		return ErrObjectExists.New("%s", newEncryptedPath)
+	}
+
+	unlockNew, err := s.lockObject(ctx, projectID, newBucket, newEncryptedPath)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, unlockNew()) }()
+
+	unlock, err := s.lockObject(ctx, projectID, bucket, encryptedPath)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, unlock()) }()
+
+	newLastPath, err := CreatePath(ctx, projectID, -1, newBucket, newEncryptedPath)
+	if err != nil {
+		return err
+	}
+	_, err = s.Get(ctx, newLastPath)
+	if err == nil {
+		return ErrObjectExists.New("%s", newEncryptedPath)
+	}
+	if !storage.ErrKeyNotFound.Has(err) {
+		return err
+	}
+
+	return s.Move(ctx, paths, newPaths, metadata)
+}
+
+// Move moves the pointers under paths to newPaths with their metadata
+// replaced. All of them are stored under their new paths before any of them
+// is deleted from its old path, so they are either moved all together or not
+// at all. None of newPaths may exist.
+func (s *Service) Move(ctx context.Context, paths, newPaths []string, metadata [][]byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	moved := make([][]byte, len(paths))
+	stored := make([][]byte, len(paths))
+	for i, path := range paths {
+		moved[i], stored[i], err = s.putMoved(ctx, path, newPaths[i], metadata[i], nil)
+		if err != nil {
+			// pointers which have been changed in the meantime are kept
+			for j, newPath := range newPaths[:i] {
+				err = errs.Combine(err, s.DB.CompareAndSwap(ctx, []byte(newPath), stored[j], nil))
+			}
+			return err
+		}
+	}
+
+	// the pointers are deleted in reverse, so a last segment stored after
+	// the others disappears first
+	for i := len(paths) - 1; i >= 0; i-- {
+		for {
+			err = s.DB.CompareAndSwap(ctx, []byte(paths[i]), moved[i], nil)
+			if !storage.ErrValueChanged.Has(err) {
+				break
+			}
+			// the pointer has been updated (e.g. repaired) in the meantime
+			moved[i], stored[i], err = s.putMoved(ctx, paths[i], newPaths[i], metadata[i], stored[i])
+			if err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// putMoved stores the pointer under path at newPath with its metadata
+// replaced, provided newPath still holds oldNewPointerBytes. It returns the
+// pointer stored under path and the one stored at newPath.
+func (s *Service) putMoved(ctx context.Context, path, newPath string, metadata, oldNewPointerBytes []byte) (pointerBytes, newPointerBytes []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointerBytes, err = s.DB.Get(ctx, []byte(path))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pointer := &pb.Pointer{}
+	err = proto.Unmarshal(pointerBytes, pointer)
+	if err != nil {
+		return nil, nil, Error.New("error unmarshaling pointer: %v", err)
+	}
+	pointer.Metadata = metadata
+
+	// the pointer is written directly, so its creation date is kept
+	newPointerBytes, err = proto.Marshal(pointer)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	err = s.DB.CompareAndSwap(ctx, []byte(newPath), oldNewPointerBytes, newPointerBytes)
+	if err != nil {
+		if storage.ErrValueChanged.Has(err) {
+			return nil, nil, Error.New("%s has been written concurrently", newPath)
+		}
+		return nil, nil, Error.Wrap(err)
+	}
+
+	return pointerBytes, newPointerBytes, nil
+}
+
+// Rename moves the pointer under path to newPath without modifying it.
+func (s *Service) Rename(ctx context.Context, path, newPath string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := s.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	return s.relocate(ctx, path, newPath, pointer)
+}
+
+// relocate stores pointer under newPath and deletes path afterwards. It fails
+// when newPath exists, a pointer stored there concurrently isn't replaced.
+func (s *Service) relocate(ctx context.Context, path, newPath string, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the pointer is written directly, so its creation date is kept
+	pointerBytes, err := proto.Marshal(pointer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	err = s.DB.CompareAndSwap(ctx, []byte(newPath), nil, pointerBytes)
+	if err != nil {
+		if storage.ErrValueChanged.Has(err) {
+			return Error.New("%s already exists", newPath)
+		}
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.DB.Delete(ctx, []byte(path)))
+}
+
 // List returns all Path keys in the pointers bucket
 func (s *Service) List(ctx context.Context, prefix string, startAfter string, endBefore string, recursive bool, limit int32,
 	metaFlags uint32) (items []*pb.ListResponse_Item, more bool, err error) {
@@ -152,10 +511,77 @@ func (s *Service) setMetadata(item *pb.ListResponse_Item, data []byte, metaFlags
 	return nil
 }
 
-// Delete deletes from item from db
+// Delete deletes from item from db. A packed or copied segment is deleted
+// along with the last pointer referring to it.
 func (s *Service) Delete(ctx context.Context, path string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return s.DB.Delete(ctx, []byte(path))
+
+	_, err = s.Remove(ctx, path)
+	return err
+}
+
+// Remove deletes the pointer under path like Delete. It returns the pointer
+// whose pieces aren't referred to anymore, which is the deleted pointer or the
+// segment it has been the last reference to.
+func (s *Service) Remove(ctx context.Context, path string) (unreferenced *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		pointerBytes, err := s.DB.Get(ctx, []byte(path))
+		if err != nil {
+			return nil, err
+		}
+
+		pointer := &pb.Pointer{}
+		err = proto.Unmarshal(pointerBytes, pointer)
+		if err != nil {
+			return nil, Error.New("error unmarshaling pointer: %v", err)
+		}
+
+		err = s.DB.CompareAndSwap(ctx, []byte(path), pointerBytes, nil)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if segment := pointer.GetPacked().GetSegment(); segment != "" {
+			return s.updateReferences(ctx, segment, -1)
+		}
+		if pointer.GetRemote() == nil {
+			return nil, nil
+		}
+		return pointer, nil
+	}
+}
+
+// DeleteObject deletes the current version of an object. In a versioned
+// bucket the object is kept as a previous version, hidden by a delete marker.
+// The segments whose pieces aren't referred to anymore are returned, so their
+// pieces can be deleted. It fails with ErrConcurrentCommit while the object is
+// being committed.
+func (s *Service) DeleteObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte, versioning bool) (unreferenced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	unlock, err := s.lockObject(ctx, projectID, bucket, encryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, unlock()) }()
+
+	if versioning {
+		unreferenced, err = s.archiveObject(ctx, projectID, bucket, encryptedPath)
+		if err != nil {
+			return unreferenced, err
+		}
+		_, err = s.putDeleteMarker(ctx, projectID, bucket, encryptedPath)
+		return unreferenced, err
+	}
+
+	return s.deleteSegments(ctx, func(segmentIndex int64) (storj.Path, error) {
+		return CreatePath(ctx, projectID, segmentIndex, bucket, encryptedPath)
+	})
 }
 
 // Iterate iterates over items in db
@@ -182,12 +608,58 @@ func (s *Service) GetBucket(ctx context.Context, bucketName []byte, projectID uu
 	return s.bucketsDB.GetBucket(ctx, bucketName, projectID)
 }
 
+// SegmentPlacement returns the placement policy of the bucket containing the
+// segment at path. The segments of deleted buckets have no placement policy.
+// Packed and copied segments keep the placement they have been stored with.
+func (s *Service) SegmentPlacement(ctx context.Context, path storj.Path) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if isSharedPath(path) {
+		pointer, err := s.Get(ctx, path)
+		if err != nil {
+			return "", err
+		}
+		return pointer.Placement, nil
+	}
+
+	// paths have the format projectID/segment/bucket/encryptedPath
+	pathElements := storj.SplitPath(path)
+	if len(pathElements) < 3 {
+		return "", errs.New("invalid segment path %q", path)
+	}
+	projectID, err := uuid.Parse(pathElements[0])
+	if err != nil {
+		return "", err
+	}
+
+	bucket, err := s.bucketsDB.GetBucket(ctx, []byte(pathElements[2]), *projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return bucket.Placement, nil
+}
+
+// UpdateBucket updates the settings of an existing bucket in the buckets db
+func (s *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.UpdateBucket(ctx, bucket)
+}
+
 // DeleteBucket deletes a bucket from the bucekts db
 func (s *Service) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	return s.bucketsDB.DeleteBucket(ctx, bucketName, projectID)
 }
 
+// ListLifecycleBuckets returns all buckets that have lifecycle rules
+func (s *Service) ListLifecycleBuckets(ctx context.Context) (buckets []storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.ListLifecycleBuckets(ctx)
+}
+
 // ListBuckets returns a list of buckets for a project
 func (s *Service) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -8,13 +8,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
 )
 
 func TestIterate(t *testing.T) {
@@ -66,3 +70,248 @@ func TestIterate(t *testing.T) {
 		require.Equal(t, 1, itemCount)
 	})
 }
+
+// racingStore changes the value of a key right after it has been read for the
+// first time, like a concurrent update would.
+type racingStore struct {
+	storage.KeyValueStore
+	change func(ctx context.Context) error
+}
+
+// Get gets the value and applies the change afterwards.
+func (store *racingStore) Get(ctx context.Context, key storage.Key) (storage.Value, error) {
+	value, err := store.KeyValueStore.Get(ctx, key)
+	if err == nil && store.change != nil {
+		change := store.change
+		store.change = nil
+		err = change(ctx)
+	}
+	return value, err
+}
+
+func TestUpdatePiecesConcurrently(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := &racingStore{KeyValueStore: teststore.New()}
+	service := metainfo.NewService(zaptest.NewLogger(t), store, nil, nil)
+
+	path := "project/l/bucket/object"
+	err := service.Put(ctx, path, &pb.Pointer{
+		Type:   pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{},
+	})
+	require.NoError(t, err)
+
+	first := &pb.RemotePiece{PieceNum: 1, NodeId: testrand.NodeID()}
+	second := &pb.RemotePiece{PieceNum: 2, NodeId: testrand.NodeID()}
+
+	// the first piece is added while the second one is being added
+	store.change = func(ctx context.Context) error {
+		_, err := service.UpdatePieces(ctx, path, []*pb.RemotePiece{first}, nil)
+		return err
+	}
+	_, err = service.UpdatePieces(ctx, path, []*pb.RemotePiece{second}, nil)
+	require.NoError(t, err)
+
+	pointer, err := service.Get(ctx, path)
+	require.NoError(t, err)
+
+	var pieceNums []int32
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		pieceNums = append(pieceNums, piece.PieceNum)
+	}
+	require.ElementsMatch(t, []int32{1, 2}, pieceNums)
+}
+
+func TestCommitPendingObjectConcurrently(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		store := &racingStore{KeyValueStore: satellite.Metainfo.Database}
+		service := metainfo.NewService(zaptest.NewLogger(t), store, satellite.DB.Buckets(), satellite.DB.PendingObjects())
+
+		bucket, path := []byte("testbucket"), []byte("test/path")
+		begin := func(data ...string) metainfo.PendingObject {
+			object, err := service.BeginObject(ctx, metainfo.PendingObject{
+				ProjectID:     projectID,
+				BucketName:    bucket,
+				EncryptedPath: path,
+				Redundancy:    &pb.RedundancyScheme{},
+			})
+			require.NoError(t, err)
+			for i, segment := range data {
+				err = service.PutPendingSegment(ctx, object, int64(i), &pb.Pointer{
+					Type:          pb.Pointer_INLINE,
+					InlineSegment: []byte(segment),
+					SegmentSize:   int64(len(segment)),
+				})
+				require.NoError(t, err)
+			}
+			object.SegmentCount = int64(len(data))
+			return object
+		}
+		first := begin("first 0", "first 1")
+		second := begin("second 0", "second 1")
+
+		// the second object is committed and the object is deleted while the
+		// first one is being committed
+		var concurrentErr, deleteErr error
+		store.change = func(ctx context.Context) error {
+			_, _, concurrentErr = service.CommitPendingObject(ctx, second, nil, false, "")
+			_, deleteErr = service.DeleteObject(ctx, projectID, bucket, path, true)
+			return nil
+		}
+		_, _, err = service.CommitPendingObject(ctx, first, nil, false, "")
+		require.NoError(t, err)
+		require.True(t, metainfo.ErrConcurrentCommit.Has(concurrentErr), concurrentErr)
+		require.True(t, metainfo.ErrConcurrentCommit.Has(deleteErr), deleteErr)
+
+		// the second object can be committed afterwards, it replaces the first one
+		_, _, err = service.CommitPendingObject(ctx, second, nil, false, "")
+		require.NoError(t, err)
+
+		for segmentIndex, data := range map[int64]string{0: "second 0", -1: "second 1"} {
+			segmentPath, err := metainfo.CreatePath(ctx, projectID, segmentIndex, bucket, path)
+			require.NoError(t, err)
+			pointer, err := service.Get(ctx, segmentPath)
+			require.NoError(t, err)
+			require.Equal(t, data, string(pointer.InlineSegment))
+		}
+
+		// only the segments of the object are left
+		keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+	})
+}
+
+func TestMoveObjectConcurrently(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		store := &racingStore{KeyValueStore: satellite.Metainfo.Database}
+		service := metainfo.NewService(zaptest.NewLogger(t), store, satellite.DB.Buckets(), satellite.DB.PendingObjects())
+
+		bucket, path, newPath := []byte("testbucket"), []byte("test/path"), []byte("test/moved")
+		pointerAt := func(encryptedPath []byte) *pb.Pointer {
+			return &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: encryptedPath,
+				SegmentSize:   int64(len(encryptedPath)),
+			}
+		}
+		_, err = service.PutObject(ctx, projectID, bucket, path, pointerAt(path), false)
+		require.NoError(t, err)
+
+		lastPath, err := metainfo.CreatePath(ctx, projectID, -1, bucket, path)
+		require.NoError(t, err)
+		newLastPath, err := metainfo.CreatePath(ctx, projectID, -1, bucket, newPath)
+		require.NoError(t, err)
+
+		// an object is put at the new path while the object is being moved there
+		var concurrentErr error
+		store.change = func(ctx context.Context) error {
+			_, concurrentErr = service.PutObject(ctx, projectID, bucket, newPath, pointerAt(newPath), false)
+			return nil
+		}
+		err = service.MoveObject(ctx, projectID, bucket, path, []string{lastPath}, bucket, newPath, []string{newLastPath}, [][]byte{nil})
+		require.NoError(t, err)
+		require.True(t, metainfo.ErrConcurrentCommit.Has(concurrentErr), concurrentErr)
+
+		pointer, err := service.Get(ctx, newLastPath)
+		require.NoError(t, err)
+		require.Equal(t, path, pointer.InlineSegment)
+		_, err = service.Get(ctx, lastPath)
+		require.True(t, storage.ErrKeyNotFound.Has(err), err)
+
+		// the moved object isn't replaced by a copy or a move
+		_, err = service.PutObject(ctx, projectID, bucket, path, pointerAt(path), false)
+		require.NoError(t, err)
+		err = service.MoveObject(ctx, projectID, bucket, path, []string{lastPath}, bucket, newPath, []string{newLastPath}, [][]byte{nil})
+		require.True(t, metainfo.ErrObjectExists.Has(err), err)
+		_, _, err = service.CopyObject(ctx, projectID, []string{lastPath}, bucket, newPath, [][]byte{nil}, false, "", false)
+		require.True(t, metainfo.ErrObjectExists.Has(err), err)
+
+		pointer, err = service.Get(ctx, newLastPath)
+		require.NoError(t, err)
+		require.Equal(t, path, pointer.InlineSegment)
+
+		// only the segments of both objects are left
+		keys, err := storage.ListKeys(ctx, satellite.Metainfo.Database, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+	})
+}
+
+func TestCopiedSegmentPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Metainfo.Service
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		projectID := projects[0].ID
+
+		createBucket := func(name, placement string) {
+			_, err := service.CreateBucket(ctx, storj.Bucket{
+				ID:         testrand.UUID(),
+				Name:       name,
+				ProjectID:  projectID,
+				PathCipher: storj.EncAESGCM,
+				Placement:  placement,
+			})
+			require.NoError(t, err)
+		}
+		createBucket("source", "US")
+		createBucket("destination", "")
+
+		path, rootPieceID := []byte("test/path"), testrand.PieceID()
+		_, err = service.PutObject(ctx, projectID, []byte("source"), path, &pb.Pointer{
+			Type:        pb.Pointer_REMOTE,
+			SegmentSize: 10,
+			Remote: &pb.RemoteSegment{
+				Redundancy:  &pb.RedundancyScheme{},
+				RootPieceId: rootPieceID,
+			},
+		}, false)
+		require.NoError(t, err)
+
+		lastPath, err := metainfo.CreatePath(ctx, projectID, -1, []byte("source"), path)
+		require.NoError(t, err)
+		copies, _, err := service.CopyObject(ctx, projectID, []string{lastPath}, []byte("destination"), path, [][]byte{nil}, false, "", false)
+		require.NoError(t, err)
+		require.Len(t, copies, 1)
+
+		// the shared segment doesn't belong to the bucket it has been copied from
+		sharedPath := copies[0].GetPacked().GetSegment()
+		require.Equal(t, storj.JoinPaths(projectID.String(), "c", rootPieceID.String()), sharedPath)
+
+		// it keeps the placement of its pieces when the source bucket is gone
+		_, err = service.DeleteObject(ctx, projectID, []byte("source"), path, false)
+		require.NoError(t, err)
+		require.NoError(t, service.DeleteBucket(ctx, []byte("source"), projectID))
+		createBucket("source", "EU")
+
+		placement, err := service.SegmentPlacement(ctx, sharedPath)
+		require.NoError(t, err)
+		require.Equal(t, "US", placement)
+
+		copyPath, err := metainfo.CreatePath(ctx, projectID, -1, []byte("destination"), path)
+		require.NoError(t, err)
+		pointer, err := service.Get(ctx, copyPath)
+		require.NoError(t, err)
+		require.Equal(t, sharedPath, pointer.GetPacked().GetSegment())
+	})
+}
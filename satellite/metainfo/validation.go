@@ -165,35 +165,35 @@ func (endpoint *Endpoint) validateCreateSegment(ctx context.Context, req *pb.Seg
 	return nil
 }
 
-func (endpoint *Endpoint) validateCommitSegment(ctx context.Context, req *pb.SegmentCommitRequestOld) (err error) {
+func (endpoint *Endpoint) validateCommitSegment(ctx context.Context, bucket []byte, pointer *pb.Pointer, originalLimits []*pb.OrderLimit) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	err = endpoint.validateBucket(ctx, req.Bucket)
+	err = endpoint.validateBucket(ctx, bucket)
 	if err != nil {
 		return err
 	}
 
-	err = endpoint.validatePointer(ctx, req.Pointer)
+	err = endpoint.validatePointer(ctx, pointer)
 	if err != nil {
 		return err
 	}
 
-	if req.Pointer.Type == pb.Pointer_REMOTE {
-		remote := req.Pointer.Remote
+	if pointer.Type == pb.Pointer_REMOTE {
+		remote := pointer.Remote
 
-		if len(req.OriginalLimits) == 0 {
+		if len(originalLimits) == 0 {
 			return Error.New("no order limits")
 		}
-		if int32(len(req.OriginalLimits)) != remote.Redundancy.Total {
+		if int32(len(originalLimits)) != remote.Redundancy.Total {
 			return Error.New("invalid no order limit for piece")
 		}
 
-		if req.Pointer.SegmentSize > endpoint.rsConfig.MaxSegmentSize.Int64() || req.Pointer.SegmentSize < 0 {
-			return Error.New("segment size %v is out of range, maximum is %v", req.Pointer.SegmentSize, endpoint.rsConfig.MaxSegmentSize)
+		if pointer.SegmentSize > endpoint.rsConfig.MaxSegmentSize.Int64() || pointer.SegmentSize < 0 {
+			return Error.New("segment size %v is out of range, maximum is %v", pointer.SegmentSize, endpoint.rsConfig.MaxSegmentSize)
 		}
 
 		for _, piece := range remote.RemotePieces {
-			limit := req.OriginalLimits[piece.PieceNum]
+			limit := originalLimits[piece.PieceNum]
 
 			err := endpoint.orders.VerifyOrderLimitSignature(ctx, limit)
 			if err != nil {
@@ -213,15 +213,15 @@ func (endpoint *Endpoint) validateCommitSegment(ctx context.Context, req *pb.Seg
 		}
 	}
 
-	if len(req.OriginalLimits) > 0 {
-		createRequest, found := endpoint.createRequests.Load(req.OriginalLimits[0].SerialNumber)
+	if len(originalLimits) > 0 {
+		createRequest, found := endpoint.createRequests.Load(originalLimits[0].SerialNumber)
 
 		switch {
 		case !found:
 			return Error.New("missing create request or request expired")
-		case !createRequest.Expiration.Equal(req.Pointer.ExpirationDate):
+		case !createRequest.Expiration.Equal(pointer.ExpirationDate):
 			return Error.New("pointer expiration date does not match requested one")
-		case !proto.Equal(createRequest.Redundancy, req.Pointer.Remote.Redundancy):
+		case !proto.Equal(createRequest.Redundancy, pointer.Remote.Redundancy):
 			return Error.New("pointer redundancy scheme date does not match requested one")
 		}
 	}
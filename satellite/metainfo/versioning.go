@@ -0,0 +1,611 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// NullVersionID is the version id of objects uploaded while versioning
+// wasn't enabled for their bucket.
+const NullVersionID = "null"
+
+// newVersionID creates a new version id. Version ids created later sort
+// before the earlier ones, so listing the versions of an object returns the
+// newest version first.
+func newVersionID(now time.Time) (string, error) {
+	var random [4]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%016x%s", uint64(math.MaxInt64-now.UnixNano()), hex.EncodeToString(random[:])), nil
+}
+
+// versionIDOf returns the version id of the object the pointer belongs to.
+func versionIDOf(pointer *pb.Pointer) string {
+	if pointer.GetVersionId() == "" {
+		return NullVersionID
+	}
+	return pointer.GetVersionId()
+}
+
+// CreateVersionPath will create the path of a segment of a previous version of an object
+func CreateVersionPath(ctx context.Context, projectID uuid.UUID, segmentIndex int64, bucket, path []byte, versionID string) (_ storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if versionID == "" {
+		return "", errors.New("version id is required")
+	}
+
+	prefix, err := createVersionPrefix(ctx, projectID, segmentIndex, bucket, path)
+	if err != nil {
+		return "", err
+	}
+	return storj.JoinPaths(prefix, versionID), nil
+}
+
+// createVersionPrefix creates the path below which the previous versions of
+// a segment of an object are stored.
+func createVersionPrefix(ctx context.Context, projectID uuid.UUID, segmentIndex int64, bucket, path []byte) (_ storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(bucket) == 0 || len(path) == 0 {
+		return "", errors.New("bucket and path are required")
+	}
+
+	segmentPath, err := CreatePath(ctx, projectID, segmentIndex, bucket, path)
+	if err != nil {
+		return "", err
+	}
+
+	// previous versions are kept apart from the current ones, so they don't
+	// show up when listing the objects of a bucket
+	entries := storj.SplitPath(segmentPath)
+	entries[1] = "v" + entries[1]
+	return storj.JoinPaths(entries...), nil
+}
+
+// bucketVersioning returns whether versioning is enabled for the bucket.
+func (endpoint *Endpoint) bucketVersioning(ctx context.Context, projectID uuid.UUID, bucket []byte) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := endpoint.metainfo.GetBucket(ctx, bucket, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return bucketInfo.Versioning, nil
+}
+
+// segmentPath returns the path of a segment of the given version of an object.
+// An empty version id refers to the current version. The returned errors are
+// grpc status errors.
+func (endpoint *Endpoint) segmentPath(ctx context.Context, projectID uuid.UUID, segmentIndex int64, bucket, encryptedPath []byte, versionID string) (_ storj.Path, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path, err := CreatePath(ctx, projectID, segmentIndex, bucket, encryptedPath)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if versionID == "" {
+		return path, nil
+	}
+
+	lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	last, err := endpoint.metainfo.Get(ctx, lastPath)
+	switch {
+	case err == nil && versionIDOf(last) == versionID:
+		return path, nil
+	case err != nil && !storage.ErrKeyNotFound.Has(err):
+		return "", status.Errorf(codes.Internal, err.Error())
+	}
+
+	path, err = CreateVersionPath(ctx, projectID, segmentIndex, bucket, encryptedPath, versionID)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return path, nil
+}
+
+// archiveSegment moves a segment of the current version of an object to the
+// previous versions of the object. It returns false when the version of the
+// segment is unknown, because the last segment of the object is gone already.
+// The segment of a replaced version is returned when its pieces aren't
+// referred to anymore.
+func (endpoint *Endpoint) archiveSegment(ctx context.Context, projectID uuid.UUID, segmentIndex int64, bucket, encryptedPath []byte, pointer *pb.Pointer) (archived bool, unreferenced *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path, err := CreatePath(ctx, projectID, segmentIndex, bucket, encryptedPath)
+	if err != nil {
+		return false, nil, err
+	}
+
+	last := pointer
+	if segmentIndex != -1 {
+		lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+		if err != nil {
+			return false, nil, err
+		}
+
+		last, err = endpoint.metainfo.Get(ctx, lastPath)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return false, nil, nil
+			}
+			return false, nil, err
+		}
+	}
+
+	versionPath, err := CreateVersionPath(ctx, projectID, segmentIndex, bucket, encryptedPath, versionIDOf(last))
+	if err != nil {
+		return false, nil, err
+	}
+
+	// an existing version with the same id can only be a previously archived
+	// null version, it's removed first, so a segment it refers to is released
+	unreferenced, err = endpoint.metainfo.Remove(ctx, versionPath)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return false, nil, err
+	}
+
+	return true, unreferenced, endpoint.metainfo.Rename(ctx, path, versionPath)
+}
+
+// SetBucketVersioning enables or disables versioning for a bucket
+func (endpoint *Endpoint) SetBucketVersioning(ctx context.Context, req *pb.BucketSetVersioningRequest) (resp *pb.BucketSetVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	bucket, err := endpoint.metainfo.GetBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	bucket.Versioning = req.Versioning
+	bucket, err = endpoint.metainfo.UpdateBucket(ctx, bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketSetVersioningResponse{
+		Bucket: convertBucketToProto(ctx, bucket),
+	}, nil
+}
+
+// ListObjectVersions returns the last segments of all versions of an object, starting with the newest one
+func (endpoint *Endpoint) ListObjectVersions(ctx context.Context, req *pb.ObjectListVersionsRequest) (resp *pb.ObjectListVersionsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if len(req.EncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "path is required")
+	}
+
+	resp = &pb.ObjectListVersionsResponse{}
+
+	lastPath, err := CreatePath(ctx, keyInfo.ProjectID, -1, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	last, err := endpoint.metainfo.Get(ctx, lastPath)
+	switch {
+	case err == nil:
+		resp.Items = append(resp.Items, &pb.ObjectListVersionsResponse_Item{
+			VersionId: versionIDOf(last),
+			IsLatest:  true,
+			Pointer:   last,
+		})
+	case !storage.ErrKeyNotFound.Has(err):
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	archived, err := endpoint.listArchivedVersions(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	for _, item := range archived {
+		item.IsLatest = len(resp.Items) == 0
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
+// listArchivedVersions returns the last segments of the previous versions of an object.
+func (endpoint *Endpoint) listArchivedVersions(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (items []*pb.ObjectListVersionsResponse_Item, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix, err := createVersionPrefix(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	startAfter := ""
+	for {
+		listed, more, err := endpoint.metainfo.List(ctx, prefix, startAfter, "", false, 0, meta.None)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range listed {
+			// prefixes contain the versions of objects nested below this one
+			if item.IsPrefix {
+				continue
+			}
+
+			pointer, err := endpoint.metainfo.Get(ctx, storj.JoinPaths(prefix, item.Path))
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, &pb.ObjectListVersionsResponse_Item{
+				VersionId:    item.Path,
+				DeleteMarker: pointer.DeleteMarker,
+				Pointer:      pointer,
+			})
+		}
+
+		if !more || len(listed) == 0 {
+			return items, nil
+		}
+		startAfter = listed[len(listed)-1].Path
+	}
+}
+
+// DeleteObjectVersion permanently deletes a version of an object and returns
+// the order limits for deleting the pieces of its segments. When the current
+// version or the delete marker of a deleted object is removed, the newest
+// previous version becomes the current one.
+func (endpoint *Endpoint) DeleteObjectVersion(ctx context.Context, req *pb.ObjectDeleteVersionRequest) (resp *pb.ObjectDeleteVersionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionDelete,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if len(req.EncryptedPath) == 0 || req.VersionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "path and version id are required")
+	}
+
+	// restoring a previous version mustn't replace a concurrently committed one
+	unlock, err := endpoint.lockObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	lastPath, err := CreatePath(ctx, keyInfo.ProjectID, -1, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	last, err := endpoint.metainfo.Get(ctx, lastPath)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	deleted := err != nil
+
+	var unreferenced []*pb.Pointer
+	if !deleted && versionIDOf(last) == req.VersionId {
+		unreferenced, err = endpoint.metainfo.deleteSegments(ctx, func(segmentIndex int64) (storj.Path, error) {
+			return CreatePath(ctx, keyInfo.ProjectID, segmentIndex, req.Bucket, req.EncryptedPath)
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		err = endpoint.restoreNewestVersion(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		return endpoint.deleteVersionResponse(ctx, keyInfo.ProjectID, req.Bucket, unreferenced)
+	}
+
+	unreferenced, err = endpoint.metainfo.deleteSegments(ctx, func(segmentIndex int64) (storj.Path, error) {
+		return CreateVersionPath(ctx, keyInfo.ProjectID, segmentIndex, req.Bucket, req.EncryptedPath, req.VersionId)
+	})
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// removing the delete marker of a deleted object brings back its previous version
+	if deleted {
+		err = endpoint.restoreNewestVersion(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	return endpoint.deleteVersionResponse(ctx, keyInfo.ProjectID, req.Bucket, unreferenced)
+}
+
+// deleteVersionResponse creates the response of DeleteObjectVersion with the
+// order limits for deleting the pieces of the unreferenced segments.
+func (endpoint *Endpoint) deleteVersionResponse(ctx context.Context, projectID uuid.UUID, bucket []byte, unreferenced []*pb.Pointer) (resp *pb.ObjectDeleteVersionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := endpoint.createDeleteLimits(ctx, projectID, bucket, unreferenced)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &pb.ObjectDeleteVersionResponse{DeletedSegments: deleted}, nil
+}
+
+// deleteSegments deletes all segments of an object version, pathOf returns
+// the path of the segment with the given index. The segments whose pieces
+// aren't referred to anymore are returned.
+func (s *Service) deleteSegments(ctx context.Context, pathOf func(segmentIndex int64) (storj.Path, error)) (unreferenced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// not all key value stores report deleting a missing key, so the
+	// existence of the segments is checked first
+	lastPath, err := pathOf(-1)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.Get(ctx, lastPath)
+	if err != nil {
+		return nil, err
+	}
+
+	remove := func(path storj.Path) error {
+		pointer, err := s.Remove(ctx, path)
+		if pointer != nil {
+			unreferenced = append(unreferenced, pointer)
+		}
+		return err
+	}
+
+	for segmentIndex := int64(0); ; segmentIndex++ {
+		path, err := pathOf(segmentIndex)
+		if err != nil {
+			return unreferenced, err
+		}
+
+		_, err = s.Get(ctx, path)
+		if storage.ErrKeyNotFound.Has(err) {
+			break
+		}
+		if err != nil {
+			return unreferenced, err
+		}
+
+		err = remove(path)
+		if err != nil {
+			return unreferenced, err
+		}
+	}
+
+	return unreferenced, remove(lastPath)
+}
+
+// restoreNewestVersion makes the newest previous version of an object the
+// current one, unless it is a delete marker. The object has to be locked.
+func (endpoint *Endpoint) restoreNewestVersion(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	archived, err := endpoint.listArchivedVersions(ctx, projectID, bucket, encryptedPath)
+	if err != nil {
+		return err
+	}
+	if len(archived) == 0 || archived[0].DeleteMarker {
+		return nil
+	}
+	versionID := archived[0].VersionId
+
+	for segmentIndex := int64(0); ; segmentIndex++ {
+		versionPath, err := CreateVersionPath(ctx, projectID, segmentIndex, bucket, encryptedPath, versionID)
+		if err != nil {
+			return err
+		}
+		path, err := CreatePath(ctx, projectID, segmentIndex, bucket, encryptedPath)
+		if err != nil {
+			return err
+		}
+
+		err = endpoint.metainfo.Rename(ctx, versionPath, path)
+		if storage.ErrKeyNotFound.Has(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	versionPath, err := CreateVersionPath(ctx, projectID, -1, bucket, encryptedPath, versionID)
+	if err != nil {
+		return err
+	}
+	path, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return err
+	}
+	return endpoint.metainfo.Rename(ctx, versionPath, path)
+}
+
+// CreateDeleteMarker marks an object in a versioned bucket as deleted
+func (endpoint *Endpoint) CreateDeleteMarker(ctx context.Context, req *pb.ObjectCreateDeleteMarkerRequest) (resp *pb.ObjectCreateDeleteMarkerResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionDelete,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	versioning, err := endpoint.bucketVersioning(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !versioning {
+		return nil, status.Errorf(codes.FailedPrecondition, "versioning is not enabled for the bucket")
+	}
+	if len(req.EncryptedPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "path is required")
+	}
+
+	unlock, err := endpoint.lockObject(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	versionID, err := endpoint.metainfo.putDeleteMarker(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.ObjectCreateDeleteMarkerResponse{VersionId: versionID}, nil
+}
+
+// putDeleteMarker adds a delete marker as the newest previous version of an
+// object. The object has to be locked.
+func (s *Service) putDeleteMarker(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (versionID string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionID, err = newVersionID(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	path, err := CreateVersionPath(ctx, projectID, -1, bucket, encryptedPath, versionID)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.Put(ctx, path, &pb.Pointer{
+		Type:         pb.Pointer_INLINE,
+		VersionId:    versionID,
+		DeleteMarker: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return versionID, nil
+}
+
+// archiveObject moves all segments of the current version of an object to
+// the previous versions of the object, the object has to be locked. The segments of a replaced version
+// whose pieces aren't referred to anymore are returned.
+func (s *Service) archiveObject(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (unreferenced []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastPath, err := CreatePath(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	last, err := s.Get(ctx, lastPath)
+	if err != nil {
+		return nil, err
+	}
+	versionID := versionIDOf(last)
+
+	// an existing version with the same id can only be a previously archived
+	// null version, it's deleted first, so the segments it refers to are released
+	unreferenced, err = s.deleteSegments(ctx, func(segmentIndex int64) (storj.Path, error) {
+		return CreateVersionPath(ctx, projectID, segmentIndex, bucket, encryptedPath, versionID)
+	})
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return unreferenced, err
+	}
+
+	for segmentIndex := int64(0); ; segmentIndex++ {
+		path, err := CreatePath(ctx, projectID, segmentIndex, bucket, encryptedPath)
+		if err != nil {
+			return unreferenced, err
+		}
+		versionPath, err := CreateVersionPath(ctx, projectID, segmentIndex, bucket, encryptedPath, versionID)
+		if err != nil {
+			return unreferenced, err
+		}
+
+		err = s.Rename(ctx, path, versionPath)
+		if storage.ErrKeyNotFound.Has(err) {
+			break
+		}
+		if err != nil {
+			return unreferenced, err
+		}
+	}
+
+	versionPath, err := CreateVersionPath(ctx, projectID, -1, bucket, encryptedPath, versionID)
+	if err != nil {
+		return unreferenced, err
+	}
+	return unreferenced, s.Rename(ctx, lastPath, versionPath)
+}
@@ -54,6 +54,8 @@ var (
 	Error = errs.Class("orders error")
 	// ErrUsingSerialNumber error class for serial number
 	ErrUsingSerialNumber = errs.Class("serial number")
+	// ErrUsageLimit error class for exceeded storage and bandwidth usage limits
+	ErrUsageLimit = errs.Class("usage limit")
 
 	mon = monkit.Package()
 )
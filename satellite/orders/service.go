@@ -13,6 +13,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/overlay"
@@ -31,6 +32,7 @@ type Service struct {
 	satellite                           signing.Signer
 	cache                               *overlay.Cache
 	orders                              DB
+	projectUsage                        *accounting.ProjectUsage
 	satelliteAddress                    *pb.NodeAddress
 	orderExpiration                     time.Duration
 	repairMaxExcessRateOptimalThreshold float64
@@ -39,7 +41,7 @@ type Service struct {
 // NewService creates new service for creating order limits.
 func NewService(
 	log *zap.Logger, satellite signing.Signer, cache *overlay.Cache,
-	orders DB, orderExpiration time.Duration, satelliteAddress *pb.NodeAddress,
+	orders DB, projectUsage *accounting.ProjectUsage, orderExpiration time.Duration, satelliteAddress *pb.NodeAddress,
 	repairMaxExcessRateOptimalThreshold float64,
 ) *Service {
 	return &Service{
@@ -47,6 +49,7 @@ func NewService(
 		satellite:                           satellite,
 		cache:                               cache,
 		orders:                              orders,
+		projectUsage:                        projectUsage,
 		satelliteAddress:                    satelliteAddress,
 		orderExpiration:                     orderExpiration,
 		repairMaxExcessRateOptimalThreshold: repairMaxExcessRateOptimalThreshold,
@@ -117,10 +120,58 @@ func (service *Service) updateBandwidth(ctx context.Context, projectID uuid.UUID
 	return nil
 }
 
+// checkBandwidthUsage returns ErrUsageLimit when the egress limit of the project
+// or of the bucket has been exceeded. Failures to retrieve the usage are only logged.
+func (service *Service) checkBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketID []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := service.projectUsage.ExceedsBandwidthUsage(ctx, projectID, bucketID)
+	if err != nil {
+		service.log.Error("retrieving project bandwidth total", zap.Error(err))
+		return nil
+	}
+	if exceeded {
+		service.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for bandwidth for projectID %s.",
+			limit, projectID,
+		)
+		return ErrUsageLimit.New("exceeded bandwidth limit of %s", limit)
+	}
+	return nil
+}
+
+// checkStorageUsage returns ErrUsageLimit when the storage limit of the project
+// or of the bucket has been exceeded. Failures to retrieve the usage are only logged.
+func (service *Service) checkStorageUsage(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := service.projectUsage.ExceedsBucketStorageUsage(ctx, projectID, bucketName)
+	if err != nil {
+		service.log.Error("retrieving project storage totals", zap.Error(err))
+		return nil
+	}
+	if exceeded {
+		service.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for storage for projectID %s",
+			limit, projectID,
+		)
+		return ErrUsageLimit.New("exceeded storage limit of %s", limit)
+	}
+	return nil
+}
+
 // CreateGetOrderLimits creates the order limits for downloading the pieces of pointer.
+// ErrUsageLimit is returned when the egress limit of the project or the bucket has been exceeded.
 func (service *Service) CreateGetOrderLimits(ctx context.Context, bucketID []byte, pointer *pb.Pointer) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	projectID, bucketName, err := SplitBucketID(bucketID)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	if err := service.checkBandwidthUsage(ctx, *projectID, bucketID); err != nil {
+		return nil, storj.PiecePrivateKey{}, err
+	}
+
 	rootPieceID := pointer.GetRemote().RootPieceId
 	pieceExpiration := pointer.ExpirationDate
 	orderExpiration := time.Now().Add(service.orderExpiration)
@@ -197,21 +248,32 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucketID []byt
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
-	projectID, bucketName, err := SplitBucketID(bucketID)
-	if err != nil {
-		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
-	}
 	if err := service.updateBandwidth(ctx, *projectID, bucketName, limits...); err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
+	if err := service.projectUsage.AddBandwidthUsage(ctx, *projectID, bucketName, pieceSize*int64(len(limits))); err != nil {
+		service.log.Error("could not track egress of bucket", zap.Stringer("Project ID", projectID), zap.Error(err))
+		// but continue. the allocation is tracked by the orders DB, only the live egress is off until the next reset.
+	}
+
 	return limits, piecePrivateKey, nil
 }
 
 // CreatePutOrderLimits creates the order limits for uploading pieces to nodes.
+// ErrUsageLimit is returned when the storage limit of the project or the bucket has been exceeded.
 func (service *Service) CreatePutOrderLimits(ctx context.Context, bucketID []byte, nodes []*pb.Node, expiration time.Time, maxPieceSize int64) (_ storj.PieceID, _ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	projectID, bucketName, err := SplitBucketID(bucketID)
+	if err != nil {
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	if err := service.checkStorageUsage(ctx, *projectID, bucketName); err != nil {
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, err
+	}
+
 	orderExpiration := time.Now().Add(service.orderExpiration)
 
 	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
@@ -257,10 +319,6 @@ func (service *Service) CreatePutOrderLimits(ctx context.Context, bucketID []byt
 		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
 
-	projectID, bucketName, err := SplitBucketID(bucketID)
-	if err != nil {
-		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
-	}
 	if err := service.updateBandwidth(ctx, *projectID, bucketName, limits...); err != nil {
 		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
@@ -704,6 +762,60 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucketID
 	return limits, piecePrivateKey, nil
 }
 
+// CreateGracefulExitPutOrderLimit creates an order limit for uploading a piece of a gracefully exiting node to newNode.
+func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, bucketID []byte, newNode *pb.Node, pieceNum int32, rootPieceID storj.PieceID, pieceSize int64, pieceExpiration time.Time) (limit *pb.AddressedOrderLimit, _ storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	orderExpiration := time.Now().Add(service.orderExpiration)
+
+	piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	serialNumber, err := service.createSerial(ctx)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	orderLimit, err := signing.SignOrderLimit(ctx, service.satellite, &pb.OrderLimit{
+		SerialNumber:     serialNumber,
+		SatelliteId:      service.satellite.ID(),
+		SatelliteAddress: service.satelliteAddress,
+		UplinkPublicKey:  piecePublicKey,
+		StorageNodeId:    newNode.Id,
+		PieceId:          rootPieceID.Derive(newNode.Id, pieceNum),
+		Action:           pb.PieceAction_PUT_GRACEFUL_EXIT,
+		Limit:            pieceSize,
+		PieceExpiration:  pieceExpiration,
+		OrderCreation:    time.Now(),
+		OrderExpiration:  orderExpiration,
+	})
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	limit = &pb.AddressedOrderLimit{
+		Limit:              orderLimit,
+		StorageNodeAddress: newNode.Address,
+	}
+
+	err = service.saveSerial(ctx, serialNumber, bucketID, orderExpiration)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	projectID, bucketName, err := SplitBucketID(bucketID)
+	if err != nil {
+		return limit, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+	if err := service.updateBandwidth(ctx, *projectID, bucketName, limit); err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	return limit, piecePrivateKey, nil
+}
+
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket
 func (service *Service) UpdateGetInlineOrder(ctx context.Context, projectID uuid.UUID, bucketName []byte, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -33,7 +33,6 @@ import (
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/datarepair/repairer"
-	"storj.io/storj/pkg/discovery"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/overlay"
@@ -46,7 +45,11 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/marketingweb"
@@ -100,6 +103,10 @@ type DB interface {
 	Containment() audit.Containment
 	// Buckets returns the database to interact with buckets
 	Buckets() metainfo.BucketsDB
+	// PendingObjects returns database for keeping track of pending objects
+	PendingObjects() metainfo.PendingObjectsDB
+	// GracefulExit returns database for graceful exit
+	GracefulExit() gracefulexit.DB
 }
 
 // Config is the global config satellite
@@ -107,9 +114,8 @@ type Config struct {
 	Identity identity.Config
 	Server   server.Config
 
-	Kademlia  kademlia.Config
-	Overlay   overlay.Config
-	Discovery discovery.Config
+	Kademlia kademlia.Config
+	Overlay  overlay.Config
 
 	Metainfo metainfo.Config
 	Orders   orders.Config
@@ -118,6 +124,12 @@ type Config struct {
 	Repairer repairer.Config
 	Audit    audit.Config
 
+	GarbageCollection gc.Config
+
+	GracefulExit gracefulexit.Config
+
+	Lifecycle lifecycle.Config
+
 	Tally          tally.Config
 	Rollup         rollup.Config
 	LiveAccounting live.Config
@@ -159,8 +171,8 @@ type Peer struct {
 		Inspector *overlay.Inspector
 	}
 
-	Discovery struct {
-		Service *discovery.Discovery
+	Contact struct {
+		Endpoint *contact.Endpoint
 	}
 
 	Metainfo struct {
@@ -187,6 +199,19 @@ type Peer struct {
 		Service *audit.Service
 	}
 
+	GarbageCollection struct {
+		Service *gc.Service
+	}
+
+	GracefulExit struct {
+		Chore    *gracefulexit.Chore
+		Endpoint *gracefulexit.Endpoint
+	}
+
+	Lifecycle struct {
+		Chore *lifecycle.Chore
+	}
+
 	Accounting struct {
 		Tally        *tally.Service
 		Rollup       *rollup.Service
@@ -264,7 +289,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		log.Debug("Starting overlay")
 		config := config.Overlay
 
-		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), config.Node)
+		var regions overlay.RegionLookup
+		if config.Node.RegionDatabase != "" {
+			regionDB, err := overlay.OpenRegionDB(config.Node.RegionDatabase)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			regions = regionDB
+		} else if config.Node.MaxPiecesPerRegion > 0 {
+			return nil, errs.Combine(errs.New("overlay.node.region-database is required for the per region limit"), peer.Close())
+		}
+
+		peer.Overlay.Service = overlay.NewCacheWithRegions(peer.Log.Named("overlay"), peer.DB.OverlayCache(), config.Node, regions)
 		peer.Transport = peer.Transport.WithObservers(peer.Overlay.Service)
 
 		peer.Overlay.Inspector = overlay.NewInspector(peer.Overlay.Service)
@@ -335,10 +371,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		pb.RegisterKadInspectorServer(peer.Server.PrivateGRPC(), peer.Kademlia.Inspector)
 	}
 
-	{ // setup discovery
-		log.Debug("Setting up discovery")
-		config := config.Discovery
-		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, config)
+	{ // setup contact service
+		log.Debug("Setting up contact service")
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Transport, peer.Overlay.Service)
+		pb.RegisterContactServer(peer.Server.GRPC(), peer.Contact.Endpoint)
 	}
 
 	{ // setup vouchers
@@ -354,8 +390,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 
 	{ // setup live accounting
 		log.Debug("Setting up live accounting")
+		tallyInterval := config.Tally.Interval
 		config := config.LiveAccounting
-		liveAccountingService, err := live.New(peer.Log.Named("live-accounting"), config)
+		liveAccountingService, err := live.New(peer.Log.Named("live-accounting"), config, tallyInterval)
 		if err != nil {
 			return nil, err
 		}
@@ -385,6 +422,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay.Service,
 			peer.DB.Orders(),
+			peer.Accounting.ProjectUsage,
 			config.Orders.Expiration,
 			&pb.NodeAddress{
 				Transport: pb.NodeTransport_TCP_TLS_GRPC,
@@ -406,6 +444,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Metainfo.Service = metainfo.NewService(peer.Log.Named("metainfo:service"),
 			peer.Metainfo.Database,
 			peer.DB.Buckets(),
+			peer.DB.PendingObjects(),
 		)
 
 		peer.Metainfo.Endpoint2 = metainfo.NewEndpoint(
@@ -423,6 +462,50 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		pb.RegisterMetainfoServer(peer.Server.GRPC(), peer.Metainfo.Endpoint2)
 	}
 
+	{ // setup garbage collection
+		log.Debug("Setting up garbage collection")
+		peer.GarbageCollection.Service = gc.NewService(
+			peer.Log.Named("garbage collection"),
+			config.GarbageCollection,
+			peer.Transport,
+			peer.Overlay.Service,
+		)
+	}
+
+	{ // setup graceful exit
+		log.Debug("Setting up graceful exit")
+		peer.GracefulExit.Chore = gracefulexit.NewChore(
+			peer.Log.Named("graceful exit chore"),
+			peer.DB.GracefulExit(),
+			peer.Metainfo.Service,
+			config.GracefulExit,
+		)
+
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("graceful exit endpoint"),
+			peer.DB.GracefulExit(),
+			peer.Overlay.Service,
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.Kademlia.Service,
+			config.GracefulExit,
+		)
+
+		pb.RegisterSatelliteGracefulExitServer(peer.Server.GRPC(), peer.GracefulExit.Endpoint)
+	}
+
+	{ // setup bucket lifecycle
+		log.Debug("Setting up bucket lifecycle")
+		peer.Lifecycle.Chore = lifecycle.NewChore(
+			peer.Log.Named("lifecycle chore"),
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.DB.Containment(),
+			peer.Transport,
+			config.Lifecycle,
+		)
+	}
+
 	{ // setup datarepair
 		log.Debug("Setting up datarepair")
 		// TODO: simplify argument list somehow
@@ -430,6 +513,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Metainfo.Service,
 			peer.DB.RepairQueue(),
 			peer.Overlay.Service, peer.DB.Irreparable(),
+			peer.GarbageCollection.Service,
 			0, peer.Log.Named("checker"),
 			config.Checker)
 
@@ -644,9 +728,6 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
 	})
-	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
-	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Checker.Run(ctx))
 	})
@@ -662,6 +743,12 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Chore.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Lifecycle.Chore.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		// Don't change the format of this comment, it is used to figure out the node id.
@@ -714,15 +801,17 @@ func (peer *Peer) Close() error {
 	if peer.Repair.Checker != nil {
 		errlist.Add(peer.Repair.Checker.Close())
 	}
+	if peer.Lifecycle.Chore != nil {
+		errlist.Add(peer.Lifecycle.Chore.Close())
+	}
+	if peer.GracefulExit.Chore != nil {
+		errlist.Add(peer.GracefulExit.Chore.Close())
+	}
 
 	if peer.Metainfo.Database != nil {
 		errlist.Add(peer.Metainfo.Database.Close())
 	}
 
-	if peer.Discovery.Service != nil {
-		errlist.Add(peer.Discovery.Service.Close())
-	}
-
 	// TODO: add kademlia.Endpoint for consistency
 	if peer.Kademlia.Service != nil {
 		errlist.Add(peer.Kademlia.Service.Close())
@@ -735,6 +824,10 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Overlay.Service.Close())
 	}
 
+	if peer.LiveAccounting.Service != nil {
+		errlist.Add(peer.LiveAccounting.Service.Close())
+	}
+
 	if peer.Kademlia.ndb != nil || peer.Kademlia.kdb != nil || peer.Kademlia.adb != nil {
 		errlist.Add(peer.Kademlia.kdb.Close())
 		errlist.Add(peer.Kademlia.ndb.Close())
@@ -0,0 +1,85 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that bucketLimits implements console.BucketLimits.
+var _ console.BucketLimits = (*bucketLimits)(nil)
+
+// bucketLimits is an implementation of console.BucketLimits
+type bucketLimits struct {
+	db dbx.Methods
+}
+
+// Get is a method for querying the limits of a bucket from the database.
+func (limits *bucketLimits) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *console.BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := limits.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name([]byte(bucketName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketLimitFromDBX(projectID, bucket)
+}
+
+// Update is a method for updating the limits of a bucket.
+func (limits *bucketLimits) Update(ctx context.Context, limit console.BucketLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updateFields := dbx.BucketMetainfo_Update_Fields{
+		StorageLimit: dbx.BucketMetainfo_StorageLimit_Null(),
+		EgressLimit:  dbx.BucketMetainfo_EgressLimit_Null(),
+	}
+	if limit.StorageLimit > 0 {
+		updateFields.StorageLimit = dbx.BucketMetainfo_StorageLimit(limit.StorageLimit)
+	}
+	if limit.EgressLimit > 0 {
+		updateFields.EgressLimit = dbx.BucketMetainfo_EgressLimit(limit.EgressLimit)
+	}
+
+	bucket, err := limits.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(limit.ProjectID[:]),
+		dbx.BucketMetainfo_Name([]byte(limit.BucketName)),
+		updateFields,
+	)
+	if err != nil {
+		return err
+	}
+	if bucket == nil {
+		return errs.New("bucket %q not found", limit.BucketName)
+	}
+	return nil
+}
+
+// bucketLimitFromDBX is used for creating BucketLimit entity from autogenerated dbx.BucketMetainfo struct
+func bucketLimitFromDBX(projectID uuid.UUID, bucket *dbx.BucketMetainfo) (*console.BucketLimit, error) {
+	if bucket == nil {
+		return nil, errs.New("bucket parameter is nil")
+	}
+
+	limit := &console.BucketLimit{
+		ProjectID:  projectID,
+		BucketName: string(bucket.Name),
+	}
+	if bucket.StorageLimit != nil {
+		limit.StorageLimit = *bucket.StorageLimit
+	}
+	if bucket.EgressLimit != nil {
+		limit.EgressLimit = *bucket.EgressLimit
+	}
+	return limit, nil
+}
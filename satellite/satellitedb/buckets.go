@@ -8,9 +8,11 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/skyrings/skyring-common/tools/uuid"
 
 	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/metainfo"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
@@ -28,6 +30,14 @@ func (db *DB) Buckets() metainfo.BucketsDB {
 // CreateBucket creates a new bucket
 func (db *bucketsDB) CreateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
 	defer mon.Task()(&ctx)(&err)
+	lifecycleRules, err := lifecycleRulesField(bucket.LifecycleRules)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+	placement := dbx.BucketMetainfo_Placement_Null()
+	if bucket.Placement != "" {
+		placement = dbx.BucketMetainfo_Placement(bucket.Placement)
+	}
 	row, err := db.db.Create_BucketMetainfo(ctx,
 		dbx.BucketMetainfo_Id(bucket.ID[:]),
 		dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
@@ -42,8 +52,11 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket storj.Bucket) (_ s
 		dbx.BucketMetainfo_DefaultRedundancyRepairShares(int(bucket.DefaultRedundancyScheme.RepairShares)),
 		dbx.BucketMetainfo_DefaultRedundancyOptimalShares(int(bucket.DefaultRedundancyScheme.OptimalShares)),
 		dbx.BucketMetainfo_DefaultRedundancyTotalShares(int(bucket.DefaultRedundancyScheme.TotalShares)),
+		dbx.BucketMetainfo_Versioning(bucket.Versioning),
 		dbx.BucketMetainfo_Create_Fields{
-			PartnerId: dbx.BucketMetainfo_PartnerId(bucket.PartnerID[:]),
+			PartnerId:      dbx.BucketMetainfo_PartnerId(bucket.PartnerID[:]),
+			LifecycleRules: lifecycleRules,
+			Placement:      placement,
 		},
 	)
 	if err != nil {
@@ -73,6 +86,30 @@ func (db *bucketsDB) GetBucket(ctx context.Context, bucketName []byte, projectID
 	return convertDBXtoBucket(dbxBucket)
 }
 
+// UpdateBucket updates the settings of a bucket
+func (db *bucketsDB) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	lifecycleRules, err := lifecycleRulesField(bucket.LifecycleRules)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
+		dbx.BucketMetainfo_Name([]byte(bucket.Name)),
+		dbx.BucketMetainfo_Update_Fields{
+			Versioning:     dbx.BucketMetainfo_Versioning(bucket.Versioning),
+			LifecycleRules: lifecycleRules,
+		},
+	)
+	if err != nil {
+		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.Bucket{}, storj.ErrBucketNotFound.New("%s", bucket.Name)
+	}
+	return convertDBXtoBucket(dbxBucket)
+}
+
 // DeleteBucket deletes a bucket
 func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -163,6 +200,47 @@ func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listO
 	return bucketList, nil
 }
 
+// ListLifecycleBuckets returns all buckets that have lifecycle rules
+func (db *bucketsDB) ListLifecycleBuckets(ctx context.Context) (buckets []storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBuckets, err := db.db.All_BucketMetainfo_By_LifecycleRules_IsNot_Null(ctx)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+
+	for _, dbxBucket := range dbxBuckets {
+		bucket, err := convertDBXtoBucket(dbxBucket)
+		if err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets, nil
+}
+
+// lifecycleRulesField encodes lifecycle rules for storing them with a bucket.
+func lifecycleRulesField(rules []storj.LifecycleRule) (dbx.BucketMetainfo_LifecycleRules_Field, error) {
+	if len(rules) == 0 {
+		return dbx.BucketMetainfo_LifecycleRules_Null(), nil
+	}
+
+	lifecycle := &pb.BucketLifecycle{}
+	for _, rule := range rules {
+		lifecycle.Rules = append(lifecycle.Rules, &pb.LifecycleRule{
+			Id:                        rule.ID,
+			EncryptedPrefix:           []byte(rule.Prefix),
+			ExpirationDays:            int32(rule.ExpirationDays),
+			AbortIncompleteUploadDays: int32(rule.AbortIncompleteUploadDays),
+		})
+	}
+
+	data, err := proto.Marshal(lifecycle)
+	if err != nil {
+		return dbx.BucketMetainfo_LifecycleRules_Field{}, err
+	}
+	return dbx.BucketMetainfo_LifecycleRules(data), nil
+}
+
 func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err error) {
 	id, err := bytesToUUID(dbxBucket.Id)
 	if err != nil {
@@ -172,6 +250,28 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err
 	if err != nil {
 		return bucket, err
 	}
+
+	var lifecycleRules []storj.LifecycleRule
+	if dbxBucket.LifecycleRules != nil {
+		lifecycle := &pb.BucketLifecycle{}
+		if err := proto.Unmarshal(*dbxBucket.LifecycleRules, lifecycle); err != nil {
+			return bucket, err
+		}
+		for _, rule := range lifecycle.Rules {
+			lifecycleRules = append(lifecycleRules, storj.LifecycleRule{
+				ID:                        rule.Id,
+				Prefix:                    storj.Path(rule.EncryptedPrefix),
+				ExpirationDays:            int(rule.ExpirationDays),
+				AbortIncompleteUploadDays: int(rule.AbortIncompleteUploadDays),
+			})
+		}
+	}
+
+	var placement string
+	if dbxBucket.Placement != nil {
+		placement = *dbxBucket.Placement
+	}
+
 	return storj.Bucket{
 		ID:                  id,
 		Name:                string(dbxBucket.Name),
@@ -191,5 +291,8 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err
 			CipherSuite: storj.CipherSuite(dbxBucket.DefaultEncryptionCipherSuite),
 			BlockSize:   int32(dbxBucket.DefaultEncryptionBlockSize),
 		},
+		Versioning:     dbxBucket.Versioning,
+		LifecycleRules: lifecycleRules,
+		Placement:      placement,
 	}, nil
 }
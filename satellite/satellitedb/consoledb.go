@@ -46,6 +46,11 @@ func (db *ConsoleDB) BucketUsage() accounting.BucketUsage {
 	return &bucketusage{db.methods}
 }
 
+// BucketLimits is a getter for console.BucketLimits repository
+func (db *ConsoleDB) BucketLimits() console.BucketLimits {
+	return &bucketLimits{db.methods}
+}
+
 // RegistrationTokens is a getter for RegistrationTokens repository
 func (db *ConsoleDB) RegistrationTokens() console.RegistrationTokens {
 	return &registrationTokens{db.methods}
@@ -18,6 +18,7 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/rewards"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
@@ -150,3 +151,8 @@ func (db *DB) Orders() orders.DB {
 func (db *DB) Containment() audit.Containment {
 	return &containment{db: db.db}
 }
+
+// GracefulExit returns database for graceful exit
+func (db *DB) GracefulExit() gracefulexit.DB {
+	return &gracefulexitDB{db: db.db}
+}
@@ -333,10 +333,36 @@ CREATE TABLE certRecords (
 	update_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE graceful_exit_progress (
+	node_id bytea NOT NULL,
+	bytes_transferred bigint NOT NULL,
+	pieces_transferred bigint NOT NULL,
+	pieces_failed bigint NOT NULL,
+	exit_initiated_at timestamp with time zone NOT NULL,
+	exit_loop_completed_at timestamp with time zone,
+	exit_finished_at timestamp with time zone,
+	exit_success boolean NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE graceful_exit_transfer_queue (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_num integer NOT NULL,
+	durability_ratio double precision NOT NULL,
+	queued_at timestamp NOT NULL,
+	last_failed_at timestamp,
+	last_failed_code integer,
+	failed_count integer NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE injuredsegments (
 	path bytea NOT NULL,
 	data bytea NOT NULL,
 	attempted timestamp,
+	num_healthy_pieces integer NOT NULL,
+	redundancy_margin integer NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -404,11 +430,23 @@ CREATE TABLE pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE pending_objects (
+	stream_id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	encrypted_path bytea NOT NULL,
+	redundancy bytea NOT NULL,
+	segment_count bigint NOT NULL,
+	expires_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( stream_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	description text NOT NULL,
 	usage_limit bigint NOT NULL,
+	bandwidth_limit bigint NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
@@ -497,6 +535,11 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares integer NOT NULL,
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
+	versioning boolean NOT NULL,
+	lifecycle_rules bytea,
+	placement text,
+	storage_limit bigint,
+	egress_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
@@ -550,7 +593,9 @@ CREATE TABLE project_payments (
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX injuredsegments_attempted_index ON injuredsegments ( attempted );
+CREATE INDEX injuredsegments_redundancy_margin_index ON injuredsegments ( redundancy_margin );
 CREATE INDEX node_last_ip ON nodes ( last_net );
+CREATE INDEX pending_objects_created_at_index ON pending_objects ( created_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );`
@@ -678,10 +723,36 @@ CREATE TABLE certRecords (
 	update_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE graceful_exit_progress (
+	node_id BLOB NOT NULL,
+	bytes_transferred INTEGER NOT NULL,
+	pieces_transferred INTEGER NOT NULL,
+	pieces_failed INTEGER NOT NULL,
+	exit_initiated_at TIMESTAMP NOT NULL,
+	exit_loop_completed_at TIMESTAMP,
+	exit_finished_at TIMESTAMP,
+	exit_success INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE graceful_exit_transfer_queue (
+	node_id BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_num INTEGER NOT NULL,
+	durability_ratio REAL NOT NULL,
+	queued_at TIMESTAMP NOT NULL,
+	last_failed_at TIMESTAMP,
+	last_failed_code INTEGER,
+	failed_count INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE injuredsegments (
 	path BLOB NOT NULL,
 	data BLOB NOT NULL,
 	attempted TIMESTAMP,
+	num_healthy_pieces INTEGER NOT NULL,
+	redundancy_margin INTEGER NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -749,11 +820,23 @@ CREATE TABLE pending_audits (
 	reverify_count INTEGER NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE pending_objects (
+	stream_id BLOB NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	encrypted_path BLOB NOT NULL,
+	redundancy BLOB NOT NULL,
+	segment_count INTEGER NOT NULL,
+	expires_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( stream_id )
+);
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
 	usage_limit INTEGER NOT NULL,
+	bandwidth_limit INTEGER NOT NULL,
 	partner_id BLOB,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
@@ -842,6 +925,11 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares INTEGER NOT NULL,
 	default_redundancy_optimal_shares INTEGER NOT NULL,
 	default_redundancy_total_shares INTEGER NOT NULL,
+	versioning INTEGER NOT NULL,
+	lifecycle_rules BLOB,
+	placement TEXT,
+	storage_limit INTEGER,
+	egress_limit INTEGER,
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
@@ -895,7 +983,9 @@ CREATE TABLE project_payments (
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX injuredsegments_attempted_index ON injuredsegments ( attempted );
+CREATE INDEX injuredsegments_redundancy_margin_index ON injuredsegments ( redundancy_margin );
 CREATE INDEX node_last_ip ON nodes ( last_net );
+CREATE INDEX pending_objects_created_at_index ON pending_objects ( created_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );`
@@ -1875,408 +1965,909 @@ func (f CertRecord_UpdateAt_Field) value() interface{} {
 
 func (CertRecord_UpdateAt_Field) _Column() string { return "update_at" }
 
-type Injuredsegment struct {
-	Path      []byte
-	Data      []byte
-	Attempted *time.Time
+type GracefulExitProgress struct {
+	NodeId              []byte
+	BytesTransferred    int64
+	PiecesTransferred   int64
+	PiecesFailed        int64
+	ExitInitiatedAt     time.Time
+	ExitLoopCompletedAt *time.Time
+	ExitFinishedAt      *time.Time
+	ExitSuccess         bool
+	UpdatedAt           time.Time
 }
 
-func (Injuredsegment) _Table() string { return "injuredsegments" }
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
 
-type Injuredsegment_Create_Fields struct {
-	Attempted Injuredsegment_Attempted_Field
+type GracefulExitProgress_Create_Fields struct {
+	ExitLoopCompletedAt GracefulExitProgress_ExitLoopCompletedAt_Field
+	ExitFinishedAt      GracefulExitProgress_ExitFinishedAt_Field
 }
 
-type Injuredsegment_Update_Fields struct {
-	Attempted Injuredsegment_Attempted_Field
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred    GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred   GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed        GracefulExitProgress_PiecesFailed_Field
+	ExitLoopCompletedAt GracefulExitProgress_ExitLoopCompletedAt_Field
+	ExitFinishedAt      GracefulExitProgress_ExitFinishedAt_Field
+	ExitSuccess         GracefulExitProgress_ExitSuccess_Field
 }
 
-type Injuredsegment_Path_Field struct {
+type GracefulExitProgress_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Injuredsegment_Path(v []byte) Injuredsegment_Path_Field {
-	return Injuredsegment_Path_Field{_set: true, _value: v}
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Path_Field) value() interface{} {
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Path_Field) _Column() string { return "path" }
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
 
-type Injuredsegment_Data_Field struct {
+type GracefulExitProgress_BytesTransferred_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
-	return Injuredsegment_Data_Field{_set: true, _value: v}
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Data_Field) value() interface{} {
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Data_Field) _Column() string { return "data" }
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
 
-type Injuredsegment_Attempted_Field struct {
+type GracefulExitProgress_PiecesTransferred_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int64
 }
 
-func Injuredsegment_Attempted(v time.Time) Injuredsegment_Attempted_Field {
-	v = toUTC(v)
-	return Injuredsegment_Attempted_Field{_set: true, _value: &v}
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
 }
 
-func Injuredsegment_Attempted_Raw(v *time.Time) Injuredsegment_Attempted_Field {
-	if v == nil {
-		return Injuredsegment_Attempted_Null()
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Injuredsegment_Attempted(*v)
+	return f._value
 }
 
-func Injuredsegment_Attempted_Null() Injuredsegment_Attempted_Field {
-	return Injuredsegment_Attempted_Field{_set: true, _null: true}
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f Injuredsegment_Attempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+}
 
-func (f Injuredsegment_Attempted_Field) value() interface{} {
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Attempted_Field) _Column() string { return "attempted" }
-
-type Irreparabledb struct {
-	Segmentpath        []byte
-	Segmentdetail      []byte
-	PiecesLostCount    int64
-	SegDamagedUnixSec  int64
-	RepairAttemptCount int64
-}
-
-func (Irreparabledb) _Table() string { return "irreparabledbs" }
-
-type Irreparabledb_Update_Fields struct {
-	Segmentdetail      Irreparabledb_Segmentdetail_Field
-	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
-	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
-	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
-}
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
 
-type Irreparabledb_Segmentpath_Field struct {
+type GracefulExitProgress_ExitInitiatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
-	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+func GracefulExitProgress_ExitInitiatedAt(v time.Time) GracefulExitProgress_ExitInitiatedAt_Field {
+	return GracefulExitProgress_ExitInitiatedAt_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+func (f GracefulExitProgress_ExitInitiatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+func (GracefulExitProgress_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
 
-type Irreparabledb_Segmentdetail_Field struct {
+type GracefulExitProgress_ExitLoopCompletedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
-	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+func GracefulExitProgress_ExitLoopCompletedAt(v time.Time) GracefulExitProgress_ExitLoopCompletedAt_Field {
+	return GracefulExitProgress_ExitLoopCompletedAt_Field{_set: true, _value: &v}
 }
 
-func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+func GracefulExitProgress_ExitLoopCompletedAt_Raw(v *time.Time) GracefulExitProgress_ExitLoopCompletedAt_Field {
+	if v == nil {
+		return GracefulExitProgress_ExitLoopCompletedAt_Null()
+	}
+	return GracefulExitProgress_ExitLoopCompletedAt(*v)
+}
+
+func GracefulExitProgress_ExitLoopCompletedAt_Null() GracefulExitProgress_ExitLoopCompletedAt_Field {
+	return GracefulExitProgress_ExitLoopCompletedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitProgress_ExitLoopCompletedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitProgress_ExitLoopCompletedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+func (GracefulExitProgress_ExitLoopCompletedAt_Field) _Column() string {
+	return "exit_loop_completed_at"
+}
 
-type Irreparabledb_PiecesLostCount_Field struct {
+type GracefulExitProgress_ExitFinishedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
-	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+func GracefulExitProgress_ExitFinishedAt(v time.Time) GracefulExitProgress_ExitFinishedAt_Field {
+	return GracefulExitProgress_ExitFinishedAt_Field{_set: true, _value: &v}
 }
 
-func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+func GracefulExitProgress_ExitFinishedAt_Raw(v *time.Time) GracefulExitProgress_ExitFinishedAt_Field {
+	if v == nil {
+		return GracefulExitProgress_ExitFinishedAt_Null()
+	}
+	return GracefulExitProgress_ExitFinishedAt(*v)
+}
+
+func GracefulExitProgress_ExitFinishedAt_Null() GracefulExitProgress_ExitFinishedAt_Field {
+	return GracefulExitProgress_ExitFinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitProgress_ExitFinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitProgress_ExitFinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+func (GracefulExitProgress_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
 
-type Irreparabledb_SegDamagedUnixSec_Field struct {
+type GracefulExitProgress_ExitSuccess_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value bool
 }
 
-func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
-	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+func GracefulExitProgress_ExitSuccess(v bool) GracefulExitProgress_ExitSuccess_Field {
+	return GracefulExitProgress_ExitSuccess_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+func (f GracefulExitProgress_ExitSuccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+func (GracefulExitProgress_ExitSuccess_Field) _Column() string { return "exit_success" }
 
-type Irreparabledb_RepairAttemptCount_Field struct {
+type GracefulExitProgress_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
-	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node struct {
-	Id                    []byte
-	Address               string
-	LastNet               string
-	Protocol              int
-	Type                  int
-	Email                 string
-	Wallet                string
-	FreeBandwidth         int64
-	FreeDisk              int64
-	Major                 int64
-	Minor                 int64
-	Patch                 int64
-	Hash                  string
-	Timestamp             time.Time
-	Release               bool
-	Latency90             int64
-	AuditSuccessCount     int64
-	TotalAuditCount       int64
-	UptimeSuccessCount    int64
-	TotalUptimeCount      int64
-	CreatedAt             time.Time
-	UpdatedAt             time.Time
-	LastContactSuccess    time.Time
-	LastContactFailure    time.Time
-	Contained             bool
-	Disqualified          *time.Time
-	AuditReputationAlpha  float64
-	AuditReputationBeta   float64
-	UptimeReputationAlpha float64
-	UptimeReputationBeta  float64
+type GracefulExitTransferQueue struct {
+	NodeId          []byte
+	PieceId         []byte
+	Path            []byte
+	PieceNum        int
+	DurabilityRatio float64
+	QueuedAt        time.Time
+	LastFailedAt    *time.Time
+	LastFailedCode  *int
+	FailedCount     int
 }
 
-func (Node) _Table() string { return "nodes" }
+func (GracefulExitTransferQueue) _Table() string { return "graceful_exit_transfer_queue" }
 
-type Node_Create_Fields struct {
-	Disqualified Node_Disqualified_Field
+type GracefulExitTransferQueue_Create_Fields struct {
+	LastFailedAt   GracefulExitTransferQueue_LastFailedAt_Field
+	LastFailedCode GracefulExitTransferQueue_LastFailedCode_Field
 }
 
-type Node_Update_Fields struct {
-	Address               Node_Address_Field
-	LastNet               Node_LastNet_Field
-	Protocol              Node_Protocol_Field
-	Type                  Node_Type_Field
-	Email                 Node_Email_Field
-	Wallet                Node_Wallet_Field
-	FreeBandwidth         Node_FreeBandwidth_Field
-	FreeDisk              Node_FreeDisk_Field
-	Major                 Node_Major_Field
-	Minor                 Node_Minor_Field
-	Patch                 Node_Patch_Field
-	Hash                  Node_Hash_Field
-	Timestamp             Node_Timestamp_Field
-	Release               Node_Release_Field
-	Latency90             Node_Latency90_Field
-	AuditSuccessCount     Node_AuditSuccessCount_Field
-	TotalAuditCount       Node_TotalAuditCount_Field
-	UptimeSuccessCount    Node_UptimeSuccessCount_Field
-	TotalUptimeCount      Node_TotalUptimeCount_Field
-	LastContactSuccess    Node_LastContactSuccess_Field
-	LastContactFailure    Node_LastContactFailure_Field
-	Contained             Node_Contained_Field
-	Disqualified          Node_Disqualified_Field
-	AuditReputationAlpha  Node_AuditReputationAlpha_Field
-	AuditReputationBeta   Node_AuditReputationBeta_Field
-	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
-	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+type GracefulExitTransferQueue_Update_Fields struct {
+	LastFailedAt   GracefulExitTransferQueue_LastFailedAt_Field
+	LastFailedCode GracefulExitTransferQueue_LastFailedCode_Field
+	FailedCount    GracefulExitTransferQueue_FailedCount_Field
 }
 
-type Node_Id_Field struct {
+type GracefulExitTransferQueue_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_NodeId(v []byte) GracefulExitTransferQueue_NodeId_Field {
+	return GracefulExitTransferQueue_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f GracefulExitTransferQueue_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (GracefulExitTransferQueue_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_Address_Field struct {
+type GracefulExitTransferQueue_PieceId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_PieceId(v []byte) GracefulExitTransferQueue_PieceId_Field {
+	return GracefulExitTransferQueue_PieceId_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f GracefulExitTransferQueue_PieceId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (GracefulExitTransferQueue_PieceId_Field) _Column() string { return "piece_id" }
 
-type Node_LastNet_Field struct {
+type GracefulExitTransferQueue_Path_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_LastNet(v string) Node_LastNet_Field {
-	return Node_LastNet_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_Path(v []byte) GracefulExitTransferQueue_Path_Field {
+	return GracefulExitTransferQueue_Path_Field{_set: true, _value: v}
 }
 
-func (f Node_LastNet_Field) value() interface{} {
+func (f GracefulExitTransferQueue_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastNet_Field) _Column() string { return "last_net" }
+func (GracefulExitTransferQueue_Path_Field) _Column() string { return "path" }
 
-type Node_Protocol_Field struct {
+type GracefulExitTransferQueue_PieceNum_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_PieceNum(v int) GracefulExitTransferQueue_PieceNum_Field {
+	return GracefulExitTransferQueue_PieceNum_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f GracefulExitTransferQueue_PieceNum_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (GracefulExitTransferQueue_PieceNum_Field) _Column() string { return "piece_num" }
 
-type Node_Type_Field struct {
+type GracefulExitTransferQueue_DurabilityRatio_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value float64
 }
 
-func Node_Type(v int) Node_Type_Field {
-	return Node_Type_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_DurabilityRatio(v float64) GracefulExitTransferQueue_DurabilityRatio_Field {
+	return GracefulExitTransferQueue_DurabilityRatio_Field{_set: true, _value: v}
 }
 
-func (f Node_Type_Field) value() interface{} {
+func (f GracefulExitTransferQueue_DurabilityRatio_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Type_Field) _Column() string { return "type" }
+func (GracefulExitTransferQueue_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
 
-type Node_Email_Field struct {
+type GracefulExitTransferQueue_QueuedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_QueuedAt(v time.Time) GracefulExitTransferQueue_QueuedAt_Field {
+	v = toUTC(v)
+	return GracefulExitTransferQueue_QueuedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func (f GracefulExitTransferQueue_QueuedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (GracefulExitTransferQueue_QueuedAt_Field) _Column() string { return "queued_at" }
 
-type Node_Wallet_Field struct {
+type GracefulExitTransferQueue_LastFailedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *time.Time
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func GracefulExitTransferQueue_LastFailedAt(v time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
+	v = toUTC(v)
+	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _value: &v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func GracefulExitTransferQueue_LastFailedAt_Raw(v *time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_LastFailedAt_Null()
+	}
+	return GracefulExitTransferQueue_LastFailedAt(*v)
+}
+
+func GracefulExitTransferQueue_LastFailedAt_Null() GracefulExitTransferQueue_LastFailedAt_Field {
+	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_LastFailedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (GracefulExitTransferQueue_LastFailedAt_Field) _Column() string { return "last_failed_at" }
 
-type Node_FreeBandwidth_Field struct {
+type GracefulExitTransferQueue_LastFailedCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitTransferQueue_LastFailedCode(v int) GracefulExitTransferQueue_LastFailedCode_Field {
+	return GracefulExitTransferQueue_LastFailedCode_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_LastFailedCode_Raw(v *int) GracefulExitTransferQueue_LastFailedCode_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_LastFailedCode_Null()
+	}
+	return GracefulExitTransferQueue_LastFailedCode(*v)
+}
+
+func GracefulExitTransferQueue_LastFailedCode_Null() GracefulExitTransferQueue_LastFailedCode_Field {
+	return GracefulExitTransferQueue_LastFailedCode_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_LastFailedCode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_LastFailedCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+
+type GracefulExitTransferQueue_FailedCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitTransferQueue_FailedCount(v int) GracefulExitTransferQueue_FailedCount_Field {
+	return GracefulExitTransferQueue_FailedCount_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_FailedCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_FailedCount_Field) _Column() string { return "failed_count" }
+
+type Injuredsegment struct {
+	Path             []byte
+	Data             []byte
+	Attempted        *time.Time
+	NumHealthyPieces int
+	RedundancyMargin int
+}
+
+func (Injuredsegment) _Table() string { return "injuredsegments" }
+
+type Injuredsegment_Create_Fields struct {
+	Attempted Injuredsegment_Attempted_Field
+}
+
+type Injuredsegment_Update_Fields struct {
+	Attempted        Injuredsegment_Attempted_Field
+	NumHealthyPieces Injuredsegment_NumHealthyPieces_Field
+	RedundancyMargin Injuredsegment_RedundancyMargin_Field
+}
+
+type Injuredsegment_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Injuredsegment_Path(v []byte) Injuredsegment_Path_Field {
+	return Injuredsegment_Path_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Path_Field) _Column() string { return "path" }
+
+type Injuredsegment_Data_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
+	return Injuredsegment_Data_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Data_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Data_Field) _Column() string { return "data" }
+
+type Injuredsegment_Attempted_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Injuredsegment_Attempted(v time.Time) Injuredsegment_Attempted_Field {
+	v = toUTC(v)
+	return Injuredsegment_Attempted_Field{_set: true, _value: &v}
+}
+
+func Injuredsegment_Attempted_Raw(v *time.Time) Injuredsegment_Attempted_Field {
+	if v == nil {
+		return Injuredsegment_Attempted_Null()
+	}
+	return Injuredsegment_Attempted(*v)
+}
+
+func Injuredsegment_Attempted_Null() Injuredsegment_Attempted_Field {
+	return Injuredsegment_Attempted_Field{_set: true, _null: true}
+}
+
+func (f Injuredsegment_Attempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Injuredsegment_Attempted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Attempted_Field) _Column() string { return "attempted" }
+
+type Injuredsegment_NumHealthyPieces_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Injuredsegment_NumHealthyPieces(v int) Injuredsegment_NumHealthyPieces_Field {
+	return Injuredsegment_NumHealthyPieces_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_NumHealthyPieces_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_NumHealthyPieces_Field) _Column() string { return "num_healthy_pieces" }
+
+type Injuredsegment_RedundancyMargin_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Injuredsegment_RedundancyMargin(v int) Injuredsegment_RedundancyMargin_Field {
+	return Injuredsegment_RedundancyMargin_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_RedundancyMargin_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_RedundancyMargin_Field) _Column() string { return "redundancy_margin" }
+
+type Irreparabledb struct {
+	Segmentpath        []byte
+	Segmentdetail      []byte
+	PiecesLostCount    int64
+	SegDamagedUnixSec  int64
+	RepairAttemptCount int64
+}
+
+func (Irreparabledb) _Table() string { return "irreparabledbs" }
+
+type Irreparabledb_Update_Fields struct {
+	Segmentdetail      Irreparabledb_Segmentdetail_Field
+	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
+	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
+	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+}
+
+type Irreparabledb_Segmentpath_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
+	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+
+type Irreparabledb_Segmentdetail_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
+	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+
+type Irreparabledb_PiecesLostCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
+	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+
+type Irreparabledb_SegDamagedUnixSec_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
+	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+
+type Irreparabledb_RepairAttemptCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
+	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+
+type Node struct {
+	Id                    []byte
+	Address               string
+	LastNet               string
+	Protocol              int
+	Type                  int
+	Email                 string
+	Wallet                string
+	FreeBandwidth         int64
+	FreeDisk              int64
+	Major                 int64
+	Minor                 int64
+	Patch                 int64
+	Hash                  string
+	Timestamp             time.Time
+	Release               bool
+	Latency90             int64
+	AuditSuccessCount     int64
+	TotalAuditCount       int64
+	UptimeSuccessCount    int64
+	TotalUptimeCount      int64
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	LastContactSuccess    time.Time
+	LastContactFailure    time.Time
+	Contained             bool
+	Disqualified          *time.Time
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Create_Fields struct {
+	Disqualified Node_Disqualified_Field
+}
+
+type Node_Update_Fields struct {
+	Address               Node_Address_Field
+	LastNet               Node_LastNet_Field
+	Protocol              Node_Protocol_Field
+	Type                  Node_Type_Field
+	Email                 Node_Email_Field
+	Wallet                Node_Wallet_Field
+	FreeBandwidth         Node_FreeBandwidth_Field
+	FreeDisk              Node_FreeDisk_Field
+	Major                 Node_Major_Field
+	Minor                 Node_Minor_Field
+	Patch                 Node_Patch_Field
+	Hash                  Node_Hash_Field
+	Timestamp             Node_Timestamp_Field
+	Release               Node_Release_Field
+	Latency90             Node_Latency90_Field
+	AuditSuccessCount     Node_AuditSuccessCount_Field
+	TotalAuditCount       Node_TotalAuditCount_Field
+	UptimeSuccessCount    Node_UptimeSuccessCount_Field
+	TotalUptimeCount      Node_TotalUptimeCount_Field
+	LastContactSuccess    Node_LastContactSuccess_Field
+	LastContactFailure    Node_LastContactFailure_Field
+	Contained             Node_Contained_Field
+	Disqualified          Node_Disqualified_Field
+	AuditReputationAlpha  Node_AuditReputationAlpha_Field
+	AuditReputationBeta   Node_AuditReputationBeta_Field
+	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
+}
+
+func (f Node_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Address_Field) _Column() string { return "address" }
+
+type Node_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
+}
+
+func (f Node_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastNet_Field) _Column() string { return "last_net" }
+
+type Node_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
+}
+
+func (f Node_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Protocol_Field) _Column() string { return "protocol" }
+
+type Node_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Type(v int) Node_Type_Field {
+	return Node_Type_Field{_set: true, _value: v}
+}
+
+func (f Node_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Type_Field) _Column() string { return "type" }
+
+type Node_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
+}
+
+func (f Node_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Email_Field) _Column() string { return "email" }
+
+type Node_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
+}
+
+func (f Node_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Wallet_Field) _Column() string { return "wallet" }
+
+type Node_FreeBandwidth_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
@@ -2998,177 +3589,364 @@ func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
 type Offer_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int
+}
+
+func Offer_Status(v int) Offer_Status_Field {
+	return Offer_Status_Field{_set: true, _value: v}
+}
+
+func (f Offer_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Status_Field) _Column() string { return "status" }
+
+type Offer_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Offer_Type(v int) Offer_Type_Field {
+	return Offer_Type_Field{_set: true, _value: v}
+}
+
+func (f Offer_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Offer_Type_Field) _Column() string { return "type" }
+
+type PendingAudits struct {
+	NodeId            []byte
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
+}
+
+func (PendingAudits) _Table() string { return "pending_audits" }
+
+type PendingAudits_Update_Fields struct {
+	ReverifyCount PendingAudits_ReverifyCount_Field
+}
+
+type PendingAudits_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_NodeId(v []byte) PendingAudits_NodeId_Field {
+	return PendingAudits_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_NodeId_Field) _Column() string { return "node_id" }
+
+type PendingAudits_PieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_PieceId(v []byte) PendingAudits_PieceId_Field {
+	return PendingAudits_PieceId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_PieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+
+type PendingAudits_StripeIndex_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_StripeIndex(v int64) PendingAudits_StripeIndex_Field {
+	return PendingAudits_StripeIndex_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_StripeIndex_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+
+type PendingAudits_ShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_ShareSize(v int64) PendingAudits_ShareSize_Field {
+	return PendingAudits_ShareSize_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+
+type PendingAudits_ExpectedShareHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_ExpectedShareHash(v []byte) PendingAudits_ExpectedShareHash_Field {
+	return PendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ExpectedShareHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+
+type PendingAudits_ReverifyCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_ReverifyCount(v int64) PendingAudits_ReverifyCount_Field {
+	return PendingAudits_ReverifyCount_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ReverifyCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+
+type PendingObject struct {
+	StreamId      []byte
+	ProjectId     []byte
+	BucketName    []byte
+	EncryptedPath []byte
+	Redundancy    []byte
+	SegmentCount  int64
+	ExpiresAt     *time.Time
+	CreatedAt     time.Time
+}
+
+func (PendingObject) _Table() string { return "pending_objects" }
+
+type PendingObject_Create_Fields struct {
+	ExpiresAt PendingObject_ExpiresAt_Field
+}
+
+type PendingObject_Update_Fields struct {
+	SegmentCount PendingObject_SegmentCount_Field
+}
+
+type PendingObject_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func Offer_Status(v int) Offer_Status_Field {
-	return Offer_Status_Field{_set: true, _value: v}
+func PendingObject_StreamId(v []byte) PendingObject_StreamId_Field {
+	return PendingObject_StreamId_Field{_set: true, _value: v}
 }
 
-func (f Offer_Status_Field) value() interface{} {
+func (f PendingObject_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Status_Field) _Column() string { return "status" }
+func (PendingObject_StreamId_Field) _Column() string { return "stream_id" }
 
-type Offer_Type_Field struct {
+type PendingObject_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_Type(v int) Offer_Type_Field {
-	return Offer_Type_Field{_set: true, _value: v}
+func PendingObject_ProjectId(v []byte) PendingObject_ProjectId_Field {
+	return PendingObject_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Offer_Type_Field) value() interface{} {
+func (f PendingObject_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Type_Field) _Column() string { return "type" }
-
-type PendingAudits struct {
-	NodeId            []byte
-	PieceId           []byte
-	StripeIndex       int64
-	ShareSize         int64
-	ExpectedShareHash []byte
-	ReverifyCount     int64
-}
-
-func (PendingAudits) _Table() string { return "pending_audits" }
-
-type PendingAudits_Update_Fields struct {
-	ReverifyCount PendingAudits_ReverifyCount_Field
-}
+func (PendingObject_ProjectId_Field) _Column() string { return "project_id" }
 
-type PendingAudits_NodeId_Field struct {
+type PendingObject_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func PendingAudits_NodeId(v []byte) PendingAudits_NodeId_Field {
-	return PendingAudits_NodeId_Field{_set: true, _value: v}
+func PendingObject_BucketName(v []byte) PendingObject_BucketName_Field {
+	return PendingObject_BucketName_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_NodeId_Field) value() interface{} {
+func (f PendingObject_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_NodeId_Field) _Column() string { return "node_id" }
+func (PendingObject_BucketName_Field) _Column() string { return "bucket_name" }
 
-type PendingAudits_PieceId_Field struct {
+type PendingObject_EncryptedPath_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func PendingAudits_PieceId(v []byte) PendingAudits_PieceId_Field {
-	return PendingAudits_PieceId_Field{_set: true, _value: v}
+func PendingObject_EncryptedPath(v []byte) PendingObject_EncryptedPath_Field {
+	return PendingObject_EncryptedPath_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_PieceId_Field) value() interface{} {
+func (f PendingObject_EncryptedPath_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+func (PendingObject_EncryptedPath_Field) _Column() string { return "encrypted_path" }
 
-type PendingAudits_StripeIndex_Field struct {
+type PendingObject_Redundancy_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func PendingAudits_StripeIndex(v int64) PendingAudits_StripeIndex_Field {
-	return PendingAudits_StripeIndex_Field{_set: true, _value: v}
+func PendingObject_Redundancy(v []byte) PendingObject_Redundancy_Field {
+	return PendingObject_Redundancy_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_StripeIndex_Field) value() interface{} {
+func (f PendingObject_Redundancy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+func (PendingObject_Redundancy_Field) _Column() string { return "redundancy" }
 
-type PendingAudits_ShareSize_Field struct {
+type PendingObject_SegmentCount_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func PendingAudits_ShareSize(v int64) PendingAudits_ShareSize_Field {
-	return PendingAudits_ShareSize_Field{_set: true, _value: v}
+func PendingObject_SegmentCount(v int64) PendingObject_SegmentCount_Field {
+	return PendingObject_SegmentCount_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_ShareSize_Field) value() interface{} {
+func (f PendingObject_SegmentCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+func (PendingObject_SegmentCount_Field) _Column() string { return "segment_count" }
 
-type PendingAudits_ExpectedShareHash_Field struct {
+type PendingObject_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func PendingAudits_ExpectedShareHash(v []byte) PendingAudits_ExpectedShareHash_Field {
-	return PendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+func PendingObject_ExpiresAt(v time.Time) PendingObject_ExpiresAt_Field {
+	return PendingObject_ExpiresAt_Field{_set: true, _value: &v}
 }
 
-func (f PendingAudits_ExpectedShareHash_Field) value() interface{} {
+func PendingObject_ExpiresAt_Raw(v *time.Time) PendingObject_ExpiresAt_Field {
+	if v == nil {
+		return PendingObject_ExpiresAt_Null()
+	}
+	return PendingObject_ExpiresAt(*v)
+}
+
+func PendingObject_ExpiresAt_Null() PendingObject_ExpiresAt_Field {
+	return PendingObject_ExpiresAt_Field{_set: true, _null: true}
+}
+
+func (f PendingObject_ExpiresAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f PendingObject_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+func (PendingObject_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type PendingAudits_ReverifyCount_Field struct {
+type PendingObject_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func PendingAudits_ReverifyCount(v int64) PendingAudits_ReverifyCount_Field {
-	return PendingAudits_ReverifyCount_Field{_set: true, _value: v}
+func PendingObject_CreatedAt(v time.Time) PendingObject_CreatedAt_Field {
+	return PendingObject_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_ReverifyCount_Field) value() interface{} {
+func (f PendingObject_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (PendingObject_CreatedAt_Field) _Column() string { return "created_at" }
 
 type Project struct {
-	Id          []byte
-	Name        string
-	Description string
-	UsageLimit  int64
-	PartnerId   []byte
-	CreatedAt   time.Time
+	Id             []byte
+	Name           string
+	Description    string
+	UsageLimit     int64
+	BandwidthLimit int64
+	PartnerId      []byte
+	CreatedAt      time.Time
 }
 
 func (Project) _Table() string { return "projects" }
@@ -3178,8 +3956,9 @@ type Project_Create_Fields struct {
 }
 
 type Project_Update_Fields struct {
-	Description Project_Description_Field
-	UsageLimit  Project_UsageLimit_Field
+	Description    Project_Description_Field
+	UsageLimit     Project_UsageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
 }
 
 type Project_Id_Field struct {
@@ -3258,6 +4037,25 @@ func (f Project_UsageLimit_Field) value() interface{} {
 
 func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
 
+type Project_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: v}
+}
+
+func (f Project_BandwidthLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+
 type Project_PartnerId_Field struct {
 	_set   bool
 	_null  bool
@@ -4267,12 +5065,21 @@ type BucketMetainfo struct {
 	DefaultRedundancyRepairShares   int
 	DefaultRedundancyOptimalShares  int
 	DefaultRedundancyTotalShares    int
+	Versioning                      bool
+	LifecycleRules                  *[]byte
+	Placement                       *string
+	StorageLimit                    *int64
+	EgressLimit                     *int64
 }
 
 func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
 
 type BucketMetainfo_Create_Fields struct {
-	PartnerId BucketMetainfo_PartnerId_Field
+	PartnerId      BucketMetainfo_PartnerId_Field
+	LifecycleRules BucketMetainfo_LifecycleRules_Field
+	Placement      BucketMetainfo_Placement_Field
+	StorageLimit   BucketMetainfo_StorageLimit_Field
+	EgressLimit    BucketMetainfo_EgressLimit_Field
 }
 
 type BucketMetainfo_Update_Fields struct {
@@ -4285,6 +5092,10 @@ type BucketMetainfo_Update_Fields struct {
 	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
 	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
 	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
+	Versioning                      BucketMetainfo_Versioning_Field
+	LifecycleRules                  BucketMetainfo_LifecycleRules_Field
+	StorageLimit                    BucketMetainfo_StorageLimit_Field
+	EgressLimit                     BucketMetainfo_EgressLimit_Field
 }
 
 type BucketMetainfo_Id_Field struct {
@@ -4601,6 +5412,171 @@ func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
 	return "default_redundancy_total_shares"
 }
 
+type BucketMetainfo_Versioning_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func BucketMetainfo_Versioning(v bool) BucketMetainfo_Versioning_Field {
+	return BucketMetainfo_Versioning_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_Versioning_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Versioning_Field) _Column() string {
+	return "versioning"
+}
+
+type BucketMetainfo_LifecycleRules_Field struct {
+	_set   bool
+	_null  bool
+	_value *[]byte
+}
+
+func BucketMetainfo_LifecycleRules(v []byte) BucketMetainfo_LifecycleRules_Field {
+	return BucketMetainfo_LifecycleRules_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_LifecycleRules_Raw(v *[]byte) BucketMetainfo_LifecycleRules_Field {
+	if v == nil {
+		return BucketMetainfo_LifecycleRules_Null()
+	}
+	return BucketMetainfo_LifecycleRules(*v)
+}
+
+func BucketMetainfo_LifecycleRules_Null() BucketMetainfo_LifecycleRules_Field {
+	return BucketMetainfo_LifecycleRules_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_LifecycleRules_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_LifecycleRules_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_LifecycleRules_Field) _Column() string {
+	return "lifecycle_rules"
+}
+
+type BucketMetainfo_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func BucketMetainfo_Placement(v string) BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_Placement_Raw(v *string) BucketMetainfo_Placement_Field {
+	if v == nil {
+		return BucketMetainfo_Placement_Null()
+	}
+	return BucketMetainfo_Placement(*v)
+}
+
+func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_Placement_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_Placement_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Placement_Field) _Column() string {
+	return "placement"
+}
+
+type BucketMetainfo_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketMetainfo_StorageLimit(v int64) BucketMetainfo_StorageLimit_Field {
+	return BucketMetainfo_StorageLimit_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_StorageLimit_Raw(v *int64) BucketMetainfo_StorageLimit_Field {
+	if v == nil {
+		return BucketMetainfo_StorageLimit_Null()
+	}
+	return BucketMetainfo_StorageLimit(*v)
+}
+
+func BucketMetainfo_StorageLimit_Null() BucketMetainfo_StorageLimit_Field {
+	return BucketMetainfo_StorageLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_StorageLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_StorageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_StorageLimit_Field) _Column() string {
+	return "storage_limit"
+}
+
+type BucketMetainfo_EgressLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketMetainfo_EgressLimit(v int64) BucketMetainfo_EgressLimit_Field {
+	return BucketMetainfo_EgressLimit_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_EgressLimit_Raw(v *int64) BucketMetainfo_EgressLimit_Field {
+	if v == nil {
+		return BucketMetainfo_EgressLimit_Null()
+	}
+	return BucketMetainfo_EgressLimit(*v)
+}
+
+func BucketMetainfo_EgressLimit_Null() BucketMetainfo_EgressLimit_Field {
+	return BucketMetainfo_EgressLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_EgressLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_EgressLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_EgressLimit_Field) _Column() string {
+	return "egress_limit"
+}
+
 type ProjectInvoiceStamp struct {
 	ProjectId []byte
 	InvoiceId []byte
@@ -5712,6 +6688,7 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
 	project_usage_limit Project_UsageLimit_Field,
+	project_bandwidth_limit Project_BandwidthLimit_Field,
 	optional Project_Create_Fields) (
 	project *Project, err error) {
 
@@ -5720,16 +6697,17 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 	__name_val := project_name.value()
 	__description_val := project_description.value()
 	__usage_limit_val := project_usage_limit.value()
+	__bandwidth_limit_val := project_bandwidth_limit.value()
 	__partner_id_val := optional.PartnerId.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, partner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, bandwidth_limit, partner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __partner_id_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __partner_id_val, __created_at_val)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __partner_id_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __partner_id_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6150,6 +7128,7 @@ func (obj *postgresImpl) Create_BucketMetainfo(ctx context.Context,
 	bucket_metainfo_default_redundancy_repair_shares BucketMetainfo_DefaultRedundancyRepairShares_Field,
 	bucket_metainfo_default_redundancy_optimal_shares BucketMetainfo_DefaultRedundancyOptimalShares_Field,
 	bucket_metainfo_default_redundancy_total_shares BucketMetainfo_DefaultRedundancyTotalShares_Field,
+	bucket_metainfo_versioning BucketMetainfo_Versioning_Field,
 	optional BucketMetainfo_Create_Fields) (
 	bucket_metainfo *BucketMetainfo, err error) {
 
@@ -6169,14 +7148,19 @@ func (obj *postgresImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_repair_shares_val := bucket_metainfo_default_redundancy_repair_shares.value()
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
+	__versioning_val := bucket_metainfo_versioning.value()
+	__lifecycle_rules_val := optional.LifecycleRules.value()
+	__placement_val := optional.Placement.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__egress_limit_val := optional.EgressLimit.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, versioning, lifecycle_rules, placement, storage_limit, egress_limit ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __versioning_val, __lifecycle_rules_val, __placement_val, __storage_limit_val, __egress_limit_val)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __versioning_val, __lifecycle_rules_val, __placement_val, __storage_limit_val, __egress_limit_val).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6184,6 +7168,40 @@ func (obj *postgresImpl) Create_BucketMetainfo(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Create_PendingObject(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field,
+	pending_object_project_id PendingObject_ProjectId_Field,
+	pending_object_bucket_name PendingObject_BucketName_Field,
+	pending_object_encrypted_path PendingObject_EncryptedPath_Field,
+	pending_object_redundancy PendingObject_Redundancy_Field,
+	pending_object_segment_count PendingObject_SegmentCount_Field,
+	optional PendingObject_Create_Fields) (
+	pending_object *PendingObject, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__stream_id_val := pending_object_stream_id.value()
+	__project_id_val := pending_object_project_id.value()
+	__bucket_name_val := pending_object_bucket_name.value()
+	__encrypted_path_val := pending_object_encrypted_path.value()
+	__redundancy_val := pending_object_redundancy.value()
+	__segment_count_val := pending_object_segment_count.value()
+	__expires_at_val := optional.ExpiresAt.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_objects ( stream_id, project_id, bucket_name, encrypted_path, redundancy, segment_count, expires_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __stream_id_val, __project_id_val, __bucket_name_val, __encrypted_path_val, __redundancy_val, __segment_count_val, __expires_at_val, __created_at_val)
+
+	pending_object = &PendingObject{}
+	err = obj.driver.QueryRow(__stmt, __stream_id_val, __project_id_val, __bucket_name_val, __encrypted_path_val, __redundancy_val, __segment_count_val, __expires_at_val, __created_at_val).Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_object, nil
+
+}
+
 func (obj *postgresImpl) Get_ValueAttribution_By_ProjectId_And_BucketName(ctx context.Context,
 	value_attribution_project_id ValueAttribution_ProjectId_Field,
 	value_attribution_bucket_name ValueAttribution_BucketName_Field) (
@@ -6575,7 +7593,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -6584,7 +7602,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6595,7 +7613,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 func (obj *postgresImpl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -6611,7 +7629,7 @@ func (obj *postgresImpl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6628,7 +7646,7 @@ func (obj *postgresImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 	project_created_at_less Project_CreatedAt_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -6644,7 +7662,7 @@ func (obj *postgresImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6661,7 +7679,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -6677,7 +7695,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7646,45 +8664,114 @@ func (obj *postgresImpl) Count_UserCredit_By_ReferredBy(ctx context.Context,
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-
-	return count, nil
+
+	return count, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	bucket_metainfo *BucketMetainfo, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_metainfo = &BucketMetainfo{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_metainfo, nil
+
+}
+
+func (obj *postgresImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name_greater_or_equal BucketMetainfo_Name_Field,
+	limit int, offset int64) (
+	rows []*BucketMetainfo, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_metainfo := &BucketMetainfo{}
+		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_metainfo)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+func (obj *postgresImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy_Asc_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name BucketMetainfo_Name_Field) (
-	bucket_metainfo *BucketMetainfo, err error) {
+	bucket_metainfo_name_greater BucketMetainfo_Name_Field,
+	limit int, offset int64) (
+	rows []*BucketMetainfo, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	bucket_metainfo = &BucketMetainfo{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return bucket_metainfo, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_metainfo := &BucketMetainfo{}
+		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_metainfo)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx context.Context,
-	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name_greater_or_equal BucketMetainfo_Name_Field,
-	limit int, offset int64) (
+func (obj *postgresImpl) All_BucketMetainfo_By_LifecycleRules_IsNot_Null(ctx context.Context) (
 	rows []*BucketMetainfo, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.lifecycle_rules is not NULL")
 
 	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
-
-	__values = append(__values, limit, offset)
+	__values = append(__values)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -7697,7 +8784,7 @@ func (obj *postgresImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrE
 
 	for __rows.Next() {
 		bucket_metainfo := &BucketMetainfo{}
-		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7710,16 +8797,36 @@ func (obj *postgresImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrE
 
 }
 
-func (obj *postgresImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy_Asc_Name(ctx context.Context,
-	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name_greater BucketMetainfo_Name_Field,
+func (obj *postgresImpl) Get_PendingObject_By_StreamId(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field) (
+	pending_object *PendingObject, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at FROM pending_objects WHERE pending_objects.stream_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_object_stream_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_object = &PendingObject{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_object, nil
+
+}
+
+func (obj *postgresImpl) Limited_PendingObject_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	pending_object_created_at_less PendingObject_CreatedAt_Field,
 	limit int, offset int64) (
-	rows []*BucketMetainfo, err error) {
+	rows []*PendingObject, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at FROM pending_objects WHERE pending_objects.created_at < ? ORDER BY pending_objects.created_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
+	__values = append(__values, pending_object_created_at_less.value())
 
 	__values = append(__values, limit, offset)
 
@@ -7733,12 +8840,12 @@ func (obj *postgresImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_Or
 	defer __rows.Close()
 
 	for __rows.Next() {
-		bucket_metainfo := &BucketMetainfo{}
-		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+		pending_object := &PendingObject{}
+		err = __rows.Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, bucket_metainfo)
+		rows = append(rows, pending_object)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -8119,7 +9226,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	project *Project, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -8135,6 +9242,11 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("usage_limit = ?"))
 	}
 
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -8148,7 +9260,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -8399,6 +9511,148 @@ func (obj *postgresImpl) Update_Offer_By_Id(ctx context.Context,
 	return offer, nil
 }
 
+func (obj *postgresImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field,
+	update BucketMetainfo_Update_Fields) (
+	bucket_metainfo *BucketMetainfo, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.DefaultSegmentSize._set {
+		__values = append(__values, update.DefaultSegmentSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_segment_size = ?"))
+	}
+
+	if update.DefaultEncryptionCipherSuite._set {
+		__values = append(__values, update.DefaultEncryptionCipherSuite.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_encryption_cipher_suite = ?"))
+	}
+
+	if update.DefaultEncryptionBlockSize._set {
+		__values = append(__values, update.DefaultEncryptionBlockSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_encryption_block_size = ?"))
+	}
+
+	if update.DefaultRedundancyAlgorithm._set {
+		__values = append(__values, update.DefaultRedundancyAlgorithm.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_algorithm = ?"))
+	}
+
+	if update.DefaultRedundancyShareSize._set {
+		__values = append(__values, update.DefaultRedundancyShareSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_share_size = ?"))
+	}
+
+	if update.DefaultRedundancyRequiredShares._set {
+		__values = append(__values, update.DefaultRedundancyRequiredShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_required_shares = ?"))
+	}
+
+	if update.DefaultRedundancyRepairShares._set {
+		__values = append(__values, update.DefaultRedundancyRepairShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_repair_shares = ?"))
+	}
+
+	if update.DefaultRedundancyOptimalShares._set {
+		__values = append(__values, update.DefaultRedundancyOptimalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_optimal_shares = ?"))
+	}
+
+	if update.DefaultRedundancyTotalShares._set {
+		__values = append(__values, update.DefaultRedundancyTotalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_total_shares = ?"))
+	}
+
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+
+	if update.LifecycleRules._set {
+		__values = append(__values, update.LifecycleRules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_rules = ?"))
+	}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.EgressLimit._set {
+		__values = append(__values, update.EgressLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_limit = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_metainfo = &BucketMetainfo{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_metainfo, nil
+}
+
+func (obj *postgresImpl) Update_PendingObject_By_StreamId_And_SegmentCount_Less(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field,
+	pending_object_segment_count_less PendingObject_SegmentCount_Field,
+	update PendingObject_Update_Fields) (
+	pending_object *PendingObject, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_objects SET "), __sets, __sqlbundle_Literal(" WHERE pending_objects.stream_id = ? AND pending_objects.segment_count < ? RETURNING pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.SegmentCount._set {
+		__values = append(__values, update.SegmentCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, pending_object_stream_id.value(), pending_object_segment_count_less.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_object = &PendingObject{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_object, nil
+}
+
 func (obj *postgresImpl) Delete_ValueAttribution_By_ProjectId_And_BucketName(ctx context.Context,
 	value_attribution_project_id ValueAttribution_ProjectId_Field,
 	value_attribution_bucket_name ValueAttribution_BucketName_Field) (
@@ -8818,6 +10072,32 @@ func (obj *postgresImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context
 
 }
 
+func (obj *postgresImpl) Delete_PendingObject_By_StreamId(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_objects WHERE pending_objects.stream_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_object_stream_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (impl postgresImpl) isConstraintError(err error) (
 	constraint string, ok bool) {
 	if e, ok := err.(*pq.Error); ok {
@@ -8986,6 +10266,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_objects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -9404,6 +10694,7 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
 	project_usage_limit Project_UsageLimit_Field,
+	project_bandwidth_limit Project_BandwidthLimit_Field,
 	optional Project_Create_Fields) (
 	project *Project, err error) {
 
@@ -9412,15 +10703,16 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	__name_val := project_name.value()
 	__description_val := project_description.value()
 	__usage_limit_val := project_usage_limit.value()
+	__bandwidth_limit_val := project_bandwidth_limit.value()
 	__partner_id_val := optional.PartnerId.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, partner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, usage_limit, bandwidth_limit, partner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __partner_id_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __partner_id_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __partner_id_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __partner_id_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9887,6 +11179,7 @@ func (obj *sqlite3Impl) Create_BucketMetainfo(ctx context.Context,
 	bucket_metainfo_default_redundancy_repair_shares BucketMetainfo_DefaultRedundancyRepairShares_Field,
 	bucket_metainfo_default_redundancy_optimal_shares BucketMetainfo_DefaultRedundancyOptimalShares_Field,
 	bucket_metainfo_default_redundancy_total_shares BucketMetainfo_DefaultRedundancyTotalShares_Field,
+	bucket_metainfo_versioning BucketMetainfo_Versioning_Field,
 	optional BucketMetainfo_Create_Fields) (
 	bucket_metainfo *BucketMetainfo, err error) {
 
@@ -9906,13 +11199,18 @@ func (obj *sqlite3Impl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_repair_shares_val := bucket_metainfo_default_redundancy_repair_shares.value()
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
+	__versioning_val := bucket_metainfo_versioning.value()
+	__lifecycle_rules_val := optional.LifecycleRules.value()
+	__placement_val := optional.Placement.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__egress_limit_val := optional.EgressLimit.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, versioning, lifecycle_rules, placement, storage_limit, egress_limit ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __versioning_val, __lifecycle_rules_val, __placement_val, __storage_limit_val, __egress_limit_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __versioning_val, __lifecycle_rules_val, __placement_val, __storage_limit_val, __egress_limit_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9924,6 +11222,43 @@ func (obj *sqlite3Impl) Create_BucketMetainfo(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Create_PendingObject(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field,
+	pending_object_project_id PendingObject_ProjectId_Field,
+	pending_object_bucket_name PendingObject_BucketName_Field,
+	pending_object_encrypted_path PendingObject_EncryptedPath_Field,
+	pending_object_redundancy PendingObject_Redundancy_Field,
+	pending_object_segment_count PendingObject_SegmentCount_Field,
+	optional PendingObject_Create_Fields) (
+	pending_object *PendingObject, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__stream_id_val := pending_object_stream_id.value()
+	__project_id_val := pending_object_project_id.value()
+	__bucket_name_val := pending_object_bucket_name.value()
+	__encrypted_path_val := pending_object_encrypted_path.value()
+	__redundancy_val := pending_object_redundancy.value()
+	__segment_count_val := pending_object_segment_count.value()
+	__expires_at_val := optional.ExpiresAt.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_objects ( stream_id, project_id, bucket_name, encrypted_path, redundancy, segment_count, expires_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __stream_id_val, __project_id_val, __bucket_name_val, __encrypted_path_val, __redundancy_val, __segment_count_val, __expires_at_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __stream_id_val, __project_id_val, __bucket_name_val, __encrypted_path_val, __redundancy_val, __segment_count_val, __expires_at_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastPendingObject(ctx, __pk)
+
+}
+
 func (obj *sqlite3Impl) Get_ValueAttribution_By_ProjectId_And_BucketName(ctx context.Context,
 	value_attribution_project_id ValueAttribution_ProjectId_Field,
 	value_attribution_bucket_name ValueAttribution_BucketName_Field) (
@@ -10315,7 +11650,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -10324,7 +11659,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -10335,7 +11670,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -10351,7 +11686,7 @@ func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -10368,7 +11703,7 @@ func (obj *sqlite3Impl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 	project_created_at_less Project_CreatedAt_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -10384,7 +11719,7 @@ func (obj *sqlite3Impl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -10401,7 +11736,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -10417,7 +11752,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -11396,7 +12731,7 @@ func (obj *sqlite3Impl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Con
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
 	bucket_metainfo *BucketMetainfo, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -11404,27 +12739,96 @@ func (obj *sqlite3Impl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Con
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	bucket_metainfo = &BucketMetainfo{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	bucket_metainfo = &BucketMetainfo{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_metainfo, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name_greater_or_equal BucketMetainfo_Name_Field,
+	limit int, offset int64) (
+	rows []*BucketMetainfo, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_metainfo := &BucketMetainfo{}
+		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_metainfo)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy_Asc_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name_greater BucketMetainfo_Name_Field,
+	limit int, offset int64) (
+	rows []*BucketMetainfo, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return bucket_metainfo, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_metainfo := &BucketMetainfo{}
+		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_metainfo)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx context.Context,
-	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name_greater_or_equal BucketMetainfo_Name_Field,
-	limit int, offset int64) (
+func (obj *sqlite3Impl) All_BucketMetainfo_By_LifecycleRules_IsNot_Null(ctx context.Context) (
 	rows []*BucketMetainfo, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.lifecycle_rules is not NULL")
 
 	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
-
-	__values = append(__values, limit, offset)
+	__values = append(__values)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -11437,7 +12841,7 @@ func (obj *sqlite3Impl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEq
 
 	for __rows.Next() {
 		bucket_metainfo := &BucketMetainfo{}
-		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -11450,16 +12854,36 @@ func (obj *sqlite3Impl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEq
 
 }
 
-func (obj *sqlite3Impl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy_Asc_Name(ctx context.Context,
-	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name_greater BucketMetainfo_Name_Field,
+func (obj *sqlite3Impl) Get_PendingObject_By_StreamId(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field) (
+	pending_object *PendingObject, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at FROM pending_objects WHERE pending_objects.stream_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_object_stream_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_object = &PendingObject{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_object, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_PendingObject_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	pending_object_created_at_less PendingObject_CreatedAt_Field,
 	limit int, offset int64) (
-	rows []*BucketMetainfo, err error) {
+	rows []*PendingObject, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at FROM pending_objects WHERE pending_objects.created_at < ? ORDER BY pending_objects.created_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
+	__values = append(__values, pending_object_created_at_less.value())
 
 	__values = append(__values, limit, offset)
 
@@ -11473,12 +12897,12 @@ func (obj *sqlite3Impl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_Ord
 	defer __rows.Close()
 
 	for __rows.Next() {
-		bucket_metainfo := &BucketMetainfo{}
-		err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+		pending_object := &PendingObject{}
+		err = __rows.Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, bucket_metainfo)
+		rows = append(rows, pending_object)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -11925,6 +13349,11 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("usage_limit = ?"))
 	}
 
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -11943,12 +13372,12 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -12249,6 +13678,168 @@ func (obj *sqlite3Impl) Update_Offer_By_Id(ctx context.Context,
 	return offer, nil
 }
 
+func (obj *sqlite3Impl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field,
+	update BucketMetainfo_Update_Fields) (
+	bucket_metainfo *BucketMetainfo, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.DefaultSegmentSize._set {
+		__values = append(__values, update.DefaultSegmentSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_segment_size = ?"))
+	}
+
+	if update.DefaultEncryptionCipherSuite._set {
+		__values = append(__values, update.DefaultEncryptionCipherSuite.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_encryption_cipher_suite = ?"))
+	}
+
+	if update.DefaultEncryptionBlockSize._set {
+		__values = append(__values, update.DefaultEncryptionBlockSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_encryption_block_size = ?"))
+	}
+
+	if update.DefaultRedundancyAlgorithm._set {
+		__values = append(__values, update.DefaultRedundancyAlgorithm.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_algorithm = ?"))
+	}
+
+	if update.DefaultRedundancyShareSize._set {
+		__values = append(__values, update.DefaultRedundancyShareSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_share_size = ?"))
+	}
+
+	if update.DefaultRedundancyRequiredShares._set {
+		__values = append(__values, update.DefaultRedundancyRequiredShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_required_shares = ?"))
+	}
+
+	if update.DefaultRedundancyRepairShares._set {
+		__values = append(__values, update.DefaultRedundancyRepairShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_repair_shares = ?"))
+	}
+
+	if update.DefaultRedundancyOptimalShares._set {
+		__values = append(__values, update.DefaultRedundancyOptimalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_optimal_shares = ?"))
+	}
+
+	if update.DefaultRedundancyTotalShares._set {
+		__values = append(__values, update.DefaultRedundancyTotalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_total_shares = ?"))
+	}
+
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+
+	if update.LifecycleRules._set {
+		__values = append(__values, update.LifecycleRules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_rules = ?"))
+	}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.EgressLimit._set {
+		__values = append(__values, update.EgressLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_limit = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_metainfo = &BucketMetainfo{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_metainfo, nil
+}
+
+func (obj *sqlite3Impl) Update_PendingObject_By_StreamId_And_SegmentCount_Less(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field,
+	pending_object_segment_count_less PendingObject_SegmentCount_Field,
+	update PendingObject_Update_Fields) (
+	pending_object *PendingObject, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_objects SET "), __sets, __sqlbundle_Literal(" WHERE pending_objects.stream_id = ? AND pending_objects.segment_count < ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.SegmentCount._set {
+		__values = append(__values, update.SegmentCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, pending_object_stream_id.value(), pending_object_segment_count_less.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_object = &PendingObject{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at FROM pending_objects WHERE pending_objects.stream_id = ? AND pending_objects.segment_count < ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_object, nil
+}
+
 func (obj *sqlite3Impl) Delete_ValueAttribution_By_ProjectId_And_BucketName(ctx context.Context,
 	value_attribution_project_id ValueAttribution_ProjectId_Field,
 	value_attribution_bucket_name ValueAttribution_BucketName_Field) (
@@ -12668,6 +14259,32 @@ func (obj *sqlite3Impl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.
 
 }
 
+func (obj *sqlite3Impl) Delete_PendingObject_By_StreamId(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_objects WHERE pending_objects.stream_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_object_stream_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *sqlite3Impl) getLastValueAttribution(ctx context.Context,
 	pk int64) (
 	value_attribution *ValueAttribution, err error) {
@@ -12816,13 +14433,13 @@ func (obj *sqlite3Impl) getLastProject(ctx context.Context,
 	pk int64) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.partner_id, projects.created_at FROM projects WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.partner_id, projects.created_at FROM projects WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.PartnerId, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.PartnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -13086,13 +14703,13 @@ func (obj *sqlite3Impl) getLastBucketMetainfo(ctx context.Context,
 	pk int64) (
 	bucket_metainfo *BucketMetainfo, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.egress_limit FROM bucket_metainfos WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.EgressLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -13100,6 +14717,24 @@ func (obj *sqlite3Impl) getLastBucketMetainfo(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) getLastPendingObject(ctx context.Context,
+	pk int64) (
+	pending_object *PendingObject, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_objects.stream_id, pending_objects.project_id, pending_objects.bucket_name, pending_objects.encrypted_path, pending_objects.redundancy, pending_objects.segment_count, pending_objects.expires_at, pending_objects.created_at FROM pending_objects WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	pending_object = &PendingObject{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&pending_object.StreamId, &pending_object.ProjectId, &pending_object.BucketName, &pending_object.EncryptedPath, &pending_object.Redundancy, &pending_object.SegmentCount, &pending_object.ExpiresAt, &pending_object.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_object, nil
+
+}
+
 func (impl sqlite3Impl) isConstraintError(err error) (
 	constraint string, ok bool) {
 	if e, ok := err.(sqlite3.Error); ok {
@@ -13273,6 +14908,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_objects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -13398,6 +15043,54 @@ type Rx struct {
 	tx *Tx
 }
 
+func (rx *Rx) Create_PendingObject(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field,
+	pending_object_project_id PendingObject_ProjectId_Field,
+	pending_object_bucket_name PendingObject_BucketName_Field,
+	pending_object_encrypted_path PendingObject_EncryptedPath_Field,
+	pending_object_redundancy PendingObject_Redundancy_Field,
+	pending_object_segment_count PendingObject_SegmentCount_Field,
+	optional PendingObject_Create_Fields) (
+	pending_object *PendingObject, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_PendingObject(ctx, pending_object_stream_id, pending_object_project_id, pending_object_bucket_name, pending_object_encrypted_path, pending_object_redundancy, pending_object_segment_count, optional)
+
+}
+
+func (rx *Rx) Delete_PendingObject_By_StreamId(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_PendingObject_By_StreamId(ctx, pending_object_stream_id)
+}
+
+func (rx *Rx) Get_PendingObject_By_StreamId(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field) (
+	pending_object *PendingObject, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_PendingObject_By_StreamId(ctx, pending_object_stream_id)
+}
+
+func (rx *Rx) Limited_PendingObject_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	pending_object_created_at_less PendingObject_CreatedAt_Field,
+	limit int, offset int64) (
+	rows []*PendingObject, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_PendingObject_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx, pending_object_created_at_less, limit, offset)
+}
+
 func (rx *Rx) UnsafeTx(ctx context.Context) (unsafe_tx *sql.Tx, err error) {
 	tx, err := rx.getTx(ctx)
 	if err != nil {
@@ -13455,6 +15148,15 @@ func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 	return tx.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, api_key_project_id)
 }
 
+func (rx *Rx) All_BucketMetainfo_By_LifecycleRules_IsNot_Null(ctx context.Context) (
+	rows []*BucketMetainfo, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BucketMetainfo_By_LifecycleRules_IsNot_Null(ctx)
+}
+
 func (rx *Rx) All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx context.Context,
 	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
 	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
@@ -13665,13 +15367,14 @@ func (rx *Rx) Create_BucketMetainfo(ctx context.Context,
 	bucket_metainfo_default_redundancy_repair_shares BucketMetainfo_DefaultRedundancyRepairShares_Field,
 	bucket_metainfo_default_redundancy_optimal_shares BucketMetainfo_DefaultRedundancyOptimalShares_Field,
 	bucket_metainfo_default_redundancy_total_shares BucketMetainfo_DefaultRedundancyTotalShares_Field,
+	bucket_metainfo_versioning BucketMetainfo_Versioning_Field,
 	optional BucketMetainfo_Create_Fields) (
 	bucket_metainfo *BucketMetainfo, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_BucketMetainfo(ctx, bucket_metainfo_id, bucket_metainfo_project_id, bucket_metainfo_name, bucket_metainfo_path_cipher, bucket_metainfo_default_segment_size, bucket_metainfo_default_encryption_cipher_suite, bucket_metainfo_default_encryption_block_size, bucket_metainfo_default_redundancy_algorithm, bucket_metainfo_default_redundancy_share_size, bucket_metainfo_default_redundancy_required_shares, bucket_metainfo_default_redundancy_repair_shares, bucket_metainfo_default_redundancy_optimal_shares, bucket_metainfo_default_redundancy_total_shares, optional)
+	return tx.Create_BucketMetainfo(ctx, bucket_metainfo_id, bucket_metainfo_project_id, bucket_metainfo_name, bucket_metainfo_path_cipher, bucket_metainfo_default_segment_size, bucket_metainfo_default_encryption_cipher_suite, bucket_metainfo_default_encryption_block_size, bucket_metainfo_default_redundancy_algorithm, bucket_metainfo_default_redundancy_share_size, bucket_metainfo_default_redundancy_required_shares, bucket_metainfo_default_redundancy_repair_shares, bucket_metainfo_default_redundancy_optimal_shares, bucket_metainfo_default_redundancy_total_shares, bucket_metainfo_versioning, optional)
 
 }
 
@@ -13820,13 +15523,14 @@ func (rx *Rx) Create_Project(ctx context.Context,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
 	project_usage_limit Project_UsageLimit_Field,
+	project_bandwidth_limit Project_BandwidthLimit_Field,
 	optional Project_Create_Fields) (
 	project *Project, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Project(ctx, project_id, project_name, project_description, project_usage_limit, optional)
+	return tx.Create_Project(ctx, project_id, project_name, project_description, project_usage_limit, project_bandwidth_limit, optional)
 
 }
 
@@ -14557,6 +16261,18 @@ func (rx *Rx) Update_ApiKey_By_Id(ctx context.Context,
 	return tx.Update_ApiKey_By_Id(ctx, api_key_id, update)
 }
 
+func (rx *Rx) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field,
+	update BucketMetainfo_Update_Fields) (
+	bucket_metainfo *BucketMetainfo, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BucketMetainfo_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name, update)
+}
+
 func (rx *Rx) Update_CertRecord_By_Id(ctx context.Context,
 	certRecord_id CertRecord_Id_Field,
 	update CertRecord_Update_Fields) (
@@ -14612,6 +16328,18 @@ func (rx *Rx) Update_PendingAudits_By_NodeId(ctx context.Context,
 	return tx.Update_PendingAudits_By_NodeId(ctx, pending_audits_node_id, update)
 }
 
+func (rx *Rx) Update_PendingObject_By_StreamId_And_SegmentCount_Less(ctx context.Context,
+	pending_object_stream_id PendingObject_StreamId_Field,
+	pending_object_segment_count_less PendingObject_SegmentCount_Field,
+	update PendingObject_Update_Fields) (
+	pending_object *PendingObject, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_PendingObject_By_StreamId_And_SegmentCount_Less(ctx, pending_object_stream_id, pending_object_segment_count_less, update)
+}
+
 func (rx *Rx) Update_ProjectPayment_By_Id(ctx context.Context,
 	project_payment_id ProjectPayment_Id_Field,
 	update ProjectPayment_Update_Fields) (
@@ -14665,6 +16393,9 @@ type Methods interface {
 		api_key_project_id ApiKey_ProjectId_Field) (
 		rows []*ApiKey, err error)
 
+	All_BucketMetainfo_By_LifecycleRules_IsNot_Null(ctx context.Context) (
+		rows []*BucketMetainfo, err error)
+
 	All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx context.Context,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
@@ -14764,6 +16495,7 @@ type Methods interface {
 		bucket_metainfo_default_redundancy_repair_shares BucketMetainfo_DefaultRedundancyRepairShares_Field,
 		bucket_metainfo_default_redundancy_optimal_shares BucketMetainfo_DefaultRedundancyOptimalShares_Field,
 		bucket_metainfo_default_redundancy_total_shares BucketMetainfo_DefaultRedundancyTotalShares_Field,
+		bucket_metainfo_versioning BucketMetainfo_Versioning_Field,
 		optional BucketMetainfo_Create_Fields) (
 		bucket_metainfo *BucketMetainfo, err error)
 
@@ -14858,11 +16590,22 @@ type Methods interface {
 		pending_audits_reverify_count PendingAudits_ReverifyCount_Field) (
 		pending_audits *PendingAudits, err error)
 
+	Create_PendingObject(ctx context.Context,
+		pending_object_stream_id PendingObject_StreamId_Field,
+		pending_object_project_id PendingObject_ProjectId_Field,
+		pending_object_bucket_name PendingObject_BucketName_Field,
+		pending_object_encrypted_path PendingObject_EncryptedPath_Field,
+		pending_object_redundancy PendingObject_Redundancy_Field,
+		pending_object_segment_count PendingObject_SegmentCount_Field,
+		optional PendingObject_Create_Fields) (
+		pending_object *PendingObject, err error)
+
 	Create_Project(ctx context.Context,
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
 		project_description Project_Description_Field,
 		project_usage_limit Project_UsageLimit_Field,
+		project_bandwidth_limit Project_BandwidthLimit_Field,
 		optional Project_Create_Fields) (
 		project *Project, err error)
 
@@ -14975,6 +16718,10 @@ type Methods interface {
 		pending_audits_node_id PendingAudits_NodeId_Field) (
 		deleted bool, err error)
 
+	Delete_PendingObject_By_StreamId(ctx context.Context,
+		pending_object_stream_id PendingObject_StreamId_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
@@ -15075,6 +16822,10 @@ type Methods interface {
 		pending_audits_node_id PendingAudits_NodeId_Field) (
 		pending_audits *PendingAudits, err error)
 
+	Get_PendingObject_By_StreamId(ctx context.Context,
+		pending_object_stream_id PendingObject_StreamId_Field) (
+		pending_object *PendingObject, err error)
+
 	Get_ProjectInvoiceStamp_By_ProjectId_And_StartDate(ctx context.Context,
 		project_invoice_stamp_project_id ProjectInvoiceStamp_ProjectId_Field,
 		project_invoice_stamp_start_date ProjectInvoiceStamp_StartDate_Field) (
@@ -15169,6 +16920,11 @@ type Methods interface {
 		limit int, offset int64) (
 		rows []*Id_LastNet_Address_Protocol_Row, err error)
 
+	Limited_PendingObject_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+		pending_object_created_at_less PendingObject_CreatedAt_Field,
+		limit int, offset int64) (
+		rows []*PendingObject, err error)
+
 	Limited_ProjectMember_By_ProjectId(ctx context.Context,
 		project_member_project_id ProjectMember_ProjectId_Field,
 		limit int, offset int64) (
@@ -15184,6 +16940,12 @@ type Methods interface {
 		update ApiKey_Update_Fields) (
 		api_key *ApiKey, err error)
 
+	Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field,
+		update BucketMetainfo_Update_Fields) (
+		bucket_metainfo *BucketMetainfo, err error)
+
 	Update_CertRecord_By_Id(ctx context.Context,
 		certRecord_id CertRecord_Id_Field,
 		update CertRecord_Update_Fields) (
@@ -15209,6 +16971,12 @@ type Methods interface {
 		update PendingAudits_Update_Fields) (
 		pending_audits *PendingAudits, err error)
 
+	Update_PendingObject_By_StreamId_And_SegmentCount_Less(ctx context.Context,
+		pending_object_stream_id PendingObject_StreamId_Field,
+		pending_object_segment_count_less PendingObject_SegmentCount_Field,
+		update PendingObject_Update_Fields) (
+		pending_object *PendingObject, err error)
+
 	Update_ProjectPayment_By_Id(ctx context.Context,
 		project_payment_id ProjectPayment_Id_Field,
 		update ProjectPayment_Update_Fields) (
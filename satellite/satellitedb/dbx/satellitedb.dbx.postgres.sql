@@ -61,10 +61,36 @@ CREATE TABLE certRecords (
 	update_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE graceful_exit_progress (
+	node_id bytea NOT NULL,
+	bytes_transferred bigint NOT NULL,
+	pieces_transferred bigint NOT NULL,
+	pieces_failed bigint NOT NULL,
+	exit_initiated_at timestamp with time zone NOT NULL,
+	exit_loop_completed_at timestamp with time zone,
+	exit_finished_at timestamp with time zone,
+	exit_success boolean NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE graceful_exit_transfer_queue (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_num integer NOT NULL,
+	durability_ratio double precision NOT NULL,
+	queued_at timestamp NOT NULL,
+	last_failed_at timestamp,
+	last_failed_code integer,
+	failed_count integer NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE injuredsegments (
 	path bytea NOT NULL,
 	data bytea NOT NULL,
 	attempted timestamp,
+	num_healthy_pieces integer NOT NULL,
+	redundancy_margin integer NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -132,11 +158,23 @@ CREATE TABLE pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE pending_objects (
+	stream_id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	encrypted_path bytea NOT NULL,
+	redundancy bytea NOT NULL,
+	segment_count bigint NOT NULL,
+	expires_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( stream_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	description text NOT NULL,
 	usage_limit bigint NOT NULL,
+	bandwidth_limit bigint NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
@@ -225,6 +263,11 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares integer NOT NULL,
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
+	versioning boolean NOT NULL,
+	lifecycle_rules bytea,
+	placement text,
+	storage_limit bigint,
+	egress_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
@@ -278,7 +321,9 @@ CREATE TABLE project_payments (
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX injuredsegments_attempted_index ON injuredsegments ( attempted );
+CREATE INDEX injuredsegments_redundancy_margin_index ON injuredsegments ( redundancy_margin );
 CREATE INDEX node_last_ip ON nodes ( last_net );
+CREATE INDEX pending_objects_created_at_index ON pending_objects ( created_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );
@@ -61,10 +61,36 @@ CREATE TABLE certRecords (
 	update_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE graceful_exit_progress (
+	node_id BLOB NOT NULL,
+	bytes_transferred INTEGER NOT NULL,
+	pieces_transferred INTEGER NOT NULL,
+	pieces_failed INTEGER NOT NULL,
+	exit_initiated_at TIMESTAMP NOT NULL,
+	exit_loop_completed_at TIMESTAMP,
+	exit_finished_at TIMESTAMP,
+	exit_success INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE graceful_exit_transfer_queue (
+	node_id BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_num INTEGER NOT NULL,
+	durability_ratio REAL NOT NULL,
+	queued_at TIMESTAMP NOT NULL,
+	last_failed_at TIMESTAMP,
+	last_failed_code INTEGER,
+	failed_count INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE injuredsegments (
 	path BLOB NOT NULL,
 	data BLOB NOT NULL,
 	attempted TIMESTAMP,
+	num_healthy_pieces INTEGER NOT NULL,
+	redundancy_margin INTEGER NOT NULL,
 	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
@@ -132,11 +158,23 @@ CREATE TABLE pending_audits (
 	reverify_count INTEGER NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE pending_objects (
+	stream_id BLOB NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	encrypted_path BLOB NOT NULL,
+	redundancy BLOB NOT NULL,
+	segment_count INTEGER NOT NULL,
+	expires_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( stream_id )
+);
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
 	usage_limit INTEGER NOT NULL,
+	bandwidth_limit INTEGER NOT NULL,
 	partner_id BLOB,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
@@ -225,6 +263,11 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares INTEGER NOT NULL,
 	default_redundancy_optimal_shares INTEGER NOT NULL,
 	default_redundancy_total_shares INTEGER NOT NULL,
+	versioning INTEGER NOT NULL,
+	lifecycle_rules BLOB,
+	placement TEXT,
+	storage_limit INTEGER,
+	egress_limit INTEGER,
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
@@ -278,7 +321,9 @@ CREATE TABLE project_payments (
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
 CREATE INDEX injuredsegments_attempted_index ON injuredsegments ( attempted );
+CREATE INDEX injuredsegments_redundancy_margin_index ON injuredsegments ( redundancy_margin );
 CREATE INDEX node_last_ip ON nodes ( last_net );
+CREATE INDEX pending_objects_created_at_index ON pending_objects ( created_at );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );
@@ -0,0 +1,274 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/dbutil/pgutil"
+	"storj.io/storj/internal/dbutil/sqliteutil"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/gracefulexit"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type gracefulexitDB struct {
+	db *dbx.DB
+}
+
+// InitiateExit marks the node as exiting, calling it again for the same node does nothing.
+func (db *gracefulexitDB) InitiateExit(ctx context.Context, nodeID storj.NodeID, initiatedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO graceful_exit_progress (
+			node_id, bytes_transferred, pieces_transferred, pieces_failed,
+			exit_initiated_at, exit_success, updated_at
+		) VALUES ( ?, 0, 0, 0, ?, ?, ? )`),
+		nodeID.Bytes(), initiatedAt, false, time.Now().UTC(),
+	)
+	if err != nil {
+		if pgutil.IsConstraintError(err) || sqliteutil.IsConstraintError(err) {
+			return nil // the exit has already been initiated
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// GetProgress returns the graceful exit progress of the node.
+func (db *gracefulexitDB) GetProgress(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress := &gracefulexit.Progress{NodeID: nodeID}
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT bytes_transferred, pieces_transferred, pieces_failed,
+			exit_initiated_at, exit_loop_completed_at, exit_finished_at,
+			exit_success, updated_at
+		FROM graceful_exit_progress
+		WHERE node_id = ?`), nodeID.Bytes(),
+	).Scan(
+		&progress.BytesTransferred, &progress.PiecesTransferred, &progress.PiecesFailed,
+		&progress.ExitInitiatedAt, &progress.ExitLoopCompletedAt, &progress.ExitFinishedAt,
+		&progress.ExitSuccess, &progress.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, gracefulexit.ErrNotFound.New("%v", nodeID)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return progress, nil
+}
+
+// GetExitingNodesLoopIncomplete returns the exiting nodes whose pieces haven't been collected yet.
+func (db *gracefulexitDB) GetExitingNodesLoopIncomplete(ctx context.Context) (nodeIDs storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT node_id FROM graceful_exit_progress
+		WHERE exit_loop_completed_at IS NULL AND exit_finished_at IS NULL`))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var id []byte
+		if err := rows.Scan(&id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodeID, err := storj.NodeIDFromBytes(id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return nodeIDs, Error.Wrap(rows.Err())
+}
+
+// MarkLoopCompleted records that all pieces of the node have been added to the transfer queue.
+func (db *gracefulexitDB) MarkLoopCompleted(ctx context.Context, nodeID storj.NodeID, completedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE graceful_exit_progress
+		SET exit_loop_completed_at = ?, updated_at = ?
+		WHERE node_id = ?`),
+		completedAt, time.Now().UTC(), nodeID.Bytes(),
+	)
+	return Error.Wrap(err)
+}
+
+// MarkFinished records the end result of the graceful exit.
+func (db *gracefulexitDB) MarkFinished(ctx context.Context, nodeID storj.NodeID, finishedAt time.Time, success bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE graceful_exit_progress
+		SET exit_finished_at = ?, exit_success = ?, updated_at = ?
+		WHERE node_id = ?`),
+		finishedAt, success, time.Now().UTC(), nodeID.Bytes(),
+	)
+	return Error.Wrap(err)
+}
+
+// IncrementProgress adds the given amounts to the progress of the node.
+func (db *gracefulexitDB) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes, successfulTransfers, failedTransfers int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE graceful_exit_progress
+		SET bytes_transferred = bytes_transferred + ?,
+			pieces_transferred = pieces_transferred + ?,
+			pieces_failed = pieces_failed + ?,
+			updated_at = ?
+		WHERE node_id = ?`),
+		bytes, successfulTransfers, failedTransfers, time.Now().UTC(), nodeID.Bytes(),
+	)
+	return Error.Wrap(err)
+}
+
+// Enqueue adds the items to the transfer queue, items which are already queued are ignored.
+func (db *gracefulexitDB) Enqueue(ctx context.Context, items []gracefulexit.TransferQueueItem) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		now := time.Now().UTC()
+		for _, item := range items {
+			_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(`
+				INSERT INTO graceful_exit_transfer_queue (
+					node_id, piece_id, path, piece_num, durability_ratio, queued_at, failed_count
+				) VALUES ( ?, ?, ?, ?, ?, ?, 0 )
+				ON CONFLICT (node_id, piece_id) DO NOTHING`),
+				item.NodeID.Bytes(), item.PieceID.Bytes(), []byte(item.Path), item.PieceNum, item.DurabilityRatio, now,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// GetTransferQueueItem returns the queued piece of the node.
+func (db *gracefulexitDB) GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (_ *gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT node_id, piece_id, path, piece_num, durability_ratio,
+			queued_at, last_failed_at, last_failed_code, failed_count
+		FROM graceful_exit_transfer_queue
+		WHERE node_id = ? AND piece_id = ?`),
+		nodeID.Bytes(), pieceID.Bytes(),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	items, err := scanTransferQueueItems(rows)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(items) == 0 {
+		return nil, gracefulexit.ErrNotFound.New("%v %v", nodeID, pieceID)
+	}
+	return items[0], nil
+}
+
+// GetIncomplete returns the queued pieces of the node which haven't failed too often,
+// starting with the least durable segments.
+func (db *gracefulexitDB) GetIncomplete(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int) (_ []*gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT node_id, piece_id, path, piece_num, durability_ratio,
+			queued_at, last_failed_at, last_failed_code, failed_count
+		FROM graceful_exit_transfer_queue
+		WHERE node_id = ? AND failed_count < ?
+		ORDER BY durability_ratio ASC, queued_at ASC
+		LIMIT ?`),
+		nodeID.Bytes(), maxFailures, limit,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	items, err := scanTransferQueueItems(rows)
+	return items, Error.Wrap(err)
+}
+
+// MarkTransferFailed records a failed transfer of the queued piece and returns the updated item.
+func (db *gracefulexitDB) MarkTransferFailed(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID, failedAt time.Time, code int) (_ *gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE graceful_exit_transfer_queue
+		SET last_failed_at = ?, last_failed_code = ?, failed_count = failed_count + 1
+		WHERE node_id = ? AND piece_id = ?`),
+		failedAt, code, nodeID.Bytes(), pieceID.Bytes(),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return db.GetTransferQueueItem(ctx, nodeID, pieceID)
+}
+
+// DeleteTransferQueueItem removes the queued piece of the node.
+func (db *gracefulexitDB) DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		DELETE FROM graceful_exit_transfer_queue
+		WHERE node_id = ? AND piece_id = ?`),
+		nodeID.Bytes(), pieceID.Bytes(),
+	)
+	return Error.Wrap(err)
+}
+
+// DeleteTransferQueueItems removes all queued pieces of the node.
+func (db *gracefulexitDB) DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		DELETE FROM graceful_exit_transfer_queue
+		WHERE node_id = ?`),
+		nodeID.Bytes(),
+	)
+	return Error.Wrap(err)
+}
+
+func scanTransferQueueItems(rows *sql.Rows) (items []*gracefulexit.TransferQueueItem, err error) {
+	for rows.Next() {
+		var nodeID, pieceID, path []byte
+		item := &gracefulexit.TransferQueueItem{}
+		err := rows.Scan(
+			&nodeID, &pieceID, &path, &item.PieceNum, &item.DurabilityRatio,
+			&item.QueuedAt, &item.LastFailedAt, &item.LastFailedCode, &item.FailedCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		item.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, err
+		}
+		item.PieceID, err = storj.PieceIDFromBytes(pieceID)
+		if err != nil {
+			return nil, err
+		}
+		item.Path = storj.Path(path)
+
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
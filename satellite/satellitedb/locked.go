@@ -26,6 +26,7 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/rewards"
@@ -117,6 +118,20 @@ func (m *lockedBuckets) ListBuckets(ctx context.Context, projectID uuid.UUID, li
 	return m.db.ListBuckets(ctx, projectID, listOpts, allowedBuckets)
 }
 
+// ListLifecycleBuckets returns all buckets that have lifecycle rules
+func (m *lockedBuckets) ListLifecycleBuckets(ctx context.Context) (buckets []storj.Bucket, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListLifecycleBuckets(ctx)
+}
+
+// UpdateBucket updates the settings of an existing bucket
+func (m *lockedBuckets) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateBucket(ctx, bucket)
+}
+
 // CertDB returns database for storing uplink's public key & ID
 func (m *locked) CertDB() certdb.DB {
 	m.Lock()
@@ -219,6 +234,33 @@ func (m *lockedAPIKeys) Update(ctx context.Context, key console.APIKeyInfo) erro
 	return m.db.Update(ctx, key)
 }
 
+// BucketLimits is a getter for BucketLimits repository
+func (m *lockedConsole) BucketLimits() console.BucketLimits {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedBucketLimits{m.Locker, m.db.BucketLimits()}
+}
+
+// lockedBucketLimits implements locking wrapper for console.BucketLimits
+type lockedBucketLimits struct {
+	sync.Locker
+	db console.BucketLimits
+}
+
+// Get is a method for querying the limits of a bucket from the database.
+func (m *lockedBucketLimits) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (*console.BucketLimit, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucketName)
+}
+
+// Update is a method for updating the limits of a bucket.
+func (m *lockedBucketLimits) Update(ctx context.Context, limit console.BucketLimit) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Update(ctx, limit)
+}
+
 // BucketUsage is a getter for accounting.BucketUsage repository
 func (m *lockedConsole) BucketUsage() accounting.BucketUsage {
 	m.Lock()
@@ -714,6 +756,103 @@ func (m *locked) DropSchema(schema string) error {
 	return m.db.DropSchema(schema)
 }
 
+// GracefulExit returns database for graceful exit
+func (m *locked) GracefulExit() gracefulexit.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedGracefulExit{m.Locker, m.db.GracefulExit()}
+}
+
+// lockedGracefulExit implements locking wrapper for gracefulexit.DB
+type lockedGracefulExit struct {
+	sync.Locker
+	db gracefulexit.DB
+}
+
+// DeleteTransferQueueItem deletes a graceful exit transfer queue entry.
+func (m *lockedGracefulExit) DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteTransferQueueItem(ctx, nodeID, pieceID)
+}
+
+// DeleteTransferQueueItems deletes all graceful exit transfer queue entries of a node.
+func (m *lockedGracefulExit) DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteTransferQueueItems(ctx, nodeID)
+}
+
+// Enqueue batch inserts graceful exit transfer queue entries, it ignores entries that already exist.
+func (m *lockedGracefulExit) Enqueue(ctx context.Context, items []gracefulexit.TransferQueueItem) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Enqueue(ctx, items)
+}
+
+// GetExitingNodesLoopIncomplete returns the exiting nodes whose pieces have not been added to the transfer queue yet.
+func (m *lockedGracefulExit) GetExitingNodesLoopIncomplete(ctx context.Context) (storj.NodeIDList, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetExitingNodesLoopIncomplete(ctx)
+}
+
+// GetIncomplete gets the transfer queue entries that have failed less than maxFailures times, ordered by durability ratio.
+func (m *lockedGracefulExit) GetIncomplete(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int) ([]*gracefulexit.TransferQueueItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetIncomplete(ctx, nodeID, maxFailures, limit)
+}
+
+// GetProgress gets the graceful exit progress of a node.
+func (m *lockedGracefulExit) GetProgress(ctx context.Context, nodeID storj.NodeID) (*gracefulexit.Progress, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProgress(ctx, nodeID)
+}
+
+// GetTransferQueueItem gets a graceful exit transfer queue entry.
+func (m *lockedGracefulExit) GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) (*gracefulexit.TransferQueueItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetTransferQueueItem(ctx, nodeID, pieceID)
+}
+
+// IncrementProgress increments transfer stats for a node.
+func (m *lockedGracefulExit) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementProgress(ctx, nodeID, bytes, successfulTransfers, failedTransfers)
+}
+
+// InitiateExit records that the node has started a graceful exit, it does nothing when the exit has already been initiated.
+func (m *lockedGracefulExit) InitiateExit(ctx context.Context, nodeID storj.NodeID, initiatedAt time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.InitiateExit(ctx, nodeID, initiatedAt)
+}
+
+// MarkFinished records the end of the graceful exit of the node.
+func (m *lockedGracefulExit) MarkFinished(ctx context.Context, nodeID storj.NodeID, finishedAt time.Time, success bool) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.MarkFinished(ctx, nodeID, finishedAt, success)
+}
+
+// MarkLoopCompleted records that all pieces of the node have been added to the transfer queue.
+func (m *lockedGracefulExit) MarkLoopCompleted(ctx context.Context, nodeID storj.NodeID, completedAt time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.MarkLoopCompleted(ctx, nodeID, completedAt)
+}
+
+// MarkTransferFailed increments the failure count of a transfer queue entry and returns the updated entry.
+func (m *lockedGracefulExit) MarkTransferFailed(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID, failedAt time.Time, code int) (*gracefulexit.TransferQueueItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.MarkTransferFailed(ctx, nodeID, pieceID, failedAt, code)
+}
+
 // Irreparable returns database for failed repairs
 func (m *locked) Irreparable() irreparable.DB {
 	m.Lock()
@@ -858,6 +997,13 @@ func (m *lockedOverlayCache) Get(ctx context.Context, nodeID storj.NodeID) (*ove
 	return m.db.Get(ctx, nodeID)
 }
 
+// GetPlacements returns the attributes of the nodes limited by the diversity constraints
+func (m *lockedOverlayCache) GetPlacements(ctx context.Context, nodeIDs storj.NodeIDList) ([]*overlay.NodePlacement, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetPlacements(ctx, nodeIDs)
+}
+
 // IsVetted returns whether or not the node reaches reputable thresholds
 func (m *lockedOverlayCache) IsVetted(ctx context.Context, id storj.NodeID, criteria *overlay.NodeCriteria) (bool, error) {
 	m.Lock()
@@ -942,6 +1088,54 @@ func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.Node
 	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ)
 }
 
+// PendingObjects returns database for keeping track of pending objects
+func (m *locked) PendingObjects() metainfo.PendingObjectsDB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedPendingObjects{m.Locker, m.db.PendingObjects()}
+}
+
+// lockedPendingObjects implements locking wrapper for metainfo.PendingObjectsDB
+type lockedPendingObjects struct {
+	sync.Locker
+	db metainfo.PendingObjectsDB
+}
+
+// CreatePendingObject adds a new pending object
+func (m *lockedPendingObjects) CreatePendingObject(ctx context.Context, object metainfo.PendingObject) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreatePendingObject(ctx, object)
+}
+
+// DeletePendingObject deletes a pending object
+func (m *lockedPendingObjects) DeletePendingObject(ctx context.Context, streamID storj.StreamID) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeletePendingObject(ctx, streamID)
+}
+
+// GetPendingObject returns a pending object
+func (m *lockedPendingObjects) GetPendingObject(ctx context.Context, streamID storj.StreamID) (_ metainfo.PendingObject, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetPendingObject(ctx, streamID)
+}
+
+// ListPendingObjectsBefore returns up to limit pending objects created before the given time
+func (m *lockedPendingObjects) ListPendingObjectsBefore(ctx context.Context, before time.Time, limit int) (_ []metainfo.PendingObject, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListPendingObjectsBefore(ctx, before, limit)
+}
+
+// UpdateSegmentCount raises the segment count of a pending object to at least segmentCount
+func (m *lockedPendingObjects) UpdateSegmentCount(ctx context.Context, streamID storj.StreamID, segmentCount int64) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateSegmentCount(ctx, streamID, segmentCount)
+}
+
 // ProjectAccounting returns database for storing information about project data use
 func (m *locked) ProjectAccounting() accounting.ProjectAccounting {
 	m.Lock()
@@ -969,6 +1163,34 @@ func (m *lockedProjectAccounting) GetAllocatedBandwidthTotal(ctx context.Context
 	return m.db.GetAllocatedBandwidthTotal(ctx, projectID, from)
 }
 
+// GetBucketAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a bucket in the past time frame
+func (m *lockedProjectAccounting) GetBucketAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, bucketName []byte, from time.Time) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetBucketAllocatedBandwidthTotal(ctx, projectID, bucketName, from)
+}
+
+// GetBucketLimits returns the storage and egress limits configured for the bucket, 0 when there is none
+func (m *lockedProjectAccounting) GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (storage memory.Size, egress memory.Size, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetBucketLimits(ctx, projectID, bucketName)
+}
+
+// GetBucketStorageTotals returns the current inline and remote storage usage for a bucket
+func (m *lockedProjectAccounting) GetBucketStorageTotals(ctx context.Context, projectID uuid.UUID, bucketName []byte) (int64, int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetBucketStorageTotals(ctx, projectID, bucketName)
+}
+
+// GetProjectBandwidthLimit returns the egress limit configured for the project, 0 when there is none
+func (m *lockedProjectAccounting) GetProjectBandwidthLimit(ctx context.Context, projectID uuid.UUID) (memory.Size, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProjectBandwidthLimit(ctx, projectID)
+}
+
 // GetProjectUsageLimits returns project usage limit
 func (m *lockedProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (memory.Size, error) {
 	m.Lock()
@@ -1010,14 +1232,14 @@ func (m *lockedRepairQueue) Delete(ctx context.Context, s *pb.InjuredSegment) er
 	return m.db.Delete(ctx, s)
 }
 
-// Insert adds an injured segment.
+// Insert adds an injured segment, or updates the health of an already queued segment.
 func (m *lockedRepairQueue) Insert(ctx context.Context, s *pb.InjuredSegment) error {
 	m.Lock()
 	defer m.Unlock()
 	return m.db.Insert(ctx, s)
 }
 
-// Select gets an injured segment.
+// Select gets the injured segment with the smallest redundancy margin.
 func (m *lockedRepairQueue) Select(ctx context.Context) (*pb.InjuredSegment, error) {
 	m.Lock()
 	defer m.Unlock()
@@ -1019,6 +1019,102 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE bucket_metainfos ADD COLUMN partner_id BYTEA`,
 				},
 			},
+			{
+				Description: "Add graceful exit progress and transfer queue tables",
+				Version:     46,
+				Action: migrate.SQL{
+					`CREATE TABLE graceful_exit_progress (
+						node_id bytea NOT NULL,
+						bytes_transferred bigint NOT NULL,
+						pieces_transferred bigint NOT NULL,
+						pieces_failed bigint NOT NULL,
+						exit_initiated_at timestamp with time zone NOT NULL,
+						exit_loop_completed_at timestamp with time zone,
+						exit_finished_at timestamp with time zone,
+						exit_success boolean NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+					`CREATE TABLE graceful_exit_transfer_queue (
+						node_id bytea NOT NULL,
+						piece_id bytea NOT NULL,
+						path bytea NOT NULL,
+						piece_num integer NOT NULL,
+						durability_ratio double precision NOT NULL,
+						queued_at timestamp NOT NULL,
+						last_failed_at timestamp,
+						last_failed_code integer,
+						failed_count integer NOT NULL,
+						PRIMARY KEY ( node_id, piece_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add versioning flag to buckets",
+				Version:     47,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN versioning boolean NOT NULL DEFAULT false;
+					 ALTER TABLE bucket_metainfos ALTER COLUMN versioning DROP DEFAULT;`,
+				},
+			},
+			{
+				Description: "Add lifecycle rules to buckets",
+				Version:     48,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN lifecycle_rules bytea;`,
+				},
+			},
+			{
+				Description: "Add segment health to the repair queue",
+				Version:     49,
+				Action: migrate.SQL{
+					`ALTER TABLE injuredsegments ADD COLUMN num_healthy_pieces integer NOT NULL DEFAULT 0;
+					 ALTER TABLE injuredsegments ALTER COLUMN num_healthy_pieces DROP DEFAULT;`,
+					`ALTER TABLE injuredsegments ADD COLUMN redundancy_margin integer NOT NULL DEFAULT 0;
+					 ALTER TABLE injuredsegments ALTER COLUMN redundancy_margin DROP DEFAULT;`,
+					`CREATE INDEX injuredsegments_redundancy_margin_index ON injuredsegments ( redundancy_margin );`,
+				},
+			},
+			{
+				Description: "Add placement policy to buckets",
+				Version:     50,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN placement text;`,
+				},
+			},
+			{
+				Description: "Add egress limit to projects and storage and egress limits to buckets",
+				Version:     51,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD COLUMN bandwidth_limit bigint NOT NULL DEFAULT 0;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN storage_limit bigint;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN egress_limit bigint;`,
+				},
+			},
+			{
+				Description: "Add pending objects table",
+				Version:     52,
+				Action: migrate.SQL{
+					`CREATE TABLE pending_objects (
+						stream_id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						encrypted_path bytea NOT NULL,
+						redundancy bytea NOT NULL,
+						segment_count bigint NOT NULL,
+						expires_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( stream_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add index on the creation time of pending objects",
+				Version:     53,
+				Action: migrate.SQL{
+					`CREATE INDEX pending_objects_created_at_index ON pending_objects ( created_at );`,
+				},
+			},
 		},
 	}
 }
@@ -39,6 +39,7 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 
 	safeQuery := `
 		WHERE disqualified IS NULL
+		AND id NOT IN (SELECT node_id FROM graceful_exit_progress)
 		AND type = ?
 		AND free_bandwidth >= ?
 		AND free_disk >= ?
@@ -95,6 +96,7 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 
 	safeQuery := `
 		WHERE disqualified IS NULL
+		AND id NOT IN (SELECT node_id FROM graceful_exit_progress)
 		AND type = ?
 		AND free_bandwidth >= ?
 		AND free_disk >= ?
@@ -508,6 +510,39 @@ func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteri
 	return badNodes, nil
 }
 
+// GetPlacements returns the attributes of the nodes limited by the diversity constraints.
+func (cache *overlaycache) GetPlacements(ctx context.Context, nodeIDs storj.NodeIDList) (_ []*overlay.NodePlacement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		args = append(args, id.Bytes())
+	}
+
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT id, last_net, wallet, email FROM nodes
+		WHERE id IN (?`+strings.Repeat(", ?", len(nodeIDs)-1)+`)`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var placements []*overlay.NodePlacement
+	for rows.Next() {
+		placement := &overlay.NodePlacement{}
+		err = rows.Scan(&placement.ID, &placement.LastNet, &placement.Wallet, &placement.Email)
+		if err != nil {
+			return nil, err
+		}
+		placements = append(placements, placement)
+	}
+	return placements, rows.Err()
+}
+
 // Reliable returns all reliable nodes.
 func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
 	// get reliable and online nodes
@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type pendingObjectsDB struct {
+	db *dbx.DB
+}
+
+// PendingObjects returns database for keeping track of pending objects
+func (db *DB) PendingObjects() metainfo.PendingObjectsDB {
+	return &pendingObjectsDB{db: db.db}
+}
+
+// CreatePendingObject adds a new pending object.
+func (db *pendingObjectsDB) CreatePendingObject(ctx context.Context, object metainfo.PendingObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	redundancy, err := proto.Marshal(object.Redundancy)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var expiresAt *time.Time
+	if !object.ExpiresAt.IsZero() {
+		expiresAt = &object.ExpiresAt
+	}
+
+	_, err = db.db.Create_PendingObject(ctx,
+		dbx.PendingObject_StreamId(object.StreamID.Bytes()),
+		dbx.PendingObject_ProjectId(object.ProjectID[:]),
+		dbx.PendingObject_BucketName(object.BucketName),
+		dbx.PendingObject_EncryptedPath(object.EncryptedPath),
+		dbx.PendingObject_Redundancy(redundancy),
+		dbx.PendingObject_SegmentCount(object.SegmentCount),
+		dbx.PendingObject_Create_Fields{
+			ExpiresAt: dbx.PendingObject_ExpiresAt_Raw(expiresAt),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// GetPendingObject returns a pending object.
+func (db *pendingObjectsDB) GetPendingObject(ctx context.Context, streamID storj.StreamID) (_ metainfo.PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxObject, err := db.db.Get_PendingObject_By_StreamId(ctx, dbx.PendingObject_StreamId(streamID.Bytes()))
+	if err == sql.ErrNoRows {
+		return metainfo.PendingObject{}, metainfo.ErrPendingObjectNotFound.New("%v", streamID)
+	}
+	if err != nil {
+		return metainfo.PendingObject{}, Error.Wrap(err)
+	}
+
+	object, err := pendingObjectFromDBX(dbxObject)
+	return object, Error.Wrap(err)
+}
+
+// UpdateSegmentCount raises the segment count of a pending object to at least segmentCount.
+func (db *pendingObjectsDB) UpdateSegmentCount(ctx context.Context, streamID storj.StreamID, segmentCount int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Update_PendingObject_By_StreamId_And_SegmentCount_Less(ctx,
+		dbx.PendingObject_StreamId(streamID.Bytes()),
+		dbx.PendingObject_SegmentCount(segmentCount),
+		dbx.PendingObject_Update_Fields{
+			SegmentCount: dbx.PendingObject_SegmentCount(segmentCount),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// DeletePendingObject deletes a pending object.
+func (db *pendingObjectsDB) DeletePendingObject(ctx context.Context, streamID storj.StreamID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_PendingObject_By_StreamId(ctx, dbx.PendingObject_StreamId(streamID.Bytes()))
+	return Error.Wrap(err)
+}
+
+// ListPendingObjectsBefore returns up to limit pending objects created before the given time.
+func (db *pendingObjectsDB) ListPendingObjectsBefore(ctx context.Context, before time.Time, limit int) (_ []metainfo.PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxObjects, err := db.db.Limited_PendingObject_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx,
+		dbx.PendingObject_CreatedAt(before.UTC()),
+		limit, 0,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	objects := make([]metainfo.PendingObject, 0, len(dbxObjects))
+	for _, dbxObject := range dbxObjects {
+		object, err := pendingObjectFromDBX(dbxObject)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// pendingObjectFromDBX converts a row of the pending_objects table to a pending object.
+func pendingObjectFromDBX(dbxObject *dbx.PendingObject) (object metainfo.PendingObject, err error) {
+	object.StreamID, err = storj.StreamIDFromBytes(dbxObject.StreamId)
+	if err != nil {
+		return metainfo.PendingObject{}, err
+	}
+	object.ProjectID, err = bytesToUUID(dbxObject.ProjectId)
+	if err != nil {
+		return metainfo.PendingObject{}, err
+	}
+	object.BucketName = dbxObject.BucketName
+	object.EncryptedPath = dbxObject.EncryptedPath
+	object.Redundancy = &pb.RedundancyScheme{}
+	if err := proto.Unmarshal(dbxObject.Redundancy, object.Redundancy); err != nil {
+		return metainfo.PendingObject{}, err
+	}
+	object.SegmentCount = dbxObject.SegmentCount
+	if dbxObject.ExpiresAt != nil {
+		object.ExpiresAt = *dbxObject.ExpiresAt
+	}
+	object.CreatedAt = dbxObject.CreatedAt
+	return object, nil
+}
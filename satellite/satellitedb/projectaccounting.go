@@ -124,3 +124,64 @@ func (db *ProjectAccounting) GetProjectUsageLimits(ctx context.Context, projectI
 	}
 	return memory.Size(project.UsageLimit), nil
 }
+
+// GetProjectBandwidthLimit returns the egress limit configured for the project
+func (db *ProjectAccounting) GetProjectBandwidthLimit(ctx context.Context, projectID uuid.UUID) (_ memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+	project, err := db.db.Get_Project_By_Id(ctx, dbx.Project_Id(projectID[:]))
+	if err != nil {
+		return 0, err
+	}
+	return memory.Size(project.BandwidthLimit), nil
+}
+
+// GetBucketAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a bucket for a time frame
+func (db *ProjectAccounting) GetBucketAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, bucketName []byte, from time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var sum *int64
+	query := `SELECT SUM(allocated) FROM bucket_bandwidth_rollups WHERE project_id = ? AND bucket_name = ? AND action = ? AND interval_start > ?;`
+	err = db.db.QueryRow(db.db.Rebind(query), projectID[:], bucketName, pb.PieceAction_GET, from).Scan(&sum)
+	if err == sql.ErrNoRows || sum == nil {
+		return 0, nil
+	}
+
+	return *sum, err
+}
+
+// GetBucketStorageTotals returns the inline and remote storage usage of a bucket in the most recent tally of its project
+func (db *ProjectAccounting) GetBucketStorageTotals(ctx context.Context, projectID uuid.UUID, bucketName []byte) (inline int64, remote int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	query := `SELECT inline, remote
+		FROM bucket_storage_tallies
+		WHERE project_id = ? AND bucket_name = ? AND interval_start = (
+			SELECT MAX(interval_start) FROM bucket_storage_tallies WHERE project_id = ?
+		);`
+
+	err = db.db.QueryRow(db.db.Rebind(query), projectID[:], bucketName, projectID[:]).Scan(&inline, &remote)
+	if err == sql.ErrNoRows {
+		return 0, 0, nil
+	}
+	return inline, remote, err
+}
+
+// GetBucketLimits returns the storage and egress limits configured for the bucket
+func (db *ProjectAccounting) GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (storage memory.Size, egress memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	if bucket.StorageLimit != nil {
+		storage = memory.Size(*bucket.StorageLimit)
+	}
+	if bucket.EgressLimit != nil {
+		egress = memory.Size(*bucket.EgressLimit)
+	}
+	return storage, egress, nil
+}
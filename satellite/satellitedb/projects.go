@@ -80,6 +80,7 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 		dbx.Project_Name(project.Name),
 		dbx.Project_Description(project.Description),
 		dbx.Project_UsageLimit(0),
+		dbx.Project_BandwidthLimit(0),
 		dbx.Project_Create_Fields{
 			PartnerId: dbx.Project_PartnerId(project.PartnerID[:]),
 		},
@@ -106,8 +107,9 @@ func (projects *projects) Update(ctx context.Context, project *console.Project)
 	defer mon.Task()(&ctx)(&err)
 
 	updateFields := dbx.Project_Update_Fields{
-		Description: dbx.Project_Description(project.Description),
-		UsageLimit:  dbx.Project_UsageLimit(project.UsageLimit),
+		Description:    dbx.Project_Description(project.Description),
+		UsageLimit:     dbx.Project_UsageLimit(project.UsageLimit),
+		BandwidthLimit: dbx.Project_BandwidthLimit(project.BandwidthLimit),
 	}
 
 	_, err = projects.db.Update_Project_By_Id(ctx,
@@ -131,10 +133,12 @@ func projectFromDBX(ctx context.Context, project *dbx.Project) (_ *console.Proje
 	}
 
 	u := &console.Project{
-		ID:          id,
-		Name:        project.Name,
-		Description: project.Description,
-		CreatedAt:   project.CreatedAt,
+		ID:             id,
+		Name:           project.Name,
+		Description:    project.Description,
+		UsageLimit:     project.UsageLimit,
+		BandwidthLimit: project.BandwidthLimit,
+		CreatedAt:      project.CreatedAt,
 	}
 
 	return u, nil
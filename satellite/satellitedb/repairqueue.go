@@ -10,6 +10,7 @@ import (
 
 	"github.com/lib/pq"
 	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/internal/dbutil/pgutil"
 	"storj.io/storj/internal/dbutil/sqliteutil"
@@ -24,10 +25,17 @@ type repairQueue struct {
 
 func (r *repairQueue) Insert(ctx context.Context, seg *pb.InjuredSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = r.db.ExecContext(ctx, r.db.Rebind(`INSERT INTO injuredsegments ( path, data ) VALUES ( ?, ? )`), seg.Path, seg)
+	_, err = r.db.ExecContext(ctx, r.db.Rebind(`
+		INSERT INTO injuredsegments ( path, data, num_healthy_pieces, redundancy_margin ) VALUES ( ?, ?, ?, ? )`),
+		seg.Path, seg, seg.NumHealthyPieces, seg.RedundancyMargin)
 	if err != nil {
 		if pgutil.IsConstraintError(err) || sqliteutil.IsConstraintError(err) {
-			return nil // quietly fail on reinsert
+			// the segment is already queued, update it with the current health
+			// and lost pieces
+			_, err = r.db.ExecContext(ctx, r.db.Rebind(`
+				UPDATE injuredsegments SET data = ?, num_healthy_pieces = ?, redundancy_margin = ? WHERE path = ?`),
+				seg, seg.NumHealthyPieces, seg.RedundancyMargin, seg.Path)
+			return err
 		}
 		return err
 	}
@@ -36,30 +44,38 @@ func (r *repairQueue) Insert(ctx context.Context, seg *pb.InjuredSegment) (err e
 
 func (r *repairQueue) postgresSelect(ctx context.Context) (seg *pb.InjuredSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
+	var numHealthy, margin int32
 	err = r.db.QueryRowContext(ctx, `
 	UPDATE injuredsegments SET attempted = timezone('utc', now()) WHERE path = (
 		SELECT path FROM injuredsegments
 		WHERE attempted IS NULL OR attempted < timezone('utc', now()) - interval '1 hour'
-		ORDER BY attempted NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
-	) RETURNING data`).Scan(&seg)
+		ORDER BY redundancy_margin, num_healthy_pieces, attempted NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
+	) RETURNING data, num_healthy_pieces, redundancy_margin`).Scan(&seg, &numHealthy, &margin)
 	if err == sql.ErrNoRows {
-		err = storage.ErrEmptyQueue.New("")
+		return nil, storage.ErrEmptyQueue.New("")
 	}
-	return
+	if err != nil {
+		return nil, err
+	}
+	seg.NumHealthyPieces, seg.RedundancyMargin = numHealthy, margin
+	return seg, nil
 }
 
 func (r *repairQueue) sqliteSelect(ctx context.Context) (seg *pb.InjuredSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
 	err = r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
 		var path []byte
+		var numHealthy, margin int32
 		err = tx.Tx.QueryRowContext(ctx, r.db.Rebind(`
-			SELECT path, data FROM injuredsegments
+			SELECT path, data, num_healthy_pieces, redundancy_margin FROM injuredsegments
 			WHERE attempted IS NULL
 			OR attempted < datetime('now','-1 hours')
-			ORDER BY attempted LIMIT 1`)).Scan(&path, &seg)
+			ORDER BY redundancy_margin, num_healthy_pieces, attempted LIMIT 1`)).Scan(&path, &seg, &numHealthy, &margin)
 		if err != nil {
 			return err
 		}
+		seg.NumHealthyPieces, seg.RedundancyMargin = numHealthy, margin
+
 		res, err := tx.Tx.ExecContext(ctx, r.db.Rebind(`UPDATE injuredsegments SET attempted = datetime('now') WHERE path = ?`), path)
 		if err != nil {
 			return err
@@ -83,12 +99,18 @@ func (r *repairQueue) Select(ctx context.Context) (seg *pb.InjuredSegment, err e
 	defer mon.Task()(&ctx)(&err)
 	switch t := r.db.DB.Driver().(type) {
 	case *sqlite3.SQLiteDriver:
-		return r.sqliteSelect(ctx)
+		seg, err = r.sqliteSelect(ctx)
 	case *pq.Driver:
-		return r.postgresSelect(ctx)
+		seg, err = r.postgresSelect(ctx)
 	default:
 		return seg, fmt.Errorf("Unsupported database %t", t)
 	}
+	if err != nil {
+		return nil, err
+	}
+	mon.IntVal("repair_queue_selected_segment_healthy_count").Observe(int64(seg.NumHealthyPieces))
+	mon.IntVal("repair_queue_selected_segment_redundancy_margin").Observe(int64(seg.RedundancyMargin))
+	return seg, nil
 }
 
 func (r *repairQueue) Delete(ctx context.Context, seg *pb.InjuredSegment) (err error) {
@@ -103,10 +125,15 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []pb.Injured
 		limit = storage.LookupLimit
 	}
 	//todo: strictly enforce order-by or change tests
-	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`SELECT data FROM injuredsegments LIMIT ?`), limit)
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`SELECT data, num_healthy_pieces, redundancy_margin FROM injuredsegments LIMIT ?`), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
 	for rows.Next() {
 		var seg pb.InjuredSegment
-		err = rows.Scan(&seg)
+		err = rows.Scan(&seg, &seg.NumHealthyPieces, &seg.RedundancyMargin)
 		if err != nil {
 			return
 		}
@@ -6,6 +6,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 )
@@ -54,6 +55,16 @@ type Blobs interface {
 	Open(ctx context.Context, ref BlobRef) (BlobReader, error)
 	// Delete deletes the blob with the namespace and key
 	Delete(ctx context.Context, ref BlobRef) error
+	// Trash moves the blob with the namespace and key into the trash
+	Trash(ctx context.Context, ref BlobRef) error
+	// OpenTrashed opens a reader for the blob with the namespace and key in the trash
+	OpenTrashed(ctx context.Context, ref BlobRef) (BlobReader, error)
+	// RestoreTrash moves all blobs of the namespace from the trash back into storage
+	RestoreTrash(ctx context.Context, namespace []byte) error
+	// EmptyTrash deletes blobs which were moved to the trash before trashedBefore
+	EmptyTrash(ctx context.Context, trashedBefore time.Time) (bytesEmptied int64, err error)
 	// FreeSpace return how much free space left for writing
 	FreeSpace() (int64, error)
+	// SpaceUsed returns how much space is used by the stored blobs
+	SpaceUsed(ctx context.Context) (int64, error)
 }
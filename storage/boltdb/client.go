@@ -184,6 +184,35 @@ func (client *Client) Delete(ctx context.Context, key storage.Key) (err error) {
 	})
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		data := bucket.Get([]byte(key))
+		if len(data) == 0 {
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New(key.String())
+			}
+			if newValue == nil {
+				return nil
+			}
+			return bucket.Put(key, newValue)
+		}
+
+		if !bytes.Equal(storage.Value(data), oldValue) {
+			return storage.ErrValueChanged.New(key.String())
+		}
+		if newValue == nil {
+			return bucket.Delete(key)
+		}
+		return bucket.Put(key, newValue)
+	})
+}
+
 // List returns either a list of keys for which boltdb has values or an error.
 func (client *Client) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -43,6 +44,7 @@ func NewDir(path string) (*Dir, error) {
 		os.MkdirAll(dir.blobdir(), dirPermission),
 		os.MkdirAll(dir.tempdir(), dirPermission),
 		os.MkdirAll(dir.trashdir(), dirPermission),
+		os.MkdirAll(dir.garbagedir(), dirPermission),
 	)
 }
 
@@ -53,6 +55,8 @@ func (dir *Dir) blobdir() string  { return filepath.Join(dir.path, "blob") }
 func (dir *Dir) tempdir() string  { return filepath.Join(dir.path, "tmp") }
 func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
 
+func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage") }
+
 // CreateTemporaryFile creates a preallocated temporary file in the temp directory
 // prealloc preallocates file to make writing faster
 func (dir *Dir) CreateTemporaryFile(ctx context.Context, prealloc int64) (_ *os.File, err error) {
@@ -83,6 +87,16 @@ func (dir *Dir) DeleteTemporary(ctx context.Context, file *os.File) (err error)
 
 // blobToPath converts blob reference to a filepath in permanent storage
 func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
+	return dir.refToDirPath(ref, dir.blobdir())
+}
+
+// blobToTrashPath converts blob reference to a filepath in the trash
+func (dir *Dir) blobToTrashPath(ref storage.BlobRef) (string, error) {
+	return dir.refToDirPath(ref, dir.trashdir())
+}
+
+// refToDirPath converts blob reference to a filepath inside the specified directory
+func (dir *Dir) refToDirPath(ref storage.BlobRef, subdir string) (string, error) {
 	if !ref.IsValid() {
 		return "", storage.ErrInvalidBlobRef.New("")
 	}
@@ -93,16 +107,16 @@ func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
 		// ensure we always have at least
 		key = "11" + key
 	}
-	return filepath.Join(dir.blobdir(), namespace, key[:2], key[2:]), nil
+	return filepath.Join(subdir, namespace, key[:2], key[2:]), nil
 }
 
-// blobToTrashPath converts blob reference to a filepath in transient storage
-// the files in trash are deleted in an interval (in case the initial deletion didn't work for some reason)
-func (dir *Dir) blobToTrashPath(ref storage.BlobRef) string {
+// blobToGarbagePath converts blob reference to a filepath in transient storage
+// the files in garbage are deleted in an interval (in case the initial deletion didn't work for some reason)
+func (dir *Dir) blobToGarbagePath(ref storage.BlobRef) string {
 	name := []byte{}
 	name = append(name, ref.Namespace...)
 	name = append(name, ref.Key...)
-	return filepath.Join(dir.trashdir(), pathEncoding.EncodeToString(name))
+	return filepath.Join(dir.garbagedir(), pathEncoding.EncodeToString(name))
 }
 
 // Commit commits temporary file to the permanent storage
@@ -151,6 +165,21 @@ func (dir *Dir) Open(ctx context.Context, ref storage.BlobRef) (_ *os.File, err
 	if err != nil {
 		return nil, err
 	}
+	return openBlob(path)
+}
+
+// OpenTrashed opens the file with the specified ref in the trash
+func (dir *Dir) OpenTrashed(ctx context.Context, ref storage.BlobRef) (_ *os.File, err error) {
+	defer mon.Task()(&ctx)(&err)
+	path, err := dir.blobToTrashPath(ref)
+	if err != nil {
+		return nil, err
+	}
+	return openBlob(path)
+}
+
+// openBlob opens the blob file at path for reading
+func openBlob(path string) (*os.File, error) {
 	file, err := openFileReadOnly(path, blobPermission)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -169,21 +198,21 @@ func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 		return err
 	}
 
-	trashPath := dir.blobToTrashPath(ref)
+	garbagePath := dir.blobToGarbagePath(ref)
 
-	// move to trash folder, this is allowed for some OS-es
-	moveErr := rename(path, trashPath)
+	// move to garbage folder, this is allowed for some OS-es
+	moveErr := rename(path, garbagePath)
 
 	// ignore concurrent delete
 	if os.IsNotExist(moveErr) {
 		return nil
 	}
 	if moveErr != nil {
-		trashPath = path
+		garbagePath = path
 	}
 
 	// try removing the file
-	err = os.Remove(trashPath)
+	err = os.Remove(garbagePath)
 
 	// ignore concurrent deletes
 	if os.IsNotExist(err) {
@@ -193,7 +222,7 @@ func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 	// this may fail, because someone might be still reading it
 	if err != nil {
 		dir.mu.Lock()
-		dir.deleteQueue = append(dir.deleteQueue, trashPath)
+		dir.deleteQueue = append(dir.deleteQueue, garbagePath)
 		dir.mu.Unlock()
 	}
 
@@ -206,6 +235,127 @@ func (dir *Dir) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 	return err
 }
 
+// Trash moves the file with the specified ref into the trash,
+// the time of the move is recorded as the modification time of the file
+func (dir *Dir) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	path, err := dir.blobToPath(ref)
+	if err != nil {
+		return err
+	}
+	trashPath, err := dir.blobToTrashPath(ref)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		// ignore already deleted files
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	mkdirErr := os.MkdirAll(filepath.Dir(trashPath), dirPermission)
+	if mkdirErr != nil && !os.IsExist(mkdirErr) {
+		return mkdirErr
+	}
+
+	err = rename(path, trashPath)
+	// ignore concurrent deletes
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// RestoreTrash moves every file in the trash of the namespace back into permanent storage
+func (dir *Dir) RestoreTrash(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	blobNamespace := filepath.Join(dir.blobdir(), pathEncoding.EncodeToString(namespace))
+	trashNamespace := filepath.Join(dir.trashdir(), pathEncoding.EncodeToString(namespace))
+
+	return walkFiles(trashNamespace, func(path string, info os.FileInfo) error {
+		rel, err := filepath.Rel(trashNamespace, path)
+		if err != nil {
+			return err
+		}
+		blobPath := filepath.Join(blobNamespace, rel)
+
+		mkdirErr := os.MkdirAll(filepath.Dir(blobPath), dirPermission)
+		if mkdirErr != nil && !os.IsExist(mkdirErr) {
+			return mkdirErr
+		}
+
+		if err := rename(path, blobPath); err != nil {
+			// ignore concurrent restores and empties
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// EmptyTrash deletes the files in the trash which were trashed before trashedBefore
+func (dir *Dir) EmptyTrash(ctx context.Context, trashedBefore time.Time) (bytesEmptied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errlist errs.Group
+	err = walkFiles(dir.trashdir(), func(path string, info os.FileInfo) error {
+		if !info.ModTime().Before(trashedBefore) {
+			return nil
+		}
+
+		err := os.Remove(path)
+		if err != nil {
+			// ignore concurrent restores and empties
+			if !os.IsNotExist(err) {
+				errlist.Add(err)
+			}
+			return nil
+		}
+		bytesEmptied += info.Size()
+		return nil
+	})
+	errlist.Add(err)
+	return bytesEmptied, errlist.Err()
+}
+
+// SpaceUsed returns the total size of the files in permanent storage
+func (dir *Dir) SpaceUsed(ctx context.Context) (spaceUsed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = walkFiles(dir.blobdir(), func(path string, info os.FileInfo) error {
+		spaceUsed += info.Size()
+		return nil
+	})
+	return spaceUsed, err
+}
+
+// walkFiles calls fn for every file in the directory tree,
+// a missing directory is treated as empty
+func walkFiles(root string, fn func(path string, info os.FileInfo) error) error {
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		return fn(path, info)
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
 // GarbageCollect collects files that are pending deletion
 func (dir *Dir) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -233,8 +383,8 @@ func (dir *Dir) GarbageCollect(ctx context.Context) (err error) {
 		dir.mu.Unlock()
 	}
 
-	// remove anything left in the trashdir
-	_ = removeAllContent(ctx, dir.trashdir())
+	// remove anything left in the garbagedir
+	_ = removeAllContent(ctx, dir.garbagedir())
 	return nil
 }
 
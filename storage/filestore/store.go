@@ -6,6 +6,7 @@ package filestore
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -57,6 +58,19 @@ func (store *Store) Open(ctx context.Context, ref storage.BlobRef) (_ storage.Bl
 	return newBlobReader(file), nil
 }
 
+// OpenTrashed loads the blob with the specified hash from the trash
+func (store *Store) OpenTrashed(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	file, err := store.dir.OpenTrashed(ctx, ref)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	return newBlobReader(file), nil
+}
+
 // Delete deletes blobs with the specified ref
 func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -64,6 +78,27 @@ func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) (err error)
 	return Error.Wrap(err)
 }
 
+// Trash moves the blob with the specified ref into the trash
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.dir.Trash(ctx, ref)
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves all blobs of the namespace from the trash back into storage
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.dir.RestoreTrash(ctx, namespace)
+	return Error.Wrap(err)
+}
+
+// EmptyTrash deletes blobs which were moved to the trash before trashedBefore
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) (bytesEmptied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bytesEmptied, err = store.dir.EmptyTrash(ctx, trashedBefore)
+	return bytesEmptied, Error.Wrap(err)
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted
 func (store *Store) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -82,6 +117,13 @@ func (store *Store) Create(ctx context.Context, ref storage.BlobRef, size int64)
 	return newBlobWriter(ref, store, file), nil
 }
 
+// SpaceUsed returns how much space is used by the stored blobs
+func (store *Store) SpaceUsed(ctx context.Context) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	used, err := store.dir.SpaceUsed(ctx)
+	return used, Error.Wrap(err)
+}
+
 // FreeSpace returns how much space left in underlying directory
 func (store *Store) FreeSpace() (int64, error) {
 	info, err := store.dir.Info()
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -219,3 +220,71 @@ func TestDeleteWhileReading(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	data := testrand.Bytes(8 << 10)
+	namespace := testrand.Bytes(32)
+	otherNamespace := testrand.Bytes(32)
+
+	create := func(ref storage.BlobRef) {
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	otherRef := storage.BlobRef{Namespace: otherNamespace, Key: testrand.Bytes(32)}
+	create(ref)
+	create(otherRef)
+
+	used, err := store.SpaceUsed(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2*len(data), used)
+
+	require.NoError(t, store.Trash(ctx, ref))
+	require.NoError(t, store.Trash(ctx, otherRef))
+	// trashing a missing blob is ignored
+	require.NoError(t, store.Trash(ctx, ref))
+
+	// trashed blobs don't count as used
+	used, err = store.SpaceUsed(ctx)
+	require.NoError(t, err)
+	require.Zero(t, used)
+
+	_, err = store.Open(ctx, ref)
+	require.True(t, os.IsNotExist(err))
+
+	// restoring only affects the namespace
+	require.NoError(t, store.RestoreTrash(ctx, namespace))
+
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	result, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, data, result)
+
+	_, err = store.Open(ctx, otherRef)
+	require.True(t, os.IsNotExist(err))
+
+	// recently trashed blobs aren't emptied
+	emptied, err := store.EmptyTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, emptied)
+
+	emptied, err = store.EmptyTrash(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, len(data), emptied)
+
+	require.NoError(t, store.RestoreTrash(ctx, otherNamespace))
+	_, err = store.Open(ctx, otherRef)
+	require.True(t, os.IsNotExist(err))
+}
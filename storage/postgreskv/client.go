@@ -126,6 +126,76 @@ func (client *Client) DeletePath(ctx context.Context, bucket, key storage.Key) (
 	return nil
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.CompareAndSwapPath(ctx, storage.Key(defaultBucket), key, oldValue, newValue)
+}
+
+// CompareAndSwapPath atomically compares and swaps oldValue with newValue (in the given bucket)
+func (client *Client) CompareAndSwapPath(ctx context.Context, bucket, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	var result sql.Result
+	switch {
+	case oldValue == nil && newValue == nil:
+		q := "SELECT metadata FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
+		var val []byte
+		err = client.pgConn.QueryRow(q, []byte(bucket), []byte(key)).Scan(&val)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return storage.ErrValueChanged.New(key.String())
+	case oldValue == nil:
+		q := `
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT DO NOTHING
+		`
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(newValue))
+		if err != nil {
+			return err
+		}
+		numRows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if numRows == 0 {
+			return storage.ErrValueChanged.New(key.String())
+		}
+		return nil
+	case newValue == nil:
+		q := "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(oldValue))
+	default:
+		q := "UPDATE pathdata SET metadata = $4::BYTEA WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(oldValue), []byte(newValue))
+	}
+	if err != nil {
+		return err
+	}
+	numRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numRows > 0 {
+		return nil
+	}
+
+	// find out whether the key is missing or its value is different
+	_, err = client.GetPath(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+	return storage.ErrValueChanged.New(key.String())
+}
+
 // List returns either a list of known keys, in order, or an error.
 func (client *Client) List(ctx context.Context, first storage.Key, limit int) (_ storage.Keys, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -4,6 +4,7 @@
 package redis
 
 import (
+	"bytes"
 	"context"
 	"net/url"
 	"sort"
@@ -125,6 +126,130 @@ func (client *Client) Delete(ctx context.Context, key storage.Key) (err error) {
 	return nil
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (client *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	txf := func(tx *redis.Tx) error {
+		value, err := tx.Get(key.String()).Bytes()
+		switch {
+		case err == redis.Nil:
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New(key.String())
+			}
+		case err != nil:
+			return Error.New("get error: %v", err)
+		case !bytes.Equal(value, oldValue):
+			return storage.ErrValueChanged.New(key.String())
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			if newValue == nil {
+				pipe.Del(key.String())
+			} else {
+				pipe.Set(key.String(), []byte(newValue), client.TTL)
+			}
+			return nil
+		})
+		return err
+	}
+
+	err = client.db.Watch(txf, key.String())
+	if err == redis.TxFailedErr {
+		return storage.ErrValueChanged.New(key.String())
+	}
+	if err != nil && !storage.ErrKeyNotFound.Has(err) && !storage.ErrValueChanged.Has(err) {
+		return Error.New("compare and swap error: %v", err)
+	}
+	return err
+}
+
+// incrementExistingScript increments the counter at KEYS[1] by ARGV[1], when it exists.
+var incrementExistingScript = redis.NewScript(`
+if redis.call("exists", KEYS[1]) == 0 then
+	return false
+end
+return redis.call("incrby", KEYS[1], ARGV[1])
+`)
+
+// incrementScript increments the counter at KEYS[1] by ARGV[1] and lets a counter
+// without expiration expire after ARGV[2] milliseconds, when ARGV[2] is positive.
+var incrementScript = redis.NewScript(`
+local value = redis.call("incrby", KEYS[1], ARGV[1])
+if tonumber(ARGV[2]) > 0 and redis.call("pttl", KEYS[1]) < 0 then
+	redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return value
+`)
+
+// Increment atomically adds delta to the counter stored at key and returns the new value.
+// A missing counter is created with the value delta and expires after ttl, when ttl is positive.
+func (client *Client) Increment(ctx context.Context, key storage.Key, delta int64, ttl time.Duration) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return 0, storage.ErrEmptyKey.New("")
+	}
+
+	value, err := incrementScript.Run(client.db, []string{key.String()}, delta, int64(ttl/time.Millisecond)).Int64()
+	if err != nil {
+		return 0, Error.New("increment error: %v", err)
+	}
+	return value, nil
+}
+
+// IncrementExisting atomically adds delta to the counter stored at key, when it exists,
+// and returns the new value. The returned bool is false when the counter doesn't exist.
+func (client *Client) IncrementExisting(ctx context.Context, key storage.Key, delta int64) (_ int64, _ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return 0, false, storage.ErrEmptyKey.New("")
+	}
+
+	value, err := incrementExistingScript.Run(client.db, []string{key.String()}, delta).Int64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, Error.New("increment error: %v", err)
+	}
+	return value, true, nil
+}
+
+// SetIfNotExists stores value at key expiring after ttl, when ttl is positive, unless key
+// already exists. It returns whether the value was stored.
+func (client *Client) SetIfNotExists(ctx context.Context, key storage.Key, value storage.Value, ttl time.Duration) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if key.IsZero() {
+		return false, storage.ErrEmptyKey.New("")
+	}
+
+	stored, err := client.db.SetNX(key.String(), []byte(value), ttl).Result()
+	if err != nil {
+		return false, Error.New("setnx error: %v", err)
+	}
+	return stored, nil
+}
+
+// DeletePrefix deletes all the keys starting with prefix.
+func (client *Client) DeletePrefix(ctx context.Context, prefix storage.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	match := string(escapeMatch([]byte(prefix))) + "*"
+	it := client.db.Scan(0, match, 0).Iterator()
+	for it.Next() {
+		if err := client.db.Del(it.Val()).Err(); err != nil {
+			return Error.New("delete error: %v", err)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return Error.New("scan error: %v", err)
+	}
+	return nil
+}
+
 // Close closes a redis client
 func (client *Client) Close() error {
 	return client.db.Close()
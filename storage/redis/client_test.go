@@ -5,7 +5,12 @@ package redis
 
 import (
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/redis/redisserver"
 	"storj.io/storj/storage/testsuite"
 )
@@ -25,6 +30,58 @@ func TestSuite(t *testing.T) {
 	testsuite.RunTests(t, client)
 }
 
+func TestCounters(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	addr, cleanup, err := redisserver.Start()
+	require.NoError(t, err)
+	defer cleanup()
+
+	// miniredis runs scripts against db 0 regardless of the selected db
+	client, err := NewClient(addr, "", 0)
+	require.NoError(t, err)
+	defer ctx.Check(client.Close)
+
+	_, ok, err := client.IncrementExisting(ctx, storage.Key("counters/a"), 5)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	value, err := client.Increment(ctx, storage.Key("counters/a"), 5, time.Hour)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, value)
+
+	// the counter expires, and incrementing it again keeps the expiration
+	value, err = client.Increment(ctx, storage.Key("counters/a"), 0, 2*time.Hour)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, value)
+	ttl, err := client.db.PTTL("counters/a").Result()
+	require.NoError(t, err)
+	require.True(t, ttl > 0 && ttl <= time.Hour, ttl)
+
+	value, ok, err = client.IncrementExisting(ctx, storage.Key("counters/a"), -2)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 3, value)
+
+	stored, err := client.SetIfNotExists(ctx, storage.Key("counters/a"), storage.Value("10"), time.Hour)
+	require.NoError(t, err)
+	require.False(t, stored)
+	stored, err = client.SetIfNotExists(ctx, storage.Key("counters/b"), storage.Value("10"), time.Hour)
+	require.NoError(t, err)
+	require.True(t, stored)
+
+	err = client.Put(ctx, storage.Key("other"), storage.Value("1"))
+	require.NoError(t, err)
+
+	err = client.DeletePrefix(ctx, storage.Key("counters/"))
+	require.NoError(t, err)
+
+	keys, err := client.List(ctx, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, storage.Keys{storage.Key("other")}, keys)
+}
+
 func TestInvalidConnection(t *testing.T) {
 	_, err := NewClient("", "", 1)
 	if err == nil {
@@ -26,14 +26,15 @@ type Client struct {
 	ForceError int
 
 	CallCount struct {
-		Get         int
-		Put         int
-		List        int
-		GetAll      int
-		ReverseList int
-		Delete      int
-		Close       int
-		Iterate     int
+		Get            int
+		Put            int
+		List           int
+		GetAll         int
+		ReverseList    int
+		Delete         int
+		CompareAndSwap int
+		Close          int
+		Iterate        int
 	}
 
 	version int
@@ -89,14 +90,18 @@ func (store *Client) Put(ctx context.Context, key storage.Key, value storage.Val
 		return nil
 	}
 
+	store.put(keyIndex, key, value)
+	return nil
+}
+
+// put inserts a new key at keyIndex
+func (store *Client) put(keyIndex int, key storage.Key, value storage.Value) {
 	store.Items = append(store.Items, storage.ListItem{})
 	copy(store.Items[keyIndex+1:], store.Items[keyIndex:])
 	store.Items[keyIndex] = storage.ListItem{
 		Key:   storage.CloneKey(key),
 		Value: storage.CloneValue(value),
 	}
-
-	return nil
 }
 
 // Get gets a value to store
@@ -148,6 +153,45 @@ func (store *Client) GetAll(ctx context.Context, keys storage.Keys) (_ storage.V
 	return values, nil
 }
 
+// CompareAndSwap atomically compares and swaps oldValue with newValue
+func (store *Client) CompareAndSwap(ctx context.Context, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.CompareAndSwap++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	keyIndex, found := store.indexOf(key)
+	if !found {
+		if oldValue != nil {
+			return storage.ErrKeyNotFound.New(key.String())
+		}
+		if newValue == nil {
+			return nil
+		}
+		store.put(keyIndex, key, newValue)
+		return nil
+	}
+
+	if !bytes.Equal(store.Items[keyIndex].Value, oldValue) {
+		return storage.ErrValueChanged.New(key.String())
+	}
+	if newValue == nil {
+		copy(store.Items[keyIndex:], store.Items[keyIndex+1:])
+		store.Items = store.Items[:len(store.Items)-1]
+		return nil
+	}
+	store.Items[keyIndex].Value = storage.CloneValue(newValue)
+	return nil
+}
+
 // Delete deletes key and the value
 func (store *Client) Delete(ctx context.Context, key storage.Key) (err error) {
 	defer mon.Task()(&ctx)(&err)
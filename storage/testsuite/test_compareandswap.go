@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("compare/and/swap")
+	defer func() { _ = store.Delete(ctx, key) }()
+
+	expect := func(t *testing.T, value storage.Value) {
+		got, err := store.Get(ctx, key)
+		if value == nil {
+			if !storage.ErrKeyNotFound.Has(err) {
+				t.Fatalf("expected key not found, got %v (%v)", got, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("failed to get %q: %v", key, err)
+		}
+		if !bytes.Equal(got, value) {
+			t.Fatalf("invalid value for %q = %v: got %v", key, value, got)
+		}
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		if err := store.CompareAndSwap(ctx, key, storage.Value("old"), storage.Value("new")); !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("swapping a missing key should fail with key not found: %v", err)
+		}
+		if err := store.CompareAndSwap(ctx, key, nil, storage.Value("old")); err != nil {
+			t.Fatalf("failed to create %q: %v", key, err)
+		}
+		expect(t, storage.Value("old"))
+
+		if err := store.CompareAndSwap(ctx, key, nil, storage.Value("new")); !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("creating an existing key should fail with value changed: %v", err)
+		}
+		expect(t, storage.Value("old"))
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		if err := store.CompareAndSwap(ctx, key, storage.Value("other"), storage.Value("new")); !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("swapping a different value should fail with value changed: %v", err)
+		}
+		expect(t, storage.Value("old"))
+
+		if err := store.CompareAndSwap(ctx, key, storage.Value("old"), storage.Value("new")); err != nil {
+			t.Fatalf("failed to swap %q: %v", key, err)
+		}
+		expect(t, storage.Value("new"))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := store.CompareAndSwap(ctx, key, storage.Value("old"), nil); !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("deleting a different value should fail with value changed: %v", err)
+		}
+		expect(t, storage.Value("new"))
+
+		if err := store.CompareAndSwap(ctx, key, storage.Value("new"), nil); err != nil {
+			t.Fatalf("failed to delete %q: %v", key, err)
+		}
+		expect(t, nil)
+
+		if err := store.CompareAndSwap(ctx, key, nil, nil); err != nil {
+			t.Fatalf("deleting a missing key should succeed: %v", err)
+		}
+	})
+}
@@ -31,6 +31,8 @@ type Usage struct {
 	GetRepair int64
 	PutRepair int64
 	Delete    int64
+
+	PutGracefulExit int64
 }
 
 // Include adds specified action to the appropriate field.
@@ -50,6 +52,8 @@ func (usage *Usage) Include(action pb.PieceAction, amount int64) {
 		usage.PutRepair += amount
 	case pb.PieceAction_DELETE:
 		usage.Delete += amount
+	case pb.PieceAction_PUT_GRACEFUL_EXIT:
+		usage.PutGracefulExit += amount
 	default:
 		usage.Unknown += amount
 	}
@@ -65,6 +69,7 @@ func (usage *Usage) Add(b *Usage) {
 	usage.GetRepair += b.GetRepair
 	usage.PutRepair += b.PutRepair
 	usage.Delete += b.Delete
+	usage.PutGracefulExit += b.PutGracefulExit
 }
 
 // Total sums all type of bandwidths
@@ -76,7 +81,8 @@ func (usage *Usage) Total() int64 {
 		usage.GetAudit +
 		usage.GetRepair +
 		usage.PutRepair +
-		usage.Delete
+		usage.Delete +
+		usage.PutGracefulExit
 }
 
 // TotalMonthlySummary returns total bandwidth usage for current month
@@ -93,19 +93,19 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 		}
 
 		for _, expired := range infos {
-			err := service.pieces.Delete(ctx, expired.SatelliteID, expired.PieceID)
+			err := service.pieces.Trash(ctx, expired.SatelliteID, expired.PieceID)
 			if err != nil {
 				errfailed := service.pieceinfos.DeleteFailed(ctx, expired.SatelliteID, expired.PieceID, now)
 				if errfailed != nil {
 					service.log.Error("unable to update piece info", zap.Stringer("satellite id", expired.SatelliteID), zap.Stringer("piece id", expired.PieceID), zap.Error(errfailed))
 				}
-				service.log.Error("unable to delete piece", zap.Stringer("satellite id", expired.SatelliteID), zap.Stringer("piece id", expired.PieceID), zap.Error(err))
+				service.log.Error("unable to trash piece", zap.Stringer("satellite id", expired.SatelliteID), zap.Stringer("piece id", expired.PieceID), zap.Error(err))
 				continue
 			}
 
-			err = service.pieceinfos.Delete(ctx, expired.SatelliteID, expired.PieceID)
+			err = service.pieceinfos.Trash(ctx, expired.SatelliteID, expired.PieceID, time.Now())
 			if err != nil {
-				service.log.Error("unable to delete piece info", zap.Stringer("satellite id", expired.SatelliteID), zap.Stringer("piece id", expired.PieceID), zap.Error(err))
+				service.log.Error("unable to mark piece info as trashed", zap.Stringer("satellite id", expired.SatelliteID), zap.Stringer("piece id", expired.PieceID), zap.Error(err))
 				continue
 			}
 
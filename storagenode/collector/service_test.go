@@ -108,6 +108,10 @@ func TestCollector(t *testing.T) {
 			err = storageNode.Collector.Collect(ctx, time.Now().Add(10*24*time.Hour))
 			require.NoError(t, err)
 
+			// the collected pieces use space until the trash is emptied
+			err = storageNode.Trash.Empty(ctx, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+
 			// verify that we deleted everything
 			used, err := pieceinfos.SpaceUsed(ctx)
 			require.NoError(t, err)
@@ -0,0 +1,124 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for contact package
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for contacting satellites
+type Config struct {
+	Interval time.Duration `help:"how frequently the node checks in with the trusted satellites" default:"30m0s"`
+}
+
+// Chore periodically checks in with the trusted satellites, so that they
+// know the current address, capacity and version of the node.
+type Chore struct {
+	log          *zap.Logger
+	transport    transport.Client
+	trust        *trust.Pool
+	routingTable *kademlia.RoutingTable
+
+	Loop sync2.Cycle
+}
+
+// NewChore creates a new contact chore
+func NewChore(log *zap.Logger, transport transport.Client, trust *trust.Pool, routingTable *kademlia.RoutingTable, config Config) *Chore {
+	return &Chore{
+		log:          log,
+		transport:    transport,
+		trust:        trust,
+		routingTable: routingTable,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run checks in with the satellites on every interval
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, chore.checkInAll)
+}
+
+func (chore *Chore) checkInAll(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errgroup.Group
+	for _, satelliteID := range chore.trust.GetSatellites(ctx) {
+		satelliteID := satelliteID
+		group.Go(func() error {
+			err := chore.checkIn(ctx, satelliteID)
+			if err != nil {
+				chore.log.Error("failed to check in with satellite", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+			}
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
+
+	return nil
+}
+
+// checkIn sends the information about the node to a satellite
+func (chore *Chore) checkIn(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	address, err := chore.trust.GetAddress(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := chore.transport.DialNode(ctx, &pb.Node{
+		Id: satelliteID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	self := chore.routingTable.Local()
+	resp, err := pb.NewContactClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+		Address:  self.Address.GetAddress(),
+		Capacity: &self.Capacity,
+		Operator: &self.Operator,
+		Version:  &self.Version,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !resp.PingNodeSuccess {
+		return Error.New("satellite failed to ping back the node: %s", resp.PingErrorMessage)
+	}
+	return nil
+}
+
+// Close stops the contact chore
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
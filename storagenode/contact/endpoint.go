@@ -0,0 +1,39 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint answers the pings of satellites that verify the address a node
+// checked in with
+type Endpoint struct {
+	log *zap.Logger
+}
+
+// NewEndpoint returns a new contact endpoint
+func NewEndpoint(log *zap.Logger) *Endpoint {
+	return &Endpoint{
+		log: log,
+	}
+}
+
+// PingNode responds to a ping from a satellite
+func (endpoint *Endpoint) PingNode(ctx context.Context, req *pb.ContactPingRequest) (_ *pb.ContactPingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	endpoint.log.Debug("pinged", zap.Stringer("by", peerID.ID))
+
+	return &pb.ContactPingResponse{}, nil
+}
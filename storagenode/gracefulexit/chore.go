@@ -0,0 +1,298 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+	"storj.io/storj/uplink/piecestore"
+)
+
+// deleteBatchSize is the number of pieces deleted at once after a successful exit.
+const deleteBatchSize = 1000
+
+// Chore transfers the pieces of the satellites the node is exiting to other nodes.
+type Chore struct {
+	log       *zap.Logger
+	Loop      sync2.Cycle
+	trust     *trust.Pool
+	transport transport.Client
+	store     *pieces.Store
+	pieceInfo pieces.DB
+	db        DB
+	config    Config
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, trust *trust.Pool, transport transport.Client, store *pieces.Store, pieceInfo pieces.DB, db DB, config Config) *Chore {
+	return &Chore{
+		log:       log,
+		Loop:      *sync2.NewCycle(config.ChoreInterval),
+		trust:     trust,
+		transport: transport,
+		store:     store,
+		pieceInfo: pieceInfo,
+		db:        db,
+		config:    config,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("error listing graceful exits", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce transfers pieces for all unfinished exits.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := chore.db.ListGracefulExits(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, exit := range exits {
+		if exit.FinishedAt != nil {
+			continue
+		}
+
+		err := chore.transferPieces(ctx, exit.SatelliteID)
+		if err != nil {
+			chore.log.Error("error transferring pieces", zap.Stringer("satellite ID", exit.SatelliteID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// transferPieces transfers pieces until the satellite doesn't hand out any more of them.
+func (chore *Chore) transferPieces(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := dialSatellite(ctx, chore.trust, chore.transport, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	client := pb.NewSatelliteGracefulExitClient(conn)
+
+	for {
+		resp, err := client.GetTransfers(ctx, &pb.GetTransfersRequest{Limit: int32(chore.config.TransferBatchSize)})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if resp.Finished {
+			return chore.finish(ctx, satelliteID, resp.Successful)
+		}
+		if len(resp.Pieces) == 0 {
+			// the satellite is still collecting pieces, try again on the next iteration
+			return nil
+		}
+
+		for _, piece := range resp.Pieces {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			chore.transferPiece(ctx, client, satelliteID, piece)
+		}
+	}
+}
+
+// transferPiece transfers a single piece, reports the result to the satellite and
+// deletes the piece once the satellite has accepted the transfer.
+func (chore *Chore) transferPiece(ctx context.Context, client pb.SatelliteGracefulExitClient, satelliteID storj.NodeID, piece *pb.TransferPiece) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	report, pieceSize := chore.transfer(ctx, satelliteID, piece)
+
+	_, err = client.ReportTransfer(ctx, report)
+	if err != nil {
+		chore.log.Warn("unable to report transfer", zap.Stringer("piece ID", piece.OriginalPieceId), zap.Error(err))
+		return
+	}
+	if report.Failed != nil {
+		return
+	}
+
+	err = chore.deletePiece(ctx, satelliteID, piece.OriginalPieceId)
+	if err != nil {
+		chore.log.Error("unable to delete transferred piece", zap.Stringer("piece ID", piece.OriginalPieceId), zap.Error(err))
+		return
+	}
+
+	err = chore.db.UpdateGracefulExit(ctx, satelliteID, pieceSize)
+	if err != nil {
+		chore.log.Error("unable to update graceful exit progress", zap.Stringer("satellite ID", satelliteID), zap.Error(err))
+	}
+}
+
+// transfer uploads the piece to the new node and returns the report for the satellite and the size of the piece.
+func (chore *Chore) transfer(ctx context.Context, satelliteID storj.NodeID, piece *pb.TransferPiece) (_ *pb.ReportTransferRequest, pieceSize int64) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	pieceID := piece.OriginalPieceId
+	failed := func(code pb.TransferFailed_Error, err error) *pb.ReportTransferRequest {
+		chore.log.Debug("transfer failed", zap.Stringer("piece ID", pieceID), zap.Stringer("reason", code), zap.Error(err))
+		return &pb.ReportTransferRequest{
+			OriginalPieceId: pieceID,
+			Failed:          &pb.TransferFailed{Error: code},
+		}
+	}
+
+	info, err := chore.pieceInfo.Get(ctx, satelliteID, pieceID)
+	if err != nil {
+		return failed(pb.TransferFailed_NOT_FOUND, err), 0
+	}
+
+	reader, err := chore.store.Reader(ctx, satelliteID, pieceID)
+	if err != nil {
+		return failed(pb.TransferFailed_NOT_FOUND, err), 0
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	addressedLimit := piece.GetAddressedOrderLimit()
+	limit := addressedLimit.GetLimit()
+	if limit == nil {
+		return failed(pb.TransferFailed_UNKNOWN, Error.New("missing order limit")), 0
+	}
+
+	ps, err := piecestore.Dial(ctx, chore.transport, &pb.Node{
+		Id:      limit.StorageNodeId,
+		Address: addressedLimit.GetStorageNodeAddress(),
+	}, chore.log.Named("piecestore"), piecestore.DefaultConfig)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE, err), 0
+	}
+	defer func() { err = errs.Combine(err, ps.Close()) }()
+
+	upload, err := ps.Upload(ctx, limit, piece.PrivateKey)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE, err), 0
+	}
+
+	_, err = io.Copy(upload, reader)
+	if err != nil {
+		return failed(pb.TransferFailed_STORAGE_NODE_UNAVAILABLE, errs.Combine(err, upload.Cancel(ctx))), 0
+	}
+
+	hash, err := upload.Commit(ctx)
+	if err != nil {
+		return failed(pb.TransferFailed_UNKNOWN, err), 0
+	}
+
+	if !bytes.Equal(hash.Hash, info.UplinkPieceHash.Hash) {
+		return failed(pb.TransferFailed_HASH_VERIFICATION, Error.New("stored piece doesn't match the uploaded hash")), 0
+	}
+
+	return &pb.ReportTransferRequest{
+		OriginalPieceId:       pieceID,
+		OriginalPieceHash:     info.UplinkPieceHash,
+		OriginalOrderLimit:    info.OrderLimit,
+		ReplacementPieceHash:  hash,
+		ReplacementOrderLimit: limit,
+	}, info.PieceSize
+}
+
+// finish records the end of the exit, after a successful exit the remaining pieces are garbage.
+func (chore *Chore) finish(ctx context.Context, satelliteID storj.NodeID, success bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if success {
+		chore.deleteAllPieces(ctx, satelliteID)
+	}
+
+	err = chore.db.CompleteGracefulExit(ctx, satelliteID, time.Now().UTC(), success)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	chore.log.Info("graceful exit finished", zap.Stringer("satellite ID", satelliteID), zap.Bool("success", success))
+	return nil
+}
+
+// deleteAllPieces deletes all pieces stored for the satellite.
+func (chore *Chore) deleteAllPieces(ctx context.Context, satelliteID storj.NodeID) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	createdBefore := time.Now().UTC()
+	offset := 0
+	for {
+		pieceIDs, err := chore.pieceInfo.GetPieceIDs(ctx, satelliteID, createdBefore, deleteBatchSize, offset)
+		if err != nil {
+			chore.log.Error("unable to list pieces", zap.Stringer("satellite ID", satelliteID), zap.Error(err))
+			return
+		}
+		if len(pieceIDs) == 0 {
+			return
+		}
+
+		for _, pieceID := range pieceIDs {
+			if err := chore.deletePiece(ctx, satelliteID, pieceID); err != nil {
+				chore.log.Error("unable to delete piece", zap.Stringer("piece ID", pieceID), zap.Error(err))
+				// the piece is still listed, skip it on the next page
+				offset++
+			}
+		}
+	}
+}
+
+// deletePiece deletes the piece and its information.
+func (chore *Chore) deletePiece(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.store.Delete(ctx, satelliteID, pieceID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(chore.pieceInfo.Delete(ctx, satelliteID, pieceID))
+}
+
+// Close closes resources.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// dialSatellite looks up the address of the trusted satellite and connects to it.
+func dialSatellite(ctx context.Context, trust *trust.Pool, transport transport.Client, satelliteID storj.NodeID) (_ *grpc.ClientConn, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	address, err := trust.GetAddress(ctx, satelliteID)
+	if err != nil {
+		return nil, Error.New("unable to get satellite address: %v", err)
+	}
+
+	return transport.DialNode(ctx, &pb.Node{
+		Id: satelliteID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// Error is the default error class for graceful exit package.
+	Error = errs.Class("gracefulexit")
+
+	mon = monkit.Package()
+)
+
+// Config is the configuration for graceful exit.
+type Config struct {
+	ChoreInterval     time.Duration `help:"how often to run the graceful exit chore, which transfers pieces to other nodes" releaseDefault:"15m" devDefault:"10s"`
+	TransferBatchSize int           `help:"number of pieces requested from the satellite at once" default:"100"`
+}
+
+// ExitProgress contains the graceful exit progress of a single satellite.
+type ExitProgress struct {
+	SatelliteID       storj.NodeID
+	InitiatedAt       time.Time
+	FinishedAt        *time.Time
+	StartingDiskUsage int64
+	BytesDeleted      int64
+	Success           bool
+}
+
+// DB tracks the graceful exits of the storage node.
+type DB interface {
+	// InitiateGracefulExit records that the node has started exiting the satellite.
+	InitiateGracefulExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time, startingDiskUsage int64) error
+	// UpdateGracefulExit adds to the number of bytes deleted during the exit.
+	UpdateGracefulExit(ctx context.Context, satelliteID storj.NodeID, addToBytesDeleted int64) error
+	// CompleteGracefulExit records the end of the exit.
+	CompleteGracefulExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, success bool) error
+	// ListGracefulExits returns the progress of all exits.
+	ListGracefulExits(ctx context.Context) ([]ExitProgress, error)
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+)
+
+// Endpoint implements private inspector for Graceful Exit.
+type Endpoint struct {
+	log       *zap.Logger
+	transport transport.Client
+	trust     *trust.Pool
+	pieceInfo pieces.DB
+	db        DB
+}
+
+// NewEndpoint creates a new graceful exit endpoint.
+func NewEndpoint(log *zap.Logger, transport transport.Client, trust *trust.Pool, pieceInfo pieces.DB, db DB) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		transport: transport,
+		trust:     trust,
+		pieceInfo: pieceInfo,
+		db:        db,
+	}
+}
+
+// GetNonExitingSatellites returns a list of satellites that the storagenode has not begun a graceful exit for.
+func (e *Endpoint) GetNonExitingSatellites(ctx context.Context, req *pb.GetNonExitingSatellitesRequest) (_ *pb.GetNonExitingSatellitesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := e.db.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	exiting := make(map[storj.NodeID]bool, len(exits))
+	for _, exit := range exits {
+		exiting[exit.SatelliteID] = true
+	}
+
+	var satellites []*pb.NonExitingSatellite
+	for _, satelliteID := range e.trust.GetSatellites(ctx) {
+		if exiting[satelliteID] {
+			continue
+		}
+
+		domain, err := e.trust.GetAddress(ctx, satelliteID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		spaceUsed, err := e.pieceInfo.SpaceUsedBySatellite(ctx, satelliteID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		satellites = append(satellites, &pb.NonExitingSatellite{
+			NodeId:     satelliteID,
+			DomainName: domain,
+			SpaceUsed:  float64(spaceUsed),
+		})
+	}
+
+	return &pb.GetNonExitingSatellitesResponse{Satellites: satellites}, nil
+}
+
+// InitiateGracefulExit notifies the satellite about the exit and starts tracking its progress.
+func (e *Endpoint) InitiateGracefulExit(ctx context.Context, req *pb.InitiateGracefulExitRequest) (_ *pb.ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	domain, err := e.trust.GetAddress(ctx, req.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	spaceUsed, err := e.pieceInfo.SpaceUsedBySatellite(ctx, req.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	conn, err := dialSatellite(ctx, e.trust, e.transport, req.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	resp, err := pb.NewSatelliteGracefulExitClient(conn).InitiateExit(ctx, &pb.InitiateExitRequest{})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = e.db.InitiateGracefulExit(ctx, req.NodeId, resp.InitiatedAt, spaceUsed)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	e.log.Info("graceful exit initiated", zap.Stringer("satellite ID", req.NodeId))
+
+	return &pb.ExitProgress{
+		DomainName: domain,
+		NodeId:     req.NodeId,
+	}, nil
+}
+
+// GetExitProgress returns graceful exit progress on each satellite that the storagenode has started exiting.
+func (e *Endpoint) GetExitProgress(ctx context.Context, req *pb.GetExitProgressRequest) (_ *pb.GetExitProgressResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := e.db.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	resp := &pb.GetExitProgressResponse{}
+	for _, exit := range exits {
+		// the satellite may have been removed from the trusted list in the meantime
+		domain, err := e.trust.GetAddress(ctx, exit.SatelliteID)
+		if err != nil {
+			e.log.Debug("unable to get satellite address", zap.Stringer("satellite ID", exit.SatelliteID), zap.Error(err))
+		}
+
+		finished := exit.FinishedAt != nil
+
+		var percentCompleted float32
+		switch {
+		case finished:
+			percentCompleted = 100
+		case exit.StartingDiskUsage > 0:
+			percentCompleted = float32(exit.BytesDeleted) / float32(exit.StartingDiskUsage) * 100
+		}
+
+		resp.Progress = append(resp.Progress, &pb.ExitProgress{
+			DomainName:      domain,
+			NodeId:          exit.SatelliteID,
+			PercentComplete: percentCompleted,
+			Finished:        finished,
+			Successful:      exit.Success,
+		})
+	}
+
+	return resp, nil
+}
@@ -18,6 +18,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 )
@@ -35,6 +36,7 @@ type Endpoint struct {
 	pieceInfo pieces.DB
 	kademlia  *kademlia.Kademlia
 	usageDB   bandwidth.DB
+	monitor   *monitor.Service
 
 	startTime        time.Time
 	pieceStoreConfig piecestore.OldConfig
@@ -47,6 +49,7 @@ func NewEndpoint(
 	pieceInfo pieces.DB,
 	kademlia *kademlia.Kademlia,
 	usageDB bandwidth.DB,
+	monitor *monitor.Service,
 	pieceStoreConfig piecestore.OldConfig,
 	dashbaordAddress net.Addr) *Endpoint {
 
@@ -55,6 +58,7 @@ func NewEndpoint(
 		pieceInfo:        pieceInfo,
 		kademlia:         kademlia,
 		usageDB:          usageDB,
+		monitor:          monitor,
 		pieceStoreConfig: pieceStoreConfig,
 		dashboardAddress: dashbaordAddress,
 		startTime:        time.Now(),
@@ -80,7 +84,7 @@ func (inspector *Endpoint) retrieveStats(ctx context.Context) (_ *pb.StatSummary
 
 	return &pb.StatSummaryResponse{
 		UsedSpace:          totalUsedSpace,
-		AvailableSpace:     inspector.pieceStoreConfig.AllocatedDiskSpace.Int64() - totalUsedSpace,
+		AvailableSpace:     inspector.pieceStoreConfig.TotalAllocatedDiskSpace().Int64() - totalUsedSpace,
 		UsedIngress:        ingress,
 		UsedEgress:         egress,
 		UsedBandwidth:      totalUsedBandwidth,
@@ -118,6 +122,20 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *pb.Dashboar
 		return &pb.DashboardResponse{}, Error.Wrap(err)
 	}
 
+	dirs, err := inspector.monitor.DiskUsage(ctx)
+	if err != nil {
+		return &pb.DashboardResponse{}, Error.Wrap(err)
+	}
+	disks := make([]*pb.DiskUsage, len(dirs))
+	for i, dir := range dirs {
+		disks[i] = &pb.DiskUsage{
+			Path:           dir.Name,
+			UsedSpace:      dir.DiskUsed,
+			AvailableSpace: dir.Available,
+			AllocatedSpace: dir.Allocated,
+		}
+	}
+
 	bootstrapNodes := inspector.kademlia.GetBootstrapNodes()
 	bsNodes := make([]string, len(bootstrapNodes))
 	for i, node := range bootstrapNodes {
@@ -135,6 +153,7 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *pb.Dashboar
 		DashboardAddress: inspector.dashboardAddress.String(),
 		Uptime:           ptypes.DurationProto(time.Since(inspector.startTime)),
 		Stats:            statsSummary,
+		Disks:            disks,
 	}, nil
 }
 
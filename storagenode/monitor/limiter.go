@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"storj.io/storj/internal/memory"
+)
+
+// minimumBurst is the least amount of bytes that can be transferred at once
+const minimumBurst = 32 * memory.KiB
+
+// Limiter limits the rate of piece transfers according to the bandwidth
+// schedule with a token bucket, which is shared by all concurrent transfers.
+type Limiter struct {
+	schedule Schedule
+
+	mu      sync.Mutex
+	rate    memory.Size
+	limiter *rate.Limiter
+}
+
+// NewLimiter creates a limiter for the schedule.
+func NewLimiter(schedule Schedule) *Limiter {
+	return &Limiter{schedule: schedule}
+}
+
+// Wait blocks until n bytes may be transferred or the context is done.
+func (limiter *Limiter) Wait(ctx context.Context, n int64) (err error) {
+	if len(limiter.schedule) == 0 {
+		return nil
+	}
+	defer mon.Task()(&ctx)(&err)
+
+	for n > 0 {
+		bucket := limiter.bucket(time.Now())
+		if bucket == nil {
+			return nil
+		}
+
+		tokens := n
+		if burst := int64(bucket.Burst()); tokens > burst {
+			tokens = burst
+		}
+		if err := bucket.WaitN(ctx, int(tokens)); err != nil {
+			return Error.Wrap(err)
+		}
+		n -= tokens
+	}
+	return nil
+}
+
+// bucket returns the token bucket for the rate at the given time, nil means
+// that the bandwidth is not limited.
+func (limiter *Limiter) bucket(now time.Time) *rate.Limiter {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	current := limiter.schedule.Rate(now)
+	if current <= 0 {
+		return nil
+	}
+
+	// the waiting transfers finish with the previous rate
+	if current != limiter.rate || limiter.limiter == nil {
+		burst := current
+		if burst < minimumBurst {
+			burst = minimumBurst
+		}
+		limiter.rate = current
+		limiter.limiter = rate.NewLimiter(rate.Limit(current), burst.Int())
+	}
+	return limiter.limiter
+}
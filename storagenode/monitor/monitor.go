@@ -31,6 +31,8 @@ type Config struct {
 	Interval         time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 	MinimumDiskSpace memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
 	MinimumBandwidth memory.Size   `help:"how much bandwidth a node at minimum has to advertise" default:"500GB"`
+
+	BandwidthSchedule Schedule `help:"comma-separated bandwidth rate limits per second during times of the day in local time, e.g. 09:00-17:00=1MB,17:00-23:00=4MB" default:""`
 }
 
 // Service which monitors disk usage and updates kademlia network as necessary.
@@ -42,8 +44,10 @@ type Service struct {
 	usageDB            bandwidth.DB
 	allocatedDiskSpace int64
 	allocatedBandwidth int64
+	interval           time.Duration
 	Loop               sync2.Cycle
 	Config             Config
+	Limiter            *Limiter
 }
 
 // TODO: should it be responsible for monitoring actual bandwidth as well?
@@ -58,8 +62,10 @@ func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pie
 		usageDB:            usageDB,
 		allocatedDiskSpace: allocatedDiskSpace,
 		allocatedBandwidth: allocatedBandwidth,
+		interval:           interval,
 		Loop:               *sync2.NewCycle(interval),
 		Config:             config,
+		Limiter:            NewLimiter(config.BandwidthSchedule),
 	}
 }
 
@@ -74,6 +80,12 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 	freeDiskSpace := storageStatus.DiskFree
+	for _, dir := range storageStatus.Dirs {
+		service.log.Info("Storage directory",
+			zap.String("path", dir.Name),
+			zap.Int64("free", dir.DiskFree),
+			zap.Int64("allocated", dir.Allocated))
+	}
 
 	totalUsed, err := service.usedSpace(ctx)
 	if err != nil {
@@ -125,6 +137,12 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	}
 
 	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		// the used space of the pieces is calculated here, so that storing
+		// pieces never has to walk the directories
+		if err := service.store.UpdateSpaceUsed(ctx); err != nil {
+			service.log.Error("error during updating the used space: ", zap.Error(err))
+		}
+
 		err := service.updateNodeInformation(ctx)
 		if err != nil {
 			service.log.Error("error during updating node information: ", zap.Error(err))
@@ -153,13 +171,22 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 	}
 
 	service.routingTable.UpdateSelf(&pb.NodeCapacity{
-		FreeBandwidth: service.allocatedBandwidth - usedBandwidth,
+		FreeBandwidth: service.scheduledBandwidth(time.Now(), service.allocatedBandwidth-usedBandwidth),
 		FreeDisk:      service.allocatedDiskSpace - usedSpace,
 	})
 
 	return nil
 }
 
+// scheduledBandwidth limits the free bandwidth to what the schedule allows until the next update
+func (service *Service) scheduledBandwidth(now time.Time, freeBandwidth int64) int64 {
+	scheduled, limited := service.Config.BandwidthSchedule.Transferable(now, service.interval)
+	if limited && scheduled < freeBandwidth {
+		return scheduled
+	}
+	return freeBandwidth
+}
+
 func (service *Service) usedSpace(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	usedSpace, err := service.pieceInfo.SpaceUsed(ctx)
@@ -189,6 +216,16 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 	return allocatedSpace - usedSpace, nil
 }
 
+// DiskUsage returns the disk usage of every storage directory.
+func (service *Service) DiskUsage(ctx context.Context) (_ []pieces.DirStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return storageStatus.Dirs, nil
+}
+
 // AvailableBandwidth returns available bandwidth for upload/download
 func (service *Service) AvailableBandwidth(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -5,6 +5,7 @@ package monitor_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,8 @@ import (
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/monitor"
 )
 
 func TestMonitor(t *testing.T) {
@@ -53,3 +56,26 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestMonitor_BandwidthSchedule(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage2.Monitor.BandwidthSchedule = monitor.Schedule{
+					{Start: 0, End: 24 * time.Hour, Rate: memory.KB},
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		storageNode := planet.StorageNodes[0]
+		storageNode.Storage2.Monitor.Loop.TriggerWait()
+
+		// the node advertises what it can transfer until the next update,
+		// testplanet updates the node information every hour
+		interval := time.Hour
+		info, err := storageNode.Kademlia.Service.FetchInfo(ctx, storageNode.Local().Node)
+		require.NoError(t, err)
+		assert.Equal(t, memory.KB.Int64()*int64(interval/time.Second), info.Capacity.FreeBandwidth)
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"storj.io/storj/internal/memory"
+)
+
+// day is the length of the day of the schedule
+const day = 24 * time.Hour
+
+// ScheduleEntry limits the bandwidth of piece transfers during a time of the
+// day. The time range may wrap around midnight.
+type ScheduleEntry struct {
+	Start time.Duration
+	End   time.Duration
+	Rate  memory.Size
+}
+
+// String converts ScheduleEntry to a string.
+func (entry ScheduleEntry) String() string {
+	return formatTimeOfDay(entry.Start) + "-" + formatTimeOfDay(entry.End) + "=" + entry.Rate.String()
+}
+
+// Contains checks whether the time of the day is within the entry.
+func (entry ScheduleEntry) Contains(timeOfDay time.Duration) bool {
+	if entry.Start < entry.End {
+		return entry.Start <= timeOfDay && timeOfDay < entry.End
+	}
+	return entry.Start <= timeOfDay || timeOfDay < entry.End
+}
+
+// Schedule defines a comma delimited flag for defining the bandwidth rate
+// limits per second during times of the day in local time, e.g.
+// "09:00-17:00=1MB,17:00-23:00=4MB". The first matching entry applies, the
+// bandwidth is not limited outside of the entries.
+type Schedule []ScheduleEntry
+
+// ParseSchedule parses a comma delimited list of schedule entries.
+func ParseSchedule(s string) (Schedule, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var schedule Schedule
+	for _, value := range strings.Split(s, ",") {
+		sep := strings.Index(value, "=")
+		if sep <= 0 {
+			return nil, Error.New("invalid schedule entry %q, expected start-end=rate", value)
+		}
+		times := strings.Split(value[:sep], "-")
+		if len(times) != 2 {
+			return nil, Error.New("invalid schedule entry %q, expected start-end=rate", value)
+		}
+
+		var entry ScheduleEntry
+		var err error
+		entry.Start, err = parseTimeOfDay(times[0])
+		if err != nil {
+			return nil, Error.New("invalid start of %q: %v", value, err)
+		}
+		entry.End, err = parseTimeOfDay(times[1])
+		if err != nil {
+			return nil, Error.New("invalid end of %q: %v", value, err)
+		}
+		if entry.Start == entry.End {
+			return nil, Error.New("empty time range in %q", value)
+		}
+		if err := entry.Rate.Set(value[sep+1:]); err != nil {
+			return nil, Error.New("invalid rate of %q: %v", value, err)
+		}
+		if entry.Rate <= 0 {
+			return nil, Error.New("rate of %q must be positive", value)
+		}
+		schedule = append(schedule, entry)
+	}
+	return schedule, nil
+}
+
+// String converts Schedule to a string.
+func (schedule Schedule) String() string {
+	var xs []string
+	for _, entry := range schedule {
+		xs = append(xs, entry.String())
+	}
+	return strings.Join(xs, ",")
+}
+
+// Set implements flag.Value interface.
+func (schedule *Schedule) Set(s string) error {
+	parsed, err := ParseSchedule(s)
+	if err != nil {
+		return err
+	}
+	*schedule = parsed
+	return nil
+}
+
+// Type implements pflag.Value.
+func (Schedule) Type() string { return "monitor.Schedule" }
+
+// Rate returns the bandwidth rate limit per second at the given time, zero
+// means that the bandwidth is not limited.
+func (schedule Schedule) Rate(now time.Time) memory.Size {
+	return schedule.rate(timeOfDay(now))
+}
+
+// Transferable returns how many bytes the schedule allows to transfer during
+// the duration which begins at the given time. It returns false when the
+// bandwidth isn't limited during some part of the duration.
+func (schedule Schedule) Transferable(start time.Time, duration time.Duration) (_ int64, limited bool) {
+	var total float64
+	at := timeOfDay(start)
+	for duration > 0 {
+		rate := schedule.rate(at)
+		if rate <= 0 {
+			return 0, false
+		}
+
+		// the rate stays the same until the next start or end of an entry
+		step := duration
+		for _, entry := range schedule {
+			for _, boundary := range []time.Duration{entry.Start, entry.End} {
+				if until := (boundary - at + day) % day; until > 0 && until < step {
+					step = until
+				}
+			}
+		}
+
+		total += float64(rate) * step.Seconds()
+		at = (at + step) % day
+		duration -= step
+	}
+	return int64(total), true
+}
+
+// rate returns the bandwidth rate limit of the first entry which contains the
+// time of the day.
+func (schedule Schedule) rate(timeOfDay time.Duration) memory.Size {
+	for _, entry := range schedule {
+		if entry.Contains(timeOfDay) {
+			return entry.Rate
+		}
+	}
+	return 0
+}
+
+// timeOfDay returns the time elapsed since the midnight of the day.
+func timeOfDay(now time.Time) time.Duration {
+	hour, min, sec := now.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
+}
+
+// parseTimeOfDay parses a time of the day in the format hh:mm.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	var hours, minutes int
+	if _, err := fmt.Sscanf(s, "%d:%d", &hours, &minutes); err != nil || len(s) != len("hh:mm") {
+		return 0, fmt.Errorf("invalid time %q, expected hh:mm", s)
+	}
+	timeOfDay := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	if hours < 0 || minutes < 0 || minutes >= 60 || timeOfDay > day {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return timeOfDay, nil
+}
+
+// formatTimeOfDay formats a time of the day as hh:mm.
+func formatTimeOfDay(timeOfDay time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(timeOfDay/time.Hour), int(timeOfDay%time.Hour/time.Minute))
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/storagenode/monitor"
+)
+
+func TestParseSchedule(t *testing.T) {
+	schedule, err := monitor.ParseSchedule("09:00-17:00=1MB,23:30-06:00=10MB")
+	require.NoError(t, err)
+	assert.Equal(t, monitor.Schedule{
+		{Start: 9 * time.Hour, End: 17 * time.Hour, Rate: memory.MB},
+		{Start: 23*time.Hour + 30*time.Minute, End: 6 * time.Hour, Rate: 10 * memory.MB},
+	}, schedule)
+	assert.Equal(t, "09:00-17:00=1.0 MB,23:30-06:00=10.0 MB", schedule.String())
+
+	reparsed, err := monitor.ParseSchedule(schedule.String())
+	require.NoError(t, err)
+	assert.Equal(t, schedule, reparsed)
+
+	empty, err := monitor.ParseSchedule("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	for _, invalid := range []string{
+		"09:00=1MB", "09:00-17:00", "9-17=1MB", "09:00-25:00=1MB", "09:60-17:00=1MB",
+		"09:00-09:00=1MB", "09:00-17:00=0", "09:00-17:00=fast",
+	} {
+		_, err := monitor.ParseSchedule(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSchedule_Rate(t *testing.T) {
+	schedule, err := monitor.ParseSchedule("09:00-17:00=1MB,23:30-06:00=10MB,08:00-10:00=2MB")
+	require.NoError(t, err)
+
+	at := func(hour, min int) time.Time {
+		return time.Date(2019, 10, 1, hour, min, 0, 0, time.Local)
+	}
+
+	assert.Equal(t, memory.Size(0), schedule.Rate(at(7, 59)))
+	assert.Equal(t, 2*memory.MB, schedule.Rate(at(8, 0)))
+	assert.Equal(t, memory.MB, schedule.Rate(at(9, 0)))
+	assert.Equal(t, memory.MB, schedule.Rate(at(16, 59)))
+	assert.Equal(t, memory.Size(0), schedule.Rate(at(17, 0)))
+	assert.Equal(t, 10*memory.MB, schedule.Rate(at(23, 30)))
+	assert.Equal(t, 10*memory.MB, schedule.Rate(at(0, 0)))
+	assert.Equal(t, 10*memory.MB, schedule.Rate(at(5, 59)))
+	assert.Equal(t, memory.Size(0), schedule.Rate(at(6, 0)))
+}
+
+func TestSchedule_Transferable(t *testing.T) {
+	schedule, err := monitor.ParseSchedule("09:00-17:00=1KB,23:30-06:00=2KB")
+	require.NoError(t, err)
+
+	at := func(hour, min int) time.Time {
+		return time.Date(2019, 10, 1, hour, min, 0, 0, time.Local)
+	}
+
+	transferable, limited := schedule.Transferable(at(10, 0), time.Hour)
+	assert.True(t, limited)
+	assert.Equal(t, memory.KB.Int64()*3600, transferable)
+
+	// the rate changes within the duration, also across midnight
+	_, limited = schedule.Transferable(at(23, 0), 2*time.Hour)
+	assert.False(t, limited)
+	transferable, limited = schedule.Transferable(at(23, 30), 2*time.Hour)
+	assert.True(t, limited)
+	assert.Equal(t, 2*memory.KB.Int64()*7200, transferable)
+
+	// the bandwidth is not limited from 17:00 on
+	_, limited = schedule.Transferable(at(16, 30), time.Hour)
+	assert.False(t, limited)
+
+	covering, err := monitor.ParseSchedule("00:00-12:00=1KB,12:00-24:00=2KB")
+	require.NoError(t, err)
+	transferable, limited = covering.Transferable(at(11, 30), time.Hour)
+	assert.True(t, limited)
+	assert.Equal(t, memory.KB.Int64()*1800+2*memory.KB.Int64()*1800, transferable)
+
+	transferable, limited = covering.Transferable(at(0, 0), 24*time.Hour)
+	assert.True(t, limited)
+	assert.Equal(t, 3*memory.KB.Int64()*12*3600, transferable)
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	unlimited := monitor.NewLimiter(nil)
+	require.NoError(t, unlimited.Wait(ctx, memory.GB.Int64()))
+
+	schedule, err := monitor.ParseSchedule("00:00-24:00=1MB")
+	require.NoError(t, err)
+	limiter := monitor.NewLimiter(schedule)
+
+	// the first second of the rate is available at once
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, memory.MB.Int64()))
+	require.NoError(t, limiter.Wait(ctx, memory.MB.Int64()/2))
+	assert.True(t, time.Since(start) >= 400*time.Millisecond, time.Since(start))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Error(t, limiter.Wait(canceled, memory.MB.Int64()))
+}
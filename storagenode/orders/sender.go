@@ -14,10 +14,10 @@ import (
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/sync2"
-	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/trust"
 )
 
 var (
@@ -56,6 +56,9 @@ const (
 type DB interface {
 	// Enqueue inserts order to the list of orders needing to be sent to the satellite.
 	Enqueue(ctx context.Context, info *Info) error
+	// Replace inserts order to the list of orders needing to be sent to the satellite,
+	// replacing the unsent order with the same serial number.
+	Replace(ctx context.Context, info *Info) error
 	// ListUnsent returns orders that haven't been sent yet.
 	ListUnsent(ctx context.Context, limit int) ([]*Info, error)
 	// ListUnsentBySatellite returns orders that haven't been sent yet grouped by satellite.
@@ -80,18 +83,18 @@ type Sender struct {
 	config SenderConfig
 
 	transport transport.Client
-	kademlia  *kademlia.Kademlia
+	trust     *trust.Pool
 	orders    DB
 
 	Loop sync2.Cycle
 }
 
 // NewSender creates an order sender.
-func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.Kademlia, orders DB, config SenderConfig) *Sender {
+func NewSender(log *zap.Logger, transport transport.Client, trust *trust.Pool, orders DB, config SenderConfig) *Sender {
 	return &Sender{
 		log:       log,
 		transport: transport,
-		kademlia:  kademlia,
+		trust:     trust,
 		orders:    orders,
 		config:    config,
 
@@ -151,12 +154,18 @@ func (sender *Sender) settle(ctx context.Context, log *zap.Logger, satelliteID s
 	log.Info("sending", zap.Int("count", len(orders)))
 	defer log.Info("finished")
 
-	satellite, err := sender.kademlia.FindNode(ctx, satelliteID)
+	address, err := sender.trust.GetAddress(ctx, satelliteID)
 	if err != nil {
-		return OrderError.New("unable to find satellite on the network: %v", err)
+		return OrderError.New("unable to get satellite address: %v", err)
 	}
 
-	conn, err := sender.transport.DialNode(ctx, &satellite)
+	conn, err := sender.transport.DialNode(ctx, &pb.Node{
+		Id: satelliteID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
 	if err != nil {
 		return OrderError.New("unable to connect to the satellite: %v", err)
 	}
@@ -25,16 +25,20 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/trash"
 	"storj.io/storj/storagenode/trust"
 	"storj.io/storj/storagenode/vouchers"
 )
@@ -58,6 +62,7 @@ type DB interface {
 	UsedSerials() piecestore.UsedSerials
 	Vouchers() vouchers.DB
 	Console() console.DB
+	GracefulExit() gracefulexit.DB
 
 	// TODO: use better interfaces
 	RoutingTable() (kdb, ndb, adb storage.KeyValueStore)
@@ -74,9 +79,14 @@ type Config struct {
 	Storage   piecestore.OldConfig
 	Storage2  piecestore.Config
 	Collector collector.Config
+	Trash     trash.Config
 
 	Vouchers vouchers.Config
 
+	Contact contact.Config
+
+	GracefulExit gracefulexit.Config
+
 	Console consoleserver.Config
 
 	Version version.Config
@@ -113,6 +123,7 @@ type Peer struct {
 		// TODO: lift things outside of it to organize better
 		Trust     *trust.Pool
 		Store     *pieces.Store
+		Migrator  *pieces.Migrator
 		Endpoint  *piecestore.Endpoint
 		Inspector *inspector.Endpoint
 		Monitor   *monitor.Service
@@ -121,7 +132,18 @@ type Peer struct {
 
 	Vouchers *vouchers.Service
 
+	Contact struct {
+		Chore    *contact.Chore
+		Endpoint *contact.Endpoint
+	}
+
+	GracefulExit struct {
+		Endpoint *gracefulexit.Endpoint
+		Chore    *gracefulexit.Chore
+	}
+
 	Collector *collector.Service
+	Trash     *trash.Chore
 
 	NodeStats *nodestats.Service
 
@@ -222,7 +244,34 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"), peer.DB.Pieces())
+		blobs := peer.DB.Pieces()
+		if config.Storage.MigrateTo != "" {
+			to, err := filestore.NewAt(config.Storage.MigrateTo)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Storage2.Migrator = pieces.NewMigrator(peer.Log.Named("pieces:migrator"), peer.DB.PieceInfo(), blobs, to)
+			blobs = peer.Storage2.Migrator.Blobs()
+		}
+
+		dirs := []pieces.Dir{{
+			Name:      config.Storage.Path,
+			Blobs:     blobs,
+			Allocated: config.Storage.AllocatedDiskSpace.Int64(),
+		}}
+		for _, dir := range config.Storage.AdditionalDirs {
+			blobs, err := filestore.NewAt(dir.Path)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			dirs = append(dirs, pieces.Dir{
+				Name:      dir.Path,
+				Blobs:     blobs,
+				Allocated: dir.Allocated.Int64(),
+			})
+		}
+
+		peer.Storage2.Store = pieces.NewStoreWithDirs(peer.Log.Named("pieces"), dirs)
 
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
@@ -230,7 +279,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.Storage2.Store,
 			peer.DB.PieceInfo(),
 			peer.DB.Bandwidth(),
-			config.Storage.AllocatedDiskSpace.Int64(),
+			config.Storage.TotalAllocatedDiskSpace().Int64(),
 			config.Storage.AllocatedBandwidth.Int64(),
 			//TODO use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
@@ -257,12 +306,25 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 		peer.Storage2.Sender = orders.NewSender(
 			log.Named("piecestore:orderssender"),
 			peer.Transport,
-			peer.Kademlia.Service,
+			peer.Storage2.Trust,
 			peer.DB.Orders(),
 			config.Storage2.Sender,
 		)
 	}
 
+	{ // setup contact service
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"))
+		pb.RegisterNodeServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+
+		peer.Contact.Chore = contact.NewChore(
+			peer.Log.Named("contact:chore"),
+			peer.Transport,
+			peer.Storage2.Trust,
+			peer.Kademlia.RoutingTable,
+			config.Contact,
+		)
+	}
+
 	{ // setup node stats service
 		peer.NodeStats = nodestats.NewService(
 			peer.Log.Named("nodestats"),
@@ -287,7 +349,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.Version,
 			peer.NodeStats,
 			config.Storage.AllocatedBandwidth,
-			config.Storage.AllocatedDiskSpace,
+			config.Storage.TotalAllocatedDiskSpace(),
 			config.Kademlia.Operator.Wallet,
 			versionInfo)
 
@@ -314,14 +376,38 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.DB.PieceInfo(),
 			peer.Kademlia.Service,
 			peer.DB.Bandwidth(),
+			peer.Storage2.Monitor,
 			config.Storage,
 			peer.Console.Listener.Addr(),
 		)
 		pb.RegisterPieceStoreInspectorServer(peer.Server.PrivateGRPC(), peer.Storage2.Inspector)
 	}
 
+	{ // setup graceful exit
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("gracefulexit:endpoint"),
+			peer.Transport,
+			peer.Storage2.Trust,
+			peer.DB.PieceInfo(),
+			peer.DB.GracefulExit(),
+		)
+		pb.RegisterNodeGracefulExitServer(peer.Server.PrivateGRPC(), peer.GracefulExit.Endpoint)
+
+		peer.GracefulExit.Chore = gracefulexit.NewChore(
+			peer.Log.Named("gracefulexit:chore"),
+			peer.Storage2.Trust,
+			peer.Transport,
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			peer.DB.GracefulExit(),
+			config.GracefulExit,
+		)
+	}
+
 	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.PieceInfo(), peer.DB.UsedSerials(), config.Collector)
 
+	peer.Trash = trash.NewChore(peer.Log.Named("trash"), config.Trash, peer.Storage2.Store, peer.DB.PieceInfo())
+
 	return peer, nil
 }
 
@@ -345,15 +431,29 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Collector.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Trash.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Storage2.Sender.Run(ctx))
 	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Storage2.Monitor.Run(ctx))
 	})
+	if peer.Storage2.Migrator != nil {
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Storage2.Migrator.Run(ctx))
+		})
+	}
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Vouchers.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Contact.Chore.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Chore.Run(ctx))
+	})
 
 	group.Go(func() error {
 		// TODO: move the message into Server instead
@@ -384,6 +484,15 @@ func (peer *Peer) Close() error {
 
 	// close services in reverse initialization order
 
+	if peer.Trash != nil {
+		errlist.Add(peer.Trash.Close())
+	}
+	if peer.GracefulExit.Chore != nil {
+		errlist.Add(peer.GracefulExit.Chore.Close())
+	}
+	if peer.Contact.Chore != nil {
+		errlist.Add(peer.Contact.Chore.Close())
+	}
 	if peer.Vouchers != nil {
 		errlist.Add(peer.Vouchers.Close())
 	}
@@ -129,6 +129,19 @@ func TestPieceInfo(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, cmp.Diff(info1, info1loaded, cmp.Comparer(pb.Equal)))
 
+		// getting the satellites
+		satellites, err := pieceinfos.GetSatellites(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []storj.NodeID{satellite0.ID, satellite1.ID, satellite2.ID}, satellites)
+
+		// paging the piece ids
+		pieceIDs, err := pieceinfos.GetPieceIDsAfter(ctx, info1.SatelliteID, now.Add(time.Hour), storj.PieceID{}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []storj.PieceID{info1.PieceID}, pieceIDs)
+		pieceIDs, err = pieceinfos.GetPieceIDsAfter(ctx, info1.SatelliteID, now.Add(time.Hour), info1.PieceID, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pieceIDs)
+
 		// getting no expired pieces
 		expired, err := pieceinfos.GetExpired(ctx, now.Add(-10*time.Hour), 10)
 		assert.NoError(t, err)
@@ -149,6 +162,44 @@ func TestPieceInfo(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, expired, 2)
 
+		// trashed pieces are not returned
+		err = pieceinfos.Trash(ctx, info1.SatelliteID, info1.PieceID, now)
+		require.NoError(t, err)
+		expired, err = pieceinfos.GetExpired(ctx, exp, 10)
+		assert.NoError(t, err)
+		assert.Len(t, expired, 1)
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, info1.SatelliteID, exp, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, pieceIDs)
+		pieceIDs, err = pieceinfos.GetTrashedPieceIDsAfter(ctx, info1.SatelliteID, storj.PieceID{}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []storj.PieceID{info1.PieceID}, pieceIDs)
+
+		// restoring the trash returns them again
+		err = pieceinfos.RestoreTrash(ctx, info1.SatelliteID)
+		require.NoError(t, err)
+		expired, err = pieceinfos.GetExpired(ctx, exp, 10)
+		assert.NoError(t, err)
+		assert.Len(t, expired, 2)
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, info1.SatelliteID, exp, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []storj.PieceID{info1.PieceID}, pieceIDs)
+		pieceIDs, err = pieceinfos.GetTrashedPieceIDsAfter(ctx, info1.SatelliteID, storj.PieceID{}, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pieceIDs)
+
+		// emptying the trash deletes the pieces trashed before
+		err = pieceinfos.Trash(ctx, info1.SatelliteID, info1.PieceID, now)
+		require.NoError(t, err)
+		err = pieceinfos.DeleteTrashed(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		_, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
+		require.NoError(t, err)
+		err = pieceinfos.DeleteTrashed(ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
+		require.Error(t, err)
+
 		// deleting
 		err = pieceinfos.Delete(ctx, info0.SatelliteID, info0.PieceID)
 		require.NoError(t, err)
@@ -167,5 +218,9 @@ func TestPieceInfo(t *testing.T) {
 		require.Error(t, err)
 		_, err = pieceinfos.Get(ctx, info1.SatelliteID, info1.PieceID)
 		require.Error(t, err)
+
+		satellites, err = pieceinfos.GetSatellites(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, satellites)
 	})
 }
@@ -0,0 +1,393 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+const (
+	migrateBatchSize = 1000
+
+	// defaultMigrateRetryInterval is how long a failed migration waits before it is retried
+	defaultMigrateRetryInterval = time.Minute
+)
+
+// MigrationStats contains the results of a piece migration.
+type MigrationStats struct {
+	// Copied is the number of pieces copied to the destination.
+	Copied int64
+	// Skipped is the number of pieces which already were in the destination.
+	Skipped int64
+	// Missing is the number of pieces known to the database, but not found in the source.
+	Missing int64
+	// Corrupted is the number of pieces whose content didn't match the uplink piece hash.
+	Corrupted int64
+	// Bytes is the number of bytes copied.
+	Bytes int64
+}
+
+// Migrator copies the pieces from one blob storage to another.
+//
+// The piece information database is the source of truth for which pieces
+// are migrated, the content of every piece is verified against the hash
+// signed by the uplink before it is committed to the destination.
+// Trashed pieces are migrated into the trash of the destination, so
+// they can still be restored after switching to the destination.
+type Migrator struct {
+	log       *zap.Logger
+	pieceinfo DB
+	from      storage.Blobs
+	to        storage.Blobs
+
+	// RetryInterval is how long the migration waits before it continues
+	// after an error.
+	RetryInterval time.Duration
+
+	mu sync.Mutex
+	// removed contains the blobs removed during the migration, the value
+	// is whether the blob was moved into the trash rather than deleted
+	removed map[string]bool
+	stats   MigrationStats
+
+	completed chan struct{}
+}
+
+// NewMigrator creates a migrator which copies the pieces from one blob storage to another.
+func NewMigrator(log *zap.Logger, pieceinfo DB, from, to storage.Blobs) *Migrator {
+	return &Migrator{
+		log:           log,
+		pieceinfo:     pieceinfo,
+		from:          from,
+		to:            to,
+		RetryInterval: defaultMigrateRetryInterval,
+		removed:       map[string]bool{},
+		completed:     make(chan struct{}),
+	}
+}
+
+// Run copies all pieces known to the piece information database, which are
+// not yet in the destination. It repeats until a pass doesn't find any new
+// pieces to copy, to catch the pieces which were added during the migration.
+//
+// Errors don't stop the migration, they are logged and the migration is
+// continued after the retry interval, so that the node keeps running.
+func (migrator *Migrator) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for first := true; ; {
+		copied, err := migrator.pass(ctx, first)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			migrator.log.Error("migration failed, retrying", zap.Duration("retry interval", migrator.RetryInterval), zap.Error(err))
+			if !sync2.Sleep(ctx, migrator.RetryInterval) {
+				return ctx.Err()
+			}
+			continue
+		}
+		first = false
+		if copied == 0 {
+			break
+		}
+	}
+
+	stats := migrator.Stats()
+	migrator.log.Info("migration completed",
+		zap.Int64("copied", stats.Copied),
+		zap.Int64("skipped", stats.Skipped),
+		zap.Int64("missing", stats.Missing),
+		zap.Int64("corrupted", stats.Corrupted),
+		zap.Int64("bytes", stats.Bytes))
+
+	close(migrator.completed)
+	return nil
+}
+
+// pass copies all pieces of all satellites once. Only the first pass
+// counts the pieces which are skipped, missing or corrupted, the later
+// passes would count them again.
+func (migrator *Migrator) pass(ctx context.Context, first bool) (copied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := migrator.pieceinfo.GetSatellites(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	createdBefore := time.Now()
+	for _, satellite := range satellites {
+		for _, trashed := range []bool{false, true} {
+			// the pieces are paged by their id, as they may be deleted meanwhile
+			for cursor := (storj.PieceID{}); ; {
+				var pieceIDs []storj.PieceID
+				if trashed {
+					pieceIDs, err = migrator.pieceinfo.GetTrashedPieceIDsAfter(ctx, satellite, cursor, migrateBatchSize)
+				} else {
+					pieceIDs, err = migrator.pieceinfo.GetPieceIDsAfter(ctx, satellite, createdBefore, cursor, migrateBatchSize)
+				}
+				if err != nil {
+					return copied, err
+				}
+
+				for _, pieceID := range pieceIDs {
+					if err := ctx.Err(); err != nil {
+						return copied, err
+					}
+
+					stats, err := migrator.migrate(ctx, satellite, pieceID, trashed)
+					if err != nil {
+						return copied, err
+					}
+					if !first {
+						stats = MigrationStats{Copied: stats.Copied, Bytes: stats.Bytes}
+					}
+					migrator.addStats(stats)
+					copied += stats.Copied
+				}
+
+				if len(pieceIDs) < migrateBatchSize {
+					break
+				}
+				cursor = pieceIDs[len(pieceIDs)-1]
+			}
+		}
+	}
+
+	return copied, nil
+}
+
+// migrate copies a single piece, or a piece in the trash into the trash of
+// the destination, and returns how it was handled.
+func (migrator *Migrator) migrate(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, trashed bool) (_ MigrationStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ref := storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	}
+
+	openDestination, openSource := migrator.to.Open, migrator.from.Open
+	if trashed {
+		openDestination, openSource = migrator.to.OpenTrashed, migrator.from.OpenTrashed
+	}
+
+	existing, err := openDestination(ctx, ref)
+	if err == nil {
+		return MigrationStats{Skipped: 1}, existing.Close()
+	}
+	if !os.IsNotExist(err) {
+		return MigrationStats{}, err
+	}
+
+	info, err := migrator.pieceinfo.Get(ctx, satellite, pieceID)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			// deleted since listing
+			return MigrationStats{}, nil
+		}
+		return MigrationStats{}, err
+	}
+
+	source, err := openSource(ctx, ref)
+	if err != nil {
+		if os.IsNotExist(err) {
+			migrator.log.Warn("piece missing from the source",
+				zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID))
+			return MigrationStats{Missing: 1}, nil
+		}
+		return MigrationStats{}, err
+	}
+	defer func() { err = errs.Combine(err, source.Close()) }()
+
+	blob, err := migrator.to.Create(ctx, ref, info.PieceSize)
+	if err != nil {
+		return MigrationStats{}, err
+	}
+
+	writer, err := NewWriter(blob, writeBufferSize.Int())
+	if err != nil {
+		return MigrationStats{}, errs.Combine(err, blob.Cancel(ctx))
+	}
+
+	if _, err := io.Copy(writer, source); err != nil {
+		return MigrationStats{}, errs.Combine(err, writer.Cancel(ctx))
+	}
+
+	if info.UplinkPieceHash == nil || !bytes.Equal(writer.Hash(), info.UplinkPieceHash.Hash) {
+		migrator.log.Warn("piece hash mismatch",
+			zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID))
+		return MigrationStats{Corrupted: 1}, writer.Cancel(ctx)
+	}
+
+	if err := writer.Commit(ctx); err != nil {
+		return MigrationStats{}, err
+	}
+
+	// the piece may have been deleted or trashed while it was copied
+	migrator.mu.Lock()
+	removedToTrash, removed := migrator.removed[string(ref.Namespace)+string(ref.Key)]
+	migrator.mu.Unlock()
+	if removed && !removedToTrash {
+		return MigrationStats{}, migrator.to.Delete(ctx, ref)
+	}
+
+	// the piece is committed like any other piece and moved into the trash afterwards
+	if trashed || removedToTrash {
+		if err := migrator.to.Trash(ctx, ref); err != nil {
+			return MigrationStats{}, err
+		}
+	}
+
+	return MigrationStats{Copied: 1, Bytes: writer.Size()}, nil
+}
+
+func (migrator *Migrator) addStats(stats MigrationStats) {
+	migrator.mu.Lock()
+	defer migrator.mu.Unlock()
+
+	migrator.stats.Copied += stats.Copied
+	migrator.stats.Skipped += stats.Skipped
+	migrator.stats.Missing += stats.Missing
+	migrator.stats.Corrupted += stats.Corrupted
+	migrator.stats.Bytes += stats.Bytes
+}
+
+// Stats returns the results of the migration so far.
+func (migrator *Migrator) Stats() MigrationStats {
+	migrator.mu.Lock()
+	defer migrator.mu.Unlock()
+	return migrator.stats
+}
+
+// Completed returns a channel which is closed when all pieces have been migrated.
+func (migrator *Migrator) Completed() <-chan struct{} { return migrator.completed }
+
+// Blobs returns a blob storage which can be used while the migration is running.
+//
+// New blobs are created in the destination, blobs are read from the destination
+// when they already have been migrated and from the source otherwise.
+// Deletions are applied to both.
+func (migrator *Migrator) Blobs() storage.Blobs { return &migratingBlobs{migrator} }
+
+// migratingBlobs implements storage.Blobs on top of a running migration.
+type migratingBlobs struct {
+	migrator *Migrator
+}
+
+// Create creates a new blob in the destination.
+func (blobs *migratingBlobs) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobs.migrator.to.Create(ctx, ref, size)
+}
+
+// Open opens the blob from the destination, or from the source when it hasn't been migrated yet.
+func (blobs *migratingBlobs) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	reader, err := blobs.migrator.to.Open(ctx, ref)
+	if os.IsNotExist(err) {
+		return blobs.migrator.from.Open(ctx, ref)
+	}
+	return reader, err
+}
+
+// OpenTrashed opens the blob from the trash of the destination, or from the
+// trash of the source when it hasn't been migrated yet.
+func (blobs *migratingBlobs) OpenTrashed(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	reader, err := blobs.migrator.to.OpenTrashed(ctx, ref)
+	if os.IsNotExist(err) {
+		return blobs.migrator.from.OpenTrashed(ctx, ref)
+	}
+	return reader, err
+}
+
+// Delete deletes the blob from both the source and the destination.
+func (blobs *migratingBlobs) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.markRemoved(ref, false)
+	return errs.Combine(
+		blobs.migrator.to.Delete(ctx, ref),
+		blobs.migrator.from.Delete(ctx, ref),
+	)
+}
+
+// Trash moves the blob into the trash of both the source and the destination.
+func (blobs *migratingBlobs) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.markRemoved(ref, true)
+	return errs.Combine(
+		blobs.migrator.to.Trash(ctx, ref),
+		blobs.migrator.from.Trash(ctx, ref),
+	)
+}
+
+// RestoreTrash restores the trash of both the source and the destination.
+func (blobs *migratingBlobs) RestoreTrash(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blobs.unmarkTrashed(namespace)
+	return errs.Combine(
+		blobs.migrator.to.RestoreTrash(ctx, namespace),
+		blobs.migrator.from.RestoreTrash(ctx, namespace),
+	)
+}
+
+// EmptyTrash empties the trash of both the source and the destination.
+func (blobs *migratingBlobs) EmptyTrash(ctx context.Context, trashedBefore time.Time) (bytesEmptied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	toEmptied, toErr := blobs.migrator.to.EmptyTrash(ctx, trashedBefore)
+	fromEmptied, fromErr := blobs.migrator.from.EmptyTrash(ctx, trashedBefore)
+	return toEmptied + fromEmptied, errs.Combine(toErr, fromErr)
+}
+
+// FreeSpace returns how much space is left in the destination.
+func (blobs *migratingBlobs) FreeSpace() (int64, error) {
+	return blobs.migrator.to.FreeSpace()
+}
+
+// SpaceUsed returns how much space is used by the blobs in the destination.
+func (blobs *migratingBlobs) SpaceUsed(ctx context.Context) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobs.migrator.to.SpaceUsed(ctx)
+}
+
+// markRemoved records that the blob was deleted or moved into the trash, so
+// that a concurrent migration of it doesn't resurrect it in the destination.
+func (blobs *migratingBlobs) markRemoved(ref storage.BlobRef, trashed bool) {
+	blobs.migrator.mu.Lock()
+	defer blobs.migrator.mu.Unlock()
+	key := string(ref.Namespace) + string(ref.Key)
+	// a deleted blob stays deleted, even when it's trashed afterwards
+	if wasTrashed, ok := blobs.migrator.removed[key]; ok && !wasTrashed {
+		return
+	}
+	blobs.migrator.removed[key] = trashed
+}
+
+// unmarkTrashed forgets the blobs of the namespace which were moved into the
+// trash, as they are restored, so that they are migrated again.
+func (blobs *migratingBlobs) unmarkTrashed(namespace []byte) {
+	blobs.migrator.mu.Lock()
+	defer blobs.migrator.mu.Unlock()
+	for key, trashed := range blobs.migrator.removed {
+		if trashed && strings.HasPrefix(key, string(namespace)) {
+			delete(blobs.migrator.removed, key)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestMigrator(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		from, err := filestore.NewAt(ctx.Dir("from"))
+		require.NoError(t, err)
+		to, err := filestore.NewAt(ctx.Dir("to"))
+		require.NoError(t, err)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+		// add stores a piece in the blob storage and its information in the database
+		add := func(blobs storage.Blobs, data []byte, hash []byte) storage.BlobRef {
+			pieceID := testrand.PieceID()
+			ref := storage.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceID.Bytes()}
+
+			if blobs != nil {
+				writer, err := blobs.Create(ctx, ref, int64(len(data)))
+				require.NoError(t, err)
+				_, err = writer.Write(data)
+				require.NoError(t, err)
+				require.NoError(t, writer.Commit(ctx))
+			}
+
+			err := db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       int64(len(data)),
+				PieceCreation:   time.Now().Add(-time.Hour),
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID, Hash: hash},
+			})
+			require.NoError(t, err)
+			return ref
+		}
+
+		data := testrand.Bytes(10000)
+		valid := add(from, data, pkcrypto.SHA256Hash(data))
+		corrupted := add(from, data, pkcrypto.SHA256Hash([]byte("other")))
+		missing := add(nil, data, pkcrypto.SHA256Hash(data))
+		migrated := add(to, data, pkcrypto.SHA256Hash(data))
+
+		migrator := pieces.NewMigrator(zaptest.NewLogger(t), db.PieceInfo(), from, to)
+		require.NoError(t, migrator.Run(ctx))
+
+		select {
+		case <-migrator.Completed():
+		default:
+			t.Fatal("migration not completed")
+		}
+
+		assert.Equal(t, pieces.MigrationStats{
+			Copied:    1,
+			Skipped:   1,
+			Missing:   1,
+			Corrupted: 1,
+			Bytes:     int64(len(data)),
+		}, migrator.Stats())
+
+		for _, ref := range []storage.BlobRef{valid, migrated} {
+			reader, err := to.Open(ctx, ref)
+			require.NoError(t, err)
+			read, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+			assert.Equal(t, data, read)
+		}
+
+		for _, ref := range []storage.BlobRef{corrupted, missing} {
+			_, err := to.Open(ctx, ref)
+			assert.True(t, os.IsNotExist(err))
+		}
+
+		// blobs which haven't been migrated are still readable from the source
+		blobs := migrator.Blobs()
+		reader, err := blobs.Open(ctx, corrupted)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// new blobs are created in the destination
+		created := storage.BlobRef{Namespace: satelliteID.Bytes(), Key: testrand.PieceID().Bytes()}
+		writer, err := blobs.Create(ctx, created, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		reader, err = to.Open(ctx, created)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// deletions are applied to both
+		require.NoError(t, blobs.Delete(ctx, valid))
+		_, err = to.Open(ctx, valid)
+		assert.True(t, os.IsNotExist(err))
+		_, err = from.Open(ctx, valid)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestMigrator_Trash(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		from, err := filestore.NewAt(ctx.Dir("from"))
+		require.NoError(t, err)
+		to, err := filestore.NewAt(ctx.Dir("to"))
+		require.NoError(t, err)
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+		data := testrand.Bytes(1000)
+		add := func() storage.BlobRef {
+			pieceID := testrand.PieceID()
+			ref := storage.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceID.Bytes()}
+
+			writer, err := from.Create(ctx, ref, int64(len(data)))
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+
+			err = db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       int64(len(data)),
+				PieceCreation:   time.Now().Add(-time.Hour),
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID, Hash: pkcrypto.SHA256Hash(data)},
+			})
+			require.NoError(t, err)
+			return ref
+		}
+		trash := func(blobs storage.Blobs, ref storage.BlobRef) {
+			require.NoError(t, blobs.Trash(ctx, ref))
+			pieceID, err := storj.PieceIDFromBytes(ref.Key)
+			require.NoError(t, err)
+			require.NoError(t, db.PieceInfo().Trash(ctx, satelliteID, pieceID, time.Now()))
+		}
+
+		restored := add()
+		trashed := add()
+
+		// the pieces are trashed and restored before they are migrated
+		migrator := pieces.NewMigrator(zaptest.NewLogger(t), db.PieceInfo(), from, to)
+		blobs := migrator.Blobs()
+		trash(blobs, restored)
+		require.NoError(t, blobs.RestoreTrash(ctx, satelliteID.Bytes()))
+		require.NoError(t, db.PieceInfo().RestoreTrash(ctx, satelliteID))
+		trash(blobs, trashed)
+
+		require.NoError(t, migrator.Run(ctx))
+		assert.Equal(t, int64(2), migrator.Stats().Copied)
+
+		// the restored piece isn't deleted by the migration
+		reader, err := to.Open(ctx, restored)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// the trashed piece is migrated into the trash of the destination
+		_, err = to.Open(ctx, trashed)
+		assert.True(t, os.IsNotExist(err))
+		reader, err = to.OpenTrashed(ctx, trashed)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// and can be restored after switching to the destination
+		require.NoError(t, to.RestoreTrash(ctx, satelliteID.Bytes()))
+		reader, err = to.Open(ctx, trashed)
+		require.NoError(t, err)
+		read, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, data, read)
+	})
+}
+
+// failingBlobs fails to create blobs until it has been told to recover.
+type failingBlobs struct {
+	storage.Blobs
+	failures int32
+}
+
+// Create creates a new blob, unless it's still failing.
+func (blobs *failingBlobs) Create(ctx context.Context, ref storage.BlobRef, size int64) (storage.BlobWriter, error) {
+	if atomic.AddInt32(&blobs.failures, -1) >= 0 {
+		return nil, errs.New("disk failure")
+	}
+	return blobs.Blobs.Create(ctx, ref, size)
+}
+
+func TestMigrator_Retry(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		from, err := filestore.NewAt(ctx.Dir("from"))
+		require.NoError(t, err)
+		dest, err := filestore.NewAt(ctx.Dir("to"))
+		require.NoError(t, err)
+		to := &failingBlobs{Blobs: dest, failures: 2}
+
+		satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+		const pieceCount = 5
+		var refs []storage.BlobRef
+		for i := 0; i < pieceCount; i++ {
+			data := testrand.Bytes(100)
+			pieceID := testrand.PieceID()
+			ref := storage.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceID.Bytes()}
+
+			writer, err := from.Create(ctx, ref, int64(len(data)))
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
+
+			err = db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceSize:       int64(len(data)),
+				PieceCreation:   time.Now().Add(-time.Hour),
+				OrderLimit:      &pb.OrderLimit{},
+				UplinkPieceHash: &pb.PieceHash{PieceId: pieceID, Hash: pkcrypto.SHA256Hash(data)},
+			})
+			require.NoError(t, err)
+			refs = append(refs, ref)
+		}
+
+		// the migration continues after the failures
+		migrator := pieces.NewMigrator(zaptest.NewLogger(t), db.PieceInfo(), from, to)
+		migrator.RetryInterval = time.Millisecond
+		require.NoError(t, migrator.Run(ctx))
+		assert.Equal(t, int64(pieceCount), migrator.Stats().Copied)
+
+		for _, ref := range refs {
+			reader, err := dest.Open(ctx, ref)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+	})
+}
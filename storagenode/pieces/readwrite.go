@@ -99,6 +99,9 @@ func NewReader(blob storage.BlobReader, bufferSize int) (*Reader, error) {
 func (r *Reader) Read(data []byte) (int, error) {
 	n, err := r.blob.Read(data)
 	r.pos += int64(n)
+	if err == io.EOF {
+		return n, err
+	}
 	return n, Error.Wrap(err)
 }
 
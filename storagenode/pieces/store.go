@@ -6,6 +6,8 @@ package pieces
 import (
 	"context"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -57,40 +59,98 @@ type DB interface {
 	Add(context.Context, *Info) error
 	// Get returns Info about a piece.
 	Get(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (*Info, error)
-	// GetPieceIDs gets pieceIDs using the satelliteID
+	// GetSatellites returns the satellites which have pieces stored.
+	GetSatellites(ctx context.Context) ([]storj.NodeID, error)
+	// GetPieceIDs gets the pieceIDs of the satellite, which are not in the trash
 	GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit, offset int) (pieceIDs []storj.PieceID, err error)
+	// GetPieceIDsAfter gets the pieceIDs of the satellite after the cursor in order, which are not in the trash
+	GetPieceIDsAfter(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, cursor storj.PieceID, limit int) (pieceIDs []storj.PieceID, err error)
+	// GetTrashedPieceIDsAfter gets the pieceIDs of the satellite in the trash after the cursor in order
+	GetTrashedPieceIDsAfter(ctx context.Context, satelliteID storj.NodeID, cursor storj.PieceID, limit int) (pieceIDs []storj.PieceID, err error)
 	// Delete deletes Info about a piece.
 	Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
 	// DeleteFailed marks piece deletion from disk failed
 	DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failedAt time.Time) error
+	// Trash marks the piece as moved to the trash
+	Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) error
+	// RestoreTrash unmarks the trashed pieces of the satellite
+	RestoreTrash(ctx context.Context, satelliteID storj.NodeID) error
+	// DeleteTrashed deletes Info about the pieces which were moved to the trash before trashedBefore
+	DeleteTrashed(ctx context.Context, trashedBefore time.Time) error
 	// SpaceUsed returns the in memory value for disk space used by all pieces
 	SpaceUsed(ctx context.Context) (int64, error)
 	// CalculatedSpaceUsed calculates disk space used by all pieces
 	CalculatedSpaceUsed(ctx context.Context) (int64, error)
 	// SpaceUsedBySatellite calculates disk space used by all pieces by satellite
 	SpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (int64, error)
-	// GetExpired gets orders that are expired and were created before some time
+	// GetExpired gets the pieces that are expired and not in the trash
 	GetExpired(ctx context.Context, expiredAt time.Time, limit int64) ([]ExpiredInfo, error)
 }
 
-// Store implements storing pieces onto a blob storage implementation.
+// Dir is a blob storage together with the disk space allocated to it.
+type Dir struct {
+	// Name identifies the directory in reports, e.g. with its path.
+	Name  string
+	Blobs storage.Blobs
+	// Allocated is the disk space allocated to the directory, zero means
+	// the space is only limited by the disk.
+	Allocated int64
+}
+
+// Store implements storing pieces onto one or more blob storages.
+//
+// New pieces are placed in the directory with the most free space,
+// existing pieces are looked up in all directories.
 type Store struct {
-	log   *zap.Logger
-	blobs storage.Blobs
+	log  *zap.Logger
+	dirs []*storeDir
+}
+
+// freeSpaceCacheDuration is how long the free space of a directory is reused
+// for the placement of new pieces, before the disk is asked again.
+const freeSpaceCacheDuration = 10 * time.Second
+
+// storeDir is a directory of the store with its used space.
+type storeDir struct {
+	Dir
+
+	// used is the space used by the pieces in the directory, it's
+	// tracked when pieces are stored and removed and calculated by
+	// UpdateSpaceUsed when it's stale.
+	used int64
+	// stale is set while used doesn't include all pieces, i.e. before
+	// it's calculated for the first time and after restoring the trash.
+	stale int32
+
+	mu          sync.Mutex
+	free        int64
+	freeUsed    int64
+	freeChecked time.Time
 }
 
 // NewStore creates a new piece store
 func NewStore(log *zap.Logger, blobs storage.Blobs) *Store {
-	return &Store{
-		log:   log,
-		blobs: blobs,
+	return NewStoreWithDirs(log, []Dir{{Blobs: blobs}})
+}
+
+// NewStoreWithDirs creates a new piece store, which stores the pieces in multiple directories.
+func NewStoreWithDirs(log *zap.Logger, dirs []Dir) *Store {
+	store := &Store{log: log}
+	for _, dir := range dirs {
+		store.dirs = append(store.dirs, &storeDir{Dir: dir, stale: 1})
 	}
+	return store
 }
 
 // Writer returns a new piece writer.
 func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ *Writer, err error) {
 	defer mon.Task()(&ctx)(&err)
-	blob, err := store.blobs.Create(ctx, storage.BlobRef{
+	dir, err := store.placement(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	blob, err := dir.Blobs.Create(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
 	}, preallocSize.Int64())
@@ -98,14 +158,14 @@ func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID
 		return nil, Error.Wrap(err)
 	}
 
-	writer, err := NewWriter(blob, writeBufferSize.Int())
+	writer, err := NewWriter(&trackedBlobWriter{BlobWriter: blob, dir: dir}, writeBufferSize.Int())
 	return writer, Error.Wrap(err)
 }
 
 // Reader returns a new piece reader.
 func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ *Reader, err error) {
 	defer mon.Task()(&ctx)(&err)
-	blob, err := store.blobs.Open(ctx, storage.BlobRef{
+	blob, _, err := store.open(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
 	})
@@ -123,28 +183,248 @@ func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID
 // Delete deletes the specified piece.
 func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	err = store.blobs.Delete(ctx, storage.BlobRef{
+	err = store.remove(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	})
+	}, storage.Blobs.Delete)
 	return Error.Wrap(err)
 }
 
+// Trash moves the specified piece into the trash.
+func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.remove(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	}, storage.Blobs.Trash)
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves all pieces of the satellite from the trash back into storage.
+func (store *Store) RestoreTrash(ctx context.Context, satellite storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.dirs {
+		group.Add(dir.Blobs.RestoreTrash(ctx, satellite.Bytes()))
+		// the restored pieces are not tracked, the used space is
+		// calculated again by UpdateSpaceUsed
+		atomic.StoreInt32(&dir.stale, 1)
+	}
+	return Error.Wrap(group.Err())
+}
+
+// EmptyTrash deletes the pieces which were moved to the trash before trashedBefore.
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) (bytesEmptied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.dirs {
+		emptied, err := dir.Blobs.EmptyTrash(ctx, trashedBefore)
+		bytesEmptied += emptied
+		group.Add(err)
+	}
+	return bytesEmptied, Error.Wrap(group.Err())
+}
+
+// open opens the blob from the first directory that contains it.
+func (store *Store) open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, _ *storeDir, err error) {
+	defer mon.Task()(&ctx)(&err)
+	notExist := error(os.ErrNotExist)
+	for _, dir := range store.dirs {
+		blob, err := dir.Blobs.Open(ctx, ref)
+		if os.IsNotExist(err) {
+			notExist = err
+			continue
+		}
+		return blob, dir, err
+	}
+	return nil, nil, notExist
+}
+
+// remove removes the blob with the remove function from the directory that
+// contains it and updates the used space of the directory.
+func (store *Store) remove(ctx context.Context, ref storage.BlobRef, remove func(storage.Blobs, context.Context, storage.BlobRef) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blob, dir, err := store.open(ctx, ref)
+	if os.IsNotExist(err) {
+		// ignore already removed pieces
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	size, err := blob.Size()
+	if err := errs.Combine(err, blob.Close()); err != nil {
+		return err
+	}
+
+	if err := remove(dir.Blobs, ctx, ref); err != nil {
+		return err
+	}
+	dir.addUsed(-size)
+	return nil
+}
+
+// placement returns the directory with the most free space. It's called for
+// every new piece, so it only uses the cached free and used space.
+func (store *Store) placement(ctx context.Context) (_ *storeDir, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(store.dirs) == 1 {
+		return store.dirs[0], nil
+	}
+
+	var best *storeDir
+	var bestFree int64
+	for _, dir := range store.dirs {
+		free, err := dir.cachedFreeSpace()
+		if err != nil {
+			store.log.Warn("unable to get the free space of the directory", zap.String("Dir", dir.Name), zap.Error(err))
+			continue
+		}
+		// a stale used space is only corrected in the background
+		free = dir.limit(free, atomic.LoadInt64(&dir.used))
+		if best == nil || free > bestFree {
+			best, bestFree = dir, free
+		}
+	}
+	if best == nil {
+		return nil, Error.New("no directory available")
+	}
+	return best, nil
+}
+
+// UpdateSpaceUsed calculates the space used by the pieces in the directories
+// whose used space is stale. The calculation walks the directories, so it's
+// done in the background, e.g. by the monitor.
+func (store *Store) UpdateSpaceUsed(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, dir := range store.dirs {
+		_, err := dir.spaceUsed(ctx)
+		group.Add(err)
+	}
+	return Error.Wrap(group.Err())
+}
+
+// spaceUsed returns the space used by the pieces in the directory, which is
+// calculated first when it's stale.
+func (dir *storeDir) spaceUsed(ctx context.Context) (_ int64, err error) {
+	// restoring the trash during the calculation marks it stale again
+	if !atomic.CompareAndSwapInt32(&dir.stale, 1, 0) {
+		return atomic.LoadInt64(&dir.used), nil
+	}
+	used, err := dir.Blobs.SpaceUsed(ctx)
+	if err != nil {
+		atomic.StoreInt32(&dir.stale, 1)
+		return 0, err
+	}
+	// pieces written during the calculation may not be included, the
+	// estimate is corrected on the next calculation
+	atomic.StoreInt64(&dir.used, used)
+	return used, nil
+}
+
+// addUsed adds delta to the used space.
+func (dir *storeDir) addUsed(delta int64) {
+	atomic.AddInt64(&dir.used, delta)
+}
+
+// cachedFreeSpace returns the free space of the disk, which is only
+// checked again when the cached value is older than freeSpaceCacheDuration.
+// The pieces stored since the check are subtracted from the cached value.
+func (dir *storeDir) cachedFreeSpace() (int64, error) {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	used := atomic.LoadInt64(&dir.used)
+	if !dir.freeChecked.IsZero() && time.Since(dir.freeChecked) < freeSpaceCacheDuration {
+		return dir.free - (used - dir.freeUsed), nil
+	}
+	free, err := dir.Blobs.FreeSpace()
+	if err != nil {
+		return 0, err
+	}
+	dir.free, dir.freeUsed, dir.freeChecked = free, used, time.Now()
+	return free, nil
+}
+
+// limit limits the free space of the disk by the allocated space.
+func (dir *storeDir) limit(free, used int64) int64 {
+	if dir.Allocated > 0 && dir.Allocated-used < free {
+		free = dir.Allocated - used
+	}
+	if free < 0 {
+		free = 0
+	}
+	return free
+}
+
+// status returns the disk usage of the directory. The used space isn't
+// calculated here, it may be stale until UpdateSpaceUsed has run.
+func (dir *storeDir) status(ctx context.Context) (_ DirStatus, err error) {
+	free, err := dir.Blobs.FreeSpace()
+	if err != nil {
+		return DirStatus{}, err
+	}
+	used := atomic.LoadInt64(&dir.used)
+	return DirStatus{
+		Name:      dir.Name,
+		Allocated: dir.Allocated,
+		DiskUsed:  used,
+		DiskFree:  free,
+		Available: dir.limit(free, used),
+	}, nil
+}
+
+// trackedBlobWriter updates the used space of the directory when the blob is committed.
+type trackedBlobWriter struct {
+	storage.BlobWriter
+	dir *storeDir
+}
+
+// Commit commits the blob and adds its size to the used space.
+func (blob *trackedBlobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	size, err := blob.BlobWriter.Size()
+	if err != nil {
+		return errs.Combine(err, blob.BlobWriter.Cancel(ctx))
+	}
+	if err := blob.BlobWriter.Commit(ctx); err != nil {
+		return err
+	}
+	blob.dir.addUsed(size)
+	return nil
+}
+
 // StorageStatus contains information about the disk store is using.
 type StorageStatus struct {
 	DiskUsed int64
 	DiskFree int64
+
+	// Dirs contains the status of every directory.
+	Dirs []DirStatus
+}
+
+// DirStatus contains information about a single directory of the store.
+type DirStatus struct {
+	Name      string
+	Allocated int64
+	DiskUsed  int64
+	DiskFree  int64
+	// Available is the free space limited by the allocated space.
+	Available int64
 }
 
 // StorageStatus returns information about the disk.
 func (store *Store) StorageStatus(ctx context.Context) (_ StorageStatus, err error) {
 	defer mon.Task()(&ctx)(&err)
-	diskFree, err := store.blobs.FreeSpace()
-	if err != nil {
-		return StorageStatus{}, err
+	var status StorageStatus
+	for _, dir := range store.dirs {
+		dirStatus, err := dir.status(ctx)
+		if err != nil {
+			return StorageStatus{}, err
+		}
+		status.DiskUsed += dirStatus.DiskUsed
+		status.DiskFree += dirStatus.DiskFree
+		status.Dirs = append(status.Dirs, dirStatus)
 	}
-	return StorageStatus{
-		DiskUsed: -1, // TODO set value
-		DiskFree: diskFree,
-	}, nil
+	return status, nil
 }
@@ -5,18 +5,24 @@ package pieces_test
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/pieces"
 )
@@ -109,3 +115,156 @@ func TestPieces(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestMultipleDirs(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	small, err := filestore.NewAt(ctx.Dir("small"))
+	require.NoError(t, err)
+	large, err := filestore.NewAt(ctx.Dir("large"))
+	require.NoError(t, err)
+
+	store := pieces.NewStoreWithDirs(zaptest.NewLogger(t), []pieces.Dir{
+		{Name: "small", Blobs: small, Allocated: 10 * memory.KiB.Int64()},
+		{Name: "large", Blobs: large, Allocated: 20 * memory.KiB.Int64()},
+	})
+
+	satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	source := testrand.Bytes(8000)
+
+	write := func() storj.PieceID {
+		pieceID := testrand.PieceID()
+		writer, err := store.Writer(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(source)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+		return pieceID
+	}
+
+	exists := func(blobs storage.Blobs, pieceID storj.PieceID) bool {
+		reader, err := blobs.Open(ctx, storage.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceID.Bytes()})
+		if os.IsNotExist(err) {
+			return false
+		}
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		return true
+	}
+
+	// pieces are placed in the directory with the most free space
+	first, second, third := write(), write(), write()
+	assert.True(t, exists(large, first))
+	assert.True(t, exists(large, second))
+	assert.True(t, exists(small, third))
+
+	status, err := store.StorageStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3*len(source)), status.DiskUsed)
+	require.Len(t, status.Dirs, 2)
+	assert.Equal(t, "small", status.Dirs[0].Name)
+	assert.Equal(t, int64(len(source)), status.Dirs[0].DiskUsed)
+	assert.Equal(t, 10*memory.KiB.Int64()-int64(len(source)), status.Dirs[0].Available)
+	assert.True(t, status.Dirs[0].DiskFree >= status.Dirs[0].Available)
+	assert.Equal(t, "large", status.Dirs[1].Name)
+	assert.Equal(t, int64(2*len(source)), status.Dirs[1].DiskUsed)
+
+	// pieces are read from all directories
+	for _, pieceID := range []storj.PieceID{first, third} {
+		reader, err := store.Reader(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, source, data)
+	}
+
+	// deletions update the used space
+	require.NoError(t, store.Delete(ctx, satelliteID, first))
+	assert.False(t, exists(large, first))
+	// deleting a missing piece is ignored
+	require.NoError(t, store.Delete(ctx, satelliteID, first))
+
+	status, err = store.StorageStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(source)), status.Dirs[1].DiskUsed)
+}
+
+func TestMultipleDirs_SpaceUsedInBackground(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var dirs []pieces.Dir
+	var counters []*countingBlobs
+	for _, name := range []string{"first", "second"} {
+		blobs, err := filestore.NewAt(ctx.Dir(name))
+		require.NoError(t, err)
+		counter := &countingBlobs{Blobs: blobs}
+		counters = append(counters, counter)
+		dirs = append(dirs, pieces.Dir{Name: name, Blobs: counter, Allocated: memory.MiB.Int64()})
+	}
+	store := pieces.NewStoreWithDirs(zaptest.NewLogger(t), dirs)
+
+	satelliteID := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	source := testrand.Bytes(1000)
+	write := func() {
+		writer, err := store.Writer(ctx, satelliteID, testrand.PieceID())
+		require.NoError(t, err)
+		_, err = writer.Write(source)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	// storing pieces neither walks the directories nor checks the disk every time
+	for i := 0; i < 5; i++ {
+		write()
+	}
+	require.NoError(t, store.RestoreTrash(ctx, satelliteID))
+	write()
+	for _, counter := range counters {
+		assert.EqualValues(t, 0, atomic.LoadInt32(&counter.spaceUsed))
+		assert.EqualValues(t, 1, atomic.LoadInt32(&counter.freeSpace))
+	}
+
+	// nor does reporting the status
+	_, err := store.StorageStatus(ctx)
+	require.NoError(t, err)
+	for _, counter := range counters {
+		assert.EqualValues(t, 0, atomic.LoadInt32(&counter.spaceUsed))
+	}
+
+	// the used space is calculated in the background
+	require.NoError(t, store.UpdateSpaceUsed(ctx))
+	for _, counter := range counters {
+		assert.EqualValues(t, 1, atomic.LoadInt32(&counter.spaceUsed))
+	}
+	status, err := store.StorageStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6*len(source)), status.DiskUsed)
+
+	// and only calculated again when it's stale
+	require.NoError(t, store.UpdateSpaceUsed(ctx))
+	for _, counter := range counters {
+		assert.EqualValues(t, 1, atomic.LoadInt32(&counter.spaceUsed))
+	}
+}
+
+// countingBlobs counts how often the used and the free space are checked.
+type countingBlobs struct {
+	storage.Blobs
+	spaceUsed int32
+	freeSpace int32
+}
+
+// SpaceUsed returns how much space is used by the stored blobs.
+func (blobs *countingBlobs) SpaceUsed(ctx context.Context) (int64, error) {
+	atomic.AddInt32(&blobs.spaceUsed, 1)
+	return blobs.Blobs.SpaceUsed(ctx)
+}
+
+// FreeSpace returns how much free space is left for writing.
+func (blobs *countingBlobs) FreeSpace() (int64, error) {
+	atomic.AddInt32(&blobs.freeSpace, 1)
+	return blobs.Blobs.FreeSpace()
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"strings"
+
+	"storj.io/storj/internal/memory"
+)
+
+// StorageDir is an additional directory to store data in.
+type StorageDir struct {
+	Path      string
+	Allocated memory.Size
+}
+
+// String converts StorageDir to a string.
+func (dir StorageDir) String() string {
+	return dir.Path + "=" + dir.Allocated.String()
+}
+
+// StorageDirs defines a comma delimited flag for defining a list of storage
+// directories with their allocated disk space, e.g. "/mnt/disk2=2TB,/mnt/disk3=4TB".
+type StorageDirs []StorageDir
+
+// ParseStorageDirs parses a comma delimited list of storage directories.
+func ParseStorageDirs(s string) (StorageDirs, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var dirs StorageDirs
+	for _, entry := range strings.Split(s, ",") {
+		sep := strings.LastIndex(entry, "=")
+		if sep <= 0 {
+			return nil, Error.New("invalid storage directory %q, expected path=allocated", entry)
+		}
+
+		dir := StorageDir{Path: entry[:sep]}
+		if err := dir.Allocated.Set(entry[sep+1:]); err != nil {
+			return nil, Error.New("invalid allocated disk space for %q: %v", dir.Path, err)
+		}
+		if dir.Allocated <= 0 {
+			return nil, Error.New("no disk space allocated for %q", dir.Path)
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
+
+// String converts StorageDirs to a string.
+func (dirs StorageDirs) String() string {
+	var xs []string
+	for _, dir := range dirs {
+		xs = append(xs, dir.String())
+	}
+	return strings.Join(xs, ",")
+}
+
+// Set implements flag.Value interface.
+func (dirs *StorageDirs) Set(s string) error {
+	parsed, err := ParseStorageDirs(s)
+	if err != nil {
+		return err
+	}
+	*dirs = parsed
+	return nil
+}
+
+// Type implements pflag.Value.
+func (StorageDirs) Type() string { return "piecestore.StorageDirs" }
+
+// Allocated returns the disk space allocated to all directories.
+func (dirs StorageDirs) Allocated() memory.Size {
+	var total memory.Size
+	for _, dir := range dirs {
+		total += dir.Allocated
+	}
+	return total
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+func TestParseStorageDirs(t *testing.T) {
+	dirs, err := piecestore.ParseStorageDirs("")
+	require.NoError(t, err)
+	assert.Empty(t, dirs)
+
+	s := "/mnt/disk2=2.0 TB,/mnt/a=b=512.0 MiB"
+	dirs, err = piecestore.ParseStorageDirs(s)
+	require.NoError(t, err)
+	assert.Equal(t, piecestore.StorageDirs{
+		{Path: "/mnt/disk2", Allocated: 2 * memory.TB},
+		{Path: "/mnt/a=b", Allocated: 512 * memory.MiB},
+	}, dirs)
+	assert.Equal(t, s, dirs.String())
+	assert.Equal(t, 2*memory.TB+512*memory.MiB, dirs.Allocated())
+
+	for _, invalid := range []string{"/mnt/disk2", "=1TB", "/mnt/disk2=", "/mnt/disk2=1x", "/mnt/disk2=0B"} {
+		_, err := piecestore.ParseStorageDirs(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// downloadPruneInterval is how often the expired downloads are removed
+const downloadPruneInterval = time.Minute
+
+// downloadKey identifies a download by its order limit
+type downloadKey struct {
+	satelliteID  storj.NodeID
+	serialNumber storj.SerialNumber
+}
+
+// trackedDownload is the state of a download, which is shared by the
+// requests continuing the download.
+type trackedDownload struct {
+	offset     int64 // offset where the last request started
+	largest    pb.Order
+	saved      int64 // how much of the largest order has been saved
+	sent       int64 // how much data has been sent by all requests
+	lastSent   int64 // how much data has been sent by the last request
+	active     bool  // whether a request is using the download
+	expiration time.Time
+}
+
+// downloadTracker keeps track of the orders of unfinished downloads, so that
+// an uplink can continue a download with the same order limit after its
+// connection dropped.
+type downloadTracker struct {
+	mu        sync.Mutex
+	downloads map[downloadKey]*trackedDownload
+	lastPrune time.Time
+}
+
+// newDownloadTracker creates an empty download tracker.
+func newDownloadTracker() *downloadTracker {
+	return &downloadTracker{
+		downloads: make(map[downloadKey]*trackedDownload),
+	}
+}
+
+// Continue checks whether the order limit belongs to an unfinished download
+// and starts using it from the offset. A download can't be continued while
+// another request is using it. The offset must not be before the offset of the
+// previous request, and must be after it when the previous request has sent
+// data, which means that the uplink has received the data before it. A request
+// which didn't send any data can be retried from the same offset. It returns
+// how much of the already ordered data has not been sent to the uplink.
+func (tracker *downloadTracker) Continue(limit *pb.OrderLimit, offset int64, now time.Time) (unsent int64, ok bool) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	download, ok := tracker.downloads[keyOf(limit)]
+	switch {
+	case !ok, download.active, !now.Before(download.expiration):
+		return 0, false
+	case offset < download.offset, offset == download.offset && download.lastSent > 0:
+		return 0, false
+	}
+	download.offset = offset
+	download.lastSent = 0
+	download.active = true
+
+	unsent = download.largest.Amount - download.sent
+	if unsent < 0 {
+		unsent = 0
+	}
+	return unsent, true
+}
+
+// Start starts tracking a new download from the offset, which can be
+// continued until expiration.
+func (tracker *downloadTracker) Start(limit *pb.OrderLimit, offset int64, expiration time.Time) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	tracker.downloads[keyOf(limit)] = &trackedDownload{
+		offset:     offset,
+		active:     true,
+		expiration: expiration,
+	}
+}
+
+// Sent records that data has been sent to the uplink.
+func (tracker *downloadTracker) Sent(limit *pb.OrderLimit, amount int64) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	download := tracker.downloads[keyOf(limit)]
+	download.sent += amount
+	download.lastSent += amount
+}
+
+// Largest returns the amount of the largest order of the download.
+func (tracker *downloadTracker) Largest(limit *pb.OrderLimit) int64 {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	return tracker.downloads[keyOf(limit)].largest.Amount
+}
+
+// Update stores the order when it is larger than the previous orders of the
+// download and returns by how much it is larger.
+func (tracker *downloadTracker) Update(limit *pb.OrderLimit, order *pb.Order) int64 {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	download := tracker.downloads[keyOf(limit)]
+	increment := order.Amount - download.largest.Amount
+	if increment <= 0 {
+		return 0
+	}
+	download.largest = *order
+	return increment
+}
+
+// Finish stops using the download and returns the largest order together with
+// the amount that has not been saved yet. The download is forgotten when it
+// has been completed.
+func (tracker *downloadTracker) Finish(limit *pb.OrderLimit, completed bool, now time.Time) (largest pb.Order, unsaved int64) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	key := keyOf(limit)
+	download := tracker.downloads[key]
+	download.active = false
+
+	largest = download.largest
+	unsaved = largest.Amount - download.saved
+	download.saved = largest.Amount
+
+	if completed {
+		delete(tracker.downloads, key)
+	}
+
+	if now.Sub(tracker.lastPrune) >= downloadPruneInterval {
+		tracker.lastPrune = now
+		for key, download := range tracker.downloads {
+			if !download.active && !now.Before(download.expiration) {
+				delete(tracker.downloads, key)
+			}
+		}
+	}
+
+	return largest, unsaved
+}
+
+// keyOf returns the key of the download of the order limit
+func keyOf(limit *pb.OrderLimit) downloadKey {
+	return downloadKey{
+		satelliteID:  limit.SatelliteId,
+		serialNumber: limit.SerialNumber,
+	}
+}
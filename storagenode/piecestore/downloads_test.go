@@ -0,0 +1,77 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestDownloadTracker(t *testing.T) {
+	now := time.Now()
+	expiration := now.Add(time.Hour)
+	limit := &pb.OrderLimit{
+		SatelliteId:  testrand.NodeID(),
+		SerialNumber: testrand.SerialNumber(),
+	}
+
+	tracker := newDownloadTracker()
+	tracker.Start(limit, 0, expiration)
+
+	// a download can't be continued while it is in use
+	_, ok := tracker.Continue(limit, 0, now)
+	require.False(t, ok)
+
+	assert.Equal(t, int64(200), tracker.Update(limit, &pb.Order{Amount: 200}))
+	tracker.Sent(limit, 50)
+
+	largest, unsaved := tracker.Finish(limit, false, now)
+	assert.Equal(t, int64(200), largest.Amount)
+	assert.Equal(t, int64(200), unsaved)
+
+	// only the ordered data which was never sent is credited
+	unsent, ok := tracker.Continue(limit, 50, now)
+	require.True(t, ok)
+	assert.Equal(t, int64(150), unsent)
+
+	// a request which didn't send any data can be retried from the same offset
+	_, unsaved = tracker.Finish(limit, false, now)
+	assert.Equal(t, int64(0), unsaved)
+
+	unsent, ok = tracker.Continue(limit, 50, now)
+	require.True(t, ok)
+	assert.Equal(t, int64(150), unsent)
+
+	tracker.Sent(limit, 150)
+	tracker.Finish(limit, false, now)
+
+	// replaying the offset of a request which sent data is refused
+	_, ok = tracker.Continue(limit, 50, now)
+	require.False(t, ok)
+
+	// the download can't continue before the previous request either
+	_, ok = tracker.Continue(limit, 0, now)
+	require.False(t, ok)
+
+	unsent, ok = tracker.Continue(limit, 200, now)
+	require.True(t, ok)
+	assert.Equal(t, int64(0), unsent)
+
+	// a completed download is forgotten
+	tracker.Finish(limit, true, now)
+	_, ok = tracker.Continue(limit, 200, now)
+	require.False(t, ok)
+
+	// an expired download can't be continued
+	tracker.Start(limit, 0, expiration)
+	tracker.Finish(limit, false, now)
+	_, ok = tracker.Continue(limit, 0, expiration)
+	require.False(t, ok)
+}
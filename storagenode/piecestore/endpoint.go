@@ -47,13 +47,20 @@ var _ pb.PiecestoreServer = (*Endpoint)(nil)
 // OldConfig contains everything necessary for a server
 type OldConfig struct {
 	Path                   string         `help:"path to store data in" default:"$CONFDIR/storage"`
+	MigrateTo              string         `help:"path to migrate the stored data to while the node is running" default:""`
 	WhitelistedSatellites  storj.NodeURLs `help:"a comma-separated list of approved satellite node urls" devDefault:"" releaseDefault:"12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S@mars.tardigrade.io:7777,118UWpMCHzs6CvSgWd9BfFVjw5K9pZbJjkfZJexMtSkmKxvvAW@satellite.stefan-benten.de:7777,121RTSDpyNZVcEU84Ticf2L1ntiuUimbWgfATz21tuvgk3vzoA6@saturn.tardigrade.io:7777,12L9ZFwhzVpuEKMUNUqkaTLGzwY9G24tbiigLiXpmZWKwmcNDDs@jupiter.tardigrade.io:7777"`
 	SatelliteIDRestriction bool           `help:"if true, only allow data from approved satellites" devDefault:"false" releaseDefault:"true"`
-	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
+	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes of the storage path" default:"1TB"`
+	AdditionalDirs         StorageDirs    `help:"additional directories to store data in, as a comma-separated list of path=allocated-disk-space pairs" default:""`
 	AllocatedBandwidth     memory.Size    `user:"true" help:"total allocated bandwidth in bytes" default:"2TB"`
 	KBucketRefreshInterval time.Duration  `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 }
 
+// TotalAllocatedDiskSpace returns the disk space allocated to the storage path and the additional directories.
+func (config OldConfig) TotalAllocatedDiskSpace() memory.Size {
+	return config.AllocatedDiskSpace + config.AdditionalDirs.Allocated()
+}
+
 // Config defines parameters for piecestore endpoint.
 type Config struct {
 	ExpirationGracePeriod time.Duration `help:"how soon before expiration date should things be considered expired" default:"48h0m0s"`
@@ -79,6 +86,7 @@ type Endpoint struct {
 	orders      orders.DB
 	usage       bandwidth.DB
 	usedSerials UsedSerials
+	downloads   *downloadTracker
 
 	liveRequests int32
 }
@@ -98,6 +106,7 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		orders:      orders,
 		usage:       usage,
 		usedSerials: usedSerials,
+		downloads:   newDownloadTracker(),
 
 		liveRequests: 0,
 	}, nil
@@ -170,8 +179,8 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 
 	// TODO: verify that we have have expected amount of storage before continuing
 
-	if limit.Action != pb.PieceAction_PUT && limit.Action != pb.PieceAction_PUT_REPAIR {
-		return ErrProtocol.New("expected put or put repair action got %v", limit.Action) // TODO: report grpc status unauthorized or bad request
+	if limit.Action != pb.PieceAction_PUT && limit.Action != pb.PieceAction_PUT_REPAIR && limit.Action != pb.PieceAction_PUT_GRACEFUL_EXIT {
+		return ErrProtocol.New("expected put or put repair or put graceful exit action got %v", limit.Action) // TODO: report grpc status unauthorized or bad request
 	}
 
 	if err := endpoint.VerifyOrderLimit(ctx, limit); err != nil {
@@ -272,6 +281,11 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 				return ErrProtocol.New("out of space")
 			}
 
+			// the next chunk is received once the bandwidth schedule allows it
+			if err := endpoint.monitor.Limiter.Wait(ctx, chunkSize); err != nil {
+				return err
+			}
+
 			if _, err := pieceWriter.Write(message.Chunk.Data); err != nil {
 				return ErrInternal.Wrap(err) // TODO: report grpc status internal server error
 			}
@@ -366,10 +380,35 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 		return ErrProtocol.New("requested more that order limit allows, limit=%v requested=%v", limit.Limit, chunk.ChunkSize)
 	}
 
-	if err := endpoint.VerifyOrderLimit(ctx, limit); err != nil {
+	serialExpiration, err := endpoint.verifyOrderLimit(ctx, limit)
+	if err != nil {
 		return Error.Wrap(err) // TODO: report grpc status unauthorized or bad request
 	}
 
+	// the uplink may continue a download after its connection dropped,
+	// in which case the serial number has already been used by the download
+	unsent, continued := endpoint.downloads.Continue(limit, chunk.Offset, startTime)
+	if !continued {
+		if err := endpoint.usedSerials.Add(ctx, limit.SatelliteId, limit.SerialNumber, serialExpiration); err != nil {
+			return Error.Wrap(ErrVerifyDuplicateRequest.Wrap(err))
+		}
+		endpoint.downloads.Start(limit, chunk.Offset, serialExpiration)
+	} else {
+		endpoint.log.Debug("download continued", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("SatelliteID", limit.SatelliteId), zap.Int64("Offset", chunk.Offset))
+	}
+
+	completed := false
+	defer func(ctx context.Context) {
+		largest, unsaved := endpoint.downloads.Finish(limit, completed, time.Now())
+		endpoint.saveDownloadOrder(ctx, limit, &largest, unsaved)
+	}(ctx)
+
+	// the data sent before isn't sent again, so the rest of the download
+	// has to fit into what remains of the order limit
+	if continued && unsent+limit.Limit-endpoint.downloads.Largest(limit) < chunk.ChunkSize {
+		return ErrProtocol.New("order limit doesn't cover the rest of the download")
+	}
+
 	var pieceReader *pieces.Reader
 	defer func() {
 		endTime := time.Now().UTC()
@@ -424,6 +463,12 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 	}
 
 	throttle := sync2.NewThrottle()
+	// the data that was ordered, but was never sent to the uplink, is sent now
+	if unsent > 0 {
+		if err := throttle.Produce(unsent); err != nil {
+			return ErrInternal.Wrap(err)
+		}
+	}
 	// TODO: see whether this can be implemented without a goroutine
 
 	group, ctx := errgroup.WithContext(ctx)
@@ -453,6 +498,10 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 				return ErrInternal.Wrap(err)
 			}
 
+			if err := endpoint.monitor.Limiter.Wait(ctx, chunkSize); err != nil {
+				return err
+			}
+
 			err = stream.Send(&pb.PieceDownloadResponse{
 				Chunk: &pb.PieceDownloadResponse_Chunk{
 					Offset: currentOffset,
@@ -464,18 +513,17 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 				// no need to propagate it
 				return ErrProtocol.Wrap(ignoreEOF(err))
 			}
+			endpoint.downloads.Sent(limit, chunkSize)
 
 			currentOffset += chunkSize
 			unsentAmount -= chunkSize
 		}
 
+		completed = true
 		return nil
 	})
 
 	recvErr := func() (err error) {
-		largestOrder := pb.Order{}
-		defer endpoint.SaveOrder(ctx, limit, &largestOrder)
-
 		// ensure that we always terminate sending goroutine
 		defer throttle.Fail(io.EOF)
 
@@ -492,11 +540,12 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 				return ErrProtocol.New("expected order as the message")
 			}
 
-			if err := endpoint.VerifyOrder(ctx, limit, message.Order, largestOrder.Amount); err != nil {
+			if err := endpoint.VerifyOrder(ctx, limit, message.Order, endpoint.downloads.Largest(limit)); err != nil {
 				return err
 			}
 
-			chunkSize := message.Order.Amount - largestOrder.Amount
+			// orders of a continued download include the amount of the previous requests
+			chunkSize := endpoint.downloads.Update(limit, message.Order)
 			availableBandwidth -= chunkSize
 			if availableBandwidth < 0 {
 				return ErrProtocol.New("out of bandwidth")
@@ -506,7 +555,6 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 				// shouldn't happen since only receiving side is calling Fail
 				return ErrInternal.Wrap(err)
 			}
-			largestOrder = *message.Order
 		}
 	}()
 
@@ -538,6 +586,35 @@ func (endpoint *Endpoint) SaveOrder(ctx context.Context, limit *pb.OrderLimit, o
 	}
 }
 
+// saveDownloadOrder saves the largest order of a download, replacing the order
+// saved by a previous request of the download. Only the unsaved amount is
+// added to the bandwidth usage.
+func (endpoint *Endpoint) saveDownloadOrder(ctx context.Context, limit *pb.OrderLimit, order *pb.Order, unsaved int64) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if unsaved <= 0 {
+		return
+	}
+	if unsaved == order.Amount {
+		endpoint.SaveOrder(ctx, limit, order)
+		return
+	}
+
+	err = endpoint.orders.Replace(ctx, &orders.Info{
+		Limit: limit,
+		Order: order,
+	})
+	if err != nil {
+		endpoint.log.Error("failed to replace order", zap.Error(err))
+	} else {
+		err = endpoint.usage.Add(ctx, limit.SatelliteId, limit.Action, unsaved, time.Now())
+		if err != nil {
+			endpoint.log.Error("failed to add bandwidth usage", zap.Error(err))
+		}
+	}
+}
+
 // Retain keeps only piece ids specified in the request
 func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainRequest) (res *pb.RetainResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -559,10 +636,11 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 
 	const limit = 1000
 	offset := 0
-	numDeleted := 0
 	hasMorePieces := true
 
 	for hasMorePieces {
+		numDeleted := 0
+
 		// subtract some time to leave room for clock difference between the satellite and storage node
 		createdBefore := retainReq.GetCreationDate().Add(-endpoint.config.RetainTimeBuffer)
 
@@ -572,14 +650,18 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 		}
 		for _, pieceID := range pieceIDs {
 			if !filter.Contains(pieceID) {
-				if err = endpoint.store.Delete(ctx, peer.ID, pieceID); err != nil {
-					endpoint.log.Error("failed to delete a piece", zap.Error(Error.Wrap(err)))
+				if err = endpoint.store.Trash(ctx, peer.ID, pieceID); err != nil {
+					endpoint.log.Error("failed to trash a piece", zap.Error(Error.Wrap(err)))
 					// continue because if we fail to delete from file system,
 					// we need to keep the pieceinfo so we can delete next time
 					continue
 				}
-				if err = endpoint.pieceinfo.Delete(ctx, peer.ID, pieceID); err != nil {
-					endpoint.log.Error("failed to delete piece info", zap.Error(Error.Wrap(err)))
+				// the pieceinfo is kept until the trash is emptied, so that
+				// the piece can be restored
+				if err = endpoint.pieceinfo.Trash(ctx, peer.ID, pieceID, time.Now()); err != nil {
+					endpoint.log.Error("failed to mark piece info as trashed", zap.Error(Error.Wrap(err)))
+					// the pieceinfo is still listed on the next page
+					continue
 				}
 				numDeleted++
 			}
@@ -594,6 +676,33 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 	return &pb.RetainResponse{}, nil
 }
 
+// RestoreTrash moves all pieces of the satellite from the trash back into storage
+func (endpoint *Endpoint) RestoreTrash(ctx context.Context, restoreTrashReq *pb.RestoreTrashRequest) (res *pb.RestoreTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, Error.New("restore trash called with untrusted ID").Error())
+	}
+
+	err = endpoint.store.RestoreTrash(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	err = endpoint.pieceinfo.RestoreTrash(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return &pb.RestoreTrashResponse{}, nil
+}
+
 // min finds the min of two values
 func min(a, b int64) int64 {
 	if a < b {
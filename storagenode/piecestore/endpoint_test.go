@@ -4,9 +4,12 @@
 package piecestore_test
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"io"
+	"io/ioutil"
+	"net"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -18,6 +21,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
@@ -33,6 +37,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/pieces"
@@ -139,7 +144,7 @@ func TestUpload(t *testing.T) {
 			pieceID:       storj.PieceID{1},
 			contentLength: 1 * memory.KiB,
 			action:        pb.PieceAction_GET,
-			err:           "expected put or put repair action got GET",
+			err:           "expected put or put repair or put graceful exit action got GET",
 		},
 	} {
 		data := testrand.Bytes(tt.contentLength)
@@ -283,6 +288,176 @@ func TestDownload(t *testing.T) {
 	}
 }
 
+func TestDownload_Resume(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplink := planet.Uplinks[0]
+		storageNode := planet.StorageNodes[0]
+		signer := signing.SignerFromFullIdentity(planet.Satellites[0].Identity)
+
+		expectedData := testrand.Bytes(memory.MiB)
+
+		client, err := uplink.DialPiecestore(ctx, storageNode)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		orderLimit, piecePrivateKey := GenerateOrderLimit(t,
+			planet.Satellites[0].ID(), storageNode.ID(), storj.PieceID{1},
+			pb.PieceAction_PUT, testrand.SerialNumber(),
+			24*time.Hour, 24*time.Hour, int64(len(expectedData)),
+		)
+		orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+		require.NoError(t, err)
+
+		uploader, err := client.Upload(ctx, orderLimit, piecePrivateKey)
+		require.NoError(t, err)
+		_, err = uploader.Write(expectedData)
+		require.NoError(t, err)
+		_, err = uploader.Commit(ctx)
+		require.NoError(t, err)
+
+		// the first connection drops in the middle of the download
+		dropping := &droppingTransport{
+			Client: uplink.Transport,
+			after:  int64(len(expectedData)) / 2,
+		}
+		node := storageNode.Local()
+		resumingClient, err := piecestore.Dial(ctx, dropping, &node.Node, uplink.Log.Named("resuming"), piecestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(resumingClient.Close)
+
+		// the data lost with the connection is ordered again, which needs
+		// some room in the order limit
+		orderLimit, piecePrivateKey = GenerateOrderLimit(t,
+			planet.Satellites[0].ID(), storageNode.ID(), storj.PieceID{1},
+			pb.PieceAction_GET, testrand.SerialNumber(),
+			24*time.Hour, 24*time.Hour, 2*int64(len(expectedData)),
+		)
+		orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+		require.NoError(t, err)
+
+		downloader, err := resumingClient.Download(ctx, orderLimit, piecePrivateKey, 0, int64(len(expectedData)))
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(downloader)
+		require.NoError(t, err)
+		require.NoError(t, downloader.Close())
+		assert.Equal(t, expectedData, data)
+		assert.True(t, atomic.LoadInt32(&dropping.dials) > 1, "connection did not drop")
+
+		// a single order for the whole download is sent to the satellite
+		unsent, err := storageNode.DB.Orders().ListUnsent(ctx, 100)
+		require.NoError(t, err)
+		var found int
+		for _, info := range unsent {
+			if info.Limit.SerialNumber == orderLimit.SerialNumber {
+				found++
+				assert.True(t, info.Order.Amount >= int64(len(expectedData)), info.Order.Amount)
+				assert.True(t, info.Order.Amount <= orderLimit.Limit, info.Order.Amount)
+			}
+		}
+		assert.Equal(t, 1, found)
+	})
+}
+
+func TestDownload_RetryWithoutProgress(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplink := planet.Uplinks[0]
+		storageNode := planet.StorageNodes[0]
+		signer := signing.SignerFromFullIdentity(planet.Satellites[0].Identity)
+
+		expectedData := testrand.Bytes(10 * memory.KiB)
+
+		client, err := uplink.DialPiecestore(ctx, storageNode)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		orderLimit, piecePrivateKey := GenerateOrderLimit(t,
+			planet.Satellites[0].ID(), storageNode.ID(), storj.PieceID{1},
+			pb.PieceAction_PUT, testrand.SerialNumber(),
+			24*time.Hour, 24*time.Hour, int64(len(expectedData)),
+		)
+		orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+		require.NoError(t, err)
+
+		uploader, err := client.Upload(ctx, orderLimit, piecePrivateKey)
+		require.NoError(t, err)
+		_, err = uploader.Write(expectedData)
+		require.NoError(t, err)
+		_, err = uploader.Commit(ctx)
+		require.NoError(t, err)
+
+		orderLimit, piecePrivateKey = GenerateOrderLimit(t,
+			planet.Satellites[0].ID(), storageNode.ID(), storj.PieceID{1},
+			pb.PieceAction_GET, testrand.SerialNumber(),
+			24*time.Hour, 24*time.Hour, int64(len(expectedData)),
+		)
+		orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+		require.NoError(t, err)
+
+		// the first request ends before any data has been received
+		downloader, err := client.Download(ctx, orderLimit, piecePrivateKey, 0, int64(len(expectedData)))
+		require.NoError(t, err)
+		require.NoError(t, downloader.Close())
+
+		// so the download is retried from the same offset
+		downloader, err = client.Download(ctx, orderLimit, piecePrivateKey, 0, int64(len(expectedData)))
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(downloader)
+		require.NoError(t, err)
+		require.NoError(t, downloader.Close())
+		assert.Equal(t, expectedData, data)
+	})
+}
+
+// droppingTransport dials connections, where the first one drops after
+// reading the specified amount of bytes.
+type droppingTransport struct {
+	transport.Client
+	after int64
+	dials int32
+}
+
+// DialNode dials the node with a connection that may drop
+func (client *droppingTransport) DialNode(ctx context.Context, node *pb.Node, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return client.Client.DialNode(ctx, node, append(opts,
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+			if err != nil {
+				return nil, err
+			}
+			if atomic.AddInt32(&client.dials, 1) > 1 {
+				return conn, nil
+			}
+			return &droppingConn{Conn: conn, remaining: client.after}, nil
+		}),
+	)...)
+}
+
+// droppingConn closes the connection after reading the remaining bytes
+type droppingConn struct {
+	net.Conn
+	remaining int64
+}
+
+// Read reads from the connection until it drops
+func (conn *droppingConn) Read(p []byte) (n int, err error) {
+	if conn.remaining <= 0 {
+		_ = conn.Conn.Close()
+		return 0, errs.New("connection dropped")
+	}
+	if int64(len(p)) > conn.remaining {
+		p = p[:conn.remaining]
+	}
+	n, err = conn.Conn.Read(p)
+	conn.remaining -= int64(n)
+	return n, err
+}
+
 func TestDelete(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -590,6 +765,12 @@ func TestRetain(t *testing.T) {
 			err = pieceInfos.Add(ctx, &pieceinfo1)
 			require.NoError(t, err)
 
+			// only the pieces of satellite0 are stored, so that they can be trashed
+			writer, err := store.Writer(ctx, satellite0.ID, id)
+			require.NoError(t, err)
+			_, err = writer.Write([]byte{1, 2, 3, 4})
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx))
 		}
 
 		ctxSatellite0 := peer.NewContext(ctx, &peer.Peer{
@@ -623,6 +804,46 @@ func TestRetain(t *testing.T) {
 		for _, id := range pieceIDs[numPiecesToKeep+numOldPieces:] {
 			require.Contains(t, satellite0Pieces, id, "piece should not have been deleted (recent piece)")
 		}
+
+		// check we trashed the old pieces which are not in the bloom filter,
+		// some of them may be kept due to false positives
+		var trashedIDs []storj.PieceID
+		for _, id := range pieceIDs[numPiecesToKeep : numPiecesToKeep+numOldPieces] {
+			if !filter.Contains(id) {
+				trashedIDs = append(trashedIDs, id)
+			}
+		}
+		require.Len(t, satellite0Pieces, numPieces-len(trashedIDs))
+		for _, id := range trashedIDs {
+			require.NotContains(t, satellite0Pieces, id, "piece should have been trashed")
+
+			_, err := store.Reader(ctx, satellite0.ID, id)
+			require.Error(t, err, "trashed piece should not be readable")
+		}
+
+		// the trashed pieces are still counted as used space
+		spaceUsed, err := pieceInfos.CalculatedSpaceUsed(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2*numPieces*4), spaceUsed)
+
+		// the satellite can restore the pieces which were moved to the trash
+		_, err = endpoint.RestoreTrash(ctxSatellite0, &pb.RestoreTrashRequest{})
+		require.NoError(t, err)
+
+		satellite0Pieces, err = pieceInfos.GetPieceIDs(ctx, satellite0.ID, recentTime.Add(time.Duration(5)*time.Second), numPieces, 0)
+		require.NoError(t, err)
+		require.Len(t, satellite0Pieces, numPieces)
+
+		for _, id := range trashedIDs {
+			require.Contains(t, satellite0Pieces, id, "restored piece should be tracked again")
+
+			reader, err := store.Reader(ctx, satellite0.ID, id)
+			require.NoError(t, err, "restored piece should be readable")
+			data, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.Equal(t, []byte{1, 2, 3, 4}, data)
+			require.NoError(t, reader.Close())
+		}
 	})
 }
 
@@ -29,52 +29,68 @@ var (
 func (endpoint *Endpoint) VerifyOrderLimit(ctx context.Context, limit *pb.OrderLimit) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	serialExpiration, err := endpoint.verifyOrderLimit(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	if err := endpoint.usedSerials.Add(ctx, limit.SatelliteId, limit.SerialNumber, serialExpiration); err != nil {
+		return ErrVerifyDuplicateRequest.Wrap(err)
+	}
+
+	return nil
+}
+
+// verifyOrderLimit verifies that the order limit is properly signed and has sane values.
+// It returns until when the serial number of the order limit needs to be remembered.
+func (endpoint *Endpoint) verifyOrderLimit(ctx context.Context, limit *pb.OrderLimit) (serialExpiration time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	// sanity checks
 	now := time.Now()
 	switch {
 	case limit.Limit < 0:
-		return ErrProtocol.New("order limit is negative")
+		return time.Time{}, ErrProtocol.New("order limit is negative")
 	case endpoint.signer.ID() != limit.StorageNodeId:
-		return ErrProtocol.New("order intended for other storagenode: %v", limit.StorageNodeId)
+		return time.Time{}, ErrProtocol.New("order intended for other storagenode: %v", limit.StorageNodeId)
 	case endpoint.IsExpired(limit.PieceExpiration):
-		return ErrProtocol.New("piece expired: %v", limit.PieceExpiration)
+		return time.Time{}, ErrProtocol.New("piece expired: %v", limit.PieceExpiration)
 	case endpoint.IsExpired(limit.OrderExpiration):
-		return ErrProtocol.New("order expired: %v", limit.OrderExpiration)
+		return time.Time{}, ErrProtocol.New("order expired: %v", limit.OrderExpiration)
 	case now.Sub(limit.OrderCreation) > endpoint.config.OrderLimitGracePeriod:
-		return ErrProtocol.New("order created too long ago: %v", limit.OrderCreation)
+		return time.Time{}, ErrProtocol.New("order created too long ago: %v", limit.OrderCreation)
 	case limit.SatelliteId.IsZero():
-		return ErrProtocol.New("missing satellite id")
+		return time.Time{}, ErrProtocol.New("missing satellite id")
 	case limit.UplinkPublicKey.IsZero():
-		return ErrProtocol.New("missing uplink public key")
+		return time.Time{}, ErrProtocol.New("missing uplink public key")
 	case len(limit.SatelliteSignature) == 0:
-		return ErrProtocol.New("missing satellite signature")
+		return time.Time{}, ErrProtocol.New("missing satellite signature")
 	case limit.PieceId.IsZero():
-		return ErrProtocol.New("missing piece id")
+		return time.Time{}, ErrProtocol.New("missing piece id")
 	}
 
 	if err := endpoint.trust.VerifySatelliteID(ctx, limit.SatelliteId); err != nil {
-		return ErrVerifyUntrusted.Wrap(err)
+		return time.Time{}, ErrVerifyUntrusted.Wrap(err)
 	}
 
 	if err := endpoint.VerifyOrderLimitSignature(ctx, limit); err != nil {
 		if err == context.Canceled {
-			return err
+			return time.Time{}, err
 		}
-		return ErrVerifyUntrusted.Wrap(err)
+		return time.Time{}, ErrVerifyUntrusted.Wrap(err)
 	}
 
-	serialExpiration := limit.OrderExpiration
+	// the address is signed by the satellite, so it can be used to contact it
+	endpoint.trust.AddAddress(ctx, limit.SatelliteId, limit.SatelliteAddress.GetAddress())
+
+	serialExpiration = limit.OrderExpiration
 
 	// Expire the serial earlier if the grace period is smaller than the serial expiration.
 	if graceExpiration := now.Add(endpoint.config.OrderLimitGracePeriod); graceExpiration.Before(serialExpiration) {
 		serialExpiration = graceExpiration
 	}
 
-	if err := endpoint.usedSerials.Add(ctx, limit.SatelliteId, limit.SerialNumber, serialExpiration); err != nil {
-		return ErrVerifyDuplicateRequest.Wrap(err)
-	}
-
-	return nil
+	return serialExpiration, nil
 }
 
 // VerifyOrder verifies that the order corresponds to the order limit and has all the necessary fields.
@@ -63,7 +63,7 @@ func TestOrderLimitPutValidation(t *testing.T) {
 			pieceExpiration: oneWeek,
 			orderExpiration: oneWeek,
 			limit:           memory.KiB.Int64(),
-			err:             "expected put or put repair action got GET",
+			err:             "expected put or put repair or put graceful exit action got GET",
 		},
 		{ // piece expired
 			pieceID:         storj.PieceID{4},
@@ -0,0 +1,85 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/gracefulexit"
+)
+
+type gracefulexitdb struct{ *InfoDB }
+
+// GracefulExit returns database for graceful exit
+func (db *DB) GracefulExit() gracefulexit.DB { return db.info.GracefulExit() }
+
+// GracefulExit returns database for graceful exit
+func (db *InfoDB) GracefulExit() gracefulexit.DB { return &gracefulexitdb{db} }
+
+// InitiateGracefulExit records that the node has started exiting the satellite.
+func (db *gracefulexitdb) InitiateGracefulExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time, startingDiskUsage int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		INSERT INTO graceful_exit_status (satellite_id, initiated_at, starting_disk_usage, bytes_deleted, success)
+		VALUES (?, ?, ?, 0, 0)
+	`), satelliteID, initiatedAt.UTC(), startingDiskUsage)
+
+	return ErrInfo.Wrap(err)
+}
+
+// UpdateGracefulExit adds to the number of bytes deleted during the exit.
+func (db *gracefulexitdb) UpdateGracefulExit(ctx context.Context, satelliteID storj.NodeID, addToBytesDeleted int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		UPDATE graceful_exit_status
+		SET bytes_deleted = bytes_deleted + ?
+		WHERE satellite_id = ?
+	`), addToBytesDeleted, satelliteID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// CompleteGracefulExit records the end of the exit.
+func (db *gracefulexitdb) CompleteGracefulExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, success bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		UPDATE graceful_exit_status
+		SET finished_at = ?, success = ?
+		WHERE satellite_id = ?
+	`), finishedAt.UTC(), success, satelliteID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// ListGracefulExits returns the progress of all exits.
+func (db *gracefulexitdb) ListGracefulExits(ctx context.Context) (exits []gracefulexit.ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT satellite_id, initiated_at, finished_at, starting_disk_usage, bytes_deleted, success
+		FROM graceful_exit_status
+	`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var exit gracefulexit.ExitProgress
+		err := rows.Scan(&exit.SatelliteID, &exit.InitiatedAt, &exit.FinishedAt, &exit.StartingDiskUsage, &exit.BytesDeleted, &exit.Success)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		exits = append(exits, exit)
+	}
+
+	return exits, ErrInfo.Wrap(rows.Err())
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testrand"
+)
+
+func TestGracefulExit(t *testing.T) {
+	Run(t, func(t *testing.T, ctx context.Context, db *DB) {
+		gracefulexits := db.GracefulExit()
+		satelliteID := testrand.NodeID()
+
+		exits, err := gracefulexits.ListGracefulExits(ctx)
+		require.NoError(t, err)
+		require.Empty(t, exits)
+
+		initiatedAt := time.Now().UTC()
+		err = gracefulexits.InitiateGracefulExit(ctx, satelliteID, initiatedAt, 1000)
+		require.NoError(t, err)
+
+		// the same exit can't be initiated twice
+		err = gracefulexits.InitiateGracefulExit(ctx, satelliteID, initiatedAt, 1000)
+		require.Error(t, err)
+
+		require.NoError(t, gracefulexits.UpdateGracefulExit(ctx, satelliteID, 100))
+		require.NoError(t, gracefulexits.UpdateGracefulExit(ctx, satelliteID, 200))
+
+		exits, err = gracefulexits.ListGracefulExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, exits, 1)
+
+		exit := exits[0]
+		require.Equal(t, satelliteID, exit.SatelliteID)
+		require.True(t, initiatedAt.Equal(exit.InitiatedAt))
+		require.Nil(t, exit.FinishedAt)
+		require.EqualValues(t, 1000, exit.StartingDiskUsage)
+		require.EqualValues(t, 300, exit.BytesDeleted)
+		require.False(t, exit.Success)
+
+		finishedAt := time.Now().UTC()
+		err = gracefulexits.CompleteGracefulExit(ctx, satelliteID, finishedAt, true)
+		require.NoError(t, err)
+
+		exits, err = gracefulexits.ListGracefulExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, exits, 1)
+
+		exit = exits[0]
+		require.NotNil(t, exit.FinishedAt)
+		require.True(t, finishedAt.Equal(*exit.FinishedAt))
+		require.True(t, exit.Success)
+	})
+}
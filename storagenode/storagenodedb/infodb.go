@@ -269,6 +269,28 @@ func (db *InfoDB) Migration() *migrate.Migration {
 					`CREATE INDEX idx_pieceinfo_expiration ON pieceinfo(piece_expiration) WHERE piece_expiration IS NOT NULL`,
 				},
 			},
+			{
+				Description: "Add graceful exit status table.",
+				Version:     11,
+				Action: migrate.SQL{
+					`CREATE TABLE graceful_exit_status (
+						satellite_id        BLOB      NOT NULL,
+						initiated_at        TIMESTAMP NOT NULL,
+						finished_at         TIMESTAMP,
+						starting_disk_usage INTEGER   NOT NULL,
+						bytes_deleted       INTEGER   NOT NULL,
+						success             INTEGER   NOT NULL,
+						PRIMARY KEY (satellite_id)
+					)`,
+				},
+			},
+			{
+				Description: "Add trashed_at to pieceinfo.",
+				Version:     12,
+				Action: migrate.SQL{
+					`ALTER TABLE pieceinfo ADD COLUMN trashed_at TIMESTAMP`,
+				},
+			},
 		},
 	}
 }
@@ -50,6 +50,32 @@ func (db *ordersdb) Enqueue(ctx context.Context, info *orders.Info) (err error)
 	return ErrInfo.Wrap(err)
 }
 
+// Replace inserts order to the unsent list, replacing the unsent order with the same serial number
+func (db *ordersdb) Replace(ctx context.Context, info *orders.Info) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limitSerialized, err := proto.Marshal(info.Limit)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	orderSerialized, err := proto.Marshal(info.Order)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	// TODO: remove uplink_cert_id
+	_, err = db.db.Exec(`
+		INSERT OR REPLACE INTO unsent_order(
+			satellite_id, serial_number,
+			order_limit_serialized, order_serialized, order_limit_expiration,
+			uplink_cert_id
+		) VALUES (?,?, ?,?,?, ?)
+	`, info.Limit.SatelliteId, info.Limit.SerialNumber, limitSerialized, orderSerialized, info.Limit.OrderExpiration.UTC(), 0)
+
+	return ErrInfo.Wrap(err)
+}
+
 // ListUnsent returns orders that haven't been sent yet.
 func (db *ordersdb) ListUnsent(ctx context.Context, limit int) (_ []*orders.Info, err error) {
 	defer mon.Task()(&ctx)(&err)
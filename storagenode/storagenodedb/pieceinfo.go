@@ -69,6 +69,30 @@ func (db *pieceinfo) Add(ctx context.Context, info *pieces.Info) (err error) {
 	return ErrInfo.Wrap(err)
 }
 
+// GetSatellites returns the satellites which have pieces stored.
+func (db *pieceinfo) GetSatellites(ctx context.Context) (satellites []storj.NodeID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT DISTINCT satellite_id
+		FROM pieceinfo
+		ORDER BY satellite_id
+	`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		err = rows.Scan(&satelliteID)
+		if err != nil {
+			return satellites, ErrInfo.Wrap(err)
+		}
+		satellites = append(satellites, satelliteID)
+	}
+	return satellites, nil
+}
+
 // GetPieceIDs gets pieceIDs using the satelliteID
 func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit, offset int) (pieceIDs []storj.PieceID, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -77,6 +101,7 @@ func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID,
 		SELECT piece_id
 		FROM pieceinfo
 		WHERE satellite_id = ? AND datetime(piece_creation) < datetime(?)
+		AND trashed_at IS NULL
 		ORDER BY piece_id
 		LIMIT ? OFFSET ?
 	`), satelliteID, createdBefore.UTC(), limit, offset)
@@ -95,6 +120,64 @@ func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID,
 	return pieceIDs, nil
 }
 
+// GetPieceIDsAfter gets pieceIDs using the satelliteID, which follow the cursor
+// in order. It can be used while pieces are deleted, unlike GetPieceIDs.
+func (db *pieceinfo) GetPieceIDsAfter(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, cursor storj.PieceID, limit int) (pieceIDs []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT piece_id
+		FROM pieceinfo
+		WHERE satellite_id = ? AND datetime(piece_creation) < datetime(?)
+		AND piece_id > ?
+		AND trashed_at IS NULL
+		ORDER BY piece_id
+		LIMIT ?
+	`), satelliteID, createdBefore.UTC(), cursor, limit)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		var pieceID storj.PieceID
+		err = rows.Scan(&pieceID)
+		if err != nil {
+			return pieceIDs, ErrInfo.Wrap(err)
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs, nil
+}
+
+// GetTrashedPieceIDsAfter gets the pieceIDs of the satellite, which are in the
+// trash, after the cursor in order.
+func (db *pieceinfo) GetTrashedPieceIDsAfter(ctx context.Context, satelliteID storj.NodeID, cursor storj.PieceID, limit int) (pieceIDs []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT piece_id
+		FROM pieceinfo
+		WHERE satellite_id = ?
+		AND piece_id > ?
+		AND trashed_at IS NOT NULL
+		ORDER BY piece_id
+		LIMIT ?
+	`), satelliteID, cursor, limit)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		var pieceID storj.PieceID
+		err = rows.Scan(&pieceID)
+		if err != nil {
+			return pieceIDs, ErrInfo.Wrap(err)
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs, nil
+}
+
 // Get gets piece information by satellite id and piece id.
 func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (_ *pieces.Info, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -102,6 +185,7 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 	info.SatelliteID = satelliteID
 	info.PieceID = pieceID
 
+	var pieceExpiration *time.Time
 	var orderLimit []byte
 	var uplinkPieceHash []byte
 
@@ -109,11 +193,15 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 		SELECT piece_size, piece_creation, piece_expiration, order_limit, uplink_piece_hash
 		FROM pieceinfo
 		WHERE satellite_id = ? AND piece_id = ?
-	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceCreation, &info.PieceExpiration, &orderLimit, &uplinkPieceHash)
+	`), satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceCreation, &pieceExpiration, &orderLimit, &uplinkPieceHash)
 	if err != nil {
 		return nil, ErrInfo.Wrap(err)
 	}
 
+	if pieceExpiration != nil {
+		info.PieceExpiration = *pieceExpiration
+	}
+
 	info.OrderLimit = &pb.OrderLimit{}
 	err = proto.Unmarshal(orderLimit, info.OrderLimit)
 	if err != nil {
@@ -172,6 +260,77 @@ func (db *pieceinfo) DeleteFailed(ctx context.Context, satelliteID storj.NodeID,
 	return ErrInfo.Wrap(err)
 }
 
+// Trash marks the piece as moved to the trash, the piece is still counted as
+// used space until the trash is emptied.
+func (db *pieceinfo) Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		UPDATE pieceinfo
+		SET trashed_at = ?
+		WHERE satellite_id = ?
+		  AND piece_id = ?
+	`), trashedAt.UTC(), satelliteID, pieceID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// RestoreTrash unmarks the trashed pieces of the satellite.
+func (db *pieceinfo) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.Rebind(`
+		UPDATE pieceinfo
+		SET trashed_at = NULL
+		WHERE satellite_id = ?
+		  AND trashed_at IS NOT NULL
+	`), satelliteID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// DeleteTrashed deletes piece information of the pieces which were moved to
+// the trash before trashedBefore.
+func (db *pieceinfo) DeleteTrashed(ctx context.Context, trashedBefore time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the size is summed in the same transaction, so pieces trashed or
+	// restored concurrently don't change the freed space
+	tx, err := db.db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ErrInfo.Wrap(err)
+	}
+
+	var size sql.NullInt64
+	err = tx.QueryRowContext(ctx, db.Rebind(`
+		SELECT SUM(piece_size)
+		FROM pieceinfo
+		WHERE trashed_at IS NOT NULL
+		  AND trashed_at < ?
+	`), trashedBefore.UTC()).Scan(&size)
+	if err != nil {
+		return ErrInfo.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	_, err = tx.ExecContext(ctx, db.Rebind(`
+		DELETE FROM pieceinfo
+		WHERE trashed_at IS NOT NULL
+		  AND trashed_at < ?
+	`), trashedBefore.UTC())
+	if err != nil {
+		return ErrInfo.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	err = tx.Commit()
+	if size.Int64 != 0 && err == nil {
+		db.loadSpaceUsed(ctx)
+
+		atomic.AddInt64(&db.space.used, -size.Int64)
+	}
+
+	return ErrInfo.Wrap(err)
+}
+
 // GetExpired gets pieceinformation identites that are expired.
 func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit int64) (infos []pieces.ExpiredInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -182,6 +341,7 @@ func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit
 		WHERE piece_expiration IS NOT NULL
 		AND piece_expiration < ?
 		AND ((deletion_failed_at IS NULL) OR deletion_failed_at <> ?)
+		AND trashed_at IS NULL
 		ORDER BY satellite_id
 		LIMIT ?
 	`), expiredAt.UTC(), expiredAt.UTC(), limit)
@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package trash implements emptying the trash of the storage node.
+package trash
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var mon = monkit.Package()
+
+// Config defines parameters for the storage node trash.
+type Config struct {
+	Interval  time.Duration `help:"how frequently the trash is emptied" default:"24h0m0s"`
+	Retention time.Duration `help:"how long deleted pieces are kept in the trash before they are removed for good" default:"168h0m0s"`
+}
+
+// Chore implements emptying the trash on the storage node.
+type Chore struct {
+	log        *zap.Logger
+	config     Config
+	store      *pieces.Store
+	pieceinfos pieces.DB
+
+	Loop sync2.Cycle
+}
+
+// NewChore creates a new trash chore.
+func NewChore(log *zap.Logger, config Config, store *pieces.Store, pieceinfos pieces.DB) *Chore {
+	return &Chore{
+		log:        log,
+		config:     config,
+		store:      store,
+		pieceinfos: pieceinfos,
+		Loop:       *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the trash chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.Empty(ctx, time.Now().Add(-chore.config.Retention))
+		if err != nil {
+			chore.log.Error("error during emptying trash: ", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the trash chore.
+func (chore *Chore) Close() (err error) {
+	chore.Loop.Close()
+	return nil
+}
+
+// Empty deletes the pieces which were moved to the trash before trashedBefore.
+func (chore *Chore) Empty(ctx context.Context, trashedBefore time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bytesEmptied, err := chore.store.EmptyTrash(ctx, trashedBefore)
+	if bytesEmptied > 0 {
+		chore.log.Info("emptied trash", zap.Stringer("size", memory.Size(bytesEmptied)))
+	}
+	if err != nil {
+		return err
+	}
+
+	// the pieces are trashed before their piece info is marked, so the
+	// piece info is never deleted while its piece can still be restored
+	return chore.pieceinfos.DeleteTrashed(ctx, trashedBefore)
+}
@@ -36,32 +36,26 @@ type satelliteInfoCache struct {
 	mu       sync.Mutex
 	identity *identity.PeerIdentity
 	nodeURL  storj.NodeURL
+	// configured is set when the address of the satellite was configured
+	configured bool
 }
 
 // NewPool creates a new trust pool using kademlia to find certificates and with the specified list of trusted satellites.
+// When all satellites are trusted, the listed satellites are still known from the start.
 func NewPool(kademlia *kademlia.Kademlia, trustAll bool, trustedSatellites storj.NodeURLs) (*Pool, error) {
-	if trustAll {
-		return &Pool{
-			kademlia: kademlia,
-
-			trustAllSatellites: true,
-			trustedSatellites:  map[storj.NodeID]*satelliteInfoCache{},
-		}, nil
-	}
-
 	// TODO: preload all satellite peer identities
 
 	// parse the comma separated list of approved satellite IDs into an array of storj.NodeIDs
 	trusted := make(map[storj.NodeID]*satelliteInfoCache)
 
 	for _, node := range trustedSatellites {
-		trusted[node.ID] = &satelliteInfoCache{nodeURL: node}
+		trusted[node.ID] = &satelliteInfoCache{nodeURL: node, configured: node.Address != ""}
 	}
 
 	return &Pool{
 		kademlia: kademlia,
 
-		trustAllSatellites: false,
+		trustAllSatellites: trustAll,
 		trustedSatellites:  trusted,
 	}, nil
 }
@@ -128,13 +122,47 @@ func (pool *Pool) GetSignee(ctx context.Context, id storj.NodeID) (_ signing.Sig
 // GetSatellites returns a slice containing all trusted satellites
 func (pool *Pool) GetSatellites(ctx context.Context) (satellites []storj.NodeID) {
 	defer mon.Task()(&ctx)(nil)
+
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
 	for sat := range pool.trustedSatellites {
 		satellites = append(satellites, sat)
 	}
 	return satellites
 }
 
-// GetAddress returns the address of a satellite in the trusted list
+// AddAddress remembers the address of a satellite seen in a verified order
+// limit. When all satellites are trusted, an unknown satellite is added to the
+// pool, so it is checked in with and its orders are settled. The address of a
+// satellite configured with its URL is kept.
+func (pool *Pool) AddAddress(ctx context.Context, id storj.NodeID, address string) {
+	defer mon.Task()(&ctx)(nil)
+
+	if address == "" {
+		return
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	info, ok := pool.trustedSatellites[id]
+	if !ok {
+		if !pool.trustAllSatellites {
+			return
+		}
+		info = &satelliteInfoCache{}
+		pool.trustedSatellites[id] = info
+	}
+
+	if !info.configured {
+		info.nodeURL = storj.NodeURL{ID: id, Address: address}
+	}
+}
+
+// GetAddress returns the address of a satellite in the trusted list. Only
+// the satellites configured with their URL or seen in an order limit have a
+// known address.
 func (pool *Pool) GetAddress(ctx context.Context, id storj.NodeID) (_ string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -145,5 +173,8 @@ func (pool *Pool) GetAddress(ctx context.Context, id storj.NodeID) (_ string, er
 	if !ok {
 		return "", Error.New("ID %v not found in trusted list", id)
 	}
+	if info.nodeURL.Address == "" {
+		return "", Error.New("address of satellite %v is not configured", id)
+	}
 	return info.nodeURL.Address, nil
 }
@@ -15,6 +15,9 @@ import (
 	"storj.io/storj/internal/errs2"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/trust"
 )
 
 func TestGetSignee(t *testing.T) {
@@ -27,9 +30,6 @@ func TestGetSignee(t *testing.T) {
 
 	planet.Start(ctx)
 
-	// make sure nodes are refreshed in db
-	planet.Satellites[0].Discovery.Service.Refresh.TriggerWait()
-
 	trust := planet.StorageNodes[0].Storage2.Trust
 
 	canceledContext, cancel := context.WithCancel(ctx)
@@ -89,3 +89,62 @@ func TestGetAddress(t *testing.T) {
 		assert.NoError(t, group.Wait())
 	})
 }
+
+func TestAddAddress(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	configured := storj.NodeURL{ID: testrand.NodeID(), Address: "127.0.0.1:10000"}
+	withoutAddress := storj.NodeURL{ID: testrand.NodeID()}
+	unknown := testrand.NodeID()
+
+	t.Run("restricted", func(t *testing.T) {
+		pool, err := trust.NewPool(nil, false, storj.NodeURLs{configured, withoutAddress})
+		require.NoError(t, err)
+
+		_, err = pool.GetAddress(ctx, withoutAddress.ID)
+		require.Error(t, err)
+
+		pool.AddAddress(ctx, configured.ID, "127.0.0.1:10001")
+		pool.AddAddress(ctx, withoutAddress.ID, "127.0.0.1:10002")
+		pool.AddAddress(ctx, unknown, "127.0.0.1:10003")
+
+		address, err := pool.GetAddress(ctx, configured.ID)
+		require.NoError(t, err)
+		assert.Equal(t, configured.Address, address)
+
+		address, err = pool.GetAddress(ctx, withoutAddress.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:10002", address)
+
+		_, err = pool.GetAddress(ctx, unknown)
+		require.Error(t, err)
+		assert.ElementsMatch(t, []storj.NodeID{configured.ID, withoutAddress.ID}, pool.GetSatellites(ctx))
+	})
+
+	t.Run("trust all", func(t *testing.T) {
+		pool, err := trust.NewPool(nil, true, storj.NodeURLs{configured})
+		require.NoError(t, err)
+
+		// the configured satellites are known from the start
+		address, err := pool.GetAddress(ctx, configured.ID)
+		require.NoError(t, err)
+		assert.Equal(t, configured.Address, address)
+
+		_, err = pool.GetAddress(ctx, unknown)
+		require.Error(t, err)
+
+		pool.AddAddress(ctx, unknown, "127.0.0.1:10003")
+		address, err = pool.GetAddress(ctx, unknown)
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:10003", address)
+
+		// a satellite which moved is followed
+		pool.AddAddress(ctx, unknown, "127.0.0.1:10004")
+		address, err = pool.GetAddress(ctx, unknown)
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:10004", address)
+
+		assert.ElementsMatch(t, []storj.NodeID{configured.ID, unknown}, pool.GetSatellites(ctx))
+	})
+}
@@ -21,6 +21,10 @@ import (
 	"storj.io/storj/storage"
 )
 
+// maxBatchSize is the maximum number of requests the satellite accepts in a
+// single batch
+const maxBatchSize = 100
+
 var (
 	mon = monkit.Package()
 
@@ -41,6 +45,47 @@ type ListItem struct {
 	IsPrefix bool
 }
 
+// ObjectVersionItem is a single version of an object
+type ObjectVersionItem struct {
+	VersionID      string
+	IsLatest       bool
+	IsDeleteMarker bool
+	Pointer        *pb.Pointer
+}
+
+// Object is a committed object
+type Object struct {
+	Bucket            string
+	EncryptedPath     storj.Path
+	VersionID         string
+	EncryptedMetadata []byte
+	CreatedAt         time.Time
+	ExpiresAt         time.Time
+}
+
+// ObjectListItem is a single object or prefix in a listing
+type ObjectListItem struct {
+	EncryptedPath     storj.Path
+	EncryptedMetadata []byte
+	CreatedAt         time.Time
+	ExpiresAt         time.Time
+	IsPrefix          bool
+}
+
+// DeletedSegment contains the order limits for deleting the pieces of a segment
+type DeletedSegment struct {
+	Limits          []*pb.AddressedOrderLimit
+	PiecePrivateKey storj.PiecePrivateKey
+}
+
+// PackedObject is an object stored in a range of a packed remote segment
+type PackedObject struct {
+	EncryptedPath     storj.Path
+	Offset            int64
+	Length            int64
+	EncryptedMetadata []byte
+}
+
 // New used as a public function
 func New(client pb.MetainfoClient) *Client {
 	return &Client{
@@ -114,11 +159,19 @@ func (client *Client) CommitSegment(ctx context.Context, bucket string, path sto
 // SegmentInfo requests the pointer of a segment
 func (client *Client) SegmentInfo(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) (pointer *pb.Pointer, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return client.SegmentInfoVersion(ctx, bucket, path, segmentIndex, "")
+}
+
+// SegmentInfoVersion requests the pointer of a segment of an object version,
+// an empty version id refers to the current version
+func (client *Client) SegmentInfoVersion(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, versionID string) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	response, err := client.client.SegmentInfoOld(ctx, &pb.SegmentInfoRequestOld{
-		Bucket:  []byte(bucket),
-		Path:    []byte(path),
-		Segment: segmentIndex,
+		Bucket:    []byte(bucket),
+		Path:      []byte(path),
+		Segment:   segmentIndex,
+		VersionId: versionID,
 	})
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -133,11 +186,19 @@ func (client *Client) SegmentInfo(ctx context.Context, bucket string, path storj
 // ReadSegment requests the order limits for reading a segment
 func (client *Client) ReadSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) (pointer *pb.Pointer, limits []*pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return client.ReadSegmentVersion(ctx, bucket, path, segmentIndex, "")
+}
+
+// ReadSegmentVersion requests the order limits for reading a segment of an
+// object version, an empty version id refers to the current version
+func (client *Client) ReadSegmentVersion(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, versionID string) (pointer *pb.Pointer, limits []*pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	response, err := client.client.DownloadSegmentOld(ctx, &pb.SegmentDownloadRequestOld{
-		Bucket:  []byte(bucket),
-		Path:    []byte(path),
-		Segment: segmentIndex,
+		Bucket:    []byte(bucket),
+		Path:      []byte(path),
+		Segment:   segmentIndex,
+		VersionId: versionID,
 	})
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -186,6 +247,112 @@ func (client *Client) DeleteSegment(ctx context.Context, bucket string, path sto
 	return response.GetAddressedLimits(), response.PrivateKey, nil
 }
 
+// CopyObject copies the object at path to newPath, segments contain the metadata re-encrypted for newPath.
+// An existing object at newPath is replaced when replace is set, the order limits for deleting the pieces
+// of the replaced object are returned
+func (client *Client) CopyObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, segments []*pb.ObjectSegmentMetadata, replace bool) (deleted []DeletedSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.CopyObject(ctx, &pb.ObjectCopyRequest{
+		Bucket:           []byte(bucket),
+		EncryptedPath:    []byte(path),
+		NewBucket:        []byte(newBucket),
+		NewEncryptedPath: []byte(newPath),
+		Segments:         segments,
+		Replace:          replace,
+	})
+	if err != nil {
+		return nil, convertRelocateError(err)
+	}
+	return convertDeletedSegments(response.GetDeletedSegments()), nil
+}
+
+// MoveObject moves the object at path to newPath, segments contain the metadata re-encrypted for newPath
+func (client *Client) MoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, segments []*pb.ObjectSegmentMetadata) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.MoveObject(ctx, &pb.ObjectMoveRequest{
+		Bucket:           []byte(bucket),
+		EncryptedPath:    []byte(path),
+		NewBucket:        []byte(newBucket),
+		NewEncryptedPath: []byte(newPath),
+		Segments:         segments,
+	})
+	return convertRelocateError(err)
+}
+
+// ListObjectVersions lists the versions of an object, starting with the newest one
+func (client *Client) ListObjectVersions(ctx context.Context, bucket string, path storj.Path) (items []ObjectVersionItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.ListObjectVersions(ctx, &pb.ObjectListVersionsRequest{
+		Bucket:        []byte(bucket),
+		EncryptedPath: []byte(path),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list := response.GetItems()
+	items = make([]ObjectVersionItem, len(list))
+	for i, item := range list {
+		items[i] = ObjectVersionItem{
+			VersionID:      item.GetVersionId(),
+			IsLatest:       item.GetIsLatest(),
+			IsDeleteMarker: item.GetDeleteMarker(),
+			Pointer:        item.GetPointer(),
+		}
+	}
+
+	return items, nil
+}
+
+// DeleteObjectVersion permanently deletes a version of an object and returns
+// the segments whose pieces have to be deleted
+func (client *Client) DeleteObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (deleted []DeletedSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.DeleteObjectVersion(ctx, &pb.ObjectDeleteVersionRequest{
+		Bucket:        []byte(bucket),
+		EncryptedPath: []byte(path),
+		VersionId:     versionID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return convertDeletedSegments(response.GetDeletedSegments()), nil
+}
+
+// CreateDeleteMarker marks an object in a versioned bucket as deleted
+func (client *Client) CreateDeleteMarker(ctx context.Context, bucket string, path storj.Path) (versionID string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.CreateDeleteMarker(ctx, &pb.ObjectCreateDeleteMarkerRequest{
+		Bucket:        []byte(bucket),
+		EncryptedPath: []byte(path),
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return response.GetVersionId(), nil
+}
+
+func convertRelocateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return storage.ErrKeyNotFound.Wrap(err)
+	case codes.AlreadyExists:
+		return storj.ErrObjectAlreadyExists.Wrap(err)
+	}
+	return Error.Wrap(err)
+}
+
 // ListSegments lists the available segments
 func (client *Client) ListSegments(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int32, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -216,6 +383,319 @@ func (client *Client) ListSegments(ctx context.Context, bucket string, prefix, s
 	return items, response.GetMore(), nil
 }
 
+// BeginObject starts the upload of an object, it stays invisible until it is committed
+func (client *Client) BeginObject(ctx context.Context, bucket string, path storj.Path, redundancy *pb.RedundancyScheme, expiration time.Time) (streamID storj.StreamID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.BeginObject(ctx, &pb.ObjectBeginRequest{
+		Bucket:           []byte(bucket),
+		EncryptedPath:    []byte(path),
+		RedundancyScheme: redundancy,
+		ExpiresAt:        expiration,
+	})
+	if err != nil {
+		return storj.StreamID{}, Error.Wrap(err)
+	}
+
+	return response.StreamId, nil
+}
+
+// BeginSegment requests the order limits to upload a remote segment of a pending object
+func (client *Client) BeginSegment(ctx context.Context, bucket string, path storj.Path, streamID storj.StreamID, segmentIndex int64, maxEncryptedSegmentSize int64) (limits []*pb.AddressedOrderLimit, rootPieceID storj.PieceID, piecePrivateKey storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.BeginSegment(ctx, &pb.SegmentBeginRequest{
+		Bucket:                  []byte(bucket),
+		EncryptedPath:           []byte(path),
+		StreamId:                streamID,
+		SegmentIndex:            segmentIndex,
+		MaxEncryptedSegmentSize: maxEncryptedSegmentSize,
+	})
+	if err != nil {
+		return nil, rootPieceID, piecePrivateKey, Error.Wrap(err)
+	}
+
+	return response.GetAddressedLimits(), response.RootPieceId, response.PrivateKey, nil
+}
+
+// CommitSegmentNew commits a segment of a pending object
+func (client *Client) CommitSegmentNew(ctx context.Context, bucket string, path storj.Path, streamID storj.StreamID, segmentIndex int64, pointer *pb.Pointer, originalLimits []*pb.OrderLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.CommitSegment(ctx, &pb.SegmentCommitRequest{
+		Bucket:         []byte(bucket),
+		EncryptedPath:  []byte(path),
+		StreamId:       streamID,
+		SegmentIndex:   segmentIndex,
+		Pointer:        pointer,
+		OriginalLimits: originalLimits,
+	})
+	return Error.Wrap(err)
+}
+
+// CommitObject makes the committed segments of a pending object visible as the object,
+// the order limits for deleting the pieces of the replaced object are returned
+func (client *Client) CommitObject(ctx context.Context, bucket string, path storj.Path, streamID storj.StreamID, segmentCount int64, metadata []byte) (object Object, deleted []DeletedSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.CommitObject(ctx, &pb.ObjectCommitRequest{
+		Bucket:            []byte(bucket),
+		EncryptedPath:     []byte(path),
+		StreamId:          streamID,
+		SegmentCount:      segmentCount,
+		EncryptedMetadata: metadata,
+	})
+	if err != nil {
+		return Object{}, nil, Error.Wrap(err)
+	}
+
+	return convertProtoToObject(response.GetObject()), convertDeletedSegments(response.GetDeletedSegments()), nil
+}
+
+// GetObject returns the current version of an object
+func (client *Client) GetObject(ctx context.Context, bucket string, path storj.Path) (object Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return client.GetObjectVersion(ctx, bucket, path, "")
+}
+
+// GetObjectVersion returns the given version of an object
+func (client *Client) GetObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (object Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.GetObject(ctx, &pb.ObjectGetRequest{
+		Bucket:        []byte(bucket),
+		EncryptedPath: []byte(path),
+		VersionId:     versionID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return Object{}, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Object{}, Error.Wrap(err)
+	}
+
+	return convertProtoToObject(response.GetObject()), nil
+}
+
+// ListObjects lists the committed objects of a bucket below the given prefix
+func (client *Client) ListObjects(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int32, metaFlags uint32) (items []ObjectListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.ListObjects(ctx, &pb.ObjectListRequest{
+		Bucket:              []byte(bucket),
+		EncryptedPrefix:     []byte(prefix),
+		EncryptedStartAfter: []byte(startAfter),
+		EncryptedEndBefore:  []byte(endBefore),
+		Recursive:           recursive,
+		Limit:               limit,
+		MetaFlags:           metaFlags,
+	})
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	list := response.GetItems()
+	items = make([]ObjectListItem, len(list))
+	for i, item := range list {
+		items[i] = ObjectListItem{
+			EncryptedPath:     storj.Path(item.GetEncryptedPath()),
+			EncryptedMetadata: item.GetEncryptedMetadata(),
+			CreatedAt:         item.GetCreatedAt(),
+			ExpiresAt:         item.GetExpiresAt(),
+			IsPrefix:          item.GetIsPrefix(),
+		}
+	}
+
+	return items, response.GetMore(), nil
+}
+
+// Batch sends several requests to the satellite in a single call, the
+// responses are returned in the order of the requests. When a request fails,
+// the responses of the requests before it are returned along with the error.
+func (client *Client) Batch(ctx context.Context, requests ...*pb.BatchRequestItem) (responses []*pb.BatchResponseItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := client.client.Batch(ctx, &pb.BatchRequest{
+		Requests: requests,
+	})
+	if err != nil {
+		return nil, convertBatchError(err)
+	}
+
+	responses = response.GetResponses()
+	if batchErr := response.GetError(); batchErr != nil {
+		return responses, convertBatchError(status.Error(codes.Code(batchErr.Code), batchErr.Message))
+	}
+	if len(responses) != len(requests) {
+		return nil, Error.New("expected %d responses, got %d", len(requests), len(responses))
+	}
+	return responses, nil
+}
+
+// convertBatchError converts the error of a batch request
+func convertBatchError(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return storage.ErrKeyNotFound.Wrap(err)
+	}
+	return Error.Wrap(err)
+}
+
+// PutInlineObject uploads an object that consists of a single inline segment
+// with a single call, the order limits for deleting the pieces of the replaced
+// object are returned
+func (client *Client) PutInlineObject(ctx context.Context, bucket string, path storj.Path, redundancy *pb.RedundancyScheme, expiration time.Time, data []byte, metadata []byte) (object Object, deleted []DeletedSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	responses, err := client.Batch(ctx,
+		&pb.BatchRequestItem{
+			Request: &pb.BatchRequestItem_ObjectBegin{
+				ObjectBegin: &pb.ObjectBeginRequest{
+					Bucket:           []byte(bucket),
+					EncryptedPath:    []byte(path),
+					RedundancyScheme: redundancy,
+					ExpiresAt:        expiration,
+				},
+			},
+		},
+		&pb.BatchRequestItem{
+			Request: &pb.BatchRequestItem_SegmentCommit{
+				SegmentCommit: &pb.SegmentCommitRequest{
+					Bucket:        []byte(bucket),
+					EncryptedPath: []byte(path),
+					SegmentIndex:  0,
+					Pointer: &pb.Pointer{
+						CreationDate:   time.Now(),
+						Type:           pb.Pointer_INLINE,
+						InlineSegment:  data,
+						SegmentSize:    int64(len(data)),
+						ExpirationDate: expiration,
+					},
+				},
+			},
+		},
+		&pb.BatchRequestItem{
+			Request: &pb.BatchRequestItem_ObjectCommit{
+				ObjectCommit: &pb.ObjectCommitRequest{
+					Bucket:            []byte(bucket),
+					EncryptedPath:     []byte(path),
+					SegmentCount:      1,
+					EncryptedMetadata: metadata,
+				},
+			},
+		},
+	)
+	if err != nil {
+		return Object{}, nil, err
+	}
+
+	objectCommit := responses[2].GetObjectCommit()
+	return convertProtoToObject(objectCommit.GetObject()), convertDeletedSegments(objectCommit.GetDeletedSegments()), nil
+}
+
+// DeleteSegments requests the order limits for deleting several segments of
+// an object, they are sent in as few calls as the batch size allows. On
+// failure the limits of the segments deleted so far are returned with the
+// error
+func (client *Client) DeleteSegments(ctx context.Context, bucket string, path storj.Path, segmentIndexes []int64) (segments []DeletedSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segments = make([]DeletedSegment, 0, len(segmentIndexes))
+	for len(segmentIndexes) > 0 {
+		batch := segmentIndexes
+		if len(batch) > maxBatchSize {
+			batch = batch[:maxBatchSize]
+		}
+		segmentIndexes = segmentIndexes[len(batch):]
+
+		requests := make([]*pb.BatchRequestItem, len(batch))
+		for i, segmentIndex := range batch {
+			requests[i] = &pb.BatchRequestItem{
+				Request: &pb.BatchRequestItem_SegmentDelete{
+					SegmentDelete: &pb.SegmentDeleteRequestOld{
+						Bucket:  []byte(bucket),
+						Path:    []byte(path),
+						Segment: segmentIndex,
+					},
+				},
+			}
+		}
+
+		// the segments deleted before a failure are returned, so their pieces
+		// can still be deleted
+		responses, err := client.Batch(ctx, requests...)
+		for _, response := range responses {
+			segmentDelete := response.GetSegmentDelete()
+			if segmentDelete == nil {
+				return segments, Error.New("unexpected response %T", response.Response)
+			}
+			segments = append(segments, convertDeletedSegments([]*pb.SegmentDeleteResponseOld{segmentDelete})...)
+		}
+		if err != nil {
+			return segments, err
+		}
+	}
+
+	return segments, nil
+}
+
+// CommitPackedObjects commits a remote segment that holds the data of several
+// small objects, each of them becomes visible with its range of the segment.
+// The order limits for deleting the pieces of the replaced objects are returned
+func (client *Client) CommitPackedObjects(ctx context.Context, bucket string, pointer *pb.Pointer, originalLimits []*pb.OrderLimit, objects []PackedObject) (committed []Object, deleted []DeletedSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	packed := make([]*pb.PackedObject, len(objects))
+	for i, object := range objects {
+		packed[i] = &pb.PackedObject{
+			EncryptedPath:     []byte(object.EncryptedPath),
+			Offset:            object.Offset,
+			Length:            object.Length,
+			EncryptedMetadata: object.EncryptedMetadata,
+		}
+	}
+
+	response, err := client.client.CommitPackedObjects(ctx, &pb.PackedObjectsCommitRequest{
+		Bucket:         []byte(bucket),
+		Pointer:        pointer,
+		OriginalLimits: originalLimits,
+		Objects:        packed,
+	})
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	committed = make([]Object, len(response.GetObjects()))
+	for i, object := range response.GetObjects() {
+		committed[i] = convertProtoToObject(object)
+	}
+	return committed, convertDeletedSegments(response.GetDeletedSegments()), nil
+}
+
+func convertDeletedSegments(responses []*pb.SegmentDeleteResponseOld) []DeletedSegment {
+	deleted := make([]DeletedSegment, len(responses))
+	for i, response := range responses {
+		deleted[i] = DeletedSegment{
+			Limits:          response.GetAddressedLimits(),
+			PiecePrivateKey: response.PrivateKey,
+		}
+	}
+	return deleted
+}
+
+func convertProtoToObject(object *pb.Object) Object {
+	if object == nil {
+		return Object{}
+	}
+	return Object{
+		Bucket:            string(object.Bucket),
+		EncryptedPath:     storj.Path(object.EncryptedPath),
+		VersionID:         object.VersionId,
+		EncryptedMetadata: object.EncryptedMetadata,
+		CreatedAt:         object.CreatedAt,
+		ExpiresAt:         object.ExpiresAt,
+	}
+}
+
 // SetAttribution tries to set the attribution information on the bucket.
 func (client *Client) SetAttribution(ctx context.Context, bucket string, partnerID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -273,6 +753,71 @@ func (client *Client) DeleteBucket(ctx context.Context, bucketName string) (err
 	return nil
 }
 
+// SetBucketVersioning enables or disables versioning for a bucket
+func (client *Client) SetBucketVersioning(ctx context.Context, bucketName string, versioning bool) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	resp, err := client.client.SetBucketVersioning(ctx, &pb.BucketSetVersioningRequest{
+		Name:       []byte(bucketName),
+		Versioning: versioning,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storj.Bucket{}, storj.ErrBucketNotFound.Wrap(err)
+		}
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+	return convertProtoToBucket(resp.Bucket), nil
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket, the prefixes of the rules must be encrypted
+func (client *Client) SetBucketLifecycle(ctx context.Context, bucketName string, rules []storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	req := &pb.BucketSetLifecycleRequest{
+		Name: []byte(bucketName),
+	}
+	for _, rule := range rules {
+		req.Rules = append(req.Rules, &pb.LifecycleRule{
+			Id:                        rule.ID,
+			EncryptedPrefix:           []byte(rule.Prefix),
+			ExpirationDays:            int32(rule.ExpirationDays),
+			AbortIncompleteUploadDays: int32(rule.AbortIncompleteUploadDays),
+		})
+	}
+
+	_, err = client.client.SetBucketLifecycle(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storj.ErrBucketNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket with encrypted prefixes
+func (client *Client) GetBucketLifecycle(ctx context.Context, bucketName string) (rules []storj.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+	resp, err := client.client.GetBucketLifecycle(ctx, &pb.BucketGetLifecycleRequest{
+		Name: []byte(bucketName),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storj.ErrBucketNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	for _, rule := range resp.Rules {
+		rules = append(rules, storj.LifecycleRule{
+			ID:                        rule.Id,
+			Prefix:                    storj.Path(rule.EncryptedPrefix),
+			ExpirationDays:            int(rule.ExpirationDays),
+			AbortIncompleteUploadDays: int(rule.AbortIncompleteUploadDays),
+		})
+	}
+	return rules, nil
+}
+
 // ListBuckets lists buckets
 func (client *Client) ListBuckets(ctx context.Context, listOpts storj.BucketListOptions) (_ storj.BucketList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -316,6 +861,7 @@ func convertBucketToProtoRequest(bucket storj.Bucket) pb.BucketCreateRequest {
 			CipherSuite: pb.CipherSuite(bucket.DefaultEncryptionParameters.CipherSuite),
 			BlockSize:   int64(bucket.DefaultEncryptionParameters.BlockSize),
 		},
+		Placement: bucket.Placement,
 	}
 }
 
@@ -339,5 +885,7 @@ func convertProtoToBucket(pbBucket *pb.Bucket) storj.Bucket {
 			CipherSuite: storj.CipherSuite(defaultEP.CipherSuite),
 			BlockSize:   int32(defaultEP.BlockSize),
 		},
+		Versioning: pbBucket.GetVersioning(),
+		Placement:  pbBucket.GetPlacement(),
 	}
 }
@@ -0,0 +1,607 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build linux darwin
+
+package mount
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/fuse"
+	"github.com/hanwen/go-fuse/fuse/nodefs"
+	"github.com/hanwen/go-fuse/fuse/pathfs"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// Error is the default error class for the mount package.
+	Error = errs.Class("mount")
+
+	mon = monkit.Package()
+)
+
+const (
+	fileMode = syscall.S_IFREG | 0644
+	dirMode  = syscall.S_IFDIR | 0755
+)
+
+// FileSystem exposes the objects of a bucket as a file system. Path
+// components are separated by slashes, the prefixes of the objects are
+// shown as directories.
+//
+// As the network has no notion of directories, directories created with
+// Mkdir only exist in memory until objects are written into them.
+type FileSystem struct {
+	pathfs.FileSystem
+
+	ctx    context.Context
+	log    *zap.Logger
+	bucket *uplink.Bucket
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	attrs   map[string]cachedAttr
+	dirs    map[string]struct{}
+	writing map[string]*writeFile
+}
+
+// cachedAttr is an entry of the metadata cache.
+type cachedAttr struct {
+	attr    fuse.Attr
+	expires time.Time
+}
+
+// NewFileSystem returns a file system for the objects of bucket. The
+// metadata of objects and directories is cached for cacheTTL.
+func NewFileSystem(ctx context.Context, log *zap.Logger, bucket *uplink.Bucket, cacheTTL time.Duration) *FileSystem {
+	return &FileSystem{
+		FileSystem: pathfs.NewDefaultFileSystem(),
+
+		ctx:    ctx,
+		log:    log,
+		bucket: bucket,
+		ttl:    cacheTTL,
+
+		attrs:   make(map[string]cachedAttr),
+		dirs:    make(map[string]struct{}),
+		writing: make(map[string]*writeFile),
+	}
+}
+
+// String returns the name of the file system.
+func (fs *FileSystem) String() string {
+	return "storj:" + fs.bucket.Name
+}
+
+// GetAttr returns the attributes of the object or directory name.
+func (fs *FileSystem) GetAttr(name string, context *fuse.Context) (_ *fuse.Attr, status fuse.Status) {
+	ctx := fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	if name == "" {
+		return &fuse.Attr{Mode: dirMode}, fuse.OK
+	}
+
+	fs.mu.Lock()
+	if file, ok := fs.writing[name]; ok {
+		fs.mu.Unlock()
+		attr := &fuse.Attr{}
+		return attr, file.GetAttr(attr)
+	}
+	if _, ok := fs.dirs[name]; ok {
+		fs.mu.Unlock()
+		return &fuse.Attr{Mode: dirMode}, fuse.OK
+	}
+	if cached, ok := fs.attrs[name]; ok && time.Now().Before(cached.expires) {
+		fs.mu.Unlock()
+		attr := cached.attr
+		return &attr, fuse.OK
+	}
+	fs.mu.Unlock()
+
+	object, err := fs.bucket.OpenObject(ctx, name)
+	if err == nil {
+		attr := objectAttr(object.Meta.Size, object.Meta.Modified)
+		fs.cacheAttr(name, attr)
+		return &attr, fuse.OK
+	}
+	if !storj.ErrObjectNotFound.Has(err) {
+		return nil, fs.errorStatus(err)
+	}
+
+	// a name is a directory when there are objects below it
+	list, err := fs.bucket.ListObjects(ctx, &uplink.ListOptions{
+		Prefix:    name,
+		Direction: storj.After,
+		Limit:     1,
+	})
+	if err != nil {
+		return nil, fs.errorStatus(err)
+	}
+	if len(list.Items) == 0 {
+		return nil, fuse.ENOENT
+	}
+
+	attr := fuse.Attr{Mode: dirMode}
+	fs.cacheAttr(name, attr)
+	return &attr, fuse.OK
+}
+
+// OpenDir lists the objects and prefixes directly below the directory name.
+func (fs *FileSystem) OpenDir(name string, context *fuse.Context) (entries []fuse.DirEntry, status fuse.Status) {
+	ctx := fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	seen := make(map[string]bool)
+	opts := &uplink.ListOptions{
+		Prefix:    name,
+		Direction: storj.After,
+	}
+	for {
+		list, err := fs.bucket.ListObjects(ctx, opts)
+		if err != nil {
+			return nil, fs.errorStatus(err)
+		}
+
+		for _, item := range list.Items {
+			// the paths of prefixes end with a slash
+			entryName := strings.TrimSuffix(item.Path, "/")
+			attr := fuse.Attr{Mode: dirMode}
+			if !item.IsPrefix {
+				attr = objectAttr(item.Size, item.Modified)
+			}
+			fs.cacheAttr(joinPath(name, entryName), attr)
+
+			seen[entryName] = true
+			entries = append(entries, fuse.DirEntry{Name: entryName, Mode: attr.Mode})
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			break
+		}
+		opts.Cursor = list.Items[len(list.Items)-1].Path
+	}
+
+	// include the directories and files which don't exist in the network yet
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for dir := range fs.dirs {
+		if entryName, ok := childName(name, dir); ok && !seen[entryName] {
+			seen[entryName] = true
+			entries = append(entries, fuse.DirEntry{Name: entryName, Mode: dirMode})
+		}
+	}
+	for file := range fs.writing {
+		if entryName, ok := childName(name, file); ok && !seen[entryName] {
+			seen[entryName] = true
+			entries = append(entries, fuse.DirEntry{Name: entryName, Mode: fileMode})
+		}
+	}
+
+	if _, ok := fs.dirs[name]; !ok && name != "" && len(entries) == 0 {
+		return nil, fuse.ENOENT
+	}
+	return entries, fuse.OK
+}
+
+// Mkdir creates an empty directory, it exists until it is removed or the
+// file system is unmounted.
+func (fs *FileSystem) Mkdir(name string, mode uint32, context *fuse.Context) fuse.Status {
+	if _, status := fs.GetAttr(name, context); status == fuse.OK {
+		return fuse.Status(syscall.EEXIST)
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.dirs[name] = struct{}{}
+	return fuse.OK
+}
+
+// Rmdir removes the empty directory name.
+func (fs *FileSystem) Rmdir(name string, context *fuse.Context) fuse.Status {
+	entries, status := fs.OpenDir(name, context)
+	if status != fuse.OK {
+		return status
+	}
+
+	if len(entries) > 0 {
+		return fuse.Status(syscall.ENOTEMPTY)
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	delete(fs.dirs, name)
+	fs.invalidate(name)
+	return fuse.OK
+}
+
+// Unlink deletes the object name.
+func (fs *FileSystem) Unlink(name string, context *fuse.Context) (status fuse.Status) {
+	ctx := fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	err := fs.bucket.DeleteObject(ctx, name)
+
+	fs.mu.Lock()
+	fs.invalidate(name)
+	fs.mu.Unlock()
+
+	if err != nil {
+		return fs.errorStatus(err)
+	}
+	return fuse.OK
+}
+
+// Rename moves the object or the objects of the directory oldName to
+// newName. The data of the objects isn't transferred.
+func (fs *FileSystem) Rename(oldName string, newName string, context *fuse.Context) (status fuse.Status) {
+	ctx := fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	attr, status := fs.GetAttr(oldName, context)
+	if status != fuse.OK {
+		return status
+	}
+
+	if attr.IsRegular() {
+		err := fs.bucket.MoveObject(ctx, oldName, fs.bucket.Name, newName)
+
+		fs.mu.Lock()
+		fs.invalidate(oldName)
+		fs.invalidate(newName)
+		fs.mu.Unlock()
+
+		if err != nil {
+			return fs.errorStatus(err)
+		}
+		return fuse.OK
+	}
+
+	// the objects are collected first, as moving them changes the listing
+	var paths []storj.Path
+	opts := &uplink.ListOptions{
+		Prefix:    oldName,
+		Direction: storj.After,
+		Recursive: true,
+	}
+	for {
+		list, err := fs.bucket.ListObjects(ctx, opts)
+		if err != nil {
+			return fs.errorStatus(err)
+		}
+		for _, item := range list.Items {
+			paths = append(paths, item.Path)
+		}
+		if !list.More || len(list.Items) == 0 {
+			break
+		}
+		opts.Cursor = list.Items[len(list.Items)-1].Path
+	}
+
+	defer func() {
+		fs.mu.Lock()
+		defer fs.mu.Unlock()
+
+		if _, ok := fs.dirs[oldName]; ok {
+			delete(fs.dirs, oldName)
+			fs.dirs[newName] = struct{}{}
+		}
+		fs.attrs = make(map[string]cachedAttr)
+	}()
+
+	for _, path := range paths {
+		err := fs.bucket.MoveObject(ctx, joinPath(oldName, path), fs.bucket.Name, joinPath(newName, path))
+		if err != nil {
+			return fs.errorStatus(err)
+		}
+	}
+	return fuse.OK
+}
+
+// Open opens the object name. Objects opened for writing are replaced when
+// the file is closed, appending to or modifying objects isn't supported.
+func (fs *FileSystem) Open(name string, flags uint32, context *fuse.Context) (_ nodefs.File, status fuse.Status) {
+	ctx := fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	if flags&syscall.O_ACCMODE != syscall.O_RDONLY {
+		if flags&syscall.O_TRUNC == 0 {
+			return nil, fuse.Status(syscall.ENOTSUP)
+		}
+		return fs.create(name)
+	}
+
+	object, err := fs.bucket.OpenObject(ctx, name)
+	if err != nil {
+		return nil, fs.errorStatus(err)
+	}
+	return &readFile{
+		File:   nodefs.NewDefaultFile(),
+		fs:     fs,
+		object: object,
+	}, fuse.OK
+}
+
+// Create creates a new object name, it is uploaded while it is written.
+func (fs *FileSystem) Create(name string, flags uint32, mode uint32, context *fuse.Context) (_ nodefs.File, status fuse.Status) {
+	ctx := fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	return fs.create(name)
+}
+
+func (fs *FileSystem) create(name string) (_ nodefs.File, status fuse.Status) {
+	writer, err := fs.bucket.NewWriter(fs.ctx, name, nil)
+	if err != nil {
+		return nil, fs.errorStatus(err)
+	}
+
+	file := &writeFile{
+		File:     nodefs.NewDefaultFile(),
+		fs:       fs,
+		name:     name,
+		writer:   writer,
+		modified: time.Now(),
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.writing[name] = file
+	fs.invalidate(name)
+	return file, fuse.OK
+}
+
+// Truncate only supports truncating the files which are being written.
+func (fs *FileSystem) Truncate(name string, size uint64, context *fuse.Context) fuse.Status {
+	fs.mu.Lock()
+	file, ok := fs.writing[name]
+	fs.mu.Unlock()
+	if !ok {
+		return fuse.ENOSYS
+	}
+	return file.Truncate(size)
+}
+
+// Utimens is accepted, but the times of objects can't be changed.
+func (fs *FileSystem) Utimens(name string, atime *time.Time, mtime *time.Time, context *fuse.Context) fuse.Status {
+	return fuse.OK
+}
+
+// cacheAttr adds the attributes of name to the metadata cache.
+func (fs *FileSystem) cacheAttr(name string, attr fuse.Attr) {
+	if fs.ttl <= 0 {
+		return
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.attrs[name] = cachedAttr{attr: attr, expires: time.Now().Add(fs.ttl)}
+}
+
+// invalidate removes name and its parent directories from the metadata
+// cache. It must be called with fs.mu held.
+func (fs *FileSystem) invalidate(name string) {
+	for {
+		delete(fs.attrs, name)
+		i := strings.LastIndexByte(name, '/')
+		if i < 0 {
+			return
+		}
+		name = name[:i]
+	}
+}
+
+// errorStatus converts an error of the uplink library to a fuse status.
+func (fs *FileSystem) errorStatus(err error) fuse.Status {
+	switch {
+	case storj.ErrObjectNotFound.Has(err), storj.ErrBucketNotFound.Has(err):
+		return fuse.ENOENT
+	case storj.ErrNoPath.Has(err):
+		return fuse.EINVAL
+	default:
+		fs.log.Error("file system operation failed", zap.Error(err))
+		return fuse.EIO
+	}
+}
+
+// readFile streams the data of an object, consecutive reads reuse the
+// download.
+type readFile struct {
+	nodefs.File
+
+	fs     *FileSystem
+	object *uplink.Object
+
+	mu     sync.Mutex
+	reader io.ReadCloser
+	offset int64
+}
+
+// Read reads the data of the object at offset off.
+func (file *readFile) Read(dest []byte, off int64) (_ fuse.ReadResult, status fuse.Status) {
+	ctx := file.fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	file.mu.Lock()
+	defer file.mu.Unlock()
+
+	if off >= file.object.Meta.Size {
+		return fuse.ReadResultData(nil), fuse.OK
+	}
+
+	if file.reader == nil || file.offset != off {
+		if file.reader != nil {
+			if err := file.reader.Close(); err != nil {
+				file.fs.log.Debug("unable to close download", zap.Error(err))
+			}
+		}
+
+		reader, err := file.object.DownloadRange(ctx, off, -1)
+		if err != nil {
+			file.reader = nil
+			return nil, file.fs.errorStatus(err)
+		}
+		file.reader, file.offset = reader, off
+	}
+
+	n, err := io.ReadFull(file.reader, dest)
+	file.offset += int64(n)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, file.fs.errorStatus(err)
+	}
+	return fuse.ReadResultData(dest[:n]), fuse.OK
+}
+
+// GetAttr returns the attributes of the object.
+func (file *readFile) GetAttr(out *fuse.Attr) fuse.Status {
+	*out = objectAttr(file.object.Meta.Size, file.object.Meta.Modified)
+	return fuse.OK
+}
+
+// Release closes the download.
+func (file *readFile) Release() {
+	file.mu.Lock()
+	defer file.mu.Unlock()
+
+	if file.reader != nil {
+		if err := file.reader.Close(); err != nil {
+			file.fs.log.Debug("unable to close download", zap.Error(err))
+		}
+		file.reader = nil
+	}
+}
+
+// writeFile uploads the data written to it, it is committed when the file
+// is closed. Only sequential writes are supported.
+type writeFile struct {
+	nodefs.File
+
+	fs   *FileSystem
+	name string
+
+	mu       sync.Mutex
+	writer   io.WriteCloser
+	offset   int64
+	modified time.Time
+	closed   bool
+	err      error
+}
+
+// Write writes data at offset off, which must be the end of the file.
+func (file *writeFile) Write(data []byte, off int64) (written uint32, status fuse.Status) {
+	ctx := file.fs.ctx
+	defer mon.Task()(&ctx)(nil)
+
+	file.mu.Lock()
+	defer file.mu.Unlock()
+
+	if file.closed {
+		return 0, fuse.EBADF
+	}
+	if off != file.offset {
+		return 0, fuse.Status(syscall.ESPIPE)
+	}
+
+	n, err := file.writer.Write(data)
+	file.offset += int64(n)
+	file.modified = time.Now()
+	if err != nil {
+		return uint32(n), file.fs.errorStatus(err)
+	}
+	return uint32(n), fuse.OK
+}
+
+// Truncate only supports truncating to the current size.
+func (file *writeFile) Truncate(size uint64) fuse.Status {
+	file.mu.Lock()
+	defer file.mu.Unlock()
+
+	if int64(size) != file.offset {
+		return fuse.ENOSYS
+	}
+	return fuse.OK
+}
+
+// GetAttr returns the attributes of the data written so far.
+func (file *writeFile) GetAttr(out *fuse.Attr) fuse.Status {
+	file.mu.Lock()
+	defer file.mu.Unlock()
+
+	*out = objectAttr(file.offset, file.modified)
+	return fuse.OK
+}
+
+// Flush commits the object when the file is closed the first time.
+func (file *writeFile) Flush() fuse.Status {
+	file.mu.Lock()
+	defer file.mu.Unlock()
+
+	if !file.closed {
+		file.closed = true
+		file.err = file.writer.Close()
+
+		file.fs.mu.Lock()
+		if file.fs.writing[file.name] == file {
+			delete(file.fs.writing, file.name)
+		}
+		file.fs.invalidate(file.name)
+		file.fs.mu.Unlock()
+	}
+
+	if file.err != nil {
+		return file.fs.errorStatus(file.err)
+	}
+	return fuse.OK
+}
+
+// Release commits the object, if it wasn't flushed.
+func (file *writeFile) Release() {
+	_ = file.Flush()
+}
+
+// Fsync is a no-op, objects can only be committed once.
+func (file *writeFile) Fsync(flags int) fuse.Status {
+	return fuse.OK
+}
+
+func objectAttr(size int64, modified time.Time) fuse.Attr {
+	attr := fuse.Attr{
+		Mode: fileMode,
+		Size: uint64(size),
+	}
+	attr.SetTimes(nil, &modified, &modified)
+	return attr
+}
+
+// joinPath joins a directory and the path of an entry in it.
+func joinPath(dir, name string) string {
+	if dir == "" {
+		return name
+	}
+	return dir + "/" + name
+}
+
+// childName returns the name of the entry of dir which contains path.
+func childName(dir, path string) (string, bool) {
+	if dir != "" {
+		if !strings.HasPrefix(path, dir+"/") {
+			return "", false
+		}
+		path = path[len(dir)+1:]
+	}
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		path = path[:i]
+	}
+	return path, path != ""
+}
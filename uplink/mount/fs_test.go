@@ -0,0 +1,260 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build linux darwin
+
+package mount_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/fuse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/uplink/mount"
+)
+
+func TestFileSystem(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		data := testrand.Bytes(10 * memory.KiB)
+		err := upl.Upload(ctx, satellite, "testbucket", "dir/existing", data)
+		require.NoError(t, err)
+
+		bucket, cleanup := openBucket(t, ctx, planet)
+		defer ctx.Check(cleanup)
+		fs := mount.NewFileSystem(ctx, zaptest.NewLogger(t), bucket, 0)
+
+		// reading objects
+		attr, status := fs.GetAttr("dir/existing", nil)
+		require.Equal(t, fuse.OK, status)
+		assert.True(t, attr.IsRegular())
+		assert.Equal(t, uint64(len(data)), attr.Size)
+
+		attr, status = fs.GetAttr("dir", nil)
+		require.Equal(t, fuse.OK, status)
+		assert.True(t, attr.IsDir())
+
+		_, status = fs.GetAttr("missing", nil)
+		assert.Equal(t, fuse.ENOENT, status)
+
+		file, status := fs.Open("dir/existing", uint32(os.O_RDONLY), nil)
+		require.Equal(t, fuse.OK, status)
+		for _, off := range []int64{100, 200, 0, int64(len(data)) - 10} {
+			result, status := file.Read(make([]byte, 100), off)
+			require.Equal(t, fuse.OK, status)
+			read, status := result.Bytes(nil)
+			require.Equal(t, fuse.OK, status)
+
+			end := off + 100
+			if end > int64(len(data)) {
+				end = int64(len(data))
+			}
+			assert.Equal(t, data[off:end], read)
+		}
+		file.Release()
+
+		// writing objects
+		file, status = fs.Create("dir/new", uint32(os.O_WRONLY|os.O_CREATE), 0644, nil)
+		require.Equal(t, fuse.OK, status)
+		_, status = file.Write([]byte("hello "), 0)
+		require.Equal(t, fuse.OK, status)
+		_, status = file.Write([]byte("world"), 10)
+		assert.Equal(t, fuse.Status(syscall.ESPIPE), status)
+		_, status = file.Write([]byte("world"), 6)
+		require.Equal(t, fuse.OK, status)
+		require.Equal(t, fuse.OK, file.Flush())
+		file.Release()
+
+		downloaded, err := upl.Download(ctx, satellite, "testbucket", "dir/new")
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(downloaded))
+
+		_, status = fs.Open("dir/new", uint32(os.O_WRONLY|os.O_APPEND), nil)
+		assert.Equal(t, fuse.Status(syscall.ENOTSUP), status)
+
+		// listing directories
+		require.Equal(t, fuse.OK, fs.Mkdir("empty", 0755, nil))
+		assert.Equal(t, fuse.Status(syscall.EEXIST), fs.Mkdir("dir", 0755, nil))
+		assert.Equal(t, []string{"dir", "empty"}, listNames(t, fs, ""))
+		assert.Equal(t, []string{"existing", "new"}, listNames(t, fs, "dir"))
+		assert.Empty(t, listNames(t, fs, "empty"))
+
+		assert.Equal(t, fuse.Status(syscall.ENOTEMPTY), fs.Rmdir("dir", nil))
+		require.Equal(t, fuse.OK, fs.Rmdir("empty", nil))
+		_, status = fs.GetAttr("empty", nil)
+		assert.Equal(t, fuse.ENOENT, status)
+
+		// renaming and deleting
+		require.Equal(t, fuse.OK, fs.Rename("dir/new", "dir/renamed", nil))
+		assert.Equal(t, []string{"existing", "renamed"}, listNames(t, fs, "dir"))
+
+		require.Equal(t, fuse.OK, fs.Rename("dir", "moved", nil))
+		assert.Equal(t, []string{"moved"}, listNames(t, fs, ""))
+		assert.Equal(t, []string{"existing", "renamed"}, listNames(t, fs, "moved"))
+
+		require.Equal(t, fuse.OK, fs.Unlink("moved/renamed", nil))
+		assert.Equal(t, fuse.ENOENT, fs.Unlink("moved/renamed", nil))
+		assert.Equal(t, []string{"existing"}, listNames(t, fs, "moved"))
+	})
+}
+
+func TestFileSystemCache(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		err := upl.Upload(ctx, satellite, "testbucket", "cached", []byte("data"))
+		require.NoError(t, err)
+
+		bucket, cleanup := openBucket(t, ctx, planet)
+		defer ctx.Check(cleanup)
+		cached := mount.NewFileSystem(ctx, zaptest.NewLogger(t), bucket, time.Hour)
+		uncached := mount.NewFileSystem(ctx, zaptest.NewLogger(t), bucket, 0)
+
+		for _, fs := range []*mount.FileSystem{cached, uncached} {
+			_, status := fs.GetAttr("cached", nil)
+			require.Equal(t, fuse.OK, status)
+		}
+
+		err = upl.Delete(ctx, satellite, "testbucket", "cached")
+		require.NoError(t, err)
+
+		_, status := cached.GetAttr("cached", nil)
+		assert.Equal(t, fuse.OK, status)
+		_, status = uncached.GetAttr("cached", nil)
+		assert.Equal(t, fuse.ENOENT, status)
+
+		// changes made through the file system invalidate the cache, even
+		// when they fail
+		assert.Equal(t, fuse.ENOENT, cached.Unlink("cached", nil))
+		_, status = cached.GetAttr("cached", nil)
+		assert.Equal(t, fuse.ENOENT, status)
+	})
+}
+
+func TestMount(t *testing.T) {
+	if _, err := exec.LookPath("fusermount"); err != nil {
+		t.Skip("fusermount is not available")
+	}
+	if _, err := os.Stat("/dev/fuse"); err != nil {
+		t.Skip("fuse is not available")
+	}
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		err := upl.Upload(ctx, satellite, "testbucket", "dir/existing", []byte("existing"))
+		require.NoError(t, err)
+
+		bucket, cleanup := openBucket(t, ctx, planet)
+		defer ctx.Check(cleanup)
+		fs := mount.NewFileSystem(ctx, zaptest.NewLogger(t), bucket, 0)
+
+		mountpoint := ctx.Dir("mountpoint")
+		mountCtx, unmount := context.WithCancel(ctx)
+		mounted := make(chan error, 1)
+		go func() { mounted <- mount.Mount(mountCtx, fs, mountpoint, false) }()
+		defer func() {
+			unmount()
+			require.NoError(t, <-mounted)
+		}()
+
+		// wait until the bucket is visible
+		existing := filepath.Join(mountpoint, "dir", "existing")
+		for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+			if _, err := os.Stat(existing); err == nil {
+				break
+			}
+			require.True(t, time.Since(start) < 10*time.Second, "mount timed out")
+		}
+
+		content, err := ioutil.ReadFile(existing)
+		require.NoError(t, err)
+		assert.Equal(t, "existing", string(content))
+
+		written := filepath.Join(mountpoint, "dir", "written")
+		require.NoError(t, ioutil.WriteFile(written, []byte("written"), 0644))
+
+		downloaded, err := upl.Download(ctx, satellite, "testbucket", "dir/written")
+		require.NoError(t, err)
+		assert.Equal(t, "written", string(downloaded))
+
+		renamed := filepath.Join(mountpoint, "renamed")
+		require.NoError(t, os.Rename(written, renamed))
+
+		infos, err := ioutil.ReadDir(mountpoint)
+		require.NoError(t, err)
+		var names []string
+		for _, info := range infos {
+			names = append(names, info.Name())
+		}
+		assert.Equal(t, []string{"dir", "renamed"}, names)
+
+		require.NoError(t, os.Remove(renamed))
+		_, err = os.Stat(renamed)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+// openBucket opens the bucket testbucket with the encryption used by the
+// uplinks of testplanet.
+func openBucket(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) (*uplink.Bucket, func() error) {
+	satellite := planet.Satellites[0]
+
+	config := uplink.Config{}
+	config.Volatile.TLS.SkipPeerCAWhitelist = true
+	up, err := uplink.NewUplink(ctx, &config)
+	require.NoError(t, err)
+
+	apiKey, err := uplink.ParseAPIKey(planet.Uplinks[0].APIKey[satellite.ID()])
+	require.NoError(t, err)
+
+	project, err := up.OpenProject(ctx, satellite.Addr(), apiKey)
+	require.NoError(t, err)
+
+	bucket, err := project.OpenBucket(ctx, "testbucket", uplink.NewEncryptionAccessWithDefaultKey(storj.Key{}))
+	require.NoError(t, err)
+
+	return bucket, func() error {
+		return errs.Combine(bucket.Close(), project.Close(), up.Close())
+	}
+}
+
+func listNames(t *testing.T, fs *mount.FileSystem, dir string) []string {
+	entries, status := fs.OpenDir(dir, nil)
+	require.Equal(t, fuse.OK, status)
+
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build linux darwin
+
+package mount
+
+import (
+	"context"
+
+	"github.com/hanwen/go-fuse/fuse/nodefs"
+	"github.com/hanwen/go-fuse/fuse/pathfs"
+	"github.com/zeebo/errs"
+)
+
+// Mount mounts the file system at mountpoint and serves it until ctx is
+// canceled or the file system is unmounted externally, e.g. with
+// fusermount -u.
+func Mount(ctx context.Context, fs *FileSystem, mountpoint string, debug bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	opts := nodefs.NewOptions()
+	// the kernel caches the metadata as long as the file system does
+	opts.AttrTimeout = fs.ttl
+	opts.EntryTimeout = fs.ttl
+	opts.Debug = debug
+
+	nodeFs := pathfs.NewPathNodeFs(fs, nil)
+	server, _, err := nodefs.MountRoot(mountpoint, nodeFs.Root(), opts)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		server.Serve()
+	}()
+
+	if err := server.WaitMount(); err != nil {
+		return Error.Wrap(errs.Combine(err, server.Unmount()))
+	}
+
+	select {
+	case <-ctx.Done():
+		err = server.Unmount()
+		<-served
+		return Error.Wrap(err)
+	case <-served:
+		return nil
+	}
+}
@@ -27,6 +27,10 @@ type Config struct {
 
 	InitialStep int64
 	MaximumStep int64
+
+	// DownloadRetries is how many times a download is continued after the
+	// connection to the storage node dropped.
+	DownloadRetries int
 }
 
 // DefaultConfig are the default params used for upload and download.
@@ -36,6 +40,8 @@ var DefaultConfig = Config{
 
 	InitialStep: 64 * memory.KiB.Int64(),
 	MaximumStep: 1 * memory.MiB.Int64(),
+
+	DownloadRetries: 3,
 }
 
 // Client implements uploading, downloading and deleting content from a piecestore.
@@ -77,6 +83,13 @@ func (client *Client) Retain(ctx context.Context, req *pb.RetainRequest) (err er
 	return Error.Wrap(err)
 }
 
+// RestoreTrash asks the piece store to move all pieces from the trash back into storage.
+func (client *Client) RestoreTrash(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = client.client.RestoreTrash(ctx, &pb.RestoreTrashRequest{})
+	return Error.Wrap(err)
+}
+
 // Close closes the underlying connection.
 func (client *Client) Close() error {
 	return client.conn.Close()
@@ -9,6 +9,9 @@ import (
 	"io"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/identity"
@@ -32,10 +35,14 @@ type Download struct {
 	stream     pb.Piecestore_DownloadClient
 	ctx        context.Context
 
+	offset       int64 // where does the download start in the piece
 	read         int64 // how much data we have read so far
 	allocated    int64 // how far have we sent orders
+	ordered      int64 // the amount of the largest order we have sent
 	downloaded   int64 // how much data have we downloaded
 	downloadSize int64 // how much do we want to download
+	retries      int   // how many times has the download been continued
+	lost         int64 // how much ordered data was lost with dropped connections
 
 	// what is the step we consider to upload
 	allocationStep int64
@@ -47,30 +54,11 @@ type Download struct {
 func (client *Client) Download(ctx context.Context, limit *pb.OrderLimit, piecePrivateKey storj.PiecePrivateKey, offset, size int64) (_ Downloader, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	stream, err := client.client.Download(ctx)
+	stream, peer, err := client.openDownload(ctx, limit, offset, size)
 	if err != nil {
 		return nil, err
 	}
 
-	peer, err := identity.PeerIdentityFromContext(stream.Context())
-	if err != nil {
-		closeErr := stream.CloseSend()
-		_, recvErr := stream.Recv()
-		return nil, ErrInternal.Wrap(errs.Combine(err, ignoreEOF(closeErr), ignoreEOF(recvErr)))
-	}
-
-	err = stream.Send(&pb.PieceDownloadRequest{
-		Limit: limit,
-		Chunk: &pb.PieceDownloadRequest_Chunk{
-			Offset:    offset,
-			ChunkSize: size,
-		},
-	})
-	if err != nil {
-		_, recvErr := stream.Recv()
-		return nil, ErrProtocol.Wrap(errs.Combine(err, recvErr))
-	}
-
 	download := &Download{
 		client:     client,
 		limit:      limit,
@@ -79,7 +67,8 @@ func (client *Client) Download(ctx context.Context, limit *pb.OrderLimit, pieceP
 		stream:     stream,
 		ctx:        ctx,
 
-		read: 0,
+		offset: offset,
+		read:   0,
 
 		allocated:    0,
 		downloaded:   0,
@@ -96,6 +85,37 @@ func (client *Client) Download(ctx context.Context, limit *pb.OrderLimit, pieceP
 	}, nil
 }
 
+// openDownload starts a download stream of the chunk of a piece.
+func (client *Client) openDownload(ctx context.Context, limit *pb.OrderLimit, offset, size int64) (_ pb.Piecestore_DownloadClient, _ *identity.PeerIdentity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stream, err := client.client.Download(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	peer, err := identity.PeerIdentityFromContext(stream.Context())
+	if err != nil {
+		closeErr := stream.CloseSend()
+		_, recvErr := stream.Recv()
+		return nil, nil, ErrInternal.Wrap(errs.Combine(err, ignoreEOF(closeErr), ignoreEOF(recvErr)))
+	}
+
+	err = stream.Send(&pb.PieceDownloadRequest{
+		Limit: limit,
+		Chunk: &pb.PieceDownloadRequest_Chunk{
+			Offset:    offset,
+			ChunkSize: size,
+		},
+	})
+	if err != nil {
+		_, recvErr := stream.Recv()
+		return nil, nil, ErrProtocol.Wrap(errs.Combine(err, recvErr))
+	}
+
+	return stream, peer, nil
+}
+
 // Read downloads data from the storage node allocating as necessary.
 func (client *Download) Read(data []byte) (read int, err error) {
 	ctx := client.ctx
@@ -121,9 +141,16 @@ func (client *Download) Read(data []byte) (read int, err error) {
 				newAllocation += client.downloaded - client.allocated
 			}
 
+			// the data lost with a dropped connection has to be ordered again
+			newAllocation += client.lost
+
 			// ensure we don't allocate more than we intend to read
-			if client.allocated+newAllocation > client.downloadSize {
-				newAllocation = client.downloadSize - client.allocated
+			if client.allocated+newAllocation > client.downloadSize+client.lost {
+				newAllocation = client.downloadSize + client.lost - client.allocated
+			}
+			// nor more than the order limit allows
+			if client.allocated+newAllocation > client.limit.Limit {
+				newAllocation = client.limit.Limit - client.allocated
 			}
 
 			// send an order
@@ -136,16 +163,26 @@ func (client *Download) Read(data []byte) (read int, err error) {
 					client.unread.IncludeError(err)
 					return read, nil
 				}
+				client.ordered = order.Amount
 
 				err = client.stream.Send(&pb.PieceDownloadRequest{
 					Order: order,
 				})
-				if err != nil {
+				if err != nil && err != io.EOF {
 					// other side doesn't want to talk to us anymore,
-					// or network went down
+					// or network went down, the order is sent again
+					// after the download continued
+					if client.resumable(err) {
+						err = client.resume()
+						if err == nil {
+							continue
+						}
+					}
 					client.unread.IncludeError(err)
 					return read, nil
 				}
+				// io.EOF means that the stream ended, the reason is
+				// returned when receiving the next chunk
 
 				// update our allocation step
 				client.allocationStep = client.client.nextAllocationStep(client.allocationStep)
@@ -164,6 +201,11 @@ func (client *Download) Read(data []byte) (read int, err error) {
 			client.unread.Fill(response.Chunk.Data)
 		}
 
+		// continue the download when the connection dropped
+		if err != nil && client.resumable(err) {
+			err = client.resume()
+		}
+
 		// we still need to continue until we have actually handled all of the errors
 		client.unread.IncludeError(err)
 	}
@@ -175,6 +217,42 @@ func (client *Download) Read(data []byte) (read int, err error) {
 	return read, nil
 }
 
+// resumable checks whether the download can be continued after the error.
+func (client *Download) resumable(err error) bool {
+	return client.retries < client.client.config.DownloadRetries &&
+		client.ctx.Err() == nil &&
+		status.Code(err) == codes.Unavailable
+}
+
+// resume continues the download with a new stream, which starts where the
+// data that has been received so far ends. The order limit of the download is
+// reused and the orders for the remaining data continue the previous orders.
+// The storage node doesn't send the data it sent before again for free, so the
+// ordered data which hasn't been received is ordered again.
+func (client *Download) resume() (err error) {
+	ctx := client.ctx
+	defer mon.Task()(&ctx)(&err)
+
+	client.retries++
+	_ = client.stream.CloseSend() // the stream is already broken
+
+	if lost := client.ordered - client.downloaded; lost > client.lost {
+		client.lost = lost
+	}
+
+	stream, _, err := client.client.openDownload(ctx, client.limit, client.offset+client.downloaded, client.downloadSize-client.downloaded)
+	if err != nil {
+		return err
+	}
+	client.client.log.Debug("continuing download",
+		zap.Stringer("Piece ID", client.limit.PieceId),
+		zap.Int64("Offset", client.offset+client.downloaded),
+		zap.Int("Retry", client.retries))
+
+	client.stream = stream
+	return nil
+}
+
 // Close closes the downloading.
 func (client *Download) Close() (err error) {
 	defer func() {